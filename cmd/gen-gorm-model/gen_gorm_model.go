@@ -4,20 +4,20 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
-	"strings"
 
-	"github.com/clin211/gin-enterprise-template/pkg/db"
-	"github.com/samber/lo"
 	"github.com/spf13/pflag"
 	"gorm.io/gen"
-	"gorm.io/gen/field"
 	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/gormgen"
 )
 
 // 帮助信息文本.
-const helpText = `Usage: main [flags] arg [arg...]
+const helpText = `Usage: gen-gorm-model [flags]
 
-This is a pflag example.
+Generate gorm models from database tables. Database settings are read from
+the apiserver configuration file (--config), APP_* environment variables
+or the flags below, in increasing order of precedence.
 
 Flags:
 `
@@ -41,11 +41,8 @@ var generateConfigs = map[string]GenerateConfig{
 
 // 命令行参数.
 var (
-	addr       = "127.0.0.1:5432"
-	username   = "postgres"
-	password   = "postgres"
-	database   = "cs-pedia"
-	modelPath  = ""
+	opts       = gormgen.NewOptions()
+	modelPath  = pflag.String("model-path", "", "Directory to generate models into, defaults to the component's model package.")
 	components = pflag.StringSlice("component", []string{"gin-enterprise-template"}, "Generated model code's for specified component.")
 	help       = pflag.BoolP("help", "h", false, "Show this help message.")
 )
@@ -56,6 +53,7 @@ func main() {
 		fmt.Printf("%s", helpText)
 		pflag.PrintDefaults()
 	}
+	opts.AddFlags(pflag.CommandLine)
 	pflag.Parse()
 
 	// 如果设置了帮助标志，则显示帮助信息并退出
@@ -64,8 +62,16 @@ func main() {
 		return
 	}
 
+	// 读取配置文件和环境变量中的数据库连接参数
+	if err := opts.Complete(); err != nil {
+		log.Fatalf("Failed to load database options: %v", err)
+	}
+	if err := opts.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
 	// 初始化数据库连接
-	dbInstance, err := initializeDatabase()
+	dbInstance, err := opts.NewDB()
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -76,19 +82,6 @@ func main() {
 	}
 }
 
-// initializeDatabase 创建并返回一个数据库连接.
-func initializeDatabase() (*gorm.DB, error) {
-	dbOptions := &db.PostgreSQLOptions{
-		Addr:     addr,
-		Username: username,
-		Password: password,
-		Database: database,
-	}
-
-	// 创建并返回数据库连接
-	return db.NewPostgreSQL(dbOptions)
-}
-
 // processComponent 处理单个组件以生成代码.
 func processComponent(component string, dbInstance *gorm.DB) {
 	config, ok := generateConfigs[component]
@@ -101,11 +94,7 @@ func processComponent(component string, dbInstance *gorm.DB) {
 	modelPkgPath := resolveModelPackagePath(config.ModelPackagePath)
 
 	// 创建生成器实例
-	generator := createGenerator(modelPkgPath)
-	generator.UseDB(dbInstance)
-
-	// 应用自定义生成器选项
-	applyGeneratorOptions(generator)
+	generator := gormgen.NewGenerator(modelPkgPath, dbInstance)
 
 	// 使用指定的函数生成模型
 	config.GenerateFunc(generator)
@@ -116,8 +105,8 @@ func processComponent(component string, dbInstance *gorm.DB) {
 
 // resolveModelPackagePath 确定模型生成的包路径.
 func resolveModelPackagePath(defaultPath string) string {
-	if modelPath != "" {
-		return modelPath
+	if *modelPath != "" {
+		return *modelPath
 	}
 	absPath, err := filepath.Abs(defaultPath)
 	if err != nil {
@@ -127,49 +116,16 @@ func resolveModelPackagePath(defaultPath string) string {
 	return absPath
 }
 
-// createGenerator 初始化并返回一个新的生成器实例.
-func createGenerator(packagePath string) *gen.Generator {
-	return gen.NewGenerator(gen.Config{
-		Mode:              gen.WithDefaultQuery | gen.WithQueryInterface | gen.WithoutContext,
-		ModelPkgPath:      packagePath,
-		WithUnitTest:      true,
-		FieldNullable:     true,  // 对于数据库中可空的字段，使用指针类型。
-		FieldSignable:     false, // 禁用无符号属性以提高兼容性。
-		FieldWithIndexTag: false, // 不包含 GORM 的索引标签。
-		FieldWithTypeTag:  false, // 不包含 GORM 的类型标签。
-	})
-}
-
-// applyGeneratorOptions 设置自定义生成器选项.
-func applyGeneratorOptions(g *gen.Generator) {
-	// 为特定字段自定义 GORM 标签
-	g.WithOpts(
-		// 将下划线连接起来的单词使用小驼峰的形式写入 json tag 中
-		gen.FieldJSONTagWithNS(func(columnName string) (tagContent string) {
-			// 只对包含下划线的字段名进行转换
-			if strings.Contains(columnName, "_") {
-				return lo.CamelCase(columnName)
-			}
-			return columnName
-		}),
-		// 为时间戳字段设置 PostgreSQL 默认值
-		gen.FieldGORMTag("created_at", func(tag field.GormTag) field.GormTag {
-			tag.Set("default", "current_timestamp")
-			return tag
-		}),
-		gen.FieldGORMTag("updated_at", func(tag field.GormTag) field.GormTag {
-			tag.Set("default", "current_timestamp")
-			return tag
-		}),
-	)
-}
-
 // GenerateCspediaModels 为 gin-enterprise-template 组件生成模型.
 func GenerateCspediaModels(g *gen.Generator) {
 	// 系统核心表
 	g.GenerateModelAs("user", "UserM")
 	g.GenerateModelAs("user_config", "UserConfigM")
 	g.GenerateModelAs("user_login_log", "UserLoginLogM")
+	g.GenerateModelAs("user_session", "UserSessionM")
+	g.GenerateModelAs("invitation", "InvitationM")
+	g.GenerateModelAs("user_identity", "UserIdentityM")
+	g.GenerateModelAs("oidc_auth_state", "OIDCAuthStateM")
 
 	// RBAC 权限控制表
 	g.GenerateModelAs("role", "RoleM")
@@ -178,7 +134,11 @@ func GenerateCspediaModels(g *gen.Generator) {
 	g.GenerateModelAs("role_permission", "RolePermissionM")
 	g.GenerateModelAs("menu", "MenuM")
 	g.GenerateModelAs("audit_log", "AuditLogM")
+	g.GenerateModelAs("access_request", "AccessRequestM")
+	g.GenerateModelAs("sod_rule", "SoDRuleM")
 
 	// 权限控制表
 	g.GenerateModelAs("casbin_rule", "CasbinRuleM")
+
+	// 业务资源表，由 gen-resource 追加
 }
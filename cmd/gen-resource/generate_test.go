@@ -0,0 +1,205 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// update 为 true 时使用生成的代码覆盖 testdata 中的 golden 文件.
+var update = flag.Bool("update", false, "Update the golden files in testdata.")
+
+// goldenDir 是部门资源 golden 文件所在的目录.
+const goldenDir = "testdata/department"
+
+func TestGenerate_Golden(t *testing.T) {
+	d := &data{Resource: testResource(), Module: "github.com/clin211/gin-enterprise-template"}
+	root := t.TempDir()
+	require.NoError(t, d.generate(&writer{root: root, out: io.Discard}))
+
+	generated := make(map[string]bool)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		generated[rel] = true
+
+		got, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		golden := filepath.Join(goldenDir, rel+".golden")
+		if *update {
+			if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+				return err
+			}
+			return os.WriteFile(golden, got, 0o644)
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, string(want), string(got), "%s differs from %s, run go test -update to refresh it", rel, golden)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// 模板不再生成的文件需要从 testdata 中删除
+	err = filepath.WalkDir(goldenDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(goldenDir, path)
+		if err != nil {
+			return err
+		}
+		assert.True(t, generated[strings.TrimSuffix(rel, ".golden")], "stale golden file %s", path)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGenerate_Build(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping building the generated resource in short mode")
+	}
+
+	// 在仓库的副本中生成并注册部门资源，再编译生成的代码
+	root := t.TempDir()
+	for _, name := range []string{"go.mod", "go.sum"} {
+		src, err := os.ReadFile(filepath.Join(repoRoot, name))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(root, name), src, 0o644))
+	}
+	for _, name := range []string{"cmd", "internal", "pkg"} {
+		require.NoError(t, os.CopyFS(filepath.Join(root, name), os.DirFS(filepath.Join(repoRoot, name))), name)
+	}
+
+	d := &data{Resource: testResource(), Module: "github.com/clin211/gin-enterprise-template"}
+	w := &writer{root: root, out: io.Discard}
+	modelSrc, err := d.renderGo("model.go.tmpl")
+	require.NoError(t, err)
+	require.NoError(t, w.write(filepath.Join("internal/apiserver/model", d.Table+".gen.go"), modelSrc))
+	require.NoError(t, d.generate(w))
+	require.NoError(t, d.register(w))
+	compileProto(t, filepath.Join(root, "pkg/api", d.ProtoFile), d.ProtoFile, d.Module+"/pkg/api/apiserver/v1;v1")
+
+	// go vet 同时编译生成的测试代码
+	cmd := exec.Command("go", "vet",
+		"./internal/apiserver/store/",
+		"./internal/apiserver/biz/...",
+		"./internal/apiserver/pkg/validation/",
+		"./internal/apiserver/pkg/conversion/",
+		"./internal/apiserver/handler/",
+		"./internal/pkg/errno/",
+	)
+	cmd.Dir = root
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "generated resource does not build:\n%s", out)
+}
+
+// protoField 匹配 proto 模板生成的字段定义.
+var protoField = regexp.MustCompile(`^(repeated |optional )?(\w+) (\w+) = (\d+);`)
+
+// protoScalars 是 proto 模板使用的标量类型.
+var protoScalars = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"string": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bool":   descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"int32":  descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"int64":  descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64": descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"float":  descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"double": descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"bytes":  descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// compileProto 使用 protoc-gen-go 的代码生成器编译 proto 模板生成的文件，使测试不依赖 protoc.
+// 只支持 proto 模板生成的消息和字段定义.
+func compileProto(t *testing.T, path, name, goPackage string) {
+	t.Helper()
+
+	src, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(name),
+		Package: proto.String("apiserver.v1"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String(goPackage)},
+	}
+	var message *descriptorpb.DescriptorProto
+	for _, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "message "):
+			message = &descriptorpb.DescriptorProto{Name: proto.String(strings.Fields(line)[1])}
+			file.MessageType = append(file.MessageType, message)
+		case line == "}":
+			message = nil
+		case message != nil:
+			m := protoField.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			number, err := strconv.Atoi(m[4])
+			require.NoError(t, err)
+			field := &descriptorpb.FieldDescriptorProto{
+				Name:     proto.String(m[3]),
+				JsonName: proto.String(m[3]),
+				Number:   proto.Int32(int32(number)),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}
+			if typ, ok := protoScalars[m[2]]; ok {
+				field.Type = typ.Enum()
+			} else {
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+				field.TypeName = proto.String(".apiserver.v1." + m[2])
+			}
+			switch m[1] {
+			case "repeated ":
+				field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			case "optional ":
+				// proto3 optional 字段使用合成的 oneof 表示
+				field.Proto3Optional = proto.Bool(true)
+				field.OneofIndex = proto.Int32(int32(len(message.OneofDecl)))
+				message.OneofDecl = append(message.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("_" + m[3])})
+			}
+			message.Field = append(message.Field, field)
+		}
+	}
+
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{name},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+	})
+	require.NoError(t, err)
+	for _, f := range plugin.Files {
+		if f.Generate {
+			gengo.GenerateFile(plugin, f)
+		}
+	}
+	resp := plugin.Response()
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.File, 1)
+	require.NoError(t, os.WriteFile(strings.TrimSuffix(path, ".proto")+".pb.go", []byte(resp.File[0].GetContent()), 0o644))
+}
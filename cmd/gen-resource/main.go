@@ -0,0 +1,352 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/gormgen"
+	"github.com/clin211/gin-enterprise-template/pkg/store/migrate"
+)
+
+// 帮助信息文本.
+const helpText = `Usage: gen-resource (--table TABLE | --message MESSAGE) [flags]
+
+Generate the model, store, biz, validation, conversion, handler, errno and
+protobuf code of a CRUD resource following the conventions of this
+repository, and register it in IStore, IBiz and the apiserver service.
+
+  --table      generate from an existing database table. Database settings
+               are read from the apiserver configuration file (--config),
+               APP_* environment variables or the database flags below.
+  --message    generate from a message compiled into pkg/api/apiserver/v1,
+               e.g. Department. Migrations creating its table are generated
+               as well.
+
+The resource is located by a string business ID column (--key, defaults to
+<table>_id) and paginated by the auto-increment id column. Existing files
+are skipped unless --force is set.
+
+Examples:
+  gen-resource --table department --title 部门 -c configs/configs.yaml
+  gen-resource --message Department --title 部门
+
+Flags:
+`
+
+// 命令行参数.
+var (
+	opts    = gormgen.NewOptions()
+	table   = pflag.String("table", "", "Database table to generate the resource from. With --message, the table to create.")
+	message = pflag.String("message", "", "Protobuf message in package apiserver.v1 to generate the resource from.")
+	kind    = pflag.String("kind", "", "Go name of the resource, defaults to the camel-cased table name or the message name.")
+	title   = pflag.String("title", "", "Display name of the resource used in comments and API docs, defaults to the kind.")
+	key     = pflag.String("key", "", "Business ID column used to locate the resource, defaults to <table>_id.")
+	path    = pflag.String("path", "", "URL path of the resource, defaults to the pluralized table name, e.g. sod-rules.")
+	root    = pflag.String("root", ".", "Root directory of the repository.")
+	force   = pflag.Bool("force", false, "Overwrite existing files.")
+	dryRun  = pflag.Bool("dry-run", false, "Print the files that would be generated without writing them.")
+	help    = pflag.BoolP("help", "h", false, "Show this help message.")
+)
+
+func main() {
+	// 设置自定义的使用说明函数
+	pflag.Usage = func() {
+		fmt.Printf("%s", helpText)
+		pflag.PrintDefaults()
+	}
+	opts.AddFlags(pflag.CommandLine)
+	pflag.Parse()
+
+	// 如果设置了帮助标志，则显示帮助信息并退出
+	if *help {
+		pflag.Usage()
+		return
+	}
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run 生成资源代码并注册.
+func run() error {
+	if *table == "" && *message == "" {
+		return fmt.Errorf("one of --table or --message is required")
+	}
+
+	module, err := readModule(filepath.Join(*root, "go.mod"))
+	if err != nil {
+		return err
+	}
+	r, modelSrc, err := loadResource()
+	if err != nil {
+		return err
+	}
+
+	d := &data{Resource: r, Module: module}
+	w := &writer{root: *root, force: *force, dryRun: *dryRun, out: os.Stdout}
+	if modelSrc == nil {
+		if modelSrc, err = d.renderGo("model.go.tmpl"); err != nil {
+			return err
+		}
+	}
+	if err := w.write(filepath.Join("internal/apiserver/model", r.Table+".gen.go"), modelSrc); err != nil {
+		return err
+	}
+	if err := d.generate(w); err != nil {
+		return err
+	}
+	if *message != "" {
+		if err := d.generateMigrations(w); err != nil {
+			return err
+		}
+	}
+	if err := d.register(w); err != nil {
+		return err
+	}
+
+	fmt.Println(`
+Next steps:
+  1. Run "make protoc.apiserver" to compile the generated protobuf messages.
+  2. Review the generated validation rules and test skeletons.`)
+	if *message != "" {
+		fmt.Println("  3. Rebuild the apiserver and run \"migrate up\" to create the table.")
+	}
+	return nil
+}
+
+// loadResource 从数据库表或 protobuf 消息加载资源描述. 从数据库表加载时同时返回 gorm.io/gen 生成的模型代码.
+func loadResource() (*Resource, []byte, error) {
+	r := &Resource{Kind: *kind, Title: *title, Table: *table, Path: *path}
+
+	var modelSrc []byte
+	if *message != "" {
+		md, err := FindMessage(*message)
+		if err != nil {
+			return nil, nil, err
+		}
+		if r.Kind == "" {
+			r.Kind = *message
+		}
+		if r.Table == "" {
+			r.Table = TableFromKind(r.Kind)
+		}
+		if r.Fields, err = FieldsFromMessage(md); err != nil {
+			return nil, nil, err
+		}
+		r.ProtoFile = md.ParentFile().Path()
+	} else {
+		if r.Kind == "" {
+			r.Kind = KindFromTable(r.Table)
+		}
+		var err error
+		if modelSrc, err = generateModel(r); err != nil {
+			return nil, nil, err
+		}
+		if r.Fields, err = FieldsFromModel(r.Table+".gen.go", modelSrc, r.Model()); err != nil {
+			return nil, nil, err
+		}
+		r.ProtoFile = "apiserver/v1/" + r.Table + ".proto"
+	}
+
+	if r.Title == "" {
+		r.Title = r.Kind
+	}
+	if r.Path == "" {
+		r.Path = PathFromTable(r.Table)
+	}
+	keyColumn := *key
+	if keyColumn == "" {
+		keyColumn = r.Table + "_id"
+	}
+	r.Key = r.Field(keyColumn)
+	return r, modelSrc, r.Validate()
+}
+
+// generateModel 连接数据库，使用与 gen-gorm-model 相同的配置生成表对应的模型代码.
+func generateModel(r *Resource) ([]byte, error) {
+	if err := opts.Complete(); err != nil {
+		return nil, fmt.Errorf("failed to load database options: %w", err)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	db, err := opts.NewDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	if !db.Migrator().HasTable(r.Table) {
+		return nil, fmt.Errorf("table %s does not exist", r.Table)
+	}
+	// gorm.io/gen 根据列的扫描类型确定字段类型，SQLite 驱动不提供扫描类型
+	columns, err := db.Migrator().ColumnTypes(r.Table)
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range columns {
+		if column.ScanType() == nil {
+			return nil, fmt.Errorf("driver %s does not report the type of column %s, generate from a PostgreSQL or MySQL table or use --message", db.Dialector.Name(), column.Name())
+		}
+	}
+
+	// 先生成到临时目录，由 writer 决定是否写入仓库
+	tmp, err := os.MkdirTemp("", "gen-resource-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	dir := filepath.Join(tmp, "model")
+	g := gormgen.NewGenerator(dir, db)
+	g.GenerateModelAs(r.Table, r.Model())
+	g.Execute()
+	return os.ReadFile(filepath.Join(dir, r.Table+".gen.go"))
+}
+
+// generate 生成资源的 Go 代码和 protobuf 消息.
+func (d *data) generate(w *writer) error {
+	bizDir := filepath.Join("internal/apiserver/biz/v1", d.Package())
+	files := []struct {
+		path     string
+		template string
+	}{
+		{filepath.Join("internal/apiserver/store", d.Table+".go"), "store.go.tmpl"},
+		{filepath.Join("internal/pkg/errno", d.Table+".go"), "errno.go.tmpl"},
+		{filepath.Join(bizDir, d.Table+".go"), "biz.go.tmpl"},
+		{filepath.Join(bizDir, "create.go"), "create.go.tmpl"},
+		{filepath.Join(bizDir, "update.go"), "update.go.tmpl"},
+		{filepath.Join(bizDir, "delete.go"), "delete.go.tmpl"},
+		{filepath.Join(bizDir, "get.go"), "get.go.tmpl"},
+		{filepath.Join(bizDir, "list.go"), "list.go.tmpl"},
+		{filepath.Join("internal/apiserver/pkg/validation", d.Table+".go"), "validation.go.tmpl"},
+		{filepath.Join("internal/apiserver/pkg/validation", d.Table+"_test.go"), "validation_test.go.tmpl"},
+		{filepath.Join("internal/apiserver/pkg/conversion", d.Table+".go"), "conversion.go.tmpl"},
+		{filepath.Join("internal/apiserver/pkg/conversion", d.Table+"_test.go"), "conversion_test.go.tmpl"},
+		{filepath.Join("internal/apiserver/handler", d.Table+".go"), "handler.go.tmpl"},
+	}
+	for _, f := range files {
+		src, err := d.renderGo(f.template)
+		if err != nil {
+			return err
+		}
+		if err := w.write(f.path, src); err != nil {
+			return err
+		}
+	}
+
+	return d.generateProto(w)
+}
+
+// protoMessages 是资源需要的 protobuf 消息模板，按在文件中的顺序排列.
+var protoMessages = []string{
+	"Kind",
+	"CreateRequest", "CreateResponse",
+	"UpdateRequest", "UpdateResponse",
+	"DeleteRequest", "DeleteResponse",
+	"GetRequest", "GetResponse",
+	"ListRequest", "ListResponse",
+}
+
+// generateProto 生成资源的 protobuf 消息. 从数据库表生成时创建新的 proto 文件；
+// 从 protobuf 消息生成时在消息所在的文件末尾追加尚未定义的请求和响应消息.
+func (d *data) generateProto(w *writer) error {
+	rel := filepath.Join("pkg/api", d.ProtoFile)
+	var buf strings.Builder
+	for _, name := range protoMessages {
+		if MessageExists(d.messageName(name)) {
+			continue
+		}
+		text, err := d.render(name)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(string(text))
+	}
+
+	if *message == "" {
+		header, err := d.render("header")
+		if err != nil {
+			return err
+		}
+		return w.write(rel, append(header, buf.String()...))
+	}
+	return w.patch(rel, func(src string) (string, error) {
+		return src + buf.String(), nil
+	})
+}
+
+// messageName 返回消息模板对应的 protobuf 消息名称.
+func (d *data) messageName(template string) string {
+	if template == "Kind" {
+		return d.Kind
+	}
+	for _, action := range []string{"Create", "Update", "Delete", "Get"} {
+		if suffix, ok := strings.CutPrefix(template, action); ok {
+			return action + d.Kind + suffix
+		}
+	}
+	return "List" + d.Plural() + strings.TrimPrefix(template, "List")
+}
+
+// generateMigrations 为每种数据库生成创建资源表的迁移文件，版本号接在已有迁移之后.
+func (d *data) generateMigrations(w *writer) error {
+	dialects := []string{dialectPostgres, dialectMySQL, dialectSQLite}
+	dirs := make([]string, 0, len(dialects))
+	for _, dialect := range dialects {
+		dirs = append(dirs, filepath.Join(w.root, "internal/apiserver/migrations", dialect))
+	}
+	version, err := migrate.NextVersion(dirs...)
+	if err != nil {
+		return err
+	}
+	// 已经生成过建表迁移时沿用原来的版本号，避免重复执行时创建新的迁移
+	for _, dir := range dirs {
+		matches, err := filepath.Glob(filepath.Join(dir, "*_create_"+d.Table+".up.sql"))
+		if err != nil {
+			return err
+		}
+		if len(matches) > 0 {
+			if _, err := fmt.Sscanf(filepath.Base(matches[0]), "%d_", &version); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	for _, dialect := range dialects {
+		for _, direction := range []string{"up", "down"} {
+			src, err := d.render(dialect + "." + direction)
+			if err != nil {
+				return err
+			}
+			name := fmt.Sprintf("%06d_create_%s.%s.sql", version, d.Table, direction)
+			if err := w.write(filepath.Join("internal/apiserver/migrations", dialect, name), src); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readModule 读取 go.mod 中的模块路径，使生成的代码在 fork 并重命名模块后仍然正确.
+func readModule(gomod string) (string, error) {
+	f, err := os.Open(gomod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("module path not found in %s", gomod)
+}
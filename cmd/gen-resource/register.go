@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 需要注册新资源的文件，相对于仓库根目录.
+const (
+	storeFile    = "internal/apiserver/store/store.go"
+	bizFile      = "internal/apiserver/biz/biz.go"
+	uuidHookFile = "internal/apiserver/model/uuid_hook.go"
+	gormGenFile  = "cmd/gen-gorm-model/gen_gorm_model.go"
+	apiProtoFile = "pkg/api/apiserver/v1/apiserver.proto"
+)
+
+// register 将资源注册到 IStore、IBiz、protobuf 服务、UUID 钩子和 gen-gorm-model 的表列表中.
+// Handler 通过 init 中的 Register 自动注册，biz 和 store 由已有的 Wire 提供者创建，不需要修改 Wire 配置.
+func (d *data) register(w *writer) error {
+	patches := []struct {
+		file string
+		fn   func(src string) (string, error)
+	}{
+		{storeFile, d.registerStore},
+		{bizFile, d.registerBiz},
+		{uuidHookFile, d.registerUUIDHook},
+		{gormGenFile, d.registerGormGen},
+		{apiProtoFile, d.registerService},
+	}
+	for _, p := range patches {
+		// gen-gorm-model 是可选的工具，fork 后可能已被删除
+		if p.file == gormGenFile && !w.exists(p.file) {
+			continue
+		}
+		if err := w.patch(p.file, p.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerStore 在 IStore 中添加资源的 store 访问方法.
+func (d *data) registerStore(src string) (string, error) {
+	if strings.Contains(src, d.Kind+"() "+d.Kind+"Store") {
+		return src, nil
+	}
+	return d.insert(src,
+		blockEnd("type IStore interface {", "store.method"),
+		fileEnd("store.accessor"),
+	)
+}
+
+// registerBiz 在 IBiz 中添加资源的 biz 访问方法.
+func (d *data) registerBiz(src string) (string, error) {
+	if strings.Contains(src, d.Kind+"V1() ") {
+		return src, nil
+	}
+	return d.insert(src,
+		afterLastLine("/internal/apiserver/biz/v1/", "biz.import"),
+		blockEnd("type IBiz interface {", "biz.method"),
+		fileEnd("biz.accessor"),
+	)
+}
+
+// registerUUIDHook 在创建记录前生成资源的业务 UUID.
+func (d *data) registerUUIDHook(src string) (string, error) {
+	if strings.Contains(src, "func (m *"+d.Model()+") BeforeCreate(") {
+		return src, nil
+	}
+	return d.insert(src, beforeLine("// ensureUUID", "uuid.hook"))
+}
+
+// registerGormGen 将资源表加入 gen-gorm-model 的表列表，之后可以通过 make models 重新生成模型.
+func (d *data) registerGormGen(src string) (string, error) {
+	if strings.Contains(src, fmt.Sprintf("GenerateModelAs(%q,", d.Table)) {
+		return src, nil
+	}
+	return d.insert(src, blockEnd("func GenerateCspediaModels(", "gormgen.model"))
+}
+
+// registerService 在 apiserver.proto 中导入资源的 proto 文件并添加 RPC.
+func (d *data) registerService(src string) (string, error) {
+	if strings.Contains(src, "rpc Create"+d.Kind+"(") {
+		return src, nil
+	}
+	var edits []edit
+	if !strings.Contains(src, `import "`+d.ProtoFile+`";`) {
+		edits = append(edits, afterLastLine(`import "apiserver/v1/`, "proto.import"))
+	}
+	return d.insert(src, append(edits, blockEnd("service ", "rpc"))...)
+}
+
+// edit 描述一次插入：locate 返回插入位置，template 是插入内容的模板名称.
+type edit struct {
+	locate   func(src string) (int, error)
+	template string
+}
+
+// insert 依次执行 edits.
+func (d *data) insert(src string, edits ...edit) (string, error) {
+	for _, e := range edits {
+		pos, err := e.locate(src)
+		if err != nil {
+			return "", err
+		}
+		text, err := d.render(e.template)
+		if err != nil {
+			return "", err
+		}
+		src = src[:pos] + string(text) + src[pos:]
+	}
+	return src, nil
+}
+
+// blockEnd 定位以 start 开头的代码块中单独成行的结束括号，在其之前插入.
+func blockEnd(start, template string) edit {
+	return edit{template: template, locate: func(src string) (int, error) {
+		i := strings.Index(src, start)
+		if i < 0 {
+			return 0, fmt.Errorf("%q not found", start)
+		}
+		j := strings.Index(src[i:], "\n}")
+		if j < 0 {
+			return 0, fmt.Errorf("end of %q not found", start)
+		}
+		return i + j + 1, nil
+	}}
+}
+
+// afterLastLine 定位最后一个包含 substr 的行，在其之后插入.
+func afterLastLine(substr, template string) edit {
+	return edit{template: template, locate: func(src string) (int, error) {
+		i := strings.LastIndex(src, substr)
+		if i < 0 {
+			return 0, fmt.Errorf("%q not found", substr)
+		}
+		j := strings.IndexByte(src[i:], '\n')
+		if j < 0 {
+			return len(src), nil
+		}
+		return i + j + 1, nil
+	}}
+}
+
+// beforeLine 定位以 prefix 开头的行，在其之前插入.
+func beforeLine(prefix, template string) edit {
+	return edit{template: template, locate: func(src string) (int, error) {
+		if strings.HasPrefix(src, prefix) {
+			return 0, nil
+		}
+		i := strings.Index(src, "\n"+prefix)
+		if i < 0 {
+			return 0, fmt.Errorf("%q not found", prefix)
+		}
+		return i + 1, nil
+	}}
+}
+
+// fileEnd 在文件末尾追加.
+func fileEnd(template string) edit {
+	return edit{template: template, locate: func(src string) (int, error) {
+		return len(src), nil
+	}}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/samber/lo"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// templates 包含生成代码使用的全部模板，Go 文件模板以文件名命名，proto、迁移和注册片段使用 define 定义的名称.
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"lowerFirst": lowerFirst,
+	"camel":      lo.CamelCase,
+	"inc":        func(i int) int { return i + 1 },
+	"add":        func(a, b int) int { return a + b },
+	"join":       strings.Join,
+}).ParseFS(templateFS, "templates/*.tmpl"))
+
+// data 是渲染模板时使用的数据.
+type data struct {
+	*Resource
+	// Module 是仓库的 Go 模块路径
+	Module string
+}
+
+// render 渲染名称为 name 的模板.
+func (d *data) render(name string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, d); err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderGo 渲染 Go 文件模板并格式化.
+func (d *data) renderGo(name string) ([]byte, error) {
+	src, err := d.render(name)
+	if err != nil {
+		return nil, err
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format %s: %w\n%s", name, err, src)
+	}
+	return formatted, nil
+}
+
+// writer 将生成的代码写入仓库. 已存在的文件默认跳过，避免覆盖手工修改过的代码.
+type writer struct {
+	root   string
+	force  bool
+	dryRun bool
+	out    io.Writer
+}
+
+// exists 判断仓库中的文件是否存在.
+func (w *writer) exists(rel string) bool {
+	_, err := os.Stat(filepath.Join(w.root, rel))
+	return err == nil
+}
+
+// write 创建文件，文件已存在且未指定 --force 时跳过.
+func (w *writer) write(rel string, content []byte) error {
+	if w.exists(rel) && !w.force {
+		fmt.Fprintf(w.out, "skip    %s (already exists, use --force to overwrite)\n", rel)
+		return nil
+	}
+
+	fmt.Fprintf(w.out, "create  %s\n", rel)
+	if w.dryRun {
+		return nil
+	}
+	path := filepath.Join(w.root, rel)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o644)
+}
+
+// patch 使用 fn 修改已有文件，内容没有变化时不写入，因此重复执行是安全的.
+func (w *writer) patch(rel string, fn func(src string) (string, error)) error {
+	path := filepath.Join(w.root, rel)
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	updated, err := fn(string(src))
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", rel, err)
+	}
+	if updated == string(src) {
+		fmt.Fprintf(w.out, "keep    %s (already registered)\n", rel)
+		return nil
+	}
+
+	fmt.Fprintf(w.out, "update  %s\n", rel)
+	if w.dryRun {
+		return nil
+	}
+	return os.WriteFile(path, []byte(updated), 0o644)
+}
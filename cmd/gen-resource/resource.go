@@ -0,0 +1,303 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm/schema"
+)
+
+// 由数据库或框架维护、不出现在创建和更新请求中的列.
+const (
+	columnID        = "id"
+	columnCreatedAt = "created_at"
+	columnUpdatedAt = "updated_at"
+	columnDeletedAt = "deleted_at"
+	columnVersion   = "version"
+)
+
+// naming 与 gorm.io/gen 生成模型字段名时使用的命名策略一致.
+var naming = schema.NamingStrategy{}
+
+// Resource 描述一个需要生成代码的资源.
+type Resource struct {
+	// Kind 是资源的 Go 名称，例如 Department
+	Kind string
+	// Title 是资源的中文名称，用于注释和 OpenAPI 文档，例如 部门
+	Title string
+	// Table 是数据库表名，同时作为文件名和 biz 包名，例如 department
+	Table string
+	// Path 是资源的 URL 路径，例如 departments
+	Path string
+	// Fields 是模型字段，顺序与表中的列一致
+	Fields []*Field
+	// Key 是资源的业务唯一 ID 字段，请求和 URL 中使用该字段定位资源
+	Key *Field
+	// ProtoFile 是资源消息所在的 proto 文件，相对于 pkg/api
+	ProtoFile string
+}
+
+// Field 描述资源的一个字段.
+type Field struct {
+	// GoName 是模型和 protobuf 生成的 Go 结构体中的字段名，例如 DepartmentID
+	GoName string
+	// GoType 是模型中的字段类型，例如 string、*string、time.Time
+	GoType string
+	// Column 是数据库列名，例如 department_id
+	Column string
+	// Comment 是列注释
+	Comment string
+	// Nullable 表示列可以为空，模型中使用指针类型
+	Nullable bool
+	// HasDefault 表示列有默认值，创建时可以不传
+	HasDefault bool
+	// Repeated 表示字段是以 JSON 保存的列表
+	Repeated bool
+	// Tag 是模型字段的 gorm 标签，从 proto 生成模型时使用
+	Tag string
+}
+
+// ProtoName 返回字段在 protobuf 消息中的名称，与仓库中 roleID、createdAt 的风格一致.
+func (f *Field) ProtoName() string {
+	return lowerFirst(f.GoName)
+}
+
+// ProtoType 返回字段在 protobuf 消息中的类型，时间使用 Unix 秒数表示. 不支持的类型返回空字符串.
+func (f *Field) ProtoType() string {
+	typ := strings.TrimPrefix(f.GoType, "*")
+	if f.Repeated {
+		typ = strings.TrimPrefix(typ, "[]")
+	}
+	switch typ {
+	case "string":
+		return "string"
+	case "bool":
+		return "bool"
+	case "int8", "int16", "int32", "uint8", "uint16":
+		return "int32"
+	case "int", "int64", "uint32", "time.Time":
+		return "int64"
+	case "uint", "uint64":
+		return "uint64"
+	case "float32":
+		return "float"
+	case "float64":
+		return "double"
+	case "[]byte":
+		return "bytes"
+	}
+	return ""
+}
+
+// Describe 返回字段在注释中的描述，没有列注释时使用字段名.
+func (f *Field) Describe() string {
+	if f.Comment != "" {
+		return f.Comment
+	}
+	return f.ProtoName()
+}
+
+// ValueType 返回字段去掉指针后的 Go 类型.
+func (f *Field) ValueType() string {
+	return strings.TrimPrefix(f.GoType, "*")
+}
+
+// IsString 判断字段是否为字符串.
+func (f *Field) IsString() bool {
+	return f.ValueType() == "string"
+}
+
+// Internal 判断字段是否由数据库或框架维护，不在 API 中出现.
+func (f *Field) Internal() bool {
+	return f.Column == columnID || f.Column == columnDeletedAt
+}
+
+// ReadOnly 判断字段是否只能由服务端设置，不出现在创建和更新请求中.
+func (f *Field) ReadOnly() bool {
+	return f.Internal() || slices.Contains([]string{columnCreatedAt, columnUpdatedAt, columnVersion}, f.Column)
+}
+
+// Required 判断字段在创建时是否必须提供.
+func (f *Field) Required() bool {
+	return !f.Nullable && !f.HasDefault && !f.Repeated
+}
+
+// Validate 检查资源是否满足生成代码的约定：使用自增的 id 列分页，使用字符串业务 ID 定位资源.
+func (r *Resource) Validate() error {
+	if r.Field(columnID) == nil {
+		return fmt.Errorf("table %s must have an auto-increment %q column used for pagination", r.Table, columnID)
+	}
+	if r.Key == nil {
+		return fmt.Errorf("table %s has no business ID column, set it with --key", r.Table)
+	}
+	if r.Key.GoType != "string" {
+		return fmt.Errorf("business ID column %s must be a non-null string, got %s", r.Key.Column, r.Key.GoType)
+	}
+	for _, field := range r.APIFields() {
+		if field.ProtoType() == "" {
+			return fmt.Errorf("column %s has unsupported type %s", field.Column, field.GoType)
+		}
+	}
+	return nil
+}
+
+// Field 返回列名为 column 的字段，不存在时返回 nil.
+func (r *Resource) Field(column string) *Field {
+	for _, field := range r.Fields {
+		if field.Column == column {
+			return field
+		}
+	}
+	return nil
+}
+
+// APIFields 返回在资源消息中出现的字段.
+func (r *Resource) APIFields() []*Field {
+	var fields []*Field
+	for _, field := range r.Fields {
+		if !field.Internal() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// WritableFields 返回可以在创建和更新请求中设置的字段.
+func (r *Resource) WritableFields() []*Field {
+	var fields []*Field
+	for _, field := range r.Fields {
+		if !field.ReadOnly() && field != r.Key {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// RequiredStrings 返回创建时必须提供、且不能更新为空的字符串字段.
+func (r *Resource) RequiredStrings() []*Field {
+	var fields []*Field
+	for _, field := range r.WritableFields() {
+		if field.Required() && field.IsString() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// WritableTimes 返回可以在创建和更新请求中设置的时间字段，请求中使用 Unix 秒数表示.
+func (r *Resource) WritableTimes() []*Field {
+	var fields []*Field
+	for _, field := range r.WritableFields() {
+		if field.ValueType() == "time.Time" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// NullableTimes 返回可以为空的时间字段，这些字段与 Unix 秒数之间需要显式转换.
+func (r *Resource) NullableTimes() []*Field {
+	var fields []*Field
+	for _, field := range r.APIFields() {
+		if field.GoType == "*time.Time" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// HasVersion 判断资源是否使用 version 列实现乐观锁.
+func (r *Resource) HasVersion() bool {
+	return r.Field(columnVersion) != nil
+}
+
+// HasTime 判断模型是否包含时间字段.
+func (r *Resource) HasTime() bool {
+	return slices.ContainsFunc(r.Fields, func(f *Field) bool { return f.ValueType() == "time.Time" })
+}
+
+// HasSoftDelete 判断模型是否使用 gorm.DeletedAt 实现软删除.
+func (r *Resource) HasSoftDelete() bool {
+	return slices.ContainsFunc(r.Fields, func(f *Field) bool { return f.GoType == "gorm.DeletedAt" })
+}
+
+// Model 返回模型的类型名.
+func (r *Resource) Model() string {
+	return r.Kind + "M"
+}
+
+// Package 返回 biz 包名.
+func (r *Resource) Package() string {
+	return r.Table
+}
+
+// Alias 返回在 biz.go 中导入 biz 包时使用的别名.
+func (r *Resource) Alias() string {
+	return strings.ReplaceAll(r.Table, "_", "") + "v1"
+}
+
+// Var 返回资源实例的变量名前缀，例如 department.
+func (r *Resource) Var() string {
+	return lowerFirst(r.Kind)
+}
+
+// Plural 返回资源的复数 Go 名称，用于 List 请求.
+func (r *Resource) Plural() string {
+	return plural(r.Kind)
+}
+
+// PluralVar 返回资源列表的变量名，例如 departments.
+func (r *Resource) PluralVar() string {
+	return lowerFirst(r.Plural())
+}
+
+// Human 返回资源在错误消息中的英文名称，例如 sod rule.
+func (r *Resource) Human() string {
+	return strings.ReplaceAll(r.Table, "_", " ")
+}
+
+// KindFromTable 根据表名生成资源的 Go 名称.
+func KindFromTable(table string) string {
+	return naming.SchemaName(table)
+}
+
+// TableFromKind 根据资源的 Go 名称生成表名.
+func TableFromKind(kind string) string {
+	return naming.ColumnName("", kind)
+}
+
+// PathFromTable 根据表名生成 URL 路径，例如 sod_rule 对应 sod-rules.
+func PathFromTable(table string) string {
+	return plural(strings.ReplaceAll(table, "_", "-"))
+}
+
+// lowerFirst 将开头的大写单词转换为小写，连续的大写缩写整体转换，例如 ID 转换为 id、URLPath 转换为 urlPath.
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		// 缩写后紧跟小写字母时，最后一个大写字母属于下一个单词
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// plural 返回英文单词的复数形式，只处理常见的规则变化.
+func plural(s string) string {
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasSuffix(lower, "y") && len(s) > 1 && !strings.ContainsAny(lower[len(lower)-2:len(lower)-1], "aeiou"):
+		return s[:len(s)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// repoRoot 是测试中读取仓库文件使用的根目录.
+const repoRoot = "../.."
+
+func TestNaming(t *testing.T) {
+	assert.Equal(t, "SodRule", KindFromTable("sod_rule"))
+	assert.Equal(t, "department", TableFromKind("Department"))
+	assert.Equal(t, "access_request", TableFromKind("AccessRequest"))
+	assert.Equal(t, "sod-rules", PathFromTable("sod_rule"))
+	assert.Equal(t, "policies", PathFromTable("policy"))
+	assert.Equal(t, "addresses", PathFromTable("address"))
+	assert.Equal(t, "keys", PathFromTable("key"))
+
+	assert.Equal(t, "id", lowerFirst("ID"))
+	assert.Equal(t, "ruleID", lowerFirst("RuleID"))
+	assert.Equal(t, "urlPath", lowerFirst("URLPath"))
+	assert.Equal(t, "soDRule", lowerFirst("SoDRule"))
+
+	assert.Equal(t, "RuleID", goCamelCase("ruleID"))
+	assert.Equal(t, "RoleIds", goCamelCase("role_ids"))
+}
+
+func TestFieldsFromModel(t *testing.T) {
+	src, err := os.ReadFile(filepath.Join(repoRoot, "internal/apiserver/model/sod_rule.gen.go"))
+	require.NoError(t, err)
+
+	fields, err := FieldsFromModel("sod_rule.gen.go", src, "SoDRuleM")
+	require.NoError(t, err)
+	r := &Resource{Kind: "SoDRule", Table: "sod_rule", Fields: fields}
+	r.Key = r.Field("rule_id")
+	require.NoError(t, r.Validate())
+
+	assert.True(t, r.Field("id").HasDefault)
+	assert.True(t, r.Field("rule_id").HasDefault)
+	assert.True(t, r.Field("description").Nullable)
+	assert.Equal(t, "*string", r.Field("description").GoType)
+	assert.Equal(t, "规则名称（唯一）", r.Field("name").Comment)
+	assert.True(t, r.HasVersion())
+	assert.Equal(t, []string{"name", "role_ids"}, columns(r.RequiredStrings()))
+	assert.Equal(t, []string{"name", "description", "role_ids", "max_roles"}, columns(r.WritableFields()))
+
+	_, err = FieldsFromModel("sod_rule.gen.go", src, "UserM")
+	assert.Error(t, err)
+}
+
+func TestFieldsFromMessage(t *testing.T) {
+	md, err := FindMessage("SoDRule")
+	require.NoError(t, err)
+
+	fields, err := FieldsFromMessage(md)
+	require.NoError(t, err)
+	r := &Resource{Kind: "SoDRule", Table: "sod_rule", Fields: fields}
+	r.Key = r.Field("rule_id")
+	require.NoError(t, r.Validate())
+
+	assert.Equal(t, "primaryKey;autoIncrement:true", r.Field("id").Tag)
+	assert.Equal(t, "[]string", r.Field("role_ids").GoType)
+	assert.True(t, r.Field("role_ids").Repeated)
+	assert.Equal(t, "time.Time", r.Field("created_at").GoType)
+	assert.True(t, r.Field("created_at").HasDefault)
+	assert.Equal(t, "not null;default:1", r.Field("version").Tag)
+
+	_, err = FindMessage("NoSuchMessage")
+	assert.Error(t, err)
+	_, err = FieldsFromMessage(mustFindMessage(t, "CreateSoDRuleResponse"))
+	assert.Error(t, err, "message fields are not supported")
+}
+
+func TestValidate(t *testing.T) {
+	r := testResource()
+	require.NoError(t, r.Validate())
+
+	r.Key = nil
+	assert.Error(t, r.Validate())
+
+	r = testResource()
+	r.Key = r.Field("sort")
+	assert.Error(t, r.Validate())
+
+	r = testResource()
+	r.Fields = r.Fields[1:]
+	assert.Error(t, r.Validate())
+}
+
+func TestRender(t *testing.T) {
+	d := &data{Resource: testResource(), Module: "github.com/clin211/gin-enterprise-template"}
+
+	names, err := fs.Glob(templateFS, "templates/*.go.tmpl")
+	require.NoError(t, err)
+	for _, name := range names {
+		_, err := d.renderGo(filepath.Base(name))
+		assert.NoError(t, err, name)
+	}
+
+	for _, name := range append(protoMessages, "header", "rpc", "postgres.up", "mysql.up", "sqlite.up") {
+		text, err := d.render(name)
+		require.NoError(t, err, name)
+		assert.NotContains(t, string(text), "<no value>", name)
+	}
+
+	assert.Equal(t, "CreateDepartmentRequest", d.messageName("CreateRequest"))
+	assert.Equal(t, "ListDepartmentsResponse", d.messageName("ListResponse"))
+	assert.Equal(t, "Department", d.messageName("Kind"))
+}
+
+func TestRegister(t *testing.T) {
+	d := &data{Resource: testResource(), Module: "github.com/clin211/gin-enterprise-template"}
+
+	for file, fn := range map[string]func(string) (string, error){
+		storeFile:    d.registerStore,
+		bizFile:      d.registerBiz,
+		uuidHookFile: d.registerUUIDHook,
+		gormGenFile:  d.registerGormGen,
+		apiProtoFile: d.registerService,
+	} {
+		src, err := os.ReadFile(filepath.Join(repoRoot, file))
+		require.NoError(t, err, file)
+
+		once, err := fn(string(src))
+		require.NoError(t, err, file)
+		assert.NotEqual(t, string(src), once, file)
+		assert.Contains(t, once, "Department", file)
+
+		twice, err := fn(once)
+		require.NoError(t, err, file)
+		assert.Equal(t, once, twice, "registering twice should not change %s", file)
+	}
+}
+
+// testResource 返回测试使用的部门资源.
+func testResource() *Resource {
+	r := &Resource{
+		Kind:      "Department",
+		Title:     "部门",
+		Table:     "department",
+		Path:      "departments",
+		ProtoFile: "apiserver/v1/department.proto",
+		Fields: []*Field{
+			{GoName: "ID", GoType: "int64", Column: "id", HasDefault: true},
+			{GoName: "DepartmentID", GoType: "string", Column: "department_id", HasDefault: true},
+			{GoName: "Name", GoType: "string", Column: "name", Comment: "部门名称"},
+			{GoName: "Description", GoType: "*string", Column: "description", Nullable: true},
+			{GoName: "MemberIDs", GoType: "[]string", Column: "member_ids", Repeated: true},
+			{GoName: "Sort", GoType: "int32", Column: "sort"},
+			{GoName: "FoundedAt", GoType: "*time.Time", Column: "founded_at", Nullable: true},
+			{GoName: "ClosedAt", GoType: "time.Time", Column: "closed_at"},
+			{GoName: "CreatedAt", GoType: "time.Time", Column: "created_at", HasDefault: true},
+			{GoName: "UpdatedAt", GoType: "time.Time", Column: "updated_at", HasDefault: true},
+			{GoName: "Version", GoType: "int64", Column: "version", HasDefault: true},
+			{GoName: "DeletedAt", GoType: "gorm.DeletedAt", Column: "deleted_at", Nullable: true},
+		},
+	}
+	r.Key = r.Field("department_id")
+	return r
+}
+
+// mustFindMessage 查找 protobuf 消息，不存在时终止测试.
+func mustFindMessage(t *testing.T, name string) protoreflect.MessageDescriptor {
+	t.Helper()
+	md, err := FindMessage(name)
+	require.NoError(t, err)
+	return md
+}
+
+// columns 返回字段的列名.
+func columns(fields []*Field) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Column)
+	}
+	return names
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// 注册 apiserver 的 protobuf 消息，用于按名称查找资源消息
+	_ "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// protoPackage 是 apiserver API 的 protobuf 包名.
+const protoPackage = "apiserver.v1"
+
+// FieldsFromModel 解析 gorm.io/gen 生成的模型文件，返回结构体 model 的字段.
+func FieldsFromModel(filename string, src []byte, model string) ([]*Field, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ast.StructType
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok && spec.Name.Name == model {
+			st, _ = spec.Type.(*ast.StructType)
+		}
+		return st == nil
+	})
+	if st == nil {
+		return nil, fmt.Errorf("struct %s not found in %s", model, filename)
+	}
+
+	fields := make([]*Field, 0, len(st.Fields.List))
+	for _, f := range st.Fields.List {
+		if len(f.Names) != 1 || f.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			return nil, err
+		}
+		settings := parseGormTag(reflect.StructTag(tag).Get("gorm"))
+
+		field := &Field{
+			GoName:  f.Names[0].Name,
+			GoType:  types.ExprString(f.Type),
+			Column:  settings["column"],
+			Comment: settings["comment"],
+		}
+		if field.Column == "" {
+			field.Column = naming.ColumnName("", field.GoName)
+		}
+		_, notNull := settings["not null"]
+		_, hasDefault := settings["default"]
+		_, autoIncrement := settings["autoincrement"]
+		field.Nullable = strings.HasPrefix(field.GoType, "*") || (field.GoType == "gorm.DeletedAt" && !notNull)
+		field.HasDefault = hasDefault || autoIncrement
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// parseGormTag 解析 gorm 标签，键转换为小写，例如 column:id;primaryKey 解析为 {column: id, primarykey: ""}.
+func parseGormTag(tag string) map[string]string {
+	settings := make(map[string]string)
+	for _, item := range strings.Split(tag, ";") {
+		key, value, _ := strings.Cut(item, ":")
+		if key = strings.TrimSpace(key); key != "" {
+			settings[strings.ToLower(key)] = value
+		}
+	}
+	return settings
+}
+
+// FindMessage 在已编译的 apiserver protobuf 消息中查找名称为 name 的消息.
+func FindMessage(name string) (protoreflect.MessageDescriptor, error) {
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(protoPackage + "." + name))
+	if err != nil {
+		return nil, fmt.Errorf("message %s.%s not found, run `make protoc.apiserver` after adding it: %w", protoPackage, name, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s.%s is not a message", protoPackage, name)
+	}
+	return md, nil
+}
+
+// MessageExists 判断 apiserver protobuf 包中是否已经定义了名称为 name 的消息.
+func MessageExists(name string) bool {
+	_, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(protoPackage + "." + name))
+	return err == nil
+}
+
+// FieldsFromMessage 根据 protobuf 消息生成模型字段. 消息中没有 id 字段时在最前面添加自增主键，
+// 以 At 结尾的 int64 字段作为时间保存，repeated 标量字段以 JSON 保存，proto3 optional 字段可以为空.
+func FieldsFromMessage(md protoreflect.MessageDescriptor) ([]*Field, error) {
+	var fields []*Field
+	if md.Fields().ByName(columnID) == nil {
+		fields = append(fields, &Field{
+			GoName:     "ID",
+			GoType:     "int64",
+			Column:     columnID,
+			Comment:    "内部主键ID（自增序列）",
+			HasDefault: true,
+			Tag:        "primaryKey;autoIncrement:true",
+		})
+	}
+
+	for i := range md.Fields().Len() {
+		fd := md.Fields().Get(i)
+		if fd.IsMap() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+			return nil, fmt.Errorf("field %s has unsupported type %s", fd.Name(), fd.Kind())
+		}
+
+		goName := goCamelCase(string(fd.Name()))
+		field := &Field{
+			GoName: goName,
+			GoType: scalarGoType(fd.Kind()),
+			Column: naming.ColumnName("", goName),
+		}
+		if fd.Kind() == protoreflect.Int64Kind && strings.HasSuffix(goName, "At") {
+			field.GoType = "time.Time"
+		}
+
+		var tags []string
+		switch {
+		case fd.IsList():
+			field.GoType = "[]" + field.GoType
+			field.Repeated = true
+			tags = append(tags, "serializer:json", "not null")
+		case fd.HasPresence():
+			field.GoType = "*" + field.GoType
+			field.Nullable = true
+		default:
+			tags = append(tags, "not null")
+		}
+		switch field.Column {
+		case columnCreatedAt, columnUpdatedAt:
+			field.HasDefault = true
+			tags = append(tags, "default:current_timestamp")
+		case columnVersion:
+			field.HasDefault = true
+			tags = append(tags, "default:1")
+		}
+		field.Tag = strings.Join(tags, ";")
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// scalarGoType 返回 protobuf 标量类型在模型中对应的 Go 类型.
+func scalarGoType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.EnumKind:
+		return "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	default:
+		return "string"
+	}
+}
+
+// goCamelCase 返回 protoc-gen-go 为字段生成的 Go 名称，例如 ruleID 对应 RuleID、role_ids 对应 RoleIds.
+func goCamelCase(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		b.WriteRune(r)
+		upper = false
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 迁移文件支持的数据库，与 internal/apiserver/migrations 下的目录名一致.
+const (
+	dialectPostgres = "postgres"
+	dialectMySQL    = "mysql"
+	dialectSQLite   = "sqlite"
+)
+
+// Columns 返回建表语句中各列的定义，用于从 protobuf 消息生成迁移文件.
+func (r *Resource) Columns(dialect string) []string {
+	columns := make([]string, 0, len(r.Fields))
+	for _, field := range r.Fields {
+		columns = append(columns, "  "+r.column(dialect, field))
+	}
+	return columns
+}
+
+// column 返回一列的定义.
+func (r *Resource) column(dialect string, f *Field) string {
+	quote := `"`
+	if dialect == dialectMySQL {
+		quote = "`"
+	}
+	name := quote + f.Column + quote
+
+	if f.Column == columnID {
+		switch dialect {
+		case dialectPostgres:
+			return fmt.Sprintf("%s int8 NOT NULL DEFAULT nextval('%s_id_seq'::regclass)", name, r.Table)
+		case dialectMySQL:
+			return fmt.Sprintf("%s bigint NOT NULL AUTO_INCREMENT COMMENT '%s'", name, f.Comment)
+		default:
+			return name + " integer PRIMARY KEY AUTOINCREMENT"
+		}
+	}
+
+	def := []string{name, sqlType(dialect, f, f == r.Key)}
+	if !f.Nullable {
+		def = append(def, "NOT NULL")
+	}
+	switch f.Column {
+	case columnCreatedAt, columnUpdatedAt:
+		if dialect == dialectMySQL {
+			def = append(def, "DEFAULT CURRENT_TIMESTAMP(6)")
+		} else {
+			def = append(def, "DEFAULT CURRENT_TIMESTAMP")
+		}
+	case columnVersion:
+		def = append(def, "DEFAULT 1")
+	}
+	if dialect == dialectMySQL && f.Comment != "" {
+		def = append(def, fmt.Sprintf("COMMENT '%s'", f.Comment))
+	}
+	return strings.Join(def, " ")
+}
+
+// sqlType 返回字段在指定数据库中的列类型. 业务 ID 保存 UUID，repeated 字段以 JSON 保存.
+func sqlType(dialect string, f *Field, key bool) string {
+	typ := f.ValueType()
+	if f.Repeated {
+		typ = "json"
+	}
+	if key {
+		typ = "uuid"
+	}
+
+	types := map[string][3]string{
+		// postgres、mysql、sqlite
+		"uuid":           {"uuid", "char(36)", "varchar(36)"},
+		"string":         {"varchar(255)", "varchar(255)", "varchar(255)"},
+		"bool":           {"bool", "tinyint(1)", "boolean"},
+		"int32":          {"int4", "int", "integer"},
+		"uint32":         {"int8", "int unsigned", "integer"},
+		"int64":          {"int8", "bigint", "integer"},
+		"uint64":         {"numeric(20)", "bigint unsigned", "integer"},
+		"float32":        {"float4", "float", "real"},
+		"float64":        {"float8", "double", "real"},
+		"[]byte":         {"bytea", "blob", "blob"},
+		"time.Time":      {"timestamptz(6)", "datetime(6)", "datetime"},
+		"gorm.DeletedAt": {"timestamptz(6)", "datetime(6)", "datetime"},
+		"json":           {"jsonb", "json", "text"},
+	}
+	t, ok := types[typ]
+	if !ok {
+		t = types["string"]
+	}
+	switch dialect {
+	case dialectPostgres:
+		return t[0]
+	case dialectMySQL:
+		return t[1]
+	default:
+		return t[2]
+	}
+}
@@ -0,0 +1,217 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/clin211/gin-enterprise-template/cmd/gin-enterprise-template-apiserver/app/options"
+	"github.com/clin211/gin-enterprise-template/pkg/store/migrate"
+)
+
+// defaultMigrationsDir 是 migrate create 默认创建迁移文件的目录，相对于仓库根目录。
+const defaultMigrationsDir = "internal/apiserver/migrations"
+
+// newMigrateCommand 创建用于管理数据库迁移的 migrate 子命令。
+// 迁移文件嵌入在二进制中，多个副本同时执行时通过数据库锁保证只有一个在执行迁移。
+func newMigrateCommand(opts *options.ServerOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage versioned database migrations.",
+		Long: `Apply, revert and inspect the versioned SQL migrations embedded in this binary.
+Applied migrations are recorded with their checksums in the schema_migrations table.`,
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(
+		newMigrateUpCommand(opts),
+		newMigrateDownCommand(opts),
+		newMigrateStatusCommand(opts),
+		newMigrateCreateCommand(),
+		newMigrateBaselineCommand(opts),
+	)
+	return cmd
+}
+
+// newMigrateUpCommand 创建 migrate up 子命令。
+func newMigrateUpCommand(opts *options.ServerOptions) *cobra.Command {
+	var steps int
+
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, closeFn, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			applied, err := migrator.Up(cmd.Context(), steps)
+			printMigrations(cmd, "Applied", applied)
+			if err != nil {
+				return err
+			}
+			if len(applied) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No pending migrations.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&steps, "steps", "n", 0, "Apply at most this many migrations. 0 applies all pending migrations.")
+	return cmd
+}
+
+// newMigrateDownCommand 创建 migrate down 子命令。
+func newMigrateDownCommand(opts *options.ServerOptions) *cobra.Command {
+	var steps int
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Revert the most recently applied migrations.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if steps <= 0 {
+				return fmt.Errorf("--steps must be greater than 0")
+			}
+
+			migrator, closeFn, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			reverted, err := migrator.Down(cmd.Context(), steps)
+			printMigrations(cmd, "Reverted", reverted)
+			if err != nil {
+				return err
+			}
+			if len(reverted) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No applied migrations.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&steps, "steps", "n", 1, "Number of migrations to revert.")
+	return cmd
+}
+
+// newMigrateStatusCommand 创建 migrate status 子命令。
+func newMigrateStatusCommand(opts *options.ServerOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of all migrations.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, closeFn, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			statuses, err := migrator.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+			for _, s := range statuses {
+				appliedAt := "-"
+				if s.AppliedAt != nil {
+					appliedAt = s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+				}
+				fmt.Fprintf(w, "%06d\t%s\t%s\t%s\n", s.Version, s.Name, s.State, appliedAt)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// newMigrateCreateCommand 创建 migrate create 子命令。
+func newMigrateCreateCommand() *cobra.Command {
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create empty up and down files for a new migration.",
+		Long: `Create the next numbered pair of up and down SQL files in the migration directory
+of every supported database. The files are embedded into the binary, so it has to be
+rebuilt before the new migration can be applied.`,
+		Example: `  gin-enterprise-template-apiserver migrate create add_user_avatar`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			drivers := []string{migrate.DriverPostgres, migrate.DriverMySQL, migrate.DriverSQLite}
+			dirs := make([]string, 0, len(drivers))
+			for _, driver := range drivers {
+				dirs = append(dirs, filepath.Join(dir, driver))
+			}
+
+			version, err := migrate.NextVersion(dirs...)
+			if err != nil {
+				return err
+			}
+			for _, d := range dirs {
+				up, down, err := migrate.Create(d, version, args[0])
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Created %s\nCreated %s\n", up, down)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", defaultMigrationsDir, "Directory containing the postgres, mysql and sqlite migration directories.")
+	return cmd
+}
+
+// newMigrateBaselineCommand 创建 migrate baseline 子命令。
+func newMigrateBaselineCommand(opts *options.ServerOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "baseline VERSION",
+		Short: "Mark migrations up to VERSION as applied without running them.",
+		Long: `Adopt a database whose schema was created before migrations were introduced,
+for example by running the former configs/template.sql by hand.`,
+		Example: `  gin-enterprise-template-apiserver migrate baseline 1`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %q: %w", args[0], err)
+			}
+
+			migrator, closeFn, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			marked, err := migrator.Baseline(cmd.Context(), version)
+			printMigrations(cmd, "Marked as applied", marked)
+			return err
+		},
+	}
+}
+
+// printMigrations 逐行输出迁移。
+func printMigrations(cmd *cobra.Command, action string, migrations []*migrate.Migration) {
+	for _, m := range migrations {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", action, m)
+	}
+}
+
+// newMigrator 加载配置并创建 Migrator。
+func newMigrator(opts *options.ServerOptions) (*migrate.Migrator, func(), error) {
+	cfg, err := loadConfig(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg.NewMigrator()
+}
@@ -2,6 +2,9 @@
 package options
 
 import (
+	"fmt"
+
+	"github.com/clin211/gin-enterprise-template/pkg/db"
 	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
 	"github.com/spf13/pflag"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -17,23 +20,62 @@ type ServerOptions struct {
 	TLSOptions *genericoptions.TLSOptions `json:"tls" mapstructure:"tls"`
 	// HTTPOptions 包含 HTTP 配置选项。
 	HTTPOptions *genericoptions.HTTPOptions `json:"http" mapstructure:"http"`
+	// DatabaseOptions 包含数据库类型配置选项。
+	DatabaseOptions *genericoptions.DatabaseOptions `json:"database" mapstructure:"database"`
 	// PostgreSQLOptions 包含 PostgreSQL 配置选项。
 	PostgreSQLOptions *genericoptions.PostgreSQLOptions `json:"postgresql" mapstructure:"postgresql"`
+	// MySQLOptions 包含 MySQL 配置选项。
+	MySQLOptions *genericoptions.MySQLOptions `json:"mysql" mapstructure:"mysql"`
+	// SQLiteOptions 包含 SQLite 配置选项。
+	SQLiteOptions *genericoptions.SQLiteOptions `json:"sqlite" mapstructure:"sqlite"`
 	// RedisOptions 包含 Redis 配置选项。
 	RedisOptions *genericoptions.RedisOptions `json:"redis" mapstructure:"redis"`
 	// OTelOptions 用于指定 OpenTelemetry 选项。
 	OTelOptions *genericoptions.OTelOptions `json:"otel" mapstructure:"otel"`
+	// RegistrationOptions 包含用户自助注册配置选项。
+	RegistrationOptions *genericoptions.RegistrationOptions `json:"registration" mapstructure:"registration"`
+	// PasswordOptions 包含密码哈希配置选项。
+	PasswordOptions *genericoptions.PasswordOptions `json:"password" mapstructure:"password"`
+	// OIDCOptions 包含 OIDC 单点登录配置选项。
+	OIDCOptions *genericoptions.OIDCOptions `json:"oidc" mapstructure:"oidc"`
+	// LDAPOptions 包含 LDAP / Active Directory 认证配置选项。
+	LDAPOptions *genericoptions.LDAPOptions `json:"ldap" mapstructure:"ldap"`
+	// AuthzOptions 包含授权策略同步配置选项。
+	AuthzOptions *genericoptions.AuthzOptions `json:"authz" mapstructure:"authz"`
+	// TrashOptions 包含回收站清理配置选项。
+	TrashOptions *genericoptions.TrashOptions `json:"trash" mapstructure:"trash"`
+	// MigrationOptions 包含数据库迁移配置选项。
+	MigrationOptions *genericoptions.MigrationOptions `json:"migration" mapstructure:"migration"`
+	// CacheOptions 包含 store 层读缓存配置选项。
+	CacheOptions *genericoptions.CacheOptions `json:"cache" mapstructure:"cache"`
+	// KafkaOptions 包含 Kafka 连接配置选项。
+	KafkaOptions *genericoptions.KafkaOptions `json:"kafka" mapstructure:"kafka"`
+	// OutboxOptions 包含领域事件发件箱配置选项。
+	OutboxOptions *genericoptions.OutboxOptions `json:"outbox" mapstructure:"outbox"`
 }
 
 // NewServerOptions 创建一个使用默认值的 ServerOptions 实例。
 func NewServerOptions() *ServerOptions {
 	opts := &ServerOptions{
-		JWTOptions:        genericoptions.NewJWTOptions(),
-		TLSOptions:        genericoptions.NewTLSOptions(),
-		HTTPOptions:       genericoptions.NewHTTPOptions(),
-		PostgreSQLOptions: genericoptions.NewPostgreSQLOptions(),
-		RedisOptions:      genericoptions.NewRedisOptions(),
-		OTelOptions:       genericoptions.NewOTelOptions(),
+		JWTOptions:          genericoptions.NewJWTOptions(),
+		TLSOptions:          genericoptions.NewTLSOptions(),
+		HTTPOptions:         genericoptions.NewHTTPOptions(),
+		DatabaseOptions:     genericoptions.NewDatabaseOptions(),
+		PostgreSQLOptions:   genericoptions.NewPostgreSQLOptions(),
+		MySQLOptions:        genericoptions.NewMySQLOptions(),
+		SQLiteOptions:       genericoptions.NewSQLiteOptions(),
+		RedisOptions:        genericoptions.NewRedisOptions(),
+		OTelOptions:         genericoptions.NewOTelOptions(),
+		RegistrationOptions: genericoptions.NewRegistrationOptions(),
+		PasswordOptions:     genericoptions.NewPasswordOptions(),
+		OIDCOptions:         genericoptions.NewOIDCOptions(),
+		LDAPOptions:         genericoptions.NewLDAPOptions(),
+		AuthzOptions:        genericoptions.NewAuthzOptions(),
+		TrashOptions:        genericoptions.NewTrashOptions(),
+		MigrationOptions:    genericoptions.NewMigrationOptions(),
+		CacheOptions:        genericoptions.NewCacheOptions(),
+		KafkaOptions:        genericoptions.NewKafkaOptions(),
+		OutboxOptions:       genericoptions.NewOutboxOptions(),
 	}
 	opts.HTTPOptions.Addr = ":5555"
 
@@ -47,9 +89,22 @@ func (o *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	// 为子选项添加命令行标志。
 	o.TLSOptions.AddFlags(fs, "tls")
 	o.HTTPOptions.AddFlags(fs, "http")
+	o.DatabaseOptions.AddFlags(fs, "database")
 	o.PostgreSQLOptions.AddFlags(fs, "postgresql")
+	o.MySQLOptions.AddFlags(fs, "mysql")
+	o.SQLiteOptions.AddFlags(fs, "sqlite")
 	o.RedisOptions.AddFlags(fs, "redis")
 	o.OTelOptions.AddFlags(fs, "otel")
+	o.RegistrationOptions.AddFlags(fs, "registration")
+	o.PasswordOptions.AddFlags(fs, "password")
+	o.OIDCOptions.AddFlags(fs, "oidc")
+	o.LDAPOptions.AddFlags(fs, "ldap")
+	o.AuthzOptions.AddFlags(fs, "authz")
+	o.TrashOptions.AddFlags(fs, "trash")
+	o.MigrationOptions.AddFlags(fs, "migration")
+	o.CacheOptions.AddFlags(fs, "cache")
+	o.KafkaOptions.AddFlags(fs, "kafka")
+	o.OutboxOptions.AddFlags(fs, "outbox")
 }
 
 // Complete 完成所有必需的选项。
@@ -67,9 +122,36 @@ func (o *ServerOptions) Validate() error {
 	// 验证子选项。
 	errs = append(errs, o.TLSOptions.Validate()...)
 	errs = append(errs, o.HTTPOptions.Validate()...)
-	errs = append(errs, o.PostgreSQLOptions.Validate()...)
+	errs = append(errs, o.DatabaseOptions.Validate()...)
+	// 只验证所选数据库的连接选项
+	switch o.DatabaseOptions.Driver {
+	case db.DriverPostgres:
+		errs = append(errs, o.PostgreSQLOptions.Validate()...)
+	case db.DriverMySQL:
+		errs = append(errs, o.MySQLOptions.Validate()...)
+	case db.DriverSQLite:
+		errs = append(errs, o.SQLiteOptions.Validate()...)
+	}
 	errs = append(errs, o.RedisOptions.Validate()...)
 	errs = append(errs, o.OTelOptions.Validate()...)
+	errs = append(errs, o.RegistrationOptions.Validate()...)
+	errs = append(errs, o.PasswordOptions.Validate()...)
+	errs = append(errs, o.OIDCOptions.Validate()...)
+	errs = append(errs, o.LDAPOptions.Validate()...)
+	errs = append(errs, o.AuthzOptions.Validate()...)
+	errs = append(errs, o.TrashOptions.Validate()...)
+	errs = append(errs, o.MigrationOptions.Validate()...)
+	errs = append(errs, o.CacheOptions.Validate()...)
+	// 只读副本追上主库前，其他请求可能把旧数据写入缓存，需要在副本追上后再次失效
+	if o.CacheOptions.Enabled && o.DatabaseOptions.Driver == db.DriverPostgres && len(o.PostgreSQLOptions.Replicas) > 0 &&
+		o.CacheOptions.InvalidateDelay < o.PostgreSQLOptions.ReplicaMaxLag {
+		errs = append(errs, fmt.Errorf("cache.invalidate-delay must not be less than postgresql.replica-max-lag when replicas are configured"))
+	}
+	errs = append(errs, o.OutboxOptions.Validate()...)
+	// 只有发布领域事件时才需要连接 Kafka
+	if o.OutboxOptions.Enabled {
+		errs = append(errs, o.KafkaOptions.Validate()...)
+	}
 
 	// 汇总所有错误并返回。
 	return utilerrors.NewAggregate(errs)
@@ -78,10 +160,23 @@ func (o *ServerOptions) Validate() error {
 // Config 基于 ServerOptions 构建 apiserver.Config。
 func (o *ServerOptions) Config() (*apiserver.Config, error) {
 	return &apiserver.Config{
-		JWTOptions:        o.JWTOptions,
-		TLSOptions:        o.TLSOptions,
-		HTTPOptions:       o.HTTPOptions,
-		PostgreSQLOptions: o.PostgreSQLOptions,
-		RedisOptions:      o.RedisOptions,
+		JWTOptions:          o.JWTOptions,
+		TLSOptions:          o.TLSOptions,
+		HTTPOptions:         o.HTTPOptions,
+		DatabaseOptions:     o.DatabaseOptions,
+		PostgreSQLOptions:   o.PostgreSQLOptions,
+		MySQLOptions:        o.MySQLOptions,
+		SQLiteOptions:       o.SQLiteOptions,
+		RedisOptions:        o.RedisOptions,
+		RegistrationOptions: o.RegistrationOptions,
+		PasswordOptions:     o.PasswordOptions,
+		OIDCOptions:         o.OIDCOptions,
+		LDAPOptions:         o.LDAPOptions,
+		AuthzOptions:        o.AuthzOptions,
+		TrashOptions:        o.TrashOptions,
+		MigrationOptions:    o.MigrationOptions,
+		CacheOptions:        o.CacheOptions,
+		KafkaOptions:        o.KafkaOptions,
+		OutboxOptions:       o.OutboxOptions,
 	}, nil
 }
@@ -116,6 +116,11 @@ func NewWebServerCommand() *cobra.Command {
 	// 添加 --version 标志
 	version.AddFlags(cmd.PersistentFlags())
 
+	// 添加导入导出 RBAC 清单的子命令
+	cmd.AddCommand(newRBACCommand(opts))
+	// 添加管理数据库迁移的子命令
+	cmd.AddCommand(newMigrateCommand(opts))
+
 	return cmd
 }
 
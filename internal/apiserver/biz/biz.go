@@ -6,8 +6,17 @@ import (
 	permissionv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/permission"
 	menuv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/menu"
 	userrolev1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/user_role"
+	sessionv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/session"
+	invitationv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/invitation"
+	ssov1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sso"
+	rbacv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/rbac"
+	accessrequestv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/access_request"
+	sodrulev1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
 	"github.com/google/wire"
 )
 
@@ -29,35 +38,60 @@ type IBiz interface {
 	MenuV1() menuv1.MenuBiz
 	// UserRoleV1 获取用户角色业务接口.
 	UserRoleV1() userrolev1.UserRoleBiz
+	// SessionV1 获取会话业务接口.
+	SessionV1() sessionv1.SessionBiz
+	// InvitationV1 获取邀请码业务接口.
+	InvitationV1() invitationv1.InvitationBiz
+	// SSOV1 获取单点登录业务接口.
+	SSOV1() ssov1.SSOBiz
+	// RBACV1 获取声明式 RBAC 清单业务接口.
+	RBACV1() rbacv1.RBACBiz
+	// AccessRequestV1 获取敏感角色授权申请业务接口.
+	AccessRequestV1() accessrequestv1.AccessRequestBiz
+	// SoDRuleV1 获取职责分离规则业务接口.
+	SoDRuleV1() sodrulev1.SoDRuleBiz
 }
 
 // biz 是 IBiz 的具体实现。
 type biz struct {
-	store store.IStore
-	authz *authz.Authz
+	store        store.IStore
+	authz        *authz.Authz
+	registration *genericoptions.RegistrationOptions
+	oidcOptions  *genericoptions.OIDCOptions
+	oidc         *oidc.Registry
+	authn        userv1.Authenticator
+	bus          *eventbus.Bus
 }
 
 // 确保 biz 实现了 IBiz 接口。
 var _ IBiz = (*biz)(nil)
 
 // NewBiz 创建 IBiz 实例。
-func NewBiz(store store.IStore, authz *authz.Authz) *biz {
-	return &biz{store: store, authz: authz}
+func NewBiz(
+	store store.IStore,
+	authz *authz.Authz,
+	registration *genericoptions.RegistrationOptions,
+	oidcOptions *genericoptions.OIDCOptions,
+	oidc *oidc.Registry,
+	authn userv1.Authenticator,
+	bus *eventbus.Bus,
+) *biz {
+	return &biz{store: store, authz: authz, registration: registration, oidcOptions: oidcOptions, oidc: oidc, authn: authn, bus: bus}
 }
 
 // UserV1 返回一个实现了 UserBiz 接口的实例.
 func (b *biz) UserV1() userv1.UserBiz {
-	return userv1.New(b.store, b.authz)
+	return userv1.New(b.store, b.authz, b.registration, b.authn, b.bus)
 }
 
 // RoleV1 返回一个实现了 RoleBiz 接口的实例.
 func (b *biz) RoleV1() rolev1.RoleBiz {
-	return rolev1.New(b.store, b.authz)
+	return rolev1.New(b.store, b.authz, b.bus)
 }
 
 // PermissionV1 返回一个实现了 PermissionBiz 接口的实例.
 func (b *biz) PermissionV1() permissionv1.PermissionBiz {
-	return permissionv1.New(b.store)
+	return permissionv1.New(b.store, b.authz)
 }
 
 // MenuV1 返回一个实现了 MenuBiz 接口的实例.
@@ -67,5 +101,35 @@ func (b *biz) MenuV1() menuv1.MenuBiz {
 
 // UserRoleV1 返回一个实现了 UserRoleBiz 接口的实例.
 func (b *biz) UserRoleV1() userrolev1.UserRoleBiz {
-	return userrolev1.New(b.store, b.authz)
+	return userrolev1.New(b.store, b.bus)
+}
+
+// SessionV1 返回一个实现了 SessionBiz 接口的实例.
+func (b *biz) SessionV1() sessionv1.SessionBiz {
+	return sessionv1.New(b.store)
+}
+
+// InvitationV1 返回一个实现了 InvitationBiz 接口的实例.
+func (b *biz) InvitationV1() invitationv1.InvitationBiz {
+	return invitationv1.New(b.store)
+}
+
+// SSOV1 返回一个实现了 SSOBiz 接口的实例.
+func (b *biz) SSOV1() ssov1.SSOBiz {
+	return ssov1.New(b.store, b.authz, b.oidcOptions, b.oidc, b.bus)
+}
+
+// RBACV1 返回一个实现了 RBACBiz 接口的实例.
+func (b *biz) RBACV1() rbacv1.RBACBiz {
+	return rbacv1.New(b.store, b.authz)
+}
+
+// AccessRequestV1 返回一个实现了 AccessRequestBiz 接口的实例.
+func (b *biz) AccessRequestV1() accessrequestv1.AccessRequestBiz {
+	return accessrequestv1.New(b.store, b.bus)
+}
+
+// SoDRuleV1 返回一个实现了 SoDRuleBiz 接口的实例.
+func (b *biz) SoDRuleV1() sodrulev1.SoDRuleBiz {
+	return sodrulev1.New(b.store)
 }
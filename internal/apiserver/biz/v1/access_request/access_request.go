@@ -0,0 +1,88 @@
+package access_request
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+)
+
+// RequestTTL 是授权申请的有效期，超过该时间未审批的申请由后台任务标记为已过期.
+const RequestTTL = 72 * time.Hour
+
+// AccessRequestBiz 定义处理授权申请请求所需的方法.
+type AccessRequestBiz interface {
+	// Create 创建授权申请
+	Create(ctx context.Context, rq *v1.CreateAccessRequestRequest) (*v1.CreateAccessRequestResponse, error)
+	// List 获取授权申请列表
+	List(ctx context.Context, rq *v1.ListAccessRequestsRequest) (*v1.ListAccessRequestsResponse, error)
+	// Get 获取授权申请详情
+	Get(ctx context.Context, rq *v1.GetAccessRequestRequest) (*v1.GetAccessRequestResponse, error)
+	// Approve 批准授权申请并授予角色
+	Approve(ctx context.Context, rq *v1.ApproveAccessRequestRequest) (*v1.ApproveAccessRequestResponse, error)
+	// Reject 拒绝授权申请
+	Reject(ctx context.Context, rq *v1.RejectAccessRequestRequest) (*v1.RejectAccessRequestResponse, error)
+}
+
+// accessRequestBiz 是 AccessRequestBiz 接口的实现.
+type accessRequestBiz struct {
+	store store.IStore
+	bus   *eventbus.Bus
+}
+
+// 确保 accessRequestBiz 实现了 AccessRequestBiz 接口.
+var _ AccessRequestBiz = (*accessRequestBiz)(nil)
+
+func New(store store.IStore, bus *eventbus.Bus) *accessRequestBiz {
+	return &accessRequestBiz{store: store, bus: bus}
+}
+
+// IsSensitive 判断授予角色是否需要审批. super_admin 始终视为敏感角色.
+func IsSensitive(roleM *model.RoleM) bool {
+	return roleM.Sensitive || "role::"+roleM.RoleCode == known.RoleSuperAdmin
+}
+
+// RequiresApproval 判断为用户分配 userRole 是否需要审批，held 为用户已持有的同一角色的分配，没有时为 nil.
+// 敏感角色的分配只有在有效期被已持有的分配完全覆盖时才能直接授予，
+// 提前生效时间、推迟或取消过期时间等放宽有效期的修改同样需要审批.
+func RequiresApproval(roleM *model.RoleM, held, userRole *model.UserRoleM) bool {
+	if !IsSensitive(roleM) {
+		return false
+	}
+	return held == nil || !covers(held, userRole)
+}
+
+// covers 判断分配 a 的有效期是否覆盖分配 b 的有效期. 生效时间为空表示立即生效，过期时间为空表示永久有效.
+func covers(a, b *model.UserRoleM) bool {
+	if a.StartsAt != nil && (b.StartsAt == nil || b.StartsAt.Before(*a.StartsAt)) {
+		return false
+	}
+	if a.ExpiresAt != nil && (b.ExpiresAt == nil || b.ExpiresAt.After(*a.ExpiresAt)) {
+		return false
+	}
+	return true
+}
+
+// canApprove 判断持有 approverRoles 的用户能否审批 roleM 的授权申请.
+// 角色配置了审批角色时，持有其中任一角色即可审批；未配置时只有 super_admin 可以审批.
+func canApprove(roleM *model.RoleM, approverRoles []*model.RoleM) bool {
+	approverRoleIDs := conversion.RoleApproverRoleIDs(roleM)
+	for _, approverRole := range approverRoles {
+		if len(approverRoleIDs) == 0 {
+			if "role::"+approverRole.RoleCode == known.RoleSuperAdmin {
+				return true
+			}
+			continue
+		}
+		if slices.Contains(approverRoleIDs, approverRole.RoleID) {
+			return true
+		}
+	}
+	return false
+}
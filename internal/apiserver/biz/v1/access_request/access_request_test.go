@@ -0,0 +1,41 @@
+package access_request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/pkg/ptr"
+)
+
+func TestRequiresApproval(t *testing.T) {
+	now := time.Now()
+	in := func(d time.Duration) *time.Time { return ptr.To(now.Add(d)) }
+	sensitive := &model.RoleM{RoleCode: "auditor", Sensitive: true}
+
+	tests := []struct {
+		name     string
+		roleM    *model.RoleM
+		held     *model.UserRoleM
+		userRole *model.UserRoleM
+		want     bool
+	}{
+		{name: "普通角色", roleM: &model.RoleM{RoleCode: "viewer"}, userRole: &model.UserRoleM{}, want: false},
+		{name: "super_admin 始终敏感", roleM: &model.RoleM{RoleCode: "super_admin"}, userRole: &model.UserRoleM{}, want: true},
+		{name: "未持有的敏感角色", roleM: sensitive, userRole: &model.UserRoleM{ExpiresAt: in(time.Hour)}, want: true},
+		{name: "保持永久授权", roleM: sensitive, held: &model.UserRoleM{}, userRole: &model.UserRoleM{}, want: false},
+		{name: "缩短有效期", roleM: sensitive, held: &model.UserRoleM{ExpiresAt: in(8 * time.Hour)}, userRole: &model.UserRoleM{ExpiresAt: in(time.Hour)}, want: false},
+		{name: "限时授权改为永久", roleM: sensitive, held: &model.UserRoleM{ExpiresAt: in(8 * time.Hour)}, userRole: &model.UserRoleM{}, want: true},
+		{name: "推迟过期时间", roleM: sensitive, held: &model.UserRoleM{ExpiresAt: in(8 * time.Hour)}, userRole: &model.UserRoleM{ExpiresAt: in(9 * time.Hour)}, want: true},
+		{name: "未生效的授权改为立即生效", roleM: sensitive, held: &model.UserRoleM{StartsAt: in(time.Hour)}, userRole: &model.UserRoleM{}, want: true},
+		{name: "推迟生效时间", roleM: sensitive, held: &model.UserRoleM{StartsAt: in(time.Hour)}, userRole: &model.UserRoleM{StartsAt: in(2 * time.Hour)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RequiresApproval(tt.roleM, tt.held, tt.userRole))
+		})
+	}
+}
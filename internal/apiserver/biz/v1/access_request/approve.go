@@ -0,0 +1,157 @@
+package access_request
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/audit"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Approve 批准授权申请并授予角色.
+// 申请状态、角色分配、审计日志在同一事务中写入，事务提交后发布 event.UserRolesAssigned，
+// 由事件订阅者将用户当前生效的角色同步到 Casbin，尚未生效的授权由后台任务在生效时同步.
+func (b *accessRequestBiz) Approve(ctx context.Context, rq *v1.ApproveAccessRequestRequest) (*v1.ApproveAccessRequestResponse, error) {
+	accessRequestM, roleM, err := b.prepareReview(ctx, rq.GetRequestID(), rq.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	assigned := &event.UserRolesAssigned{UserID: accessRequestM.UserID, RoleIDs: []string{accessRequestM.RoleID}}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.decide(ctx, accessRequestM, known.AccessRequestApproved, now); err != nil {
+			return err
+		}
+
+		// 先移除同一角色的旧分配，避免重复的用户-角色记录
+		if err := b.store.UserRole().RemoveRole(ctx, accessRequestM.UserID, accessRequestM.RoleID); err != nil {
+			return fmt.Errorf("failed to remove existing user role: %w", err)
+		}
+		// 申请期间用户可能获得了与申请角色互斥的角色，授予前重新检查职责分离规则
+		if err := sodrule.CheckGrant(ctx, b.store, accessRequestM.UserID, accessRequestM.RoleID); err != nil {
+			return err
+		}
+		userRoleM := &model.UserRoleM{
+			UserID:     accessRequestM.UserID,
+			RoleID:     accessRequestM.RoleID,
+			AssignedAt: now,
+			StartsAt:   accessRequestM.GrantStartsAt,
+			ExpiresAt:  accessRequestM.GrantExpiresAt,
+		}
+		if err := b.store.UserRole().Create(ctx, userRoleM); err != nil {
+			return fmt.Errorf("failed to grant role: %w", err)
+		}
+
+		if err := b.recordReview(ctx, audit.ActionAccessRequestApprove, accessRequestM); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, assigned)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	event.Publish(ctx, b.bus, assigned)
+
+	slog.InfoContext(ctx, "Approved access request", "requestID", accessRequestM.RequestID, "userID", accessRequestM.UserID, "role", roleM.RoleCode)
+
+	return &v1.ApproveAccessRequestResponse{
+		AccessRequest: conversion.AccessRequestModelToAccessRequestV1(accessRequestM, roleM),
+	}, nil
+}
+
+// Reject 拒绝授权申请.
+func (b *accessRequestBiz) Reject(ctx context.Context, rq *v1.RejectAccessRequestRequest) (*v1.RejectAccessRequestResponse, error) {
+	accessRequestM, roleM, err := b.prepareReview(ctx, rq.GetRequestID(), rq.GetComment())
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.decide(ctx, accessRequestM, known.AccessRequestRejected, time.Now()); err != nil {
+			return err
+		}
+		return b.recordReview(ctx, audit.ActionAccessRequestReject, accessRequestM)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Rejected access request", "requestID", accessRequestM.RequestID, "userID", accessRequestM.UserID, "role", roleM.RoleCode)
+
+	return &v1.RejectAccessRequestResponse{
+		AccessRequest: conversion.AccessRequestModelToAccessRequestV1(accessRequestM, roleM),
+	}, nil
+}
+
+// prepareReview 获取待审批的申请并校验当前用户的审批资格：
+// 申请人和被授予人都不能审批该申请，审批人必须持有角色配置的审批角色.
+func (b *accessRequestBiz) prepareReview(ctx context.Context, requestID, comment string) (*model.AccessRequestM, *model.RoleM, error) {
+	accessRequestM, err := b.get(ctx, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if accessRequestM.Status != known.AccessRequestPending || !accessRequestM.ExpiresAt.After(time.Now()) {
+		return nil, nil, errno.ErrAccessRequestNotPending
+	}
+
+	reviewerID := contextx.UserID(ctx)
+	if reviewerID == accessRequestM.RequesterID || reviewerID == accessRequestM.UserID {
+		return nil, nil, errno.ErrAccessRequestSelfApproval
+	}
+
+	roleM, err := b.store.Role().Get(ctx, where.F("role_id", accessRequestM.RoleID).L(1))
+	if err != nil {
+		return nil, nil, errno.ErrRoleNotFound
+	}
+	reviewerRoles, err := b.store.UserRole().GetUserRoles(ctx, reviewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !canApprove(roleM, reviewerRoles) {
+		return nil, nil, errno.ErrAccessRequestNotApprover
+	}
+
+	accessRequestM.ReviewerID = &reviewerID
+	if comment != "" {
+		accessRequestM.ReviewComment = &comment
+	}
+	return accessRequestM, roleM, nil
+}
+
+// decide 将待审批的申请更新为 status，申请已被并发处理或已过期时返回 errno.ErrAccessRequestNotPending.
+func (b *accessRequestBiz) decide(ctx context.Context, accessRequestM *model.AccessRequestM, status string, now time.Time) error {
+	decided, err := b.store.AccessRequest().Decide(ctx, accessRequestM, status, now)
+	if err != nil {
+		return fmt.Errorf("failed to update access request: %w", err)
+	}
+	if !decided {
+		return errno.ErrAccessRequestNotPending
+	}
+	return nil
+}
+
+// recordReview 记录审批操作的审计日志.
+func (b *accessRequestBiz) recordReview(ctx context.Context, action string, accessRequestM *model.AccessRequestM) error {
+	details := map[string]any{
+		"userID":      accessRequestM.UserID,
+		"roleID":      accessRequestM.RoleID,
+		"requesterID": accessRequestM.RequesterID,
+		"comment":     accessRequestM.ReviewComment,
+	}
+	if err := audit.Record(ctx, b.store, action, resource(accessRequestM), details); err != nil {
+		slog.ErrorContext(ctx, "Failed to record access request audit log", "error", err)
+		return errno.ErrDBWrite
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package access_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/audit"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Create 创建授权申请. 只有敏感角色需要申请，用户已持有覆盖申请有效期的该角色或已有待审批的申请时返回错误.
+// 用户持有该角色的限时授权时，可以申请放宽有效期.
+func (b *accessRequestBiz) Create(ctx context.Context, rq *v1.CreateAccessRequestRequest) (*v1.CreateAccessRequestResponse, error) {
+	if _, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID()).L(1)); err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+	roleM, err := b.store.Role().Get(ctx, where.F("role_id", rq.GetRoleID()).L(1))
+	if err != nil {
+		return nil, errno.ErrRoleNotFound
+	}
+	if !IsSensitive(roleM) {
+		return nil, errno.ErrAccessRequestRoleNotSensitive
+	}
+
+	userRoles, err := b.store.UserRole().List(ctx, where.F("user_id", rq.GetUserID(), "role_id", rq.GetRoleID()))
+	if err != nil {
+		return nil, err
+	}
+	requested := &model.UserRoleM{StartsAt: conversion.UnixToTime(rq.GrantStartsAt), ExpiresAt: conversion.UnixToTime(rq.GrantExpiresAt)}
+	if len(userRoles) > 0 && covers(userRoles[0], requested) {
+		return nil, errno.ErrAccessRequestRoleAlreadyGranted
+	}
+	if err := sodrule.CheckUserRoles(ctx, b.store, rq.GetUserID(), rq.GetRoleID()); err != nil {
+		return nil, err
+	}
+
+	accessRequestM := &model.AccessRequestM{
+		UserID:         rq.GetUserID(),
+		RoleID:         rq.GetRoleID(),
+		GrantStartsAt:  conversion.UnixToTime(rq.GrantStartsAt),
+		GrantExpiresAt: conversion.UnixToTime(rq.GrantExpiresAt),
+	}
+	if rq.GetReason() != "" {
+		reason := rq.GetReason()
+		accessRequestM.Reason = &reason
+	}
+
+	if err := Submit(ctx, b.store, accessRequestM); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateAccessRequestResponse{
+		AccessRequest: conversion.AccessRequestModelToAccessRequestV1(accessRequestM, roleM),
+	}, nil
+}
+
+// Submit 以当前用户为申请人创建一条待审批的授权申请并记录审计日志.
+// 同一用户和角色已有待审批的申请时返回 errno.ErrAccessRequestAlreadyPending.
+func Submit(ctx context.Context, s store.IStore, accessRequestM *model.AccessRequestM) error {
+	_, err := s.AccessRequest().Get(ctx, where.F(
+		"user_id", accessRequestM.UserID,
+		"role_id", accessRequestM.RoleID,
+		"status", known.AccessRequestPending,
+	).L(1))
+	if err == nil {
+		return errno.ErrAccessRequestAlreadyPending
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to check pending access request: %w", err)
+	}
+
+	now := time.Now()
+	accessRequestM.RequesterID = contextx.UserID(ctx)
+	accessRequestM.Status = known.AccessRequestPending
+	accessRequestM.ExpiresAt = now.Add(RequestTTL)
+
+	return s.TX(ctx, func(ctx context.Context) error {
+		if err := s.AccessRequest().Create(ctx, accessRequestM); err != nil {
+			return fmt.Errorf("failed to create access request: %w", err)
+		}
+
+		details := map[string]any{
+			"userID":    accessRequestM.UserID,
+			"roleID":    accessRequestM.RoleID,
+			"reason":    accessRequestM.Reason,
+			"expiresAt": accessRequestM.ExpiresAt.Unix(),
+		}
+		if err := audit.Record(ctx, s, audit.ActionAccessRequestCreate, resource(accessRequestM), details); err != nil {
+			slog.ErrorContext(ctx, "Failed to record access request audit log", "error", err)
+			return errno.ErrDBWrite
+		}
+		return nil
+	})
+}
+
+// resource 返回授权申请在审计日志中的资源标识.
+func resource(accessRequestM *model.AccessRequestM) string {
+	return "/v1/access-requests/" + accessRequestM.RequestID
+}
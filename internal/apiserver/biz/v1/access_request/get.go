@@ -0,0 +1,40 @@
+package access_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Get 获取授权申请详情.
+func (b *accessRequestBiz) Get(ctx context.Context, rq *v1.GetAccessRequestRequest) (*v1.GetAccessRequestResponse, error) {
+	accessRequestM, err := b.get(ctx, rq.GetRequestID())
+	if err != nil {
+		return nil, err
+	}
+	roleM, _ := b.store.Role().Get(ctx, where.F("role_id", accessRequestM.RoleID).L(1))
+
+	return &v1.GetAccessRequestResponse{
+		AccessRequest: conversion.AccessRequestModelToAccessRequestV1(accessRequestM, roleM),
+	}, nil
+}
+
+// get 根据申请 ID 获取授权申请.
+func (b *accessRequestBiz) get(ctx context.Context, requestID string) (*model.AccessRequestM, error) {
+	accessRequestM, err := b.store.AccessRequest().Get(ctx, where.F("request_id", requestID).L(1))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrAccessRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get access request: %w", err)
+	}
+	return accessRequestM, nil
+}
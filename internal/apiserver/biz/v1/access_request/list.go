@@ -0,0 +1,61 @@
+package access_request
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// List 获取授权申请列表，支持按状态和被授予用户过滤.
+func (b *accessRequestBiz) List(ctx context.Context, rq *v1.ListAccessRequestsRequest) (*v1.ListAccessRequestsResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize)))
+	if rq.Status != nil {
+		opts.F("status", rq.GetStatus())
+	}
+	if rq.UserID != nil {
+		opts.F("user_id", rq.GetUserID())
+	}
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, accessRequests, err := b.store.AccessRequest().List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(accessRequests) == pageSize {
+		cursor, err := pagination.NewCursor("id", accessRequests[len(accessRequests)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	roles := make(map[string]*model.RoleM)
+	result := make([]*v1.AccessRequest, 0, len(accessRequests))
+	for _, accessRequestM := range accessRequests {
+		roleM, ok := roles[accessRequestM.RoleID]
+		if !ok {
+			roleM, _ = b.store.Role().Get(ctx, where.F("role_id", accessRequestM.RoleID).L(1))
+			roles[accessRequestM.RoleID] = roleM
+		}
+		result = append(result, conversion.AccessRequestModelToAccessRequestV1(accessRequestM, roleM))
+	}
+
+	return &v1.ListAccessRequestsResponse{
+		TotalCount:     total,
+		AccessRequests: result,
+		PageToken:      nextPageToken,
+	}, nil
+}
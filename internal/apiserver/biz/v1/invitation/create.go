@@ -0,0 +1,67 @@
+package invitation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	accessrequest "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/access_request"
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Create 创建邀请码，邀请码由 crypto/rand 随机生成.
+// 敏感角色只能通过授权申请审批后授予，不能作为邀请码的预分配角色；预分配的角色组合不能违反职责分离规则.
+func (b *invitationBiz) Create(ctx context.Context, rq *v1.CreateInvitationRequest) (*v1.CreateInvitationResponse, error) {
+	// 验证预分配的角色是否存在
+	for _, roleID := range rq.GetRoleIDs() {
+		roleM, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1))
+		if err != nil {
+			slog.WarnContext(ctx, "Role not found", "roleID", roleID)
+			return nil, errno.ErrRoleNotFound
+		}
+		if accessrequest.IsSensitive(roleM) {
+			return nil, errno.ErrAccessRequestApprovalRequired.WithMessage(fmt.Sprintf("Sensitive role `%s` cannot be pre-assigned by invitations", roleM.RoleCode))
+		}
+	}
+	if err := sodrule.CheckRoles(ctx, b.store, rq.GetRoleIDs()); err != nil {
+		return nil, err
+	}
+
+	invitationM := &model.InvitationM{MaxUses: rq.GetMaxUses()}
+	if invitationM.MaxUses <= 0 {
+		invitationM.MaxUses = 1
+	}
+	if len(rq.GetRoleIDs()) > 0 {
+		data, err := json.Marshal(rq.GetRoleIDs())
+		if err != nil {
+			return nil, err
+		}
+		roleIDs := string(data)
+		invitationM.RoleIDs = &roleIDs
+	}
+	if rq.GetExpiresAt() > 0 {
+		expiresAt := time.Unix(rq.GetExpiresAt(), 0)
+		invitationM.ExpiresAt = &expiresAt
+	}
+	if rq.GetRemark() != "" {
+		remark := rq.GetRemark()
+		invitationM.Remark = &remark
+	}
+	if userID := contextx.UserID(ctx); userID != "" {
+		invitationM.CreatedBy = &userID
+	}
+
+	if err := b.store.Invitation().Create(ctx, invitationM); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateInvitationResponse{Invitation: conversion.InvitationModelToInvitationV1(invitationM)}, nil
+}
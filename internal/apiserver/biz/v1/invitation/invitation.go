@@ -0,0 +1,30 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// InvitationBiz 定义处理邀请码请求所需的方法.
+type InvitationBiz interface {
+	// Create 创建邀请码
+	Create(ctx context.Context, rq *v1.CreateInvitationRequest) (*v1.CreateInvitationResponse, error)
+	// List 获取邀请码列表
+	List(ctx context.Context, rq *v1.ListInvitationRequest) (*v1.ListInvitationResponse, error)
+	// Revoke 撤销邀请码
+	Revoke(ctx context.Context, rq *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error)
+}
+
+// invitationBiz 是 InvitationBiz 接口的实现.
+type invitationBiz struct {
+	store store.IStore
+}
+
+// 确保 invitationBiz 实现了 InvitationBiz 接口.
+var _ InvitationBiz = (*invitationBiz)(nil)
+
+func New(store store.IStore) *invitationBiz {
+	return &invitationBiz{store: store}
+}
@@ -0,0 +1,43 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// List 获取邀请码列表.
+func (b *invitationBiz) List(ctx context.Context, rq *v1.ListInvitationRequest) (*v1.ListInvitationResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize)))
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, invitations, err := b.store.Invitation().List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(invitations) == pageSize {
+		cursor, err := pagination.NewCursor("id", invitations[len(invitations)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	return &v1.ListInvitationResponse{
+		TotalCount:  total,
+		Invitations: conversion.InvitationModelListToInvitationV1List(invitations),
+		PageToken:   nextPageToken,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// Revoke 撤销邀请码，已使用该邀请码注册的用户不受影响.
+func (b *invitationBiz) Revoke(ctx context.Context, rq *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error) {
+	revoked, err := b.store.Invitation().Revoke(ctx, rq.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if revoked == 0 {
+		return nil, errno.ErrInvitationNotFound
+	}
+
+	return &v1.RevokeInvitationResponse{}, nil
+}
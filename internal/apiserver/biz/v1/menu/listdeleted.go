@@ -0,0 +1,43 @@
+package menu
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// ListDeleted 获取回收站中的菜单列表.
+func (b *menuBiz) ListDeleted(ctx context.Context, rq *v1.ListDeletedMenusRequest) (*v1.ListDeletedMenusResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize)))
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, menus, err := b.store.Menu().ListDeleted(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(menus) == pageSize {
+		cursor, err := pagination.NewCursor("id", menus[len(menus)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	return &v1.ListDeletedMenusResponse{
+		TotalCount: total,
+		Menus:      conversion.MenuModelListToMenuV1List(menus),
+		PageToken:  nextPageToken,
+	}, nil
+}
@@ -24,6 +24,10 @@ type MenuExpansion interface {
 	ListMenuTree(ctx context.Context, rq *v1.ListMenuTreeRequest) (*v1.ListMenuTreeResponse, error)
 	// GetUserMenuTree 获取用户可见的菜单树
 	GetUserMenuTree(ctx context.Context, rq *v1.GetUserMenuTreeRequest) (*v1.GetUserMenuTreeResponse, error)
+	// ListDeleted 获取回收站中的菜单列表
+	ListDeleted(ctx context.Context, rq *v1.ListDeletedMenusRequest) (*v1.ListDeletedMenusResponse, error)
+	// Restore 从回收站恢复菜单
+	Restore(ctx context.Context, rq *v1.RestoreMenuRequest) (*v1.RestoreMenuResponse, error)
 }
 
 // menuBiz 是 MenuBiz 接口的实现.
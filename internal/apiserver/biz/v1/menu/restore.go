@@ -0,0 +1,39 @@
+package menu
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Restore 从回收站恢复菜单.
+func (b *menuBiz) Restore(ctx context.Context, rq *v1.RestoreMenuRequest) (*v1.RestoreMenuResponse, error) {
+	menuM, err := b.store.Menu().GetDeleted(ctx, where.F("menu_id", rq.GetMenuID()).L(1))
+	if err != nil {
+		return nil, errno.ErrMenuNotFound
+	}
+
+	// 父菜单仍在回收站中时恢复的菜单无法挂到菜单树上
+	if menuM.ParentID != nil && *menuM.ParentID != "" {
+		if _, err := b.store.Menu().Get(ctx, where.F("menu_id", *menuM.ParentID).L(1)); err != nil {
+			return nil, errno.ErrMenuParentDeleted
+		}
+	}
+
+	// 菜单编码在删除后可以被新菜单复用，此时不能恢复
+	if _, err := b.store.Menu().GetByMenuCode(ctx, menuM.MenuCode); err == nil {
+		return nil, errno.ErrMenuAlreadyExists
+	}
+
+	restored, err := b.store.Menu().Restore(ctx, where.F("menu_id", menuM.MenuID))
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, errno.ErrMenuNotFound
+	}
+
+	return &v1.RestoreMenuResponse{}, nil
+}
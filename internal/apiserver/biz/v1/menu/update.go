@@ -2,28 +2,43 @@ package menu
 
 import (
 	"context"
+	"errors"
 
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"github.com/jinzhu/copier"
 )
 
-// Update 更新菜单.
+// Update 更新菜单，只修改 updateMask 中的字段，请求携带 version 时校验版本号.
 func (b *menuBiz) Update(ctx context.Context, rq *v1.UpdateMenuRequest) (*v1.UpdateMenuResponse, error) {
 	menuM, err := b.store.Menu().Get(ctx, where.F("menu_id", rq.GetMenuID()).L(1))
 	if err != nil {
 		return nil, errno.ErrMenuNotFound
 	}
+	if rq.Version != nil && rq.GetVersion() != menuM.Version {
+		return nil, errno.ErrConflict
+	}
 
-	// 使用 copier 更新字段
-	if err := copier.CopyWithOption(menuM, rq, copier.Option{IgnoreEmpty: true}); err != nil {
+	mask, err := fieldmask.New(rq, rq.GetUpdateMask(), "menuID", "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	fields, err := mask.Apply(menuM, rq)
+	if err != nil {
 		return nil, err
 	}
+	if len(fields) == 0 {
+		return &v1.UpdateMenuResponse{Version: menuM.Version}, nil
+	}
 
-	if err := b.store.Menu().Update(ctx, menuM); err != nil {
+	if err := b.store.Menu().UpdateFields(ctx, menuM, fields...); err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
 		return nil, err
 	}
 
-	return &v1.UpdateMenuResponse{}, nil
+	return &v1.UpdateMenuResponse{Version: menuM.Version}, nil
 }
@@ -15,6 +15,7 @@ func (b *permissionBiz) Create(ctx context.Context, rq *v1.CreatePermissionReque
 	if err := copier.Copy(&permM, rq); err != nil {
 		return nil, err
 	}
+	permM.Conditions = conversion.PermissionConditionV1ToJSON(rq.GetConditions())
 
 	// 检查权限编码是否已存在
 	if existingPerm, err := b.store.Permission().GetByPermissionCode(ctx, permM.PermissionCode); err == nil && existingPerm != nil {
@@ -9,6 +9,7 @@ import (
 )
 
 // Delete 删除权限.
+// 权限被软删除后保留角色的权限分配，以便从回收站恢复，但会重建持有该权限的角色在 Casbin 中的策略.
 func (b *permissionBiz) Delete(ctx context.Context, rq *v1.DeletePermissionRequest) (*v1.DeletePermissionResponse, error) {
 	// 检查是否有子权限
 	children, err := b.store.Permission().GetChildren(ctx, rq.GetPermissionID())
@@ -16,7 +17,13 @@ func (b *permissionBiz) Delete(ctx context.Context, rq *v1.DeletePermissionReque
 		return nil, errno.ErrPermissionHasChildren
 	}
 
-	if err := b.store.Permission().Delete(ctx, where.F("permission_id", rq.GetPermissionID())); err != nil {
+	err = b.store.TX(ctx, func(txCtx context.Context) error {
+		if err := b.store.Permission().Delete(txCtx, where.F("permission_id", rq.GetPermissionID())); err != nil {
+			return err
+		}
+		return b.syncRolePolicies(txCtx, rq.GetPermissionID())
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,67 @@
+package permission
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/apicatalog"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// GetAPICatalog 获取自动发现的 API 路由目录，按模块分组.
+func (b *permissionBiz) GetAPICatalog(ctx context.Context, rq *v1.GetAPICatalogRequest) (*v1.GetAPICatalogResponse, error) {
+	_, permissions, err := b.store.Permission().List(ctx, where.F("resource_type", apicatalog.ResourceType))
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make(map[string]*v1.APIModule)
+	for _, permM := range permissions {
+		if !apicatalog.IsModuleCode(permM.PermissionCode) {
+			continue
+		}
+		modules[permM.PermissionID] = &v1.APIModule{
+			PermissionID: permM.PermissionID,
+			Module:       strings.TrimPrefix(permM.PermissionCode, apicatalog.ModuleCode("")),
+			Name:         permM.PermissionName,
+		}
+	}
+
+	for _, permM := range permissions {
+		method, route, ok := apicatalog.ParseCode(permM.PermissionCode)
+		if !ok || (permM.Stale && !rq.GetIncludeStale()) || permM.ParentID == nil {
+			continue
+		}
+		module, ok := modules[*permM.ParentID]
+		if !ok {
+			continue
+		}
+		module.Routes = append(module.Routes, &v1.APIRoute{
+			PermissionID: permM.PermissionID,
+			Name:         permM.PermissionName,
+			Method:       method,
+			Route:        route,
+			ResourcePath: derefString(permM.ResourcePath),
+			Stale:        permM.Stale,
+		})
+	}
+
+	resp := &v1.GetAPICatalogResponse{Modules: make([]*v1.APIModule, 0, len(modules))}
+	for _, module := range modules {
+		if len(module.Routes) == 0 {
+			continue
+		}
+		sort.Slice(module.Routes, func(i, j int) bool {
+			if module.Routes[i].Route != module.Routes[j].Route {
+				return module.Routes[i].Route < module.Routes[j].Route
+			}
+			return module.Routes[i].Method < module.Routes[j].Method
+		})
+		resp.Modules = append(resp.Modules, module)
+	}
+	sort.Slice(resp.Modules, func(i, j int) bool { return resp.Modules[i].Module < resp.Modules[j].Module })
+
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// ListDeleted 获取回收站中的权限列表.
+func (b *permissionBiz) ListDeleted(ctx context.Context, rq *v1.ListDeletedPermissionsRequest) (*v1.ListDeletedPermissionsResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize)))
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, permissions, err := b.store.Permission().ListDeleted(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(permissions) == pageSize {
+		cursor, err := pagination.NewCursor("id", permissions[len(permissions)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	return &v1.ListDeletedPermissionsResponse{
+		TotalCount:  total,
+		Permissions: conversion.PermissionModelListToPermissionV1List(permissions),
+		PageToken:   nextPageToken,
+	}, nil
+}
@@ -3,8 +3,10 @@ package permission
 import (
 	"context"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/apicatalog"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
 )
 
 // PermissionBiz 定义处理权限请求所需的方法.
@@ -22,16 +24,25 @@ type PermissionBiz interface {
 type PermissionExpansion interface {
 	// ListPermissionTree 获取权限树
 	ListPermissionTree(ctx context.Context, rq *v1.ListPermissionTreeRequest) (*v1.ListPermissionTreeResponse, error)
+	// GetAPICatalog 获取自动发现的 API 路由目录
+	GetAPICatalog(ctx context.Context, rq *v1.GetAPICatalogRequest) (*v1.GetAPICatalogResponse, error)
+	// SyncAPIRoutes 将已注册的 API 路由同步为 api 类型的权限记录
+	SyncAPIRoutes(ctx context.Context, routes []*apicatalog.Route) error
+	// ListDeleted 获取回收站中的权限列表
+	ListDeleted(ctx context.Context, rq *v1.ListDeletedPermissionsRequest) (*v1.ListDeletedPermissionsResponse, error)
+	// Restore 从回收站恢复权限，并重新同步 Casbin 策略
+	Restore(ctx context.Context, rq *v1.RestorePermissionRequest) (*v1.RestorePermissionResponse, error)
 }
 
 // permissionBiz 是 PermissionBiz 接口的实现.
 type permissionBiz struct {
 	store store.IStore
+	authz *authz.Authz
 }
 
 // 确保 permissionBiz 实现了 PermissionBiz 接口.
 var _ PermissionBiz = (*permissionBiz)(nil)
 
-func New(store store.IStore) *permissionBiz {
-	return &permissionBiz{store: store}
+func New(store store.IStore, authz *authz.Authz) *permissionBiz {
+	return &permissionBiz{store: store, authz: authz}
 }
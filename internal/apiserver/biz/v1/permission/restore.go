@@ -0,0 +1,45 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Restore 从回收站恢复权限，并重建持有该权限的角色在 Casbin 中的策略.
+func (b *permissionBiz) Restore(ctx context.Context, rq *v1.RestorePermissionRequest) (*v1.RestorePermissionResponse, error) {
+	permM, err := b.store.Permission().GetDeleted(ctx, where.F("permission_id", rq.GetPermissionID()).L(1))
+	if err != nil {
+		return nil, errno.ErrPermissionNotFound
+	}
+
+	// 父权限仍在回收站中时恢复的权限无法挂到权限树上
+	if permM.ParentID != nil && *permM.ParentID != "" {
+		if _, err := b.store.Permission().Get(ctx, where.F("permission_id", *permM.ParentID).L(1)); err != nil {
+			return nil, errno.ErrPermissionParentDeleted
+		}
+	}
+
+	// 权限编码在删除后可以被新权限复用，此时不能恢复
+	if _, err := b.store.Permission().GetByPermissionCode(ctx, permM.PermissionCode); err == nil {
+		return nil, errno.ErrPermissionAlreadyExists
+	}
+
+	err = b.store.TX(ctx, func(txCtx context.Context) error {
+		restored, err := b.store.Permission().Restore(txCtx, where.F("permission_id", permM.PermissionID))
+		if err != nil {
+			return err
+		}
+		if !restored {
+			return errno.ErrPermissionNotFound
+		}
+		return b.syncRolePolicies(txCtx, permM.PermissionID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RestorePermissionResponse{}, nil
+}
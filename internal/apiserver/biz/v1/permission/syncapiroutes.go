@@ -0,0 +1,130 @@
+package permission
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/apicatalog"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// SyncAPIRoutes 将已注册的 API 路由同步为 api 类型的权限记录.
+// 每个模块对应一个父权限，路由权限挂在模块父权限下；已存在的记录仅更新资源路径和父权限，
+// 保留管理员修改过的名称和描述；资源路径变化时重建持有该权限的角色的策略。
+// 不再注册的路由不会被删除（可能已分配给角色），而是标记为 stale.
+func (b *permissionBiz) SyncAPIRoutes(ctx context.Context, routes []*apicatalog.Route) error {
+	return b.store.TX(ctx, func(ctx context.Context) error {
+		_, existing, err := b.store.Permission().List(ctx, where.F("resource_type", apicatalog.ResourceType))
+		if err != nil {
+			return fmt.Errorf("failed to list api permissions: %w", err)
+		}
+
+		byCode := make(map[string]*model.PermissionM, len(existing))
+		for _, permM := range existing {
+			byCode[permM.PermissionCode] = permM
+		}
+
+		seen := make(map[string]bool, len(routes))
+		var created, revived int
+		for _, route := range routes {
+			moduleCode := apicatalog.ModuleCode(route.Module)
+			if !seen[moduleCode] {
+				seen[moduleCode] = true
+				moduleM, isNew, isRevived, err := b.upsertAPIPermission(ctx, byCode, &model.PermissionM{
+					PermissionName: route.ModuleName,
+					PermissionCode: moduleCode,
+					ResourceType:   apicatalog.ResourceType,
+					Action:         "*",
+				})
+				if err != nil {
+					return err
+				}
+				byCode[moduleCode] = moduleM
+				created, revived = created+btoi(isNew), revived+btoi(isRevived)
+			}
+
+			resourcePath := route.ResourcePath
+			parentID := byCode[moduleCode].PermissionID
+			_, isNew, isRevived, err := b.upsertAPIPermission(ctx, byCode, &model.PermissionM{
+				PermissionName: route.Name(),
+				PermissionCode: route.Code(),
+				ResourceType:   apicatalog.ResourceType,
+				ResourcePath:   &resourcePath,
+				Action:         route.Method,
+				ParentID:       &parentID,
+			})
+			if err != nil {
+				return err
+			}
+			seen[route.Code()] = true
+			created, revived = created+btoi(isNew), revived+btoi(isRevived)
+		}
+
+		var stale int
+		for code, permM := range byCode {
+			if seen[code] || permM.Stale {
+				continue
+			}
+			permM.Stale = true
+			if err := b.store.Permission().Update(ctx, permM); err != nil {
+				return fmt.Errorf("failed to mark api permission %s as stale: %w", code, err)
+			}
+			stale++
+		}
+
+		slog.InfoContext(ctx, "Synchronized api routes to permissions",
+			"routes", len(routes), "created", created, "revived", revived, "stale", stale)
+		return nil
+	})
+}
+
+// upsertAPIPermission 创建或更新 api 类型的权限记录，返回最新记录以及是否新建、是否从 stale 恢复.
+func (b *permissionBiz) upsertAPIPermission(
+	ctx context.Context,
+	byCode map[string]*model.PermissionM,
+	want *model.PermissionM,
+) (*model.PermissionM, bool, bool, error) {
+	permM, ok := byCode[want.PermissionCode]
+	if !ok {
+		if err := b.store.Permission().Create(ctx, want); err != nil {
+			return nil, false, false, fmt.Errorf("failed to create api permission %s: %w", want.PermissionCode, err)
+		}
+		return want, true, false, nil
+	}
+
+	revived, pathChanged := permM.Stale, derefString(permM.ResourcePath) != derefString(want.ResourcePath)
+	if !revived && !pathChanged && permM.Action == want.Action &&
+		derefString(permM.ParentID) == derefString(want.ParentID) {
+		return permM, false, false, nil
+	}
+
+	permM.Stale = false
+	permM.Action = want.Action
+	permM.ResourcePath = want.ResourcePath
+	permM.ParentID = want.ParentID
+	if err := b.store.Permission().Update(ctx, permM); err != nil {
+		return nil, false, false, fmt.Errorf("failed to update api permission %s: %w", want.PermissionCode, err)
+	}
+	if pathChanged {
+		if err := b.syncRolePolicies(ctx, permM.PermissionID); err != nil {
+			return nil, false, false, err
+		}
+	}
+	return permM, false, revived, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
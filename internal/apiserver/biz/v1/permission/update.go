@@ -2,28 +2,81 @@ package permission
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	rolev1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/role"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"github.com/jinzhu/copier"
 )
 
-// Update 更新权限.
+// Update 更新权限，只修改 updateMask 中的字段，请求携带 version 时校验版本号.
+// 修改 ABAC 条件时，同步重建所有持有该权限的角色在 Casbin 中的策略.
 func (b *permissionBiz) Update(ctx context.Context, rq *v1.UpdatePermissionRequest) (*v1.UpdatePermissionResponse, error) {
 	permM, err := b.store.Permission().Get(ctx, where.F("permission_id", rq.GetPermissionID()).L(1))
 	if err != nil {
 		return nil, errno.ErrPermissionNotFound
 	}
+	if rq.Version != nil && rq.GetVersion() != permM.Version {
+		return nil, errno.ErrConflict
+	}
 
-	// 使用 copier 更新字段
-	if err := copier.CopyWithOption(permM, rq, copier.Option{IgnoreEmpty: true}); err != nil {
+	mask, err := fieldmask.New(rq, rq.GetUpdateMask(), "permissionID", "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	fields, err := mask.Apply(permM, rq, "conditions")
+	if err != nil {
 		return nil, err
 	}
 
-	if err := b.store.Permission().Update(ctx, permM); err != nil {
+	// 条件需要单独转换为 JSON 存储，传入空对象表示清除条件
+	if !mask.Has("conditions") {
+		if len(fields) == 0 {
+			return &v1.UpdatePermissionResponse{Version: permM.Version}, nil
+		}
+		if err := b.store.Permission().UpdateFields(ctx, permM, fields...); err != nil {
+			if errors.Is(err, genericstore.ErrConflict) {
+				return nil, errno.ErrConflict
+			}
+			return nil, err
+		}
+		return &v1.UpdatePermissionResponse{Version: permM.Version}, nil
+	}
+
+	permM.Conditions = conversion.PermissionConditionV1ToJSON(rq.GetConditions())
+	fields = append(fields, "Conditions")
+	err = b.store.TX(ctx, func(txCtx context.Context) error {
+		if err := b.store.Permission().UpdateFields(txCtx, permM, fields...); err != nil {
+			return err
+		}
+		return b.syncRolePolicies(txCtx, permM.PermissionID)
+	})
+	if err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
 		return nil, err
 	}
 
-	return &v1.UpdatePermissionResponse{}, nil
+	return &v1.UpdatePermissionResponse{Version: permM.Version}, nil
+}
+
+// syncRolePolicies 重建所有持有指定权限的角色在 Casbin 中的策略.
+func (b *permissionBiz) syncRolePolicies(ctx context.Context, permissionID string) error {
+	roles, err := b.store.Role().ListByPermission(ctx, permissionID)
+	if err != nil {
+		return fmt.Errorf("failed to list roles of permission: %w", err)
+	}
+	for _, roleM := range roles {
+		if err := rolev1.SyncPolicies(ctx, b.store, b.authz, roleM); err != nil {
+			return fmt.Errorf("failed to sync policies of role %s: %w", roleM.RoleCode, err)
+		}
+	}
+
+	return nil
 }
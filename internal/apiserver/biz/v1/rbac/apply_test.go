@@ -0,0 +1,60 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/rbacmanifest"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+)
+
+func TestApply_SyncsCasbinAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+	authorizer, err := authz.NewAuthz(s.DB(ctx), authz.WithoutAutoMigrate(), authz.WithAutoLoadPolicyTime(time.Hour))
+	require.NoError(t, err)
+	defer authorizer.Close()
+	b := New(s, authorizer)
+
+	manifest := &rbacmanifest.Manifest{
+		Roles: []*rbacmanifest.Role{{Code: "apply-auditor", Name: "Auditor"}},
+		Users: []*rbacmanifest.User{
+			{Username: "apply-carol", Password: "Passw0rd!123", Roles: []string{"apply-auditor"}},
+			// 新用户缺少密码，应用在事务中途失败
+			{Username: "apply-dave"},
+		},
+	}
+	_, err = b.Apply(ctx, manifest, &ApplyOptions{})
+	require.Error(t, err)
+
+	// 失败的事务不会在 Casbin 中留下任何变更
+	roles, err := authorizer.GetImplicitRolesForUser(userID(t, b, "apply-carol"))
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+
+	manifest.Users = manifest.Users[:1]
+	changes, err := b.Apply(ctx, manifest, &ApplyOptions{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, changes)
+
+	roles, err = authorizer.GetImplicitRolesForUser(userID(t, b, "apply-carol"))
+	require.NoError(t, err)
+	assert.Contains(t, roles, "role::apply-auditor")
+}
+
+// userID 返回用户名对应的用户 ID，用户不存在时返回空字符串.
+func userID(t *testing.T, b *rbacBiz, username string) string {
+	t.Helper()
+	userM, err := b.store.User().Get(context.Background(), where.F("username", username))
+	if err != nil {
+		return ""
+	}
+	return userM.UserID
+}
@@ -0,0 +1,157 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/rbacmanifest"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/ptr"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Export 将数据库当前状态导出为清单. 用户的密码不会被导出.
+func (b *rbacBiz) Export(ctx context.Context) (*rbacmanifest.Manifest, error) {
+	state, err := b.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return state.manifest(), nil
+}
+
+// state 保存数据库中与清单相关的当前状态.
+type state struct {
+	permissions     []*model.PermissionM
+	menus           []*model.MenuM
+	roles           []*model.RoleM
+	rolePermissions []*model.RolePermissionM
+	users           []*model.UserM
+	userRoles       []*model.UserRoleM
+}
+
+// load 读取数据库当前状态，只读取永久有效的绑定以及拥有永久有效角色的用户.
+func (b *rbacBiz) load(ctx context.Context) (*state, error) {
+	var s state
+	var err error
+
+	if _, s.permissions, err = b.store.Permission().List(ctx, where.NewWhere()); err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	if _, s.menus, err = b.store.Menu().List(ctx, where.NewWhere()); err != nil {
+		return nil, fmt.Errorf("failed to list menus: %w", err)
+	}
+	if _, s.roles, err = b.store.Role().List(ctx, where.NewWhere()); err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	if s.rolePermissions, err = b.store.Role().ListPermanentPermissions(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list role permissions: %w", err)
+	}
+	if s.userRoles, err = b.store.UserRole().List(ctx, where.NewWhere().Q("starts_at IS NULL AND expires_at IS NULL")); err != nil {
+		return nil, fmt.Errorf("failed to list user roles: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(s.userRoles))
+	for _, userRoleM := range s.userRoles {
+		userIDs = append(userIDs, userRoleM.UserID)
+	}
+	if len(userIDs) > 0 {
+		if _, s.users, err = b.store.User().List(ctx, where.NewWhere().Q("user_id IN ?", userIDs)); err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+	}
+
+	return &s, nil
+}
+
+// manifest 将当前状态转换为清单，数据库中的 UUID 引用转换为编码引用.
+func (s *state) manifest() *rbacmanifest.Manifest {
+	permissionCodes := make(map[string]string, len(s.permissions))
+	for _, permM := range s.permissions {
+		permissionCodes[permM.PermissionID] = permM.PermissionCode
+	}
+	menuCodes := make(map[string]string, len(s.menus))
+	for _, menuM := range s.menus {
+		menuCodes[menuM.MenuID] = menuM.MenuCode
+	}
+
+	var m rbacmanifest.Manifest
+	for _, permM := range s.permissions {
+		perm := &rbacmanifest.Permission{
+			Code:         permM.PermissionCode,
+			Name:         permM.PermissionName,
+			ResourceType: permM.ResourceType,
+			ResourcePath: ptr.From(permM.ResourcePath),
+			Action:       permM.Action,
+			Description:  ptr.From(permM.Description),
+			Parent:       permissionCodes[ptr.From(permM.ParentID)],
+			Disabled:     permM.Status != 0,
+		}
+		if permM.Conditions != nil {
+			if cond, err := authz.ParseCondition(*permM.Conditions); err == nil {
+				perm.Conditions = cond
+			}
+		}
+		m.Permissions = append(m.Permissions, perm)
+	}
+
+	for _, menuM := range s.menus {
+		m.Menus = append(m.Menus, &rbacmanifest.Menu{
+			Code:       menuM.MenuCode,
+			Name:       menuM.MenuName,
+			Type:       menuM.MenuType,
+			Icon:       ptr.From(menuM.Icon),
+			Path:       ptr.From(menuM.Path),
+			Component:  ptr.From(menuM.Component),
+			Parent:     menuCodes[ptr.From(menuM.ParentID)],
+			Permission: permissionCodes[ptr.From(menuM.PermissionID)],
+			SortOrder:  menuM.SortOrder,
+			Hidden:     menuM.Visible == 0,
+			Disabled:   menuM.Status != 0,
+		})
+	}
+
+	roles := make(map[string]*rbacmanifest.Role, len(s.roles))
+	roleCodes := make(map[string]string, len(s.roles))
+	for _, roleM := range s.roles {
+		role := &rbacmanifest.Role{
+			Code:        roleM.RoleCode,
+			Name:        roleM.RoleName,
+			Description: ptr.From(roleM.Description),
+			SortOrder:   roleM.SortOrder,
+			Disabled:    roleM.Status != 0,
+		}
+		roles[roleM.RoleID] = role
+		roleCodes[roleM.RoleID] = roleM.RoleCode
+		m.Roles = append(m.Roles, role)
+	}
+	for _, rolePermissionM := range s.rolePermissions {
+		role, ok := roles[rolePermissionM.RoleID]
+		code, found := permissionCodes[rolePermissionM.PermissionID]
+		if ok && found {
+			role.Permissions = append(role.Permissions, code)
+		}
+	}
+
+	users := make(map[string]*rbacmanifest.User, len(s.users))
+	for _, userM := range s.users {
+		user := &rbacmanifest.User{
+			Username: userM.Username,
+			Nickname: userM.Nickname,
+			Email:    ptr.From(userM.Email),
+			Phone:    ptr.From(userM.Phone),
+		}
+		users[userM.UserID] = user
+		m.Users = append(m.Users, user)
+	}
+	for _, userRoleM := range s.userRoles {
+		user, ok := users[userRoleM.UserID]
+		code, found := roleCodes[userRoleM.RoleID]
+		if ok && found {
+			user.Roles = append(user.Roles, code)
+		}
+	}
+
+	m.Sort()
+	return &m
+}
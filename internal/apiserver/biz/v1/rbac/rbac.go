@@ -0,0 +1,38 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/rbacmanifest"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+)
+
+// ApplyOptions 定义应用 RBAC 清单的选项.
+type ApplyOptions struct {
+	// DryRun 为 true 时只计算变更，不修改数据库
+	DryRun bool
+	// Prune 为 true 时删除清单中未声明的权限、菜单、角色以及清单中角色和用户未声明的绑定
+	Prune bool
+}
+
+// RBACBiz 定义导入导出声明式 RBAC 清单所需的方法.
+type RBACBiz interface {
+	// Export 将数据库中的权限、菜单、角色、永久有效的绑定以及拥有角色的用户导出为清单
+	Export(ctx context.Context) (*rbacmanifest.Manifest, error)
+	// Apply 将数据库变更为清单声明的状态并同步到 Casbin，返回实际执行（或 DryRun 时将要执行）的变更，重复执行是幂等的
+	Apply(ctx context.Context, manifest *rbacmanifest.Manifest, opts *ApplyOptions) ([]*rbacmanifest.Change, error)
+}
+
+// rbacBiz 是 RBACBiz 接口的实现.
+type rbacBiz struct {
+	store store.IStore
+	authz *authz.Authz
+}
+
+// 确保 rbacBiz 实现了 RBACBiz 接口.
+var _ RBACBiz = (*rbacBiz)(nil)
+
+func New(store store.IStore, authz *authz.Authz) *rbacBiz {
+	return &rbacBiz{store: store, authz: authz}
+}
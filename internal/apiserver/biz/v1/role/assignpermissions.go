@@ -5,15 +5,20 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 	"log/slog"
 	"gorm.io/gorm"
 )
 
 // AssignPermissionsToRole 为角色分配权限.
-// 使用事务确保数据库操作和 Casbin 同步的原子性.
+// 事务提交后发布 event.RolePermissionsChanged，由事件订阅者将角色当前生效的权限同步到 Casbin.
 func (b *roleBiz) AssignPermissionsToRole(ctx context.Context, rq *v1.AssignPermissionsToRoleRequest) (*v1.AssignPermissionsToRoleResponse, error) {
 	// 获取角色信息
 	roleM, err := b.store.Role().Get(ctx, where.F("role_id", rq.GetRoleID()).L(1))
@@ -24,25 +29,38 @@ func (b *roleBiz) AssignPermissionsToRole(ctx context.Context, rq *v1.AssignPerm
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
 
-	// 使用事务确保数据库操作和 Casbin 同步的原子性
+	// permissionIDs 中的权限永久有效，assignments 中的权限按有效期生效
+	rolePermissions := make([]*model.RolePermissionM, 0, len(rq.GetPermissionIDs())+len(rq.GetAssignments()))
+	for _, permissionID := range rq.GetPermissionIDs() {
+		rolePermissions = append(rolePermissions, &model.RolePermissionM{PermissionID: permissionID})
+	}
+	for _, assignment := range rq.GetAssignments() {
+		rolePermissions = append(rolePermissions, &model.RolePermissionM{
+			PermissionID: assignment.GetPermissionID(),
+			StartsAt:     conversion.UnixToTime(assignment.StartsAt),
+			ExpiresAt:    conversion.UnixToTime(assignment.ExpiresAt),
+		})
+	}
+
+	permissionIDs := make([]string, 0, len(rolePermissions))
+	for _, rolePermission := range rolePermissions {
+		permissionIDs = append(permissionIDs, rolePermission.PermissionID)
+	}
+
+	changed := &event.RolePermissionsChanged{RoleID: roleM.RoleID, RoleCode: roleM.RoleCode, PermissionIDs: permissionIDs}
 	err = b.store.TX(ctx, func(txCtx context.Context) error {
 		// 分配权限到数据库
-		if err := b.store.Role().AssignPermissions(txCtx, roleM.RoleID, rq.GetPermissionIDs()); err != nil {
+		if err := b.store.Role().ReplacePermissions(txCtx, roleM.RoleID, rolePermissions); err != nil {
 			return fmt.Errorf("failed to assign permissions in database: %w", err)
 		}
-
-		// 同步到 Casbin
-		if err := b.syncRolePermissionsToCasbin(txCtx, roleM.RoleCode, rq.GetPermissionIDs()); err != nil {
-			return fmt.Errorf("failed to sync permissions to casbin: %w", err)
-		}
-
-		return nil
+		return event.Record(txCtx, b.store, changed)
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
+	event.Publish(ctx, b.bus, changed)
+
 	return &v1.AssignPermissionsToRoleResponse{}, nil
 }
 
@@ -57,42 +75,61 @@ func (b *roleBiz) syncRoleToCasbin(ctx context.Context, roleCode string) error {
 	return nil
 }
 
-// syncRolePermissionsToCasbin 同步角色权限到 Casbin.
-// 使用批量替换策略的方式，避免删除和添加之间的竞态条件.
-func (b *roleBiz) syncRolePermissionsToCasbin(ctx context.Context, roleCode string, permissionIDs []string) error {
+// SyncPolicies 按角色当前生效的权限分配重建该角色在 Casbin 中的策略.
+// 使用批量替换策略的方式，避免删除和添加之间的竞态条件；限时权限生效或过期时由后台任务调用.
+func SyncPolicies(ctx context.Context, store store.IStore, authorizer *authz.Authz, roleM *model.RoleM) error {
 	// Casbin 的角色格式
-	casbinRole := "role::" + roleCode
+	casbinRole := "role::" + roleM.RoleCode
 
-	// 先收集所有需要添加的策略
+	permissions, err := store.Role().GetEffectivePermissions(ctx, roleM.RoleID)
+	if err != nil {
+		return fmt.Errorf("failed to get effective permissions: %w", err)
+	}
+
+	// 先收集所有需要添加的策略，带条件的权限写入条件策略
 	var newPolicies [][]string
-	for _, pid := range permissionIDs {
-		permM, err := b.store.Permission().Get(ctx, where.F("permission_id", pid).L(1))
-		if err != nil {
-			slog.WarnContext(ctx, "Failed to get permission", "permissionID", pid, "error", err)
+	var conditionalPolicies []*model.PermissionM
+	for _, permM := range permissions {
+		if permM.ResourcePath == nil || *permM.ResourcePath == "" {
 			continue
 		}
-
-		// 构建 p 规则: p, role::roleCode, resource_path, action, allow
-		if permM.ResourcePath != nil && *permM.ResourcePath != "" {
-			newPolicies = append(newPolicies, []string{casbinRole, *permM.ResourcePath, permM.Action, "allow"})
+		if permM.Conditions != nil {
+			conditionalPolicies = append(conditionalPolicies, permM)
+			continue
 		}
+		// 构建 p 规则: p, role::roleCode, resource_path, action, allow
+		newPolicies = append(newPolicies, []string{casbinRole, *permM.ResourcePath, permM.Action, "allow"})
 	}
 
 	// 使用事务 API 删除旧策略并添加新策略
 	// RemoveFilteredPolicy 会删除所有匹配的旧策略
 	// 然后 AddPolicies 批量添加新策略
 	// 这种方式可以最大程度减少竞态条件窗口
-	if _, err := b.authz.RemoveFilteredPolicy(0, casbinRole); err != nil {
+	if _, err := authorizer.RemoveFilteredPolicy(0, casbinRole); err != nil {
 		slog.WarnContext(ctx, "Failed to remove old policies", "role", casbinRole, "error", err)
 		// 继续尝试添加新策略
 	}
+	if _, err := authorizer.RemoveConditionalPolicies(casbinRole); err != nil {
+		slog.WarnContext(ctx, "Failed to remove old conditional policies", "role", casbinRole, "error", err)
+	}
 
 	// 批量添加新策略
 	if len(newPolicies) > 0 {
-		if _, err := b.authz.AddPolicies(newPolicies); err != nil {
+		if _, err := authorizer.AddPolicies(newPolicies); err != nil {
 			return fmt.Errorf("failed to add policies to casbin: %w", err)
 		}
 	}
 
+	// 添加条件策略: p2, role::roleCode, resource_path, action, allow, cond
+	for _, permM := range conditionalPolicies {
+		cond, err := authz.ParseCondition(*permM.Conditions)
+		if err != nil {
+			return fmt.Errorf("invalid conditions of permission %s: %w", permM.PermissionID, err)
+		}
+		if _, err := authorizer.AddConditionalPolicy(casbinRole, *permM.ResourcePath, permM.Action, cond); err != nil {
+			return fmt.Errorf("failed to add conditional policy to casbin: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 	"github.com/jinzhu/copier"
@@ -19,6 +20,10 @@ func (b *roleBiz) Create(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.Cre
 	if err := copier.Copy(&roleM, rq); err != nil {
 		return nil, fmt.Errorf("failed to copy request to model: %w", err)
 	}
+	if err := b.checkApproverRoles(ctx, rq.GetApproverRoleIDs()); err != nil {
+		return nil, err
+	}
+	roleM.ApproverRoleIDs = conversion.RoleApproverRoleIDsJSON(rq.GetApproverRoleIDs())
 
 	// 检查角色编码是否已存在
 	existingRole, err := b.store.Role().GetByRoleCode(ctx, roleM.RoleCode)
@@ -33,25 +38,29 @@ func (b *roleBiz) Create(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.Cre
 	}
 
 	// 使用事务确保数据库操作和 Casbin 同步的原子性
-	var createResp *v1.CreateRoleResponse
+	created := &event.RoleCreated{RoleCode: roleM.RoleCode}
 	err = b.store.TX(ctx, func(txCtx context.Context) error {
 		// 创建角色到数据库
 		if err := b.store.Role().Create(txCtx, &roleM); err != nil {
 			return fmt.Errorf("failed to create role: %w", err)
 		}
+		created.RoleID = roleM.RoleID
+		if err := event.Record(txCtx, b.store, created); err != nil {
+			return err
+		}
 
 		// 同步到 Casbin
 		if err := b.syncRoleToCasbin(txCtx, roleM.RoleCode); err != nil {
 			return fmt.Errorf("failed to sync role to casbin: %w", err)
 		}
 
-		createResp = &v1.CreateRoleResponse{RoleID: roleM.RoleID}
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
-	return createResp, nil
+	event.Publish(ctx, b.bus, created)
+
+	return &v1.CreateRoleResponse{RoleID: roleM.RoleID}, nil
 }
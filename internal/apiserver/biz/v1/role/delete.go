@@ -3,13 +3,14 @@ package role
 import (
 	"context"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"log/slog"
 )
 
 // Delete 删除角色.
+// 角色被软删除后保留权限分配和用户分配，以便从回收站恢复，事件订阅者会从 Casbin 中移除该角色的全部策略和角色关系.
 func (b *roleBiz) Delete(ctx context.Context, rq *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
 	roleID := rq.GetRoleID()
 
@@ -19,15 +20,18 @@ func (b *roleBiz) Delete(ctx context.Context, rq *v1.DeleteRoleRequest) (*v1.Del
 		return nil, errno.ErrRoleNotFound
 	}
 
-	if err := b.store.Role().Delete(ctx, where.F("role_id", roleID)); err != nil {
+	deleted := &event.RoleDeleted{RoleID: roleID, RoleCode: roleM.RoleCode}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Role().Delete(ctx, where.F("role_id", roleID)); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, deleted)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// 从 Casbin 中删除该角色的策略
-	casbinRole := "role::" + roleM.RoleCode
-	if _, err := b.authz.RemoveFilteredGroupingPolicy(0, casbinRole); err != nil {
-		slog.WarnContext(ctx, "Failed to remove grouping policy", "error", err)
-	}
+	event.Publish(ctx, b.bus, deleted)
 
 	return &v1.DeleteRoleResponse{}, nil
 }
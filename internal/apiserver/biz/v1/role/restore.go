@@ -0,0 +1,74 @@
+package role
+
+import (
+	"context"
+	"log/slog"
+
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Restore 从回收站恢复角色.
+// 恢复后按保留的权限分配重建 Casbin 策略，并为活跃用户当前仍在有效期内的分配重新授予角色.
+// 持有该角色的用户恢复后违反职责分离规则时拒绝恢复.
+func (b *roleBiz) Restore(ctx context.Context, rq *v1.RestoreRoleRequest) (*v1.RestoreRoleResponse, error) {
+	roleM, err := b.store.Role().GetDeleted(ctx, where.F("role_id", rq.GetRoleID()).L(1))
+	if err != nil {
+		return nil, errno.ErrRoleNotFound
+	}
+
+	// 角色编码在删除后可以被新角色复用，此时不能恢复
+	if _, err := b.store.Role().GetByRoleCode(ctx, roleM.RoleCode); err == nil {
+		return nil, errno.ErrRoleAlreadyExists
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		restored, err := b.store.Role().Restore(ctx, where.F("role_id", roleM.RoleID))
+		if err != nil {
+			return err
+		}
+		if !restored {
+			return errno.ErrRoleNotFound
+		}
+
+		// 角色删除期间可能新增了职责分离规则或分配了冲突角色，恢复后的角色组合不能违反规则
+		userRoles, err := b.store.UserRole().List(ctx, where.F("role_id", roleM.RoleID))
+		if err != nil {
+			return err
+		}
+		checked := make(map[string]bool, len(userRoles))
+		for _, userRole := range userRoles {
+			if checked[userRole.UserID] {
+				continue
+			}
+			checked[userRole.UserID] = true
+			if err := sodrule.CheckGrant(ctx, b.store, userRole.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SyncPolicies(ctx, b.store, b.authz, roleM); err != nil {
+		return nil, err
+	}
+
+	userRoles, err := b.store.UserRole().ListEffectiveByRole(ctx, roleM.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	casbinRole := "role::" + roleM.RoleCode
+	for _, userRole := range userRoles {
+		if _, err := b.authz.AddGroupingPolicy(userRole.UserID, casbinRole); err != nil {
+			slog.ErrorContext(ctx, "Failed to add grouping policy", "userID", userRole.UserID, "role", casbinRole, "error", err)
+			return nil, errno.ErrAddRole.WithMessage(err.Error())
+		}
+	}
+
+	return &v1.RestoreRoleResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
 )
 
 // RoleBiz 定义处理角色请求所需的方法.
@@ -25,17 +26,22 @@ type RoleExpansion interface {
 	AssignPermissionsToRole(ctx context.Context, rq *v1.AssignPermissionsToRoleRequest) (*v1.AssignPermissionsToRoleResponse, error)
 	// GetRolePermissions 获取角色的权限列表
 	GetRolePermissions(ctx context.Context, rq *v1.GetRolePermissionsRequest) (*v1.GetRolePermissionsResponse, error)
+	// ListDeleted 获取回收站中的角色列表
+	ListDeleted(ctx context.Context, rq *v1.ListDeletedRolesRequest) (*v1.ListDeletedRolesResponse, error)
+	// Restore 从回收站恢复角色，并重新同步 Casbin 策略
+	Restore(ctx context.Context, rq *v1.RestoreRoleRequest) (*v1.RestoreRoleResponse, error)
 }
 
 // roleBiz 是 RoleBiz 接口的实现.
 type roleBiz struct {
 	store store.IStore
 	authz *authz.Authz
+	bus   *eventbus.Bus
 }
 
 // 确保 roleBiz 实现了 RoleBiz 接口.
 var _ RoleBiz = (*roleBiz)(nil)
 
-func New(store store.IStore, authz *authz.Authz) *roleBiz {
-	return &roleBiz{store: store, authz: authz}
+func New(store store.IStore, authz *authz.Authz, bus *eventbus.Bus) *roleBiz {
+	return &roleBiz{store: store, authz: authz, bus: bus}
 }
@@ -5,14 +5,17 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"github.com/jinzhu/copier"
 	"gorm.io/gorm"
 )
 
-// Update 更新角色.
+// Update 更新角色，只修改 updateMask 中的字段，请求携带 version 时校验版本号.
 func (b *roleBiz) Update(ctx context.Context, rq *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error) {
 	roleM, err := b.store.Role().Get(ctx, where.F("role_id", rq.GetRoleID()).L(1))
 	if err != nil {
@@ -22,15 +25,58 @@ func (b *roleBiz) Update(ctx context.Context, rq *v1.UpdateRoleRequest) (*v1.Upd
 		}
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
+	if rq.Version != nil && rq.GetVersion() != roleM.Version {
+		return nil, errno.ErrConflict
+	}
 
-	// 使用 copier 更新字段
-	if err := copier.CopyWithOption(roleM, rq, copier.Option{IgnoreEmpty: true}); err != nil {
-		return nil, fmt.Errorf("failed to copy update fields: %w", err)
+	mask, err := fieldmask.New(rq, rq.GetUpdateMask(), "roleID", "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	fields, err := mask.Apply(roleM, rq, "approverRoleIDs")
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply update mask: %w", err)
+	}
+	// 审批角色列表需要单独编码为 JSON 存储
+	if mask.Has("approverRoleIDs") {
+		if err := b.checkApproverRoles(ctx, rq.GetApproverRoleIDs()); err != nil {
+			return nil, err
+		}
+		roleM.ApproverRoleIDs = conversion.RoleApproverRoleIDsJSON(rq.GetApproverRoleIDs())
+		fields = append(fields, "ApproverRoleIDs")
+	}
+	if len(fields) == 0 {
+		return &v1.UpdateRoleResponse{Version: roleM.Version}, nil
 	}
 
-	if err := b.store.Role().Update(ctx, roleM); err != nil {
+	updated := &event.RoleUpdated{RoleID: roleM.RoleID, RoleCode: roleM.RoleCode, Fields: mask.Paths()}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Role().UpdateFields(ctx, roleM, fields...); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, updated)
+	})
+	if err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
 		return nil, fmt.Errorf("failed to update role: %w", err)
 	}
 
-	return &v1.UpdateRoleResponse{}, nil
+	event.Publish(ctx, b.bus, updated)
+
+	return &v1.UpdateRoleResponse{Version: roleM.Version}, nil
+}
+
+// checkApproverRoles 验证审批角色是否全部存在.
+func (b *roleBiz) checkApproverRoles(ctx context.Context, roleIDs []string) error {
+	for _, roleID := range roleIDs {
+		if _, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1)); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.ErrRoleNotFound
+			}
+			return fmt.Errorf("failed to get approver role: %w", err)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,91 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/pkg/token"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// IssueTokens 为一次成功的认证（密码登录、单点登录等）创建会话，签发绑定该会话的 access token 和 refresh token，
+// 并记录用户的最后登录时间. 只保存最后登录时间，userM 上的其他改动需要调用方自行保存.
+func IssueTokens(ctx context.Context, store store.IStore, userM *model.UserM, deviceName *string) (*v1.LoginResponse, error) {
+	sessionM, err := createSession(ctx, store, userM.UserID, deviceName, token.GetRefreshExpiration())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create user session", "userID", userM.UserID, "error", err)
+		return nil, errno.ErrDBWrite
+	}
+
+	accessToken, refreshToken, accessExpireAt, _, err := token.Sign(userM.UserID, token.WithSessionID(sessionM.SessionID))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to sign token", "error", err)
+		return nil, errno.ErrSignToken
+	}
+
+	// 记录最后登录时间，失败不影响登录结果
+	userM.LastLoginAt = &sessionM.CreatedAt
+	if err := store.User().UpdateLastLoginAt(ctx, userM.UserID, sessionM.CreatedAt); err != nil {
+		slog.WarnContext(ctx, "Failed to update user after login", "userID", userM.UserID, "error", err)
+	}
+
+	return &v1.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpireAt:     accessExpireAt.Format(time.RFC3339),
+	}, nil
+}
+
+// IssueImpersonationToken 为管理员 actorM 模拟登录用户 userM 创建会话，并签发携带 act 声明的短期 access token.
+// 会话有效期与模拟令牌一致且不签发 refresh token，会话归属被模拟用户，因此用户本人可以在会话列表中看到并撤销它.
+func IssueImpersonationToken(ctx context.Context, store store.IStore, userM, actorM *model.UserM) (*v1.ImpersonateUserResponse, error) {
+	deviceName := fmt.Sprintf("impersonated by %s", actorM.Username)
+	sessionM, err := createSession(ctx, store, userM.UserID, &deviceName, token.GetImpersonationExpiration())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create impersonation session", "userID", userM.UserID, "error", err)
+		return nil, errno.ErrDBWrite
+	}
+
+	accessToken, expireAt, err := token.SignImpersonation(userM.UserID, actorM.UserID, token.WithSessionID(sessionM.SessionID))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to sign impersonation token", "error", err)
+		return nil, errno.ErrSignToken
+	}
+
+	return &v1.ImpersonateUserResponse{
+		AccessToken: accessToken,
+		ExpireAt:    expireAt.Format(time.RFC3339),
+		UserID:      userM.UserID,
+		ActorID:     actorM.UserID,
+	}, nil
+}
+
+// createSession 为一次成功的登录创建会话记录，会话在 ttl 后过期（普通登录与 Refresh Token 有效期一致）.
+func createSession(ctx context.Context, store store.IStore, userID string, deviceName *string, ttl time.Duration) (*model.UserSessionM, error) {
+	now := time.Now()
+	sessionM := &model.UserSessionM{
+		UserID:     userID,
+		DeviceName: deviceName,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+	if clientIP := contextx.ClientIP(ctx); clientIP != "" {
+		sessionM.IPAddress = &clientIP
+	}
+	if userAgent := contextx.UserAgent(ctx); userAgent != "" {
+		sessionM.UserAgent = &userAgent
+	}
+
+	if err := store.UserSession().Create(ctx, sessionM); err != nil {
+		return nil, err
+	}
+	return sessionM, nil
+}
@@ -0,0 +1,25 @@
+package session
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// ListSessions 获取用户当前有效的会话列表.
+func (b *sessionBiz) ListSessions(ctx context.Context, rq *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error) {
+	sessionList, err := b.store.UserSession().ListActive(ctx, rq.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	currentSessionID := contextx.SessionID(ctx)
+	sessions := make([]*v1.Session, 0, len(sessionList))
+	for _, sessionM := range sessionList {
+		sessions = append(sessions, conversion.UserSessionModelToSessionV1(sessionM, currentSessionID))
+	}
+
+	return &v1.ListSessionsResponse{TotalCount: int64(len(sessions)), Sessions: sessions}, nil
+}
@@ -0,0 +1,24 @@
+package session
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// RevokeOtherSessions 撤销用户除当前会话外的全部会话.
+func (b *sessionBiz) RevokeOtherSessions(ctx context.Context, rq *v1.RevokeOtherSessionsRequest) (*v1.RevokeOtherSessionsResponse, error) {
+	whr := where.F("user_id", rq.GetUserID())
+	if currentSessionID := contextx.SessionID(ctx); currentSessionID != "" {
+		whr = whr.Q("session_id <> ?", currentSessionID)
+	}
+
+	revoked, err := b.store.UserSession().Revoke(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RevokeOtherSessionsResponse{RevokedCount: revoked}, nil
+}
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// RevokeSession 撤销用户的指定会话.
+func (b *sessionBiz) RevokeSession(ctx context.Context, rq *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error) {
+	whr := where.F("user_id", rq.GetUserID(), "session_id", rq.GetSessionID())
+	revoked, err := b.store.UserSession().Revoke(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	if revoked == 0 {
+		return nil, errno.ErrSessionNotFound
+	}
+
+	return &v1.RevokeSessionResponse{}, nil
+}
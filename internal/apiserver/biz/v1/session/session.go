@@ -0,0 +1,32 @@
+package session
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// SessionBiz 定义处理会话请求所需的方法.
+type SessionBiz interface {
+	// ListSessions 获取用户当前有效的会话列表
+	ListSessions(ctx context.Context, rq *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error)
+	// RevokeSession 撤销用户的指定会话
+	RevokeSession(ctx context.Context, rq *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error)
+	// RevokeOtherSessions 撤销用户除当前会话外的全部会话
+	RevokeOtherSessions(ctx context.Context, rq *v1.RevokeOtherSessionsRequest) (*v1.RevokeOtherSessionsResponse, error)
+	// TerminateUserSessions 终止用户的全部会话
+	TerminateUserSessions(ctx context.Context, rq *v1.TerminateUserSessionsRequest) (*v1.TerminateUserSessionsResponse, error)
+}
+
+// sessionBiz 是 SessionBiz 接口的实现.
+type sessionBiz struct {
+	store store.IStore
+}
+
+// 确保 sessionBiz 实现了 SessionBiz 接口.
+var _ SessionBiz = (*sessionBiz)(nil)
+
+func New(store store.IStore) *sessionBiz {
+	return &sessionBiz{store: store}
+}
@@ -0,0 +1,91 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/ptr"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+	"github.com/clin211/gin-enterprise-template/pkg/token"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+func TestIssueTokens(t *testing.T) {
+	token.Init("session-test-secret", 0, 0, token.WithIdentityKey(known.XUserID))
+	s := storetest.New(t)
+	ctx := contextx.WithClientIP(context.Background(), "10.0.0.1")
+	userM := storetest.CreateUser(t, s, "session-issue")
+
+	resp, err := IssueTokens(ctx, s, userM, ptr.To("laptop"))
+	require.NoError(t, err)
+
+	// access token 和 refresh token 都绑定新创建的会话
+	userID, claims, err := token.ParseRefreshTokenClaims(resp.GetRefreshToken())
+	require.NoError(t, err)
+	assert.Equal(t, userM.UserID, userID)
+	sessionM, err := s.UserSession().Get(ctx, where.F("session_id", token.ClaimString(claims, token.ClaimSessionID)))
+	require.NoError(t, err)
+	assert.Equal(t, userM.UserID, sessionM.UserID)
+	assert.Equal(t, "laptop", *sessionM.DeviceName)
+	assert.Equal(t, "10.0.0.1", *sessionM.IPAddress)
+
+	got, err := s.User().Get(ctx, where.F("user_id", userM.UserID))
+	require.NoError(t, err)
+	assert.NotNil(t, got.LastLoginAt)
+}
+
+func TestSessionBiz(t *testing.T) {
+	s := storetest.New(t)
+	b := New(s)
+	userM := storetest.CreateUser(t, s, "session-biz")
+	otherM := storetest.CreateUser(t, s, "session-biz-other")
+
+	var sessionIDs []string
+	for range 3 {
+		sessionM, err := createSession(context.Background(), s, userM.UserID, nil, token.GetRefreshExpiration())
+		require.NoError(t, err)
+		sessionIDs = append(sessionIDs, sessionM.SessionID)
+	}
+	otherSession, err := createSession(context.Background(), s, otherM.UserID, nil, token.GetRefreshExpiration())
+	require.NoError(t, err)
+	ctx := contextx.WithSessionID(context.Background(), sessionIDs[0])
+
+	list, err := b.ListSessions(ctx, &v1.ListSessionsRequest{UserID: userM.UserID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, list.GetTotalCount())
+	for _, session := range list.GetSessions() {
+		assert.Equal(t, session.GetSessionID() == sessionIDs[0], session.GetCurrent())
+	}
+
+	// 不能撤销其他用户的会话
+	_, err = b.RevokeSession(ctx, &v1.RevokeSessionRequest{UserID: userM.UserID, SessionID: otherSession.SessionID})
+	assert.ErrorIs(t, err, errno.ErrSessionNotFound)
+	_, err = b.RevokeSession(ctx, &v1.RevokeSessionRequest{UserID: userM.UserID, SessionID: sessionIDs[1]})
+	require.NoError(t, err)
+	_, err = b.RevokeSession(ctx, &v1.RevokeSessionRequest{UserID: userM.UserID, SessionID: sessionIDs[1]})
+	assert.ErrorIs(t, err, errno.ErrSessionNotFound)
+
+	// 撤销其他会话时保留当前会话
+	others, err := b.RevokeOtherSessions(ctx, &v1.RevokeOtherSessionsRequest{UserID: userM.UserID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, others.GetRevokedCount())
+	list, err = b.ListSessions(ctx, &v1.ListSessionsRequest{UserID: userM.UserID})
+	require.NoError(t, err)
+	require.Len(t, list.GetSessions(), 1)
+	assert.Equal(t, sessionIDs[0], list.GetSessions()[0].GetSessionID())
+
+	terminated, err := b.TerminateUserSessions(ctx, &v1.TerminateUserSessionsRequest{UserID: userM.UserID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, terminated.GetRevokedCount())
+	list, err = b.ListSessions(ctx, &v1.ListSessionsRequest{UserID: otherM.UserID})
+	require.NoError(t, err)
+	assert.Len(t, list.GetSessions(), 1, "other users' sessions are not affected")
+}
@@ -0,0 +1,22 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// TerminateUserSessions 终止用户的全部会话，通常由管理员在账号异常时调用.
+func (b *sessionBiz) TerminateUserSessions(ctx context.Context, rq *v1.TerminateUserSessionsRequest) (*v1.TerminateUserSessionsResponse, error) {
+	revoked, err := b.store.UserSession().Revoke(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Terminated user sessions", "operator", contextx.UserID(ctx), "userID", rq.GetUserID(), "revoked", revoked)
+
+	return &v1.TerminateUserSessionsResponse{RevokedCount: revoked}, nil
+}
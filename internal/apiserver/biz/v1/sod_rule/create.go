@@ -0,0 +1,37 @@
+package sod_rule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// Create 创建职责分离规则. 新规则只约束之后的角色分配，已有的违规分配可通过违规报告查看.
+func (b *sodRuleBiz) Create(ctx context.Context, rq *v1.CreateSoDRuleRequest) (*v1.CreateSoDRuleResponse, error) {
+	if err := b.checkName(ctx, rq.GetName(), nil); err != nil {
+		return nil, err
+	}
+	if err := b.checkRoleIDs(ctx, rq.GetRoleIDs()); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(rq.GetRoleIDs())
+	if err != nil {
+		return nil, err
+	}
+	ruleM := &model.SoDRuleM{
+		Name:        rq.GetName(),
+		Description: rq.Description,
+		RoleIDs:     string(data),
+		MaxRoles:    rq.GetMaxRoles(),
+	}
+	if err := b.store.SoDRule().Create(ctx, ruleM); err != nil {
+		return nil, fmt.Errorf("failed to create sod rule: %w", err)
+	}
+
+	return &v1.CreateSoDRuleResponse{Rule: conversion.SoDRuleModelToSoDRuleV1(ruleM)}, nil
+}
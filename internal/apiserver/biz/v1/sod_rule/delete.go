@@ -0,0 +1,21 @@
+package sod_rule
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Delete 删除职责分离规则.
+func (b *sodRuleBiz) Delete(ctx context.Context, rq *v1.DeleteSoDRuleRequest) (*v1.DeleteSoDRuleResponse, error) {
+	if _, err := b.store.SoDRule().Get(ctx, where.F("rule_id", rq.GetRuleID()).L(1)); err != nil {
+		return nil, errno.ErrSoDRuleNotFound
+	}
+	if err := b.store.SoDRule().Delete(ctx, where.F("rule_id", rq.GetRuleID())); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteSoDRuleResponse{}, nil
+}
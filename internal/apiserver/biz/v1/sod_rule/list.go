@@ -0,0 +1,43 @@
+package sod_rule
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// List 获取职责分离规则列表.
+func (b *sodRuleBiz) List(ctx context.Context, rq *v1.ListSoDRulesRequest) (*v1.ListSoDRulesResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize)))
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, rules, err := b.store.SoDRule().List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(rules) == pageSize {
+		cursor, err := pagination.NewCursor("id", rules[len(rules)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	return &v1.ListSoDRulesResponse{
+		TotalCount: total,
+		Rules:      conversion.SoDRuleModelListToSoDRuleV1List(rules),
+		PageToken:  nextPageToken,
+	}, nil
+}
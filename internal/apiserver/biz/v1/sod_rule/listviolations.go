@@ -0,0 +1,79 @@
+package sod_rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/sod"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// ListViolations 列出现有角色分配中违反职责分离规则的用户.
+// 规则创建或修改前已存在的分配不会被自动回收，需要管理员根据报告手动调整.
+// 尚未生效的定时分配同样计入，保证生效后也不会出现冲突.
+func (b *sodRuleBiz) ListViolations(ctx context.Context, rq *v1.ListSoDViolationsRequest) (*v1.ListSoDViolationsResponse, error) {
+	rules, err := listRules(ctx, b.store)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只需要查询规则涉及的角色分配
+	var ruleRoleIDs []string
+	for _, rule := range rules {
+		ruleRoleIDs = append(ruleRoleIDs, rule.RoleIDs...)
+	}
+	if len(ruleRoleIDs) == 0 {
+		return &v1.ListSoDViolationsResponse{}, nil
+	}
+	userRoles, err := b.store.UserRole().List(ctx, where.NewWhere().Q("role_id IN ?", ruleRoleIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user roles: %w", err)
+	}
+
+	var userIDs []string
+	heldRoleIDs := make(map[string][]string)
+	for _, userRole := range userRoles {
+		if _, ok := heldRoleIDs[userRole.UserID]; !ok {
+			userIDs = append(userIDs, userRole.UserID)
+		}
+		heldRoleIDs[userRole.UserID] = append(heldRoleIDs[userRole.UserID], userRole.RoleID)
+	}
+
+	codes := make(map[string]string)
+	violations := make([]*v1.SoDViolation, 0)
+	for _, userID := range userIDs {
+		userViolations := sod.Check(rules, heldRoleIDs[userID])
+		if len(userViolations) == 0 {
+			continue
+		}
+
+		var username string
+		if userM, err := b.store.User().Get(ctx, where.F("user_id", userID).L(1)); err == nil {
+			username = userM.Username
+		}
+		for _, violation := range userViolations {
+			roleCodes := make([]string, 0, len(violation.RoleIDs))
+			for _, roleID := range violation.RoleIDs {
+				if _, ok := codes[roleID]; !ok {
+					codes[roleID] = lookupRoleCodes(ctx, b.store, []string{roleID})[0]
+				}
+				roleCodes = append(roleCodes, codes[roleID])
+			}
+			violations = append(violations, &v1.SoDViolation{
+				UserID:    userID,
+				Username:  username,
+				RuleID:    violation.Rule.ID,
+				RuleName:  violation.Rule.Name,
+				MaxRoles:  int32(violation.Rule.MaxRoles),
+				RoleIDs:   violation.RoleIDs,
+				RoleCodes: roleCodes,
+			})
+		}
+	}
+
+	return &v1.ListSoDViolationsResponse{
+		TotalCount: int64(len(violations)),
+		Violations: violations,
+	}, nil
+}
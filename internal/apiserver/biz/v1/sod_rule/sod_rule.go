@@ -0,0 +1,128 @@
+package sod_rule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/sod"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// SoDRuleBiz 定义处理职责分离规则请求所需的方法.
+type SoDRuleBiz interface {
+	// Create 创建职责分离规则
+	Create(ctx context.Context, rq *v1.CreateSoDRuleRequest) (*v1.CreateSoDRuleResponse, error)
+	// Update 更新职责分离规则
+	Update(ctx context.Context, rq *v1.UpdateSoDRuleRequest) (*v1.UpdateSoDRuleResponse, error)
+	// Delete 删除职责分离规则
+	Delete(ctx context.Context, rq *v1.DeleteSoDRuleRequest) (*v1.DeleteSoDRuleResponse, error)
+	// List 获取职责分离规则列表
+	List(ctx context.Context, rq *v1.ListSoDRulesRequest) (*v1.ListSoDRulesResponse, error)
+	// ListViolations 列出现有角色分配中违反职责分离规则的用户
+	ListViolations(ctx context.Context, rq *v1.ListSoDViolationsRequest) (*v1.ListSoDViolationsResponse, error)
+}
+
+// sodRuleBiz 是 SoDRuleBiz 接口的实现.
+type sodRuleBiz struct {
+	store store.IStore
+}
+
+// 确保 sodRuleBiz 实现了 SoDRuleBiz 接口.
+var _ SoDRuleBiz = (*sodRuleBiz)(nil)
+
+func New(store store.IStore) *sodRuleBiz {
+	return &sodRuleBiz{store: store}
+}
+
+// CheckRoles 检查同时持有 roleIDs 是否违反职责分离规则，违反时返回 errno.ErrSoDConflict，
+// 错误消息中包含违反的规则名称和冲突的角色编码.
+func CheckRoles(ctx context.Context, s store.IStore, roleIDs []string) error {
+	rules, err := listRules(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	violations := sod.Check(rules, roleIDs)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	violation := violations[0]
+	roleCodes := lookupRoleCodes(ctx, s, violation.RoleIDs)
+	message := fmt.Sprintf("角色 %s 违反职责分离规则「%s」，同一用户最多只能同时持有其中 %d 个角色。",
+		strings.Join(roleCodes, "、"), violation.Rule.Name, violation.Rule.MaxRoles)
+	return errno.ErrSoDConflict.WithMessage(message).WithMetadata(map[string]any{
+		"ruleID":    violation.Rule.ID,
+		"roleCodes": roleCodes,
+	})
+}
+
+// CheckUserRoles 检查用户在已分配的角色（包括尚未生效的分配）之外再获得 roleIDs 是否违反职责分离规则.
+func CheckUserRoles(ctx context.Context, s store.IStore, userID string, roleIDs ...string) error {
+	assigned, err := s.UserRole().ListAssignedRoleIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return CheckRoles(ctx, s, append(assigned, roleIDs...))
+}
+
+// CheckGrant 锁定用户后执行 CheckUserRoles. 授予角色时必须在写入角色分配的同一事务中调用，
+// 使同一用户的并发授予依次检查，不会因为读取到相同的已分配角色而同时通过检查.
+func CheckGrant(ctx context.Context, s store.IStore, userID string, roleIDs ...string) error {
+	if err := s.UserRole().LockUser(ctx, userID); err != nil {
+		return err
+	}
+	return CheckUserRoles(ctx, s, userID, roleIDs...)
+}
+
+// listRules 获取全部职责分离规则.
+func listRules(ctx context.Context, s store.IStore) ([]*sod.Rule, error) {
+	_, ruleMs, err := s.SoDRule().List(ctx, where.NewWhere())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sod rules: %w", err)
+	}
+	rules := make([]*sod.Rule, 0, len(ruleMs))
+	for _, ruleM := range ruleMs {
+		rules = append(rules, conversion.SoDRuleModelToSoDRule(ruleM))
+	}
+	return rules, nil
+}
+
+// lookupRoleCodes 将角色 ID 转换为角色编码，角色不存在时保留角色 ID.
+func lookupRoleCodes(ctx context.Context, s store.IStore, roleIDs []string) []string {
+	codes := make([]string, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		roleM, err := s.Role().Get(ctx, where.F("role_id", roleID).L(1))
+		if err != nil {
+			codes = append(codes, roleID)
+			continue
+		}
+		codes = append(codes, roleM.RoleCode)
+	}
+	return codes
+}
+
+// checkRoleIDs 验证规则中的角色是否全部存在.
+func (b *sodRuleBiz) checkRoleIDs(ctx context.Context, roleIDs []string) error {
+	for _, roleID := range roleIDs {
+		if _, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1)); err != nil {
+			return errno.ErrRoleNotFound
+		}
+	}
+	return nil
+}
+
+// checkName 验证规则名称未被其他规则使用.
+func (b *sodRuleBiz) checkName(ctx context.Context, name string, self *model.SoDRuleM) error {
+	existing, err := b.store.SoDRule().Get(ctx, where.F("name", name).L(1))
+	if err == nil && (self == nil || existing.ID != self.ID) {
+		return errno.ErrSoDRuleAlreadyExists
+	}
+	return nil
+}
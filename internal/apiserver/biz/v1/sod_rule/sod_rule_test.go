@@ -0,0 +1,47 @@
+package sod_rule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestCheckGrant(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	userM := storetest.CreateUser(t, s, "sod-grant")
+	maker := storetest.CreateRole(t, s, "sod-maker", false)
+	checker := storetest.CreateRole(t, s, "sod-checker", false)
+	require.NoError(t, s.SoDRule().Create(ctx, &model.SoDRuleM{
+		Name:     "sod-maker-checker",
+		RoleIDs:  `["` + maker.RoleID + `","` + checker.RoleID + `"]`,
+		MaxRoles: 1,
+	}))
+
+	require.NoError(t, CheckGrant(ctx, s, userM.UserID, maker.RoleID))
+
+	// 已过期的分配不计入已持有的角色
+	expiredAt := time.Now().Add(-time.Hour)
+	require.NoError(t, s.UserRole().Create(ctx, &model.UserRoleM{UserID: userM.UserID, RoleID: maker.RoleID, ExpiresAt: &expiredAt}))
+	require.NoError(t, CheckGrant(ctx, s, userM.UserID, checker.RoleID))
+
+	// 尚未生效的分配计入已持有的角色
+	require.NoError(t, s.UserRole().RemoveRole(ctx, userM.UserID, maker.RoleID))
+	startsAt := time.Now().Add(time.Hour)
+	require.NoError(t, s.UserRole().Create(ctx, &model.UserRoleM{UserID: userM.UserID, RoleID: maker.RoleID, StartsAt: &startsAt}))
+	err := CheckGrant(ctx, s, userM.UserID, checker.RoleID)
+	assert.ErrorIs(t, err, errno.ErrSoDConflict)
+
+	// 已删除的角色不计入已持有的角色
+	require.NoError(t, s.Role().Delete(ctx, where.F("role_id", maker.RoleID)))
+	assert.NoError(t, CheckGrant(ctx, s, userM.UserID, checker.RoleID))
+}
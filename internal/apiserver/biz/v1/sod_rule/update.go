@@ -0,0 +1,67 @@
+package sod_rule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Update 更新职责分离规则，只修改 updateMask 中的字段，请求携带 version 时校验版本号.
+func (b *sodRuleBiz) Update(ctx context.Context, rq *v1.UpdateSoDRuleRequest) (*v1.UpdateSoDRuleResponse, error) {
+	ruleM, err := b.store.SoDRule().Get(ctx, where.F("rule_id", rq.GetRuleID()).L(1))
+	if err != nil {
+		return nil, errno.ErrSoDRuleNotFound
+	}
+	if rq.Version != nil && rq.GetVersion() != ruleM.Version {
+		return nil, errno.ErrConflict
+	}
+
+	mask, err := fieldmask.New(rq, rq.GetUpdateMask(), "ruleID", "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	if mask.Has("name") {
+		if err := b.checkName(ctx, rq.GetName(), ruleM); err != nil {
+			return nil, err
+		}
+	}
+	fields, err := mask.Apply(ruleM, rq, "roleIDs")
+	if err != nil {
+		return nil, err
+	}
+	// 角色集合需要单独编码为 JSON 存储
+	if mask.Has("roleIDs") {
+		if err := b.checkRoleIDs(ctx, rq.GetRoleIDs()); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(rq.GetRoleIDs())
+		if err != nil {
+			return nil, err
+		}
+		ruleM.RoleIDs = string(data)
+		fields = append(fields, "RoleIDs")
+	}
+	if int(ruleM.MaxRoles) >= len(conversion.SoDRuleRoleIDs(ruleM)) {
+		return nil, errno.ErrInvalidArgument.WithMessage("maxRoles must be less than the number of roleIDs")
+	}
+	if len(fields) == 0 {
+		return &v1.UpdateSoDRuleResponse{Version: ruleM.Version}, nil
+	}
+
+	if err := b.store.SoDRule().UpdateFields(ctx, ruleM, fields...); err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
+		return nil, fmt.Errorf("failed to update sod rule: %w", err)
+	}
+
+	return &v1.UpdateSoDRuleResponse{Version: ruleM.Version}, nil
+}
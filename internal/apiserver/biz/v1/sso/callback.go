@@ -0,0 +1,187 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/session"
+	userrole "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/user_role"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Callback 实现 SSOBiz 接口中的 Callback 方法.
+// 外部身份按以下顺序解析到本地用户：
+//  1. 关联身份流程：关联到发起流程的用户；
+//  2. 已关联的外部身份：直接登录对应用户；
+//  3. 开启 link-by-email 且邮箱已验证：关联到邮箱相同的已有用户；
+//  4. 开启 allow-jit：自动创建用户并关联；
+//  5. 否则拒绝登录.
+func (b *ssoBiz) Callback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.OIDCCallbackResponse, error) {
+	// state 无论成功与否都只能使用一次
+	stateM, err := b.store.OIDCAuthState().Consume(ctx, rq.GetState())
+	if err != nil {
+		return nil, errno.ErrOIDCStateInvalid
+	}
+
+	if rq.GetError() != "" {
+		slog.WarnContext(ctx, "OIDC provider returned an error", "provider", stateM.Provider, "error", rq.GetError())
+		return nil, errno.ErrOIDCLoginFailed.WithMessage(fmt.Sprintf("Identity provider returned error: %s", rq.GetError()))
+	}
+
+	providerOpts, ok := b.opts.Provider(stateM.Provider)
+	if !ok {
+		return nil, errno.ErrOIDCProviderNotFound
+	}
+	provider, err := b.registry.Get(ctx, stateM.Provider)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to discover OIDC provider", "provider", stateM.Provider, "error", err)
+		return nil, errno.ErrOIDCProviderUnavailable
+	}
+
+	idToken, err := provider.Exchange(ctx, rq.GetCode(), stateM.CodeVerifier, stateM.Nonce)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to complete OIDC login", "provider", stateM.Provider, "error", err)
+		return nil, errno.ErrOIDCLoginFailed
+	}
+
+	var (
+		userM   *model.UserM
+		created bool
+		linked  bool
+	)
+	if stateM.LinkUserID != nil {
+		userM, err = b.store.User().Get(ctx, where.F("user_id", *stateM.LinkUserID))
+		if err != nil {
+			return nil, errno.ErrUserNotFound
+		}
+		if err := b.link(ctx, stateM.Provider, userM.UserID, idToken); err != nil {
+			return nil, err
+		}
+		linked = true
+	} else {
+		userM, created, linked, err = b.resolveUser(ctx, providerOpts, idToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if userM.Status == known.UserStatusDisabled {
+		return nil, errno.ErrUserDisabled
+	}
+	if userM.Status == known.UserStatusPending {
+		return nil, errno.ErrUserPendingApproval
+	}
+
+	if err := userrole.SyncMappedRoles(ctx, b.store, b.bus, userM.UserID, providerOpts.GroupRoles, idToken.Groups); err != nil {
+		return nil, err
+	}
+
+	b.touchIdentity(ctx, stateM.Provider, idToken)
+
+	slog.InfoContext(ctx, "User logged in via OIDC", "provider", stateM.Provider, "userID", userM.UserID, "created", created, "linked", linked)
+
+	resp, err := session.IssueTokens(ctx, b.store, userM, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.OIDCCallbackResponse{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		ExpireAt:     resp.GetExpireAt(),
+		UserID:       userM.UserID,
+		Created:      created,
+		Linked:       linked,
+	}, nil
+}
+
+// resolveUser 将外部身份解析为本地用户，必要时按配置关联已有用户或自动创建用户.
+func (b *ssoBiz) resolveUser(ctx context.Context, providerOpts *genericoptions.OIDCProviderOptions, idToken *oidc.IDToken) (userM *model.UserM, created, linked bool, err error) {
+	identityM, err := b.store.UserIdentity().Get(ctx, where.F("provider", providerOpts.Name, "subject", idToken.Subject))
+	if err == nil {
+		userM, err = b.store.User().Get(ctx, where.F("user_id", identityM.UserID))
+		if err != nil {
+			return nil, false, false, errno.ErrUserNotFound
+		}
+		return userM, false, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, false, err
+	}
+
+	if providerOpts.LinkByEmail && idToken.EmailVerified && idToken.Email != "" {
+		if userM, err := b.store.User().Get(ctx, where.F("email", idToken.Email)); err == nil {
+			if err := b.link(ctx, providerOpts.Name, userM.UserID, idToken); err != nil {
+				return nil, false, false, err
+			}
+			return userM, false, true, nil
+		}
+	}
+
+	if !providerOpts.AllowJIT {
+		return nil, false, false, errno.ErrOIDCUserNotProvisioned
+	}
+
+	userM, err = b.provision(ctx, providerOpts.Name, idToken)
+	if err != nil {
+		return nil, false, false, err
+	}
+	return userM, true, true, nil
+}
+
+// link 将外部身份关联到指定用户. 外部身份已关联到其他用户，或用户已关联该 Provider 的其他身份时返回错误.
+func (b *ssoBiz) link(ctx context.Context, providerName, userID string, idToken *oidc.IDToken) error {
+	if identityM, err := b.store.UserIdentity().Get(ctx, where.F("provider", providerName, "subject", idToken.Subject)); err == nil {
+		if identityM.UserID == userID {
+			return nil
+		}
+		return errno.ErrIdentityAlreadyLinked
+	}
+	if _, err := b.store.UserIdentity().Get(ctx, where.F("provider", providerName, "user_id", userID)); err == nil {
+		return errno.ErrIdentityAlreadyLinked
+	}
+
+	return b.store.UserIdentity().Create(ctx, newIdentity(providerName, userID, idToken))
+}
+
+// touchIdentity 记录外部身份的最近登录时间和最新邮箱，失败不影响登录结果.
+func (b *ssoBiz) touchIdentity(ctx context.Context, providerName string, idToken *oidc.IDToken) {
+	identityM, err := b.store.UserIdentity().Get(ctx, where.F("provider", providerName, "subject", idToken.Subject))
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	identityM.LastLoginAt = &now
+	if idToken.Email != "" {
+		identityM.Email = &idToken.Email
+	}
+	if err := b.store.UserIdentity().Update(ctx, identityM); err != nil {
+		slog.WarnContext(ctx, "Failed to update user identity", "provider", providerName, "error", err)
+	}
+}
+
+// newIdentity 根据 ID Token 构造外部身份记录.
+func newIdentity(providerName, userID string, idToken *oidc.IDToken) *model.UserIdentityM {
+	identityM := &model.UserIdentityM{
+		UserID:   userID,
+		Provider: providerName,
+		Subject:  idToken.Subject,
+	}
+	if idToken.Email != "" {
+		email := idToken.Email
+		identityM.Email = &email
+	}
+	return identityM
+}
@@ -0,0 +1,49 @@
+package sso
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// ListIdentities 实现 SSOBiz 接口中的 ListIdentities 方法.
+func (b *ssoBiz) ListIdentities(ctx context.Context, rq *v1.ListUserIdentitiesRequest) (*v1.ListUserIdentitiesResponse, error) {
+	_, identityList, err := b.store.UserIdentity().List(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make([]*v1.UserIdentity, 0, len(identityList))
+	for _, identityM := range identityList {
+		identities = append(identities, conversion.UserIdentityModelToUserIdentityV1(identityM))
+	}
+
+	return &v1.ListUserIdentitiesResponse{Identities: identities}, nil
+}
+
+// LinkIdentity 实现 SSOBiz 接口中的 LinkIdentity 方法.
+func (b *ssoBiz) LinkIdentity(ctx context.Context, rq *v1.LinkUserIdentityRequest) (*v1.StartOIDCLoginResponse, error) {
+	if _, err := b.store.UserIdentity().Get(ctx, where.F("user_id", rq.GetUserID(), "provider", rq.GetProvider())); err == nil {
+		return nil, errno.ErrIdentityAlreadyLinked
+	}
+
+	userID := rq.GetUserID()
+	return b.start(ctx, rq.GetProvider(), &userID)
+}
+
+// UnlinkIdentity 实现 SSOBiz 接口中的 UnlinkIdentity 方法.
+func (b *ssoBiz) UnlinkIdentity(ctx context.Context, rq *v1.UnlinkUserIdentityRequest) (*v1.UnlinkUserIdentityResponse, error) {
+	whr := where.F("user_id", rq.GetUserID(), "provider", rq.GetProvider())
+	if _, err := b.store.UserIdentity().Get(ctx, whr); err != nil {
+		return nil, errno.ErrIdentityNotFound
+	}
+
+	if err := b.store.UserIdentity().Delete(ctx, whr); err != nil {
+		return nil, err
+	}
+
+	return &v1.UnlinkUserIdentityResponse{}, nil
+}
@@ -0,0 +1,21 @@
+package sso
+
+import (
+	"context"
+
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// ListProviders 实现 SSOBiz 接口中的 ListProviders 方法.
+func (b *ssoBiz) ListProviders(ctx context.Context, rq *v1.ListOIDCProvidersRequest) (*v1.ListOIDCProvidersResponse, error) {
+	providers := make([]*v1.OIDCProvider, 0, len(b.opts.Providers))
+	for _, provider := range b.opts.Providers {
+		displayName := provider.DisplayName
+		if displayName == "" {
+			displayName = provider.Name
+		}
+		providers = append(providers, &v1.OIDCProvider{Name: provider.Name, DisplayName: displayName})
+	}
+
+	return &v1.ListOIDCProvidersResponse{Providers: providers}, nil
+}
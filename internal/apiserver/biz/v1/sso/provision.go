@@ -0,0 +1,103 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// invalidUsernameChars 匹配用户名中不允许出现的字符，用户名仅允许字母、数字和下划线.
+var invalidUsernameChars = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// maxUsernameAttempts 是生成不重复用户名的最大尝试次数.
+const maxUsernameAttempts = 5
+
+// provision 根据 ID Token 自动创建用户（JIT Provisioning）并关联外部身份.
+// 自动创建的用户使用随机密码，只能通过单点登录登录，直到用户自行修改密码.
+func (b *ssoBiz) provision(ctx context.Context, providerName string, idToken *oidc.IDToken) (*model.UserM, error) {
+	username, err := b.uniqueUsername(ctx, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	userM := &model.UserM{
+		Username: username,
+		Nickname: idToken.Name,
+		Password: oidc.RandomString(),
+		Status:   known.UserStatusActive,
+	}
+	if len([]rune(userM.Nickname)) == 0 || len([]rune(userM.Nickname)) >= 30 {
+		userM.Nickname = username
+	}
+	// 只有已验证且未被占用的邮箱才写入用户资料，避免冒用他人邮箱
+	if idToken.EmailVerified && idToken.Email != "" {
+		if _, err := b.store.User().Get(ctx, where.F("email", idToken.Email)); err != nil {
+			email := idToken.Email
+			userM.Email = &email
+		}
+	}
+	if idToken.Picture != "" {
+		picture := idToken.Picture
+		userM.Avatar = &picture
+	}
+
+	var created *event.UserCreated
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Create(ctx, userM); err != nil {
+			return err
+		}
+		created = &event.UserCreated{UserID: userM.UserID, Username: userM.Username, Status: userM.Status}
+		if err := event.Record(ctx, b.store, created); err != nil {
+			return err
+		}
+		return b.store.UserIdentity().Create(ctx, newIdentity(providerName, userM.UserID, idToken))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := b.authz.AddGroupingPolicy(userM.UserID, known.RoleUser); err != nil {
+		slog.ErrorContext(ctx, "Failed to add grouping policy for user", "user", userM.UserID, "role", known.RoleUser, "error", err)
+		return nil, errno.ErrAddRole.WithMessage(err.Error())
+	}
+	event.Publish(ctx, b.bus, created)
+
+	slog.InfoContext(ctx, "Provisioned user from OIDC identity", "provider", providerName, "userID", userM.UserID, "username", username)
+
+	return userM, nil
+}
+
+// uniqueUsername 根据 preferred_username 或邮箱前缀生成一个未被占用的合法用户名.
+func (b *ssoBiz) uniqueUsername(ctx context.Context, idToken *oidc.IDToken) (string, error) {
+	base := idToken.PreferredUsername
+	if base == "" && idToken.Email != "" {
+		base, _, _ = strings.Cut(idToken.Email, "@")
+	}
+	base = invalidUsernameChars.ReplaceAllString(base, "_")
+	if len(base) < 3 {
+		base = "user_" + base
+	}
+	if len(base) > 14 {
+		base = base[:14]
+	}
+
+	candidate := base
+	for range maxUsernameAttempts {
+		if _, err := b.store.User().Get(ctx, where.F("username", candidate)); err != nil {
+			return candidate, nil
+		}
+		// 追加 5 位随机后缀，总长度不超过用户名上限 20
+		candidate = fmt.Sprintf("%s_%s", base, strings.ToLower(invalidUsernameChars.ReplaceAllString(oidc.RandomString(), ""))[:5])
+	}
+
+	return "", errno.ErrUserAlreadyExists
+}
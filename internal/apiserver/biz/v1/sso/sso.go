@@ -0,0 +1,48 @@
+package sso
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
+)
+
+// SSOBiz 定义处理 OIDC 单点登录和外部身份关联请求所需的方法.
+type SSOBiz interface {
+	// ListProviders 获取已配置的 OIDC Provider 列表
+	ListProviders(ctx context.Context, rq *v1.ListOIDCProvidersRequest) (*v1.ListOIDCProvidersResponse, error)
+	// StartLogin 发起单点登录，返回 IdP 授权地址
+	StartLogin(ctx context.Context, rq *v1.StartOIDCLoginRequest) (*v1.StartOIDCLoginResponse, error)
+	// Callback 处理授权回调，完成登录或身份关联并签发令牌
+	Callback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.OIDCCallbackResponse, error)
+
+	// ListIdentities 获取用户关联的外部身份列表
+	ListIdentities(ctx context.Context, rq *v1.ListUserIdentitiesRequest) (*v1.ListUserIdentitiesResponse, error)
+	// LinkIdentity 为当前用户发起关联外部身份的授权流程
+	LinkIdentity(ctx context.Context, rq *v1.LinkUserIdentityRequest) (*v1.StartOIDCLoginResponse, error)
+	// UnlinkIdentity 解除外部身份关联
+	UnlinkIdentity(ctx context.Context, rq *v1.UnlinkUserIdentityRequest) (*v1.UnlinkUserIdentityResponse, error)
+}
+
+// ssoBiz 是 SSOBiz 接口的实现.
+type ssoBiz struct {
+	store    store.IStore
+	authz    *authz.Authz
+	opts     *genericoptions.OIDCOptions
+	registry *oidc.Registry
+	bus      *eventbus.Bus
+}
+
+// 确保 ssoBiz 实现了 SSOBiz 接口.
+var _ SSOBiz = (*ssoBiz)(nil)
+
+func New(store store.IStore, authz *authz.Authz, opts *genericoptions.OIDCOptions, registry *oidc.Registry, bus *eventbus.Bus) *ssoBiz {
+	if opts == nil {
+		opts = genericoptions.NewOIDCOptions()
+	}
+	return &ssoBiz{store: store, authz: authz, opts: opts, registry: registry, bus: bus}
+}
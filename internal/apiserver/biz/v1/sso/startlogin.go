@@ -0,0 +1,56 @@
+package sso
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+)
+
+// StartLogin 实现 SSOBiz 接口中的 StartLogin 方法.
+func (b *ssoBiz) StartLogin(ctx context.Context, rq *v1.StartOIDCLoginRequest) (*v1.StartOIDCLoginResponse, error) {
+	return b.start(ctx, rq.GetProvider(), nil)
+}
+
+// start 生成 state、nonce 和 PKCE code_verifier 并保存，返回 IdP 授权地址.
+// linkUserID 不为空时表示关联身份流程，回调时会将外部身份关联到该用户而不是登录.
+func (b *ssoBiz) start(ctx context.Context, providerName string, linkUserID *string) (*v1.StartOIDCLoginResponse, error) {
+	if _, ok := b.opts.Provider(providerName); !ok {
+		return nil, errno.ErrOIDCProviderNotFound
+	}
+
+	provider, err := b.registry.Get(ctx, providerName)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to discover OIDC provider", "provider", providerName, "error", err)
+		return nil, errno.ErrOIDCProviderUnavailable
+	}
+
+	// 顺带清理过期的登录状态，失败不影响本次登录
+	if _, err := b.store.OIDCAuthState().PurgeExpired(ctx); err != nil {
+		slog.WarnContext(ctx, "Failed to purge expired OIDC states", "error", err)
+	}
+
+	now := time.Now()
+	stateM := &model.OIDCAuthStateM{
+		State:        oidc.RandomString(),
+		Provider:     providerName,
+		CodeVerifier: oidc.GenerateVerifier(),
+		Nonce:        oidc.RandomString(),
+		LinkUserID:   linkUserID,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(b.opts.StateTTL),
+	}
+	if err := b.store.OIDCAuthState().Create(ctx, stateM); err != nil {
+		return nil, err
+	}
+
+	return &v1.StartOIDCLoginResponse{
+		AuthorizationURL: provider.AuthCodeURL(stateM.State, stateM.Nonce, stateM.CodeVerifier),
+		State:            stateM.State,
+		ExpiresAt:        stateM.ExpiresAt.Unix(),
+	}, nil
+}
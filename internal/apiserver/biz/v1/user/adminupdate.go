@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// AdminUpdate 实现 UserBiz 接口中的 AdminUpdate 方法，只修改 updateMask 中的字段，请求携带 version 时校验版本号.
+func (b *userBiz) AdminUpdate(ctx context.Context, rq *v1.AdminUpdateUserRequest) (*v1.AdminUpdateUserResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+	if rq.Version != nil && rq.GetVersion() != userM.Version {
+		return nil, errno.ErrConflict
+	}
+
+	mask, err := fieldmask.New(rq, rq.GetUpdateMask(), "userID", "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	status := userM.Status
+	fields, err := mask.Apply(userM, rq)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return &v1.AdminUpdateUserResponse{Version: userM.Version}, nil
+	}
+	events := []event.Event{&event.UserUpdated{UserID: userM.UserID, Username: userM.Username, Fields: mask.Paths()}}
+	if userM.Status != status {
+		events = append(events, &event.UserStatusChanged{UserID: userM.UserID, Username: userM.Username, Status: userM.Status})
+	}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().UpdateFields(ctx, userM, fields...); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, events...)
+	})
+	if err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
+		return nil, err
+	}
+	event.Publish(ctx, b.bus, events...)
+
+	if userM.Status != status && userM.Status == known.UserStatusDisabled {
+		if err := b.terminateSessions(ctx, userM.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.AdminUpdateUserResponse{Version: userM.Version}, nil
+}
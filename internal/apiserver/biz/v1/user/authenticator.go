@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/pkg/authn"
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Authenticator 是用户名密码认证后端.
+// 认证失败时应返回 errno.ErrUserNotFound 或 errno.ErrPasswordInvalid，使认证链继续尝试下一个后端；
+// 返回其他错误会终止认证链.
+type Authenticator interface {
+	// Name 返回认证后端的名称.
+	Name() string
+	// Authenticate 验证用户名和密码，成功时返回对应的本地用户.
+	Authenticate(ctx context.Context, username, password string) (*model.UserM, error)
+}
+
+// chain 按顺序尝试多个认证后端，返回第一个认证成功的用户.
+type chain []Authenticator
+
+// 确保 chain 实现了 Authenticator 接口.
+var _ Authenticator = (chain)(nil)
+
+// NewChain 创建按顺序尝试 authenticators 的认证链.
+func NewChain(authenticators ...Authenticator) Authenticator {
+	return chain(authenticators)
+}
+
+// Name 实现 Authenticator 接口中的 Name 方法.
+func (c chain) Name() string {
+	return "chain"
+}
+
+// Authenticate 实现 Authenticator 接口中的 Authenticate 方法.
+// 所有后端都认证失败时，只要有一个后端找到了用户就返回密码错误，否则返回用户不存在.
+func (c chain) Authenticate(ctx context.Context, username, password string) (*model.UserM, error) {
+	lastErr := errno.ErrUserNotFound
+	for _, authenticator := range c {
+		userM, err := authenticator.Authenticate(ctx, username, password)
+		if err == nil {
+			slog.DebugContext(ctx, "User authenticated", "authenticator", authenticator.Name(), "userID", userM.UserID)
+			return userM, nil
+		}
+
+		switch {
+		case errorsx.Is(err, errno.ErrPasswordInvalid):
+			lastErr = errno.ErrPasswordInvalid
+		case errorsx.Is(err, errno.ErrUserNotFound):
+			// 用户不存在，继续尝试下一个后端
+		default:
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// localAuthenticator 使用本地数据库中的密码哈希认证用户.
+type localAuthenticator struct {
+	store store.IStore
+}
+
+// NewLocalAuthenticator 创建本地数据库认证后端.
+func NewLocalAuthenticator(store store.IStore) Authenticator {
+	return &localAuthenticator{store: store}
+}
+
+// Name 实现 Authenticator 接口中的 Name 方法.
+func (a *localAuthenticator) Name() string {
+	return "local"
+}
+
+// Authenticate 实现 Authenticator 接口中的 Authenticate 方法.
+func (a *localAuthenticator) Authenticate(ctx context.Context, username, password string) (*model.UserM, error) {
+	// 获取登录用户的所有信息
+	userM, err := a.store.User().Get(ctx, where.F("username", username))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+
+	// 对比传入的明文密码和数据库中已加密过的密码是否匹配
+	if err := authn.Compare(userM.Password, password); err != nil {
+		slog.DebugContext(ctx, "Failed to compare password", "error", err)
+		return nil, errno.ErrPasswordInvalid
+	}
+
+	// 密码哈希使用了过时的算法或参数时，利用本次登录的明文密码透明地重新哈希，失败不影响登录结果
+	if authn.NeedsRehash(userM.Password) {
+		a.rehash(ctx, userM, password)
+	}
+
+	return userM, nil
+}
+
+// rehash 使用当前的默认算法和参数重新哈希密码并保存.
+func (a *localAuthenticator) rehash(ctx context.Context, userM *model.UserM, password string) {
+	hashed, err := authn.Encrypt(password)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to rehash password", "userID", userM.UserID, "error", err)
+		return
+	}
+	if err := a.store.User().UpdatePassword(ctx, userM.UserID, hashed); err != nil {
+		slog.WarnContext(ctx, "Failed to save rehashed password", "userID", userM.UserID, "error", err)
+		return
+	}
+	userM.Password = hashed
+}
@@ -0,0 +1,189 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	userrole "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/user_role"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/ldap"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// ldapAuthenticator 通过 LDAP 绑定认证用户，并将目录用户关联到本地用户.
+// 目录用户以 Provider 为 known.IdentityProviderLDAP、Subject 为小写 DN 的外部身份关联到本地用户，
+// 每次登录都会按用户所在的组同步 GroupRoles 中映射的角色.
+type ldapAuthenticator struct {
+	store  store.IStore
+	authz  *authz.Authz
+	bus    *eventbus.Bus
+	client *ldap.Client
+	opts   *genericoptions.LDAPOptions
+}
+
+// NewLDAPAuthenticator 创建 LDAP 认证后端.
+func NewLDAPAuthenticator(
+	store store.IStore,
+	authz *authz.Authz,
+	bus *eventbus.Bus,
+	client *ldap.Client,
+	opts *genericoptions.LDAPOptions,
+) Authenticator {
+	return &ldapAuthenticator{store: store, authz: authz, bus: bus, client: client, opts: opts}
+}
+
+// Name 实现 Authenticator 接口中的 Name 方法.
+func (a *ldapAuthenticator) Name() string {
+	return known.IdentityProviderLDAP
+}
+
+// Authenticate 实现 Authenticator 接口中的 Authenticate 方法.
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, username, password string) (*model.UserM, error) {
+	entry, err := a.client.Authenticate(ctx, username, password)
+	switch {
+	case errors.Is(err, ldap.ErrUserNotFound):
+		return nil, errno.ErrUserNotFound
+	case errors.Is(err, ldap.ErrInvalidCredentials):
+		return nil, errno.ErrPasswordInvalid
+	case err != nil:
+		slog.ErrorContext(ctx, "LDAP authentication failed", "username", username, "error", err)
+		return nil, errno.ErrLDAPUnavailable
+	}
+
+	subject := strings.ToLower(entry.DN)
+	userM, err := a.resolveUser(ctx, subject, username, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, 0, len(entry.Groups))
+	for _, group := range entry.Groups {
+		groups = append(groups, strings.ToLower(group))
+	}
+	if err := userrole.SyncMappedRoles(ctx, a.store, a.bus, userM.UserID, a.opts.GroupRoleMapping(), groups); err != nil {
+		return nil, err
+	}
+
+	a.touchIdentity(ctx, subject, entry)
+
+	return userM, nil
+}
+
+// resolveUser 查找目录用户关联的本地用户，未关联时按配置关联同名用户或自动创建用户.
+func (a *ldapAuthenticator) resolveUser(ctx context.Context, subject, username string, entry *ldap.Entry) (*model.UserM, error) {
+	identityM, err := a.store.UserIdentity().Get(ctx, where.F("provider", known.IdentityProviderLDAP, "subject", subject))
+	if err == nil {
+		userM, err := a.store.User().Get(ctx, where.F("user_id", identityM.UserID))
+		if err != nil {
+			return nil, errno.ErrUserNotFound
+		}
+		return userM, nil
+	}
+
+	// 同名的本地用户只有在管理员显式开启时才关联，否则目录中的同名账号可以接管本地账号
+	if userM, err := a.store.User().Get(ctx, where.F("username", username)); err == nil {
+		if !a.opts.LinkByUsername {
+			slog.WarnContext(ctx, "LDAP user conflicts with local user", "username", username, "dn", entry.DN)
+			return nil, errno.ErrLDAPUserConflict
+		}
+		if err := a.store.UserIdentity().Create(ctx, newLDAPIdentity(userM.UserID, subject, entry)); err != nil {
+			return nil, err
+		}
+		slog.InfoContext(ctx, "Linked LDAP identity to existing user", "userID", userM.UserID, "dn", entry.DN)
+		return userM, nil
+	}
+
+	if !a.opts.AllowJIT {
+		return nil, errno.ErrLDAPUserNotProvisioned
+	}
+
+	return a.provision(ctx, subject, username, entry)
+}
+
+// provision 根据目录用户自动创建本地用户（JIT Provisioning）并关联外部身份.
+// 自动创建的用户使用随机密码，只能通过 LDAP 登录，直到用户自行修改密码.
+func (a *ldapAuthenticator) provision(ctx context.Context, subject, username string, entry *ldap.Entry) (*model.UserM, error) {
+	userM := &model.UserM{
+		Username: username,
+		Nickname: entry.DisplayName,
+		Password: oidc.RandomString(),
+		Status:   known.UserStatusActive,
+	}
+	if len([]rune(userM.Nickname)) == 0 || len([]rune(userM.Nickname)) >= 30 {
+		userM.Nickname = username
+	}
+	// 只有未被占用的邮箱才写入用户资料
+	if entry.Email != "" {
+		if _, err := a.store.User().Get(ctx, where.F("email", entry.Email)); err != nil {
+			email := entry.Email
+			userM.Email = &email
+		}
+	}
+
+	var created *event.UserCreated
+	err := a.store.TX(ctx, func(ctx context.Context) error {
+		if err := a.store.User().Create(ctx, userM); err != nil {
+			return err
+		}
+		created = &event.UserCreated{UserID: userM.UserID, Username: userM.Username, Status: userM.Status}
+		if err := event.Record(ctx, a.store, created); err != nil {
+			return err
+		}
+		return a.store.UserIdentity().Create(ctx, newLDAPIdentity(userM.UserID, subject, entry))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.authz.AddGroupingPolicy(userM.UserID, known.RoleUser); err != nil {
+		slog.ErrorContext(ctx, "Failed to add grouping policy for user", "user", userM.UserID, "role", known.RoleUser, "error", err)
+		return nil, errno.ErrAddRole.WithMessage(err.Error())
+	}
+	event.Publish(ctx, a.bus, created)
+
+	slog.InfoContext(ctx, "Provisioned user from LDAP directory", "userID", userM.UserID, "username", username, "dn", entry.DN)
+
+	return userM, nil
+}
+
+// touchIdentity 记录通过目录账号登录的时间，并同步目录中的邮箱.
+func (a *ldapAuthenticator) touchIdentity(ctx context.Context, subject string, entry *ldap.Entry) {
+	identityM, err := a.store.UserIdentity().Get(ctx, where.F("provider", known.IdentityProviderLDAP, "subject", subject))
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	identityM.LastLoginAt = &now
+	if entry.Email != "" {
+		identityM.Email = &entry.Email
+	}
+	if err := a.store.UserIdentity().Update(ctx, identityM); err != nil {
+		slog.WarnContext(ctx, "Failed to update user identity", "provider", known.IdentityProviderLDAP, "error", err)
+	}
+}
+
+// newLDAPIdentity 根据目录用户构造外部身份记录.
+func newLDAPIdentity(userID, subject string, entry *ldap.Entry) *model.UserIdentityM {
+	identityM := &model.UserIdentityM{
+		UserID:   userID,
+		Provider: known.IdentityProviderLDAP,
+		Subject:  subject,
+	}
+	if entry.Email != "" {
+		email := entry.Email
+		identityM.Email = &email
+	}
+	return identityM
+}
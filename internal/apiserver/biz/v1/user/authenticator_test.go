@@ -0,0 +1,40 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/pkg/authn"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestLocalAuthenticator_Rehash(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	previous := authn.DefaultHasher()
+	defer authn.SetDefaultHasher(previous)
+	authn.SetDefaultHasher(authn.NewArgon2idHasher(authn.Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}))
+
+	legacy, err := authn.NewBcryptHasher(bcrypt.MinCost).Hash("legacy123")
+	require.NoError(t, err)
+	require.NoError(t, s.User().Import(ctx, &model.UserM{Username: "rehash-legacy", Password: legacy, Status: known.UserStatusActive}))
+
+	userM, err := NewLocalAuthenticator(s).Authenticate(ctx, "rehash-legacy", "legacy123")
+	require.NoError(t, err)
+
+	// 登录后数据库中保存的是使用当前算法重新生成的哈希
+	storedM, err := s.User().Get(ctx, where.F("username", "rehash-legacy"))
+	require.NoError(t, err)
+	assert.Equal(t, authn.AlgorithmArgon2id, authn.Identify(storedM.Password))
+	assert.Equal(t, userM.Password, storedM.Password)
+	assert.NoError(t, authn.Compare(storedM.Password, "legacy123"))
+	assert.False(t, authn.NeedsRehash(storedM.Password))
+}
@@ -2,56 +2,173 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
 	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
 
+	accessrequest "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/access_request"
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 )
 
 // Create 实现 UserBiz 接口中的 Create 方法.
+// 该方法用于用户自助注册，行为由注册模式决定：
+//   - open: 直接创建活跃用户；
+//   - closed: 拒绝注册；
+//   - invite: 必须提供有效的邀请码；
+//   - approval: 未提供邀请码时创建待审核用户，审核通过后才能登录.
+//
+// 提供有效邀请码时会占用一次使用次数，并为用户分配邀请码中预设的角色，敏感角色除外.
 func (b *userBiz) Create(ctx context.Context, rq *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	status := known.UserStatusActive
+	switch b.registrationMode() {
+	case genericoptions.RegistrationModeClosed:
+		return nil, errno.ErrRegistrationClosed
+	case genericoptions.RegistrationModeInvite:
+		if rq.GetInvitationCode() == "" {
+			return nil, errno.ErrInvitationRequired
+		}
+	case genericoptions.RegistrationModeApproval:
+		if rq.GetInvitationCode() == "" {
+			status = known.UserStatusPending
+		}
+	}
+
+	userM, err := b.create(ctx, rq, status, rq.GetInvitationCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateUserResponse{UserID: userM.UserID, PendingApproval: status == known.UserStatusPending}, nil
+}
+
+// AdminCreate 实现 UserBiz 接口中的 AdminCreate 方法.
+// 管理员创建的用户不受注册模式限制，创建后立即可用.
+func (b *userBiz) AdminCreate(ctx context.Context, rq *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	userM, err := b.create(ctx, rq, known.UserStatusActive, rq.GetInvitationCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateUserResponse{UserID: userM.UserID}, nil
+}
+
+// registrationMode 返回当前的注册模式，未配置时按开放注册处理.
+func (b *userBiz) registrationMode() string {
+	if b.registration == nil || b.registration.Mode == "" {
+		return genericoptions.RegistrationModeOpen
+	}
+	return b.registration.Mode
+}
+
+// create 创建指定状态的用户. 如果提供了邀请码，会在同一事务中占用邀请码并分配预设角色.
+// 用户名、邮箱或手机号已存在时，由数据库的唯一约束拒绝写入，返回的 AlreadyExists 错误中包含冲突的字段.
+// 只有活跃用户会立即同步 Casbin 角色，待审核用户在审核通过时再同步.
+func (b *userBiz) create(ctx context.Context, rq *v1.CreateUserRequest, status int16, invitationCode string) (*model.UserM, error) {
 	var userM model.UserM
 	if err := copier.Copy(&userM, rq); err != nil {
 		slog.ErrorContext(ctx, "Failed to copy request to model", "error", err)
 		return nil, fmt.Errorf("failed to copy request: %w", err)
 	}
+	userM.Status = status
+
+	var (
+		roleIDs []string
+		events  []event.Event
+	)
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if invitationCode != "" {
+			invitationM, err := b.store.Invitation().Consume(ctx, invitationCode)
+			if err != nil {
+				slog.WarnContext(ctx, "Invalid invitation code", "code", invitationCode, "error", err)
+				return errno.ErrInvitationInvalid
+			}
+			if roleIDs, err = b.invitationRoles(ctx, invitationM); err != nil {
+				return err
+			}
+			// 邀请码创建后职责分离规则可能发生变化，授予前再次检查
+			if err := sodrule.CheckRoles(ctx, b.store, roleIDs); err != nil {
+				return err
+			}
+		}
 
-	// 检查用户名是否已存在
-	if existingUser, err := b.store.User().Get(ctx, where.F("username", userM.Username).L(1)); err == nil && existingUser != nil {
-		slog.WarnContext(ctx, "Username already exists", "username", userM.Username)
-		return nil, errno.ErrUserAlreadyExists
+		if err := b.store.User().Create(ctx, &userM); err != nil {
+			return err
+		}
+		events = append(events, &event.UserCreated{UserID: userM.UserID, Username: userM.Username, Status: userM.Status})
+
+		if len(roleIDs) > 0 {
+			if err := b.store.UserRole().AssignRoles(ctx, userM.UserID, roleIDs); err != nil {
+				return err
+			}
+			events = append(events, &event.UserRolesAssigned{UserID: userM.UserID, RoleIDs: roleIDs})
+		}
+		return event.Record(ctx, b.store, events...)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 检查邮箱是否已存在（如果提供了邮箱）
-	if userM.Email != nil && *userM.Email != "" {
-		if existingUser, err := b.store.User().Get(ctx, where.F("email", *userM.Email).L(1)); err == nil && existingUser != nil {
-			slog.WarnContext(ctx, "Email already exists", "email", *userM.Email)
-			return nil, errno.ErrUserAlreadyExists
+	if status == known.UserStatusActive {
+		if err := b.grantRoles(ctx, userM.UserID); err != nil {
+			return nil, err
 		}
 	}
+	event.Publish(ctx, b.bus, events...)
 
-	// 检查手机号是否已存在（如果提供了手机号）
-	if userM.Phone != nil && *userM.Phone != "" {
-		if existingUser, err := b.store.User().Get(ctx, where.F("phone", *userM.Phone).L(1)); err == nil && existingUser != nil {
-			slog.WarnContext(ctx, "Phone already exists", "phone", *userM.Phone)
-			return nil, errno.ErrUserAlreadyExists
+	return &userM, nil
+}
+
+// invitationRoles 返回邀请码中可以直接授予的预设角色.
+// 邀请码创建后被标记为敏感或被删除的角色不会授予，敏感角色只能通过授权申请审批后授予.
+func (b *userBiz) invitationRoles(ctx context.Context, invitationM *model.InvitationM) ([]string, error) {
+	var roleIDs []string
+	for _, roleID := range conversion.InvitationRoleIDs(invitationM) {
+		roleM, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				slog.WarnContext(ctx, "Invitation role not found", "invitationID", invitationM.ID, "roleID", roleID)
+				continue
+			}
+			return nil, err
 		}
+		if accessrequest.IsSensitive(roleM) {
+			slog.WarnContext(ctx, "Sensitive invitation role requires approval", "invitationID", invitationM.ID, "role", roleM.RoleCode)
+			continue
+		}
+		roleIDs = append(roleIDs, roleID)
 	}
+	return roleIDs, nil
+}
 
-	if err := b.store.User().Create(ctx, &userM); err != nil {
-		return nil, err
+// grantRoles 将用户的普通用户角色以及已分配的角色同步到 Casbin.
+func (b *userBiz) grantRoles(ctx context.Context, userID string) error {
+	if _, err := b.authz.AddGroupingPolicy(userID, known.RoleUser); err != nil {
+		slog.ErrorContext(ctx, "Failed to add grouping policy for user", "user", userID, "role", known.RoleUser, "error", err)
+		return errno.ErrAddRole.WithMessage(err.Error())
 	}
 
-	if _, err := b.authz.AddGroupingPolicy(userM.UserID, known.RoleUser); err != nil {
-		slog.ErrorContext(ctx, "Failed to add grouping policy for user", "user", userM.UserID, "role", known.RoleUser, "error", err)
-		return nil, errno.ErrAddRole.WithMessage(err.Error())
+	roles, err := b.store.UserRole().GetUserRoles(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, roleM := range roles {
+		casbinRole := "role::" + roleM.RoleCode
+		if _, err := b.authz.AddGroupingPolicy(userID, casbinRole); err != nil {
+			slog.ErrorContext(ctx, "Failed to add grouping policy", "userID", userID, "role", casbinRole, "error", err)
+			return errno.ErrAddRole.WithMessage(err.Error())
+		}
 	}
 
-	return &v1.CreateUserResponse{UserID: userM.UserID}, nil
+	return nil
 }
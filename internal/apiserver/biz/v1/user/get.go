@@ -6,6 +6,7 @@ import (
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 )
 
@@ -18,3 +19,13 @@ func (b *userBiz) Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserRe
 
 	return &v1.GetUserResponse{User: conversion.UserModelToUserV1(userM)}, nil
 }
+
+// AdminGet 实现 UserBiz 接口中的 AdminGet 方法.
+func (b *userBiz) AdminGet(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+
+	return &v1.GetUserResponse{User: conversion.UserModelToUserV1(userM)}, nil
+}
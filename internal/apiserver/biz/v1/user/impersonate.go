@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/session"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/audit"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// impersonateResourceFormat 是模拟登录接口的资源路径. Casbin 默认放行未配置策略的请求，
+// 因此模拟登录要求调用者被显式授予该路径（例如 "/v1/admin/users/*/impersonate"）上的 POST 权限.
+const impersonateResourceFormat = "/v1/admin/users/%s/impersonate"
+
+// Impersonate 实现 UserBiz 接口中的 Impersonate 方法.
+func (b *userBiz) Impersonate(ctx context.Context, rq *v1.ImpersonateUserRequest) (*v1.ImpersonateUserResponse, error) {
+	actorID := contextx.UserID(ctx)
+	resource := fmt.Sprintf(impersonateResourceFormat, rq.GetUserID())
+
+	allowed, err := b.authz.ExplicitlyAllowed(actorID, resource, http.MethodPost)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to check impersonation permission", "actorID", actorID, "error", err)
+		return nil, errno.ErrInternal
+	}
+	if !allowed {
+		return nil, errno.ErrImpersonationNotAllowed
+	}
+
+	actorM, err := b.store.User().Get(ctx, where.F("user_id", actorID))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+
+	// 被禁用或待审核的用户无法登录，同样不允许被模拟
+	if userM.Status == known.UserStatusDisabled {
+		return nil, errno.ErrUserDisabled
+	}
+	if userM.Status == known.UserStatusPending {
+		return nil, errno.ErrUserPendingApproval
+	}
+
+	// 不允许模拟同样拥有模拟登录权限的用户，避免借助他人身份扩大权限
+	targetAllowed, err := b.authz.ExplicitlyAllowed(userM.UserID, resource, http.MethodPost)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to check impersonation permission", "userID", userM.UserID, "error", err)
+		return nil, errno.ErrInternal
+	}
+	if targetAllowed {
+		return nil, errno.ErrImpersonationNotAllowed.WithMessage("不允许模拟拥有模拟登录权限的用户。")
+	}
+
+	var resp *v1.ImpersonateUserResponse
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = session.IssueImpersonationToken(ctx, b.store, userM, actorM)
+		if err != nil {
+			return err
+		}
+
+		details := map[string]any{
+			"targetUserID": userM.UserID,
+			"targetUser":   userM.Username,
+			"reason":       rq.GetReason(),
+			"expireAt":     resp.GetExpireAt(),
+		}
+		if err := audit.Record(ctx, b.store, audit.ActionUserImpersonate, resource, details); err != nil {
+			slog.ErrorContext(ctx, "Failed to record impersonation audit log", "error", err)
+			return errno.ErrDBWrite
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Impersonated user", "operator", actorID, "userID", userM.UserID, "reason", rq.GetReason())
+
+	return resp, nil
+}
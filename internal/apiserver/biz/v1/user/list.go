@@ -17,6 +17,16 @@ import (
 
 // List 实现 UserBiz 接口中的 List 方法.
 func (b *userBiz) List(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUserResponse, error) {
+	return b.list(ctx, rq, contextx.Username(ctx) != known.AdminUsername)
+}
+
+// AdminList 实现 UserBiz 接口中的 AdminList 方法.
+func (b *userBiz) AdminList(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUserResponse, error) {
+	return b.list(ctx, rq, false)
+}
+
+// list 分页查询用户列表，scoped 为 true 时只返回当前登录用户自己.
+func (b *userBiz) list(ctx context.Context, rq *v1.ListUserRequest, scoped bool) (*v1.ListUserResponse, error) {
 	// 解析 page_token 获取游标
 	pageToken := rq.GetPageToken()
 	var cursor *int64
@@ -38,7 +48,7 @@ func (b *userBiz) List(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUse
 	if cursor != nil {
 		whr.Cursor = cursor
 	}
-	if contextx.Username(ctx) != known.AdminUsername {
+	if scoped {
 		whr.T(ctx)
 	}
 
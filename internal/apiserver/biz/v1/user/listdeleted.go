@@ -0,0 +1,44 @@
+package user
+
+import (
+	"context"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// ListDeleted 实现 UserBiz 接口中的 ListDeleted 方法.
+func (b *userBiz) ListDeleted(ctx context.Context, rq *v1.ListDeletedUsersRequest) (*v1.ListDeletedUsersResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize)))
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, userList, err := b.store.User().ListDeleted(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*v1.User, 0, len(userList))
+	for _, userM := range userList {
+		users = append(users, conversion.UserModelToUserV1(userM))
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(userList) == pageSize {
+		cursor, err := pagination.NewCursor("id", userList[len(userList)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	return &v1.ListDeletedUsersResponse{TotalCount: total, Users: users, PageToken: nextPageToken}, nil
+}
@@ -2,42 +2,31 @@ package user
 
 import (
 	"context"
-	"log/slog"
-	"time"
-
-	"github.com/clin211/gin-enterprise-template/pkg/authn"
-	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"github.com/clin211/gin-enterprise-template/pkg/token"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/session"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 )
 
 // Login 实现 UserBiz 接口中的 Login 方法.
 func (b *userBiz) Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginResponse, error) {
-	// 获取登录用户的所有信息
-	whr := where.F("username", rq.GetUsername())
-	userM, err := b.store.User().Get(ctx, whr)
+	// 依次尝试本地数据库、LDAP 等认证后端
+	userM, err := b.authn.Authenticate(ctx, rq.GetUsername(), rq.GetPassword())
 	if err != nil {
-		return nil, errno.ErrUserNotFound
+		return nil, err
 	}
 
-	// 对比传入的明文密码和数据库中已加密过的密码是否匹配
-	if err := authn.Compare(userM.Password, rq.GetPassword()); err != nil {
-		slog.ErrorContext(ctx, "Failed to compare password", "error", err)
-		return nil, errno.ErrPasswordInvalid
+	// 被禁用的用户不允许登录
+	if userM.Status == known.UserStatusDisabled {
+		return nil, errno.ErrUserDisabled
 	}
 
-	// 如果匹配成功，说明登录成功，签发 access token 和 refresh token 并返回
-	accessToken, refreshToken, accessExpireAt, _, err := token.Sign(userM.UserID)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to sign token", "error", err)
-		return nil, errno.ErrSignToken
+	// 待审核的用户在审核通过前不允许登录
+	if userM.Status == known.UserStatusPending {
+		return nil, errno.ErrUserPendingApproval
 	}
 
-	return &v1.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpireAt:     accessExpireAt.Format(time.RFC3339),
-	}, nil
+	// 如果匹配成功，说明登录成功，创建会话并签发 access token 和 refresh token
+	return session.IssueTokens(ctx, b.store, userM, rq.DeviceName)
 }
@@ -3,7 +3,9 @@ package user
 import (
 	"context"
 	"log/slog"
+	"time"
 
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 	"github.com/clin211/gin-enterprise-template/pkg/token"
 
 	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
@@ -13,14 +15,27 @@ import (
 
 // RefreshToken 用于刷新用户的身份验证令牌.
 // 当用户的令牌即将过期时，可以调用此方法生成新的访问令牌和刷新令牌.
+// 新令牌沿用原会话 ID，并同步延长会话的过期时间.
 // 返回 RefreshTokenResponse，包含 token, expireAt, refreshToken, refreshExpireAt.
 func (b *userBiz) RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error) {
-	accessToken, refreshToken, accessExpireAt, refreshExpireAt, err := token.Sign(contextx.UserID(ctx))
+	sessionID := contextx.SessionID(ctx)
+	accessToken, refreshToken, accessExpireAt, refreshExpireAt, err := token.Sign(contextx.UserID(ctx), token.WithSessionID(sessionID))
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to sign token", "error", err)
 		return nil, errno.ErrSignToken
 	}
 
+	sessionM, err := b.store.UserSession().Get(ctx, where.F("session_id", sessionID))
+	if err != nil {
+		return nil, errno.ErrSessionRevoked
+	}
+	sessionM.LastSeenAt = time.Now()
+	sessionM.ExpiresAt = refreshExpireAt
+	if err := b.store.UserSession().Update(ctx, sessionM); err != nil {
+		slog.ErrorContext(ctx, "Failed to extend user session", "sessionID", sessionID, "error", err)
+		return nil, errno.ErrDBWrite
+	}
+
 	return &v1.RefreshTokenResponse{
 		Token:           accessToken,
 		ExpireAt:        accessExpireAt.Unix(),
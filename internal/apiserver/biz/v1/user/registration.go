@@ -0,0 +1,108 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/pagination"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// GetRegistrationPolicy 实现 UserBiz 接口中的 GetRegistrationPolicy 方法.
+func (b *userBiz) GetRegistrationPolicy(ctx context.Context, rq *v1.GetRegistrationPolicyRequest) (*v1.GetRegistrationPolicyResponse, error) {
+	return &v1.GetRegistrationPolicyResponse{Mode: b.registrationMode()}, nil
+}
+
+// ListPendingRegistrations 实现 UserBiz 接口中的 ListPendingRegistrations 方法.
+func (b *userBiz) ListPendingRegistrations(ctx context.Context, rq *v1.ListPendingRegistrationsRequest) (*v1.ListPendingRegistrationsResponse, error) {
+	pageSize := pagination.NormalizePageSize(rq.GetPageSize())
+	opts := where.NewWhere(where.WithLimit(int64(pageSize))).F("status", known.UserStatusPending)
+	if pageToken := rq.GetPageToken(); pageToken != "" {
+		if decodedCursor, err := pagination.DecodeCursor(pageToken); err == nil {
+			if id, ok := decodedCursor.GetInt64("id"); ok {
+				opts.Cursor = &id
+			}
+		}
+	}
+
+	total, userList, err := b.store.User().List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*v1.User, 0, len(userList))
+	for _, userM := range userList {
+		users = append(users, conversion.UserModelToUserV1(userM))
+	}
+
+	// 只有当返回的数据量等于 pageSize 时，才说明可能有下一页
+	var nextPageToken string
+	if len(userList) == pageSize {
+		cursor, err := pagination.NewCursor("id", userList[len(userList)-1].ID)
+		if err == nil {
+			nextPageToken, _ = cursor.Encode()
+		}
+	}
+
+	return &v1.ListPendingRegistrationsResponse{TotalCount: total, Users: users, PageToken: nextPageToken}, nil
+}
+
+// ApproveRegistration 实现 UserBiz 接口中的 ApproveRegistration 方法.
+func (b *userBiz) ApproveRegistration(ctx context.Context, rq *v1.ApproveRegistrationRequest) (*v1.ApproveRegistrationResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID(), "status", known.UserStatusPending))
+	if err != nil {
+		return nil, errno.ErrRegistrationNotPending
+	}
+
+	userM.Status = known.UserStatusActive
+	changed := &event.UserStatusChanged{UserID: userM.UserID, Username: userM.Username, Status: userM.Status}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, changed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.grantRoles(ctx, userM.UserID); err != nil {
+		return nil, err
+	}
+	event.Publish(ctx, b.bus, changed)
+
+	slog.InfoContext(ctx, "Approved user registration", "operator", contextx.UserID(ctx), "userID", userM.UserID)
+
+	return &v1.ApproveRegistrationResponse{}, nil
+}
+
+// RejectRegistration 实现 UserBiz 接口中的 RejectRegistration 方法.
+// 被拒绝的待审核用户会被删除，以便其用户名、邮箱和手机号可以重新注册.
+func (b *userBiz) RejectRegistration(ctx context.Context, rq *v1.RejectRegistrationRequest) (*v1.RejectRegistrationResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID(), "status", known.UserStatusPending))
+	if err != nil {
+		return nil, errno.ErrRegistrationNotPending
+	}
+
+	deleted := &event.UserDeleted{UserID: userM.UserID, Username: userM.Username}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Delete(ctx, where.F("user_id", userM.UserID)); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, deleted)
+	})
+	if err != nil {
+		return nil, err
+	}
+	event.Publish(ctx, b.bus, deleted)
+
+	slog.InfoContext(ctx, "Rejected user registration", "operator", contextx.UserID(ctx), "userID", userM.UserID)
+
+	return &v1.RejectRegistrationResponse{}, nil
+}
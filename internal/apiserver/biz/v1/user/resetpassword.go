@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authn"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// ResetPassword 实现 UserBiz 接口中的 ResetPassword 方法.
+// 与 ChangePassword 不同，重置密码不需要校验旧密码，重置后该用户的全部会话都会被终止.
+func (b *userBiz) ResetPassword(ctx context.Context, rq *v1.ResetUserPasswordRequest) (*v1.ResetUserPasswordResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+
+	encryptedPassword, err := authn.Encrypt(rq.GetNewPassword())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encrypt password", "error", err)
+		return nil, fmt.Errorf("failed to encrypt password: %w", err)
+	}
+	userM.Password = encryptedPassword
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	if err := b.terminateSessions(ctx, userM.UserID); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Reset user password", "operator", contextx.UserID(ctx), "userID", userM.UserID)
+
+	return &v1.ResetUserPasswordResponse{}, nil
+}
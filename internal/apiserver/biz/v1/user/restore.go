@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// Restore 实现 UserBiz 接口中的 Restore 方法.
+// 用户名、邮箱和手机号在删除后可以被新用户复用，冲突时不能恢复. 恢复的活跃用户会重新同步 Casbin 角色.
+func (b *userBiz) Restore(ctx context.Context, rq *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error) {
+	userM, err := b.store.User().GetDeleted(ctx, where.F("user_id", rq.GetUserID()).L(1))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+
+	conflicts := []*where.Options{where.F("username", userM.Username).L(1)}
+	if userM.Email != nil && *userM.Email != "" {
+		conflicts = append(conflicts, where.F("email", *userM.Email).L(1))
+	}
+	if userM.Phone != nil && *userM.Phone != "" {
+		conflicts = append(conflicts, where.F("phone", *userM.Phone).L(1))
+	}
+	for _, whr := range conflicts {
+		if _, err := b.store.User().Get(ctx, whr); err == nil {
+			return nil, errno.ErrUserAlreadyExists
+		}
+	}
+
+	restored, err := b.store.User().Restore(ctx, where.F("user_id", userM.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, errno.ErrUserNotFound
+	}
+
+	if userM.Status == known.UserStatusActive {
+		if err := b.grantRoles(ctx, userM.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.InfoContext(ctx, "Restored user", "operator", contextx.UserID(ctx), "userID", userM.UserID)
+
+	return &v1.RestoreUserResponse{}, nil
+}
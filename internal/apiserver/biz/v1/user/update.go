@@ -2,57 +2,53 @@ package user
 
 import (
 	"context"
-	"log/slog"
+	"errors"
 
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 )
 
-// Update 实现 UserBiz 接口中的 Update 方法.
+// Update 实现 UserBiz 接口中的 Update 方法，只修改 updateMask 中的字段，请求携带 version 时校验版本号.
+// 用户名、邮箱或手机号已被其他用户占用时，store 返回违反唯一约束的 AlreadyExists 错误.
 func (b *userBiz) Update(ctx context.Context, rq *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error) {
 	userM, err := b.store.User().Get(ctx, where.T(ctx))
 	if err != nil {
 		return nil, err
 	}
-
-	// 检查用户名是否已被其他用户占用
-	if rq.Username != nil && rq.GetUsername() != userM.Username {
-		if existingUser, err := b.store.User().Get(ctx, where.F("username", rq.GetUsername()).L(1)); err == nil && existingUser != nil && existingUser.UserID != userM.UserID {
-			slog.WarnContext(ctx, "Username already exists", "username", rq.GetUsername())
-			return nil, errno.ErrUserAlreadyExists
-		}
-		userM.Username = rq.GetUsername()
+	if rq.Version != nil && rq.GetVersion() != userM.Version {
+		return nil, errno.ErrConflict
 	}
 
-	// 检查邮箱是否已被其他用户占用
-	if rq.Email != nil && rq.GetEmail() != "" && (userM.Email == nil || rq.GetEmail() != *userM.Email) {
-		if existingUser, err := b.store.User().Get(ctx, where.F("email", rq.GetEmail()).L(1)); err == nil && existingUser != nil && existingUser.UserID != userM.UserID {
-			slog.WarnContext(ctx, "Email already exists", "email", rq.GetEmail())
-			return nil, errno.ErrUserAlreadyExists
-		}
-		email := rq.GetEmail()
-		userM.Email = &email
+	mask, err := fieldmask.New(rq, rq.GetUpdateMask(), "userID", "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
 	}
-
-	// 检查手机号是否已被其他用户占用
-	if rq.Phone != nil && rq.GetPhone() != "" && (userM.Phone == nil || rq.GetPhone() != *userM.Phone) {
-		if existingUser, err := b.store.User().Get(ctx, where.F("phone", rq.GetPhone()).L(1)); err == nil && existingUser != nil && existingUser.UserID != userM.UserID {
-			slog.WarnContext(ctx, "Phone already exists", "phone", rq.GetPhone())
-			return nil, errno.ErrUserAlreadyExists
-		}
-		phone := rq.GetPhone()
-		userM.Phone = &phone
+	fields, err := mask.Apply(userM, rq)
+	if err != nil {
+		return nil, err
 	}
-
-	if rq.Nickname != nil {
-		userM.Nickname = rq.GetNickname()
+	if len(fields) == 0 {
+		return &v1.UpdateUserResponse{Version: userM.Version}, nil
 	}
-
-	if err := b.store.User().Update(ctx, userM); err != nil {
+	updated := &event.UserUpdated{UserID: userM.UserID, Username: userM.Username, Fields: mask.Paths()}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().UpdateFields(ctx, userM, fields...); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, updated)
+	})
+	if err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
 		return nil, err
 	}
+	event.Publish(ctx, b.bus, updated)
 
-	return &v1.UpdateUserResponse{}, nil
+	return &v1.UpdateUserResponse{Version: userM.Version}, nil
 }
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// UpdateStatus 实现 UserBiz 接口中的 UpdateStatus 方法.
+func (b *userBiz) UpdateStatus(ctx context.Context, rq *v1.UpdateUserStatusRequest) (*v1.UpdateUserStatusResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("user_id", rq.GetUserID()))
+	if err != nil {
+		return nil, errno.ErrUserNotFound
+	}
+
+	if rq.Version != nil && rq.GetVersion() != userM.Version {
+		return nil, errno.ErrConflict
+	}
+
+	userM.Status = int16(rq.GetStatus())
+	changed := &event.UserStatusChanged{UserID: userM.UserID, Username: userM.Username, Status: userM.Status}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().UpdateFields(ctx, userM, "Status"); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, changed)
+	})
+	if err != nil {
+		if errors.Is(err, genericstore.ErrConflict) {
+			return nil, errno.ErrConflict
+		}
+		return nil, err
+	}
+	event.Publish(ctx, b.bus, changed)
+
+	// 禁用用户后立即终止其全部会话，强制下线
+	if userM.Status == known.UserStatusDisabled {
+		if err := b.terminateSessions(ctx, userM.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.InfoContext(ctx, "Updated user status", "operator", contextx.UserID(ctx), "userID", userM.UserID, "status", userM.Status)
+
+	return &v1.UpdateUserStatusResponse{Version: userM.Version}, nil
+}
+
+// terminateSessions 终止用户的全部会话.
+func (b *userBiz) terminateSessions(ctx context.Context, userID string) error {
+	revoked, err := b.store.UserSession().Revoke(ctx, where.F("user_id", userID))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to terminate user sessions", "userID", userID, "error", err)
+		return errno.ErrDBWrite
+	}
+
+	slog.InfoContext(ctx, "Terminated user sessions", "userID", userID, "revoked", revoked)
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
 )
 
 // UserBiz 定义处理用户请求所需的方法.
@@ -25,17 +27,48 @@ type UserExpansion interface {
 	// RefreshToken 返回刷新令牌响应，包含新的访问令牌和刷新令牌及各自的过期时间
 	RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error)
 	ChangePassword(ctx context.Context, rq *v1.ChangePasswordRequest) (*v1.ChangePasswordResponse, error)
+
+	// AdminGet 获取任意用户的详细信息，权限由 RBAC 控制
+	AdminGet(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserResponse, error)
+	// AdminList 获取全部用户列表，权限由 RBAC 控制
+	AdminList(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUserResponse, error)
+	// AdminUpdate 更新任意用户的全部资料字段，权限由 RBAC 控制
+	AdminUpdate(ctx context.Context, rq *v1.AdminUpdateUserRequest) (*v1.AdminUpdateUserResponse, error)
+	// UpdateStatus 启用或禁用用户，禁用时终止该用户的全部会话
+	UpdateStatus(ctx context.Context, rq *v1.UpdateUserStatusRequest) (*v1.UpdateUserStatusResponse, error)
+	// ResetPassword 重置用户密码并终止该用户的全部会话
+	ResetPassword(ctx context.Context, rq *v1.ResetUserPasswordRequest) (*v1.ResetUserPasswordResponse, error)
+	// AdminCreate 由管理员创建用户，不受注册模式限制
+	AdminCreate(ctx context.Context, rq *v1.CreateUserRequest) (*v1.CreateUserResponse, error)
+	// Impersonate 以目标用户身份签发携带实际操作者的短期访问令牌，要求调用者被显式授予模拟登录权限
+	Impersonate(ctx context.Context, rq *v1.ImpersonateUserRequest) (*v1.ImpersonateUserResponse, error)
+	// ListDeleted 获取回收站中的用户列表
+	ListDeleted(ctx context.Context, rq *v1.ListDeletedUsersRequest) (*v1.ListDeletedUsersResponse, error)
+	// Restore 从回收站恢复用户，并重新同步 Casbin 角色
+	Restore(ctx context.Context, rq *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error)
+
+	// GetRegistrationPolicy 获取当前的注册模式
+	GetRegistrationPolicy(ctx context.Context, rq *v1.GetRegistrationPolicyRequest) (*v1.GetRegistrationPolicyResponse, error)
+	// ListPendingRegistrations 获取待审核的注册用户列表
+	ListPendingRegistrations(ctx context.Context, rq *v1.ListPendingRegistrationsRequest) (*v1.ListPendingRegistrationsResponse, error)
+	// ApproveRegistration 审核通过注册申请
+	ApproveRegistration(ctx context.Context, rq *v1.ApproveRegistrationRequest) (*v1.ApproveRegistrationResponse, error)
+	// RejectRegistration 拒绝注册申请
+	RejectRegistration(ctx context.Context, rq *v1.RejectRegistrationRequest) (*v1.RejectRegistrationResponse, error)
 }
 
 // userBiz 是 UserBiz 接口的实现.
 type userBiz struct {
-	store store.IStore
-	authz *authz.Authz
+	store        store.IStore
+	authz        *authz.Authz
+	registration *genericoptions.RegistrationOptions
+	authn        Authenticator
+	bus          *eventbus.Bus
 }
 
 // 确保 userBiz 实现了 UserBiz 接口.
 var _ UserBiz = (*userBiz)(nil)
 
-func New(store store.IStore, authz *authz.Authz) *userBiz {
-	return &userBiz{store: store, authz: authz}
+func New(store store.IStore, authz *authz.Authz, registration *genericoptions.RegistrationOptions, authn Authenticator, bus *eventbus.Bus) *userBiz {
+	return &userBiz{store: store, authz: authz, registration: registration, authn: authn, bus: bus}
 }
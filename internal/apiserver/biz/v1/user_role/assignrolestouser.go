@@ -2,14 +2,26 @@ package user_role
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 
+	accessrequest "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/access_request"
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"log/slog"
 )
 
 // AssignRolesToUser 为用户分配角色（覆盖模式）.
+// roleIDs 中的角色永久有效，assignments 中的角色按有效期生效：尚未生效的角色由后台任务在生效时授予，
+// 过期的角色由后台任务自动回收. 用户尚未持有的敏感角色以及放宽已持有敏感角色有效期的分配不会立即生效，
+// 而是创建待审批的授权申请.
+// 分配后的角色组合违反职责分离规则时返回 errno.ErrSoDConflict.
+// 事务提交后发布 event.UserRolesAssigned，由事件订阅者将用户当前生效的角色同步到 Casbin.
 func (b *userRoleBiz) AssignRolesToUser(ctx context.Context, rq *v1.AssignRolesToUserRequest) (*v1.AssignRolesToUserResponse, error) {
 	userID := rq.GetUserID()
 
@@ -18,45 +30,121 @@ func (b *userRoleBiz) AssignRolesToUser(ctx context.Context, rq *v1.AssignRolesT
 		return nil, errno.ErrUserNotFound
 	}
 
-	// 验证所有角色是否存在
+	userRoles := make([]*model.UserRoleM, 0, len(rq.GetRoleIDs())+len(rq.GetAssignments()))
 	for _, roleID := range rq.GetRoleIDs() {
-		if _, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1)); err != nil {
-			slog.WarnContext(ctx, "Role not found", "roleID", roleID)
+		userRoles = append(userRoles, &model.UserRoleM{RoleID: roleID})
+	}
+	for _, assignment := range rq.GetAssignments() {
+		userRoles = append(userRoles, &model.UserRoleM{
+			RoleID:    assignment.GetRoleID(),
+			StartsAt:  conversion.UnixToTime(assignment.StartsAt),
+			ExpiresAt: conversion.UnixToTime(assignment.ExpiresAt),
+		})
+	}
+
+	// 验证所有角色是否存在
+	roles := make(map[string]*model.RoleM, len(userRoles))
+	for _, userRole := range userRoles {
+		roleM, err := b.store.Role().Get(ctx, where.F("role_id", userRole.RoleID).L(1))
+		if err != nil {
+			slog.WarnContext(ctx, "Role not found", "roleID", userRole.RoleID)
 			return nil, errno.ErrRoleNotFound
 		}
+		roles[userRole.RoleID] = roleM
 	}
 
-	// 获取用户当前角色列表
-	oldRoles, _ := b.store.UserRole().GetUserRoles(ctx, userID)
-	oldRoleCodes := make([]string, 0, len(oldRoles))
-	for _, r := range oldRoles {
-		oldRoleCodes = append(oldRoleCodes, "role::"+r.RoleCode)
+	// 检查职责分离规则，待审批的敏感角色同样计入. 创建授权申请前先检查一次，写入时在事务中再次检查
+	roleIDs := make([]string, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		roleIDs = append(roleIDs, userRole.RoleID)
+	}
+	if err := sodrule.CheckRoles(ctx, b.store, roleIDs); err != nil {
+		return nil, err
 	}
 
-	// 分配新角色
-	if err := b.store.UserRole().AssignRoles(ctx, userID, rq.GetRoleIDs()); err != nil {
+	// 新授予或放宽有效期的敏感角色不直接生效，改为创建待审批的授权申请
+	userRoles, accessRequests, err := b.requestSensitiveRoles(ctx, userID, userRoles, roles)
+	if err != nil {
 		return nil, err
 	}
 
-	// 同步到 Casbin：删除旧的用户-角色关系
-	for _, oldRole := range oldRoleCodes {
-		if _, err := b.authz.RemoveGroupingPolicy(userID, oldRole); err != nil {
-			slog.WarnContext(ctx, "Failed to remove old grouping policy", "userID", userID, "role", oldRole, "error", err)
+	// 分配新角色
+	assignedRoleIDs := make([]string, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		assignedRoleIDs = append(assignedRoleIDs, userRole.RoleID)
+	}
+	assigned := &event.UserRolesAssigned{UserID: userID, RoleIDs: assignedRoleIDs}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 锁定用户，避免与其他授予该用户角色的请求并发写入而绕过职责分离检查
+		if err := b.store.UserRole().LockUser(ctx, userID); err != nil {
+			return err
+		}
+		if err := sodrule.CheckRoles(ctx, b.store, roleIDs); err != nil {
+			return err
+		}
+		if err := b.store.UserRole().ReplaceRoles(ctx, userID, userRoles); err != nil {
+			return err
 		}
+		return event.Record(ctx, b.store, assigned)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 同步到 Casbin：添加新的用户-角色关系
-	for _, roleID := range rq.GetRoleIDs() {
-		roleM, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1))
-		if err != nil {
+	event.Publish(ctx, b.bus, assigned)
+
+	return &v1.AssignRolesToUserResponse{AccessRequests: accessRequests}, nil
+}
+
+// requestSensitiveRoles 从 userRoles 中剔除需要审批的敏感角色分配（见 accessrequest.RequiresApproval），
+// 并为其创建待审批的授权申请；用户已持有的分配在审批前保持不变. 已有待审批申请的角色不会重复创建，直接返回已有的申请.
+func (b *userRoleBiz) requestSensitiveRoles(
+	ctx context.Context,
+	userID string,
+	userRoles []*model.UserRoleM,
+	roles map[string]*model.RoleM,
+) ([]*model.UserRoleM, []*v1.AccessRequest, error) {
+	held, err := b.store.UserRole().List(ctx, where.F("user_id", userID))
+	if err != nil {
+		return nil, nil, err
+	}
+	heldRoles := make(map[string]*model.UserRoleM, len(held))
+	for _, userRole := range held {
+		heldRoles[userRole.RoleID] = userRole
+	}
+
+	granted := make([]*model.UserRoleM, 0, len(userRoles))
+	var accessRequests []*v1.AccessRequest
+	for _, userRole := range userRoles {
+		roleM, heldRole := roles[userRole.RoleID], heldRoles[userRole.RoleID]
+		if !accessrequest.RequiresApproval(roleM, heldRole, userRole) {
+			granted = append(granted, userRole)
 			continue
 		}
-		casbinRole := "role::" + roleM.RoleCode
-		if _, err := b.authz.AddGroupingPolicy(userID, casbinRole); err != nil {
-			slog.ErrorContext(ctx, "Failed to add grouping policy", "userID", userID, "role", casbinRole, "error", err)
-			return nil, errno.ErrAddRole.WithMessage(err.Error())
+		if heldRole != nil {
+			granted = append(granted, &model.UserRoleM{RoleID: heldRole.RoleID, StartsAt: heldRole.StartsAt, ExpiresAt: heldRole.ExpiresAt})
+		}
+
+		accessRequestM := &model.AccessRequestM{
+			UserID:         userID,
+			RoleID:         userRole.RoleID,
+			GrantStartsAt:  userRole.StartsAt,
+			GrantExpiresAt: userRole.ExpiresAt,
+		}
+		err := accessrequest.Submit(ctx, b.store, accessRequestM)
+		if errors.Is(err, errno.ErrAccessRequestAlreadyPending) {
+			accessRequestM, err = b.store.AccessRequest().Get(ctx, where.F(
+				"user_id", userID,
+				"role_id", userRole.RoleID,
+				"status", known.AccessRequestPending,
+			).L(1))
+		}
+		if err != nil {
+			return nil, nil, err
 		}
+		slog.InfoContext(ctx, "Sensitive role requires approval", "userID", userID, "role", roleM.RoleCode, "requestID", accessRequestM.RequestID)
+		accessRequests = append(accessRequests, conversion.AccessRequestModelToAccessRequestV1(accessRequestM, roleM))
 	}
 
-	return &v1.AssignRolesToUserResponse{}, nil
+	return granted, accessRequests, nil
 }
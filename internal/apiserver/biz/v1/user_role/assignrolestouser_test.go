@@ -0,0 +1,66 @@
+package user_role
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/ptr"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestAssignRolesToUser_SensitiveRoles(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+	b := New(s, nil)
+
+	userM := storetest.CreateUser(t, s, "assign-sensitive")
+	viewer := storetest.CreateRole(t, s, "assign-viewer", false)
+	auditor := storetest.CreateRole(t, s, "assign-auditor", true)
+
+	// 用户持有审批通过的 8 小时限时授权
+	expiresAt := time.Now().Add(8 * time.Hour).Truncate(time.Second)
+	require.NoError(t, s.UserRole().Create(ctx, &model.UserRoleM{UserID: userM.UserID, RoleID: auditor.RoleID, ExpiresAt: &expiresAt}))
+
+	// 将限时授权改为永久授权需要审批，审批前保留原有授权
+	resp, err := b.AssignRolesToUser(ctx, &v1.AssignRolesToUserRequest{
+		UserID:  userM.UserID,
+		RoleIDs: []string{viewer.RoleID, auditor.RoleID},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetAccessRequests(), 1)
+	assert.Equal(t, auditor.RoleID, resp.GetAccessRequests()[0].GetRoleID())
+	held := userRole(t, s, userM.UserID, auditor.RoleID)
+	require.NotNil(t, held.ExpiresAt)
+	assert.True(t, held.ExpiresAt.Equal(expiresAt))
+	assert.NotNil(t, userRole(t, s, userM.UserID, viewer.RoleID))
+
+	// 缩短有效期不需要审批
+	resp, err = b.AssignRolesToUser(ctx, &v1.AssignRolesToUserRequest{
+		UserID:      userM.UserID,
+		Assignments: []*v1.RoleAssignment{{RoleID: auditor.RoleID, ExpiresAt: ptr.To(expiresAt.Add(-time.Hour).Unix())}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetAccessRequests())
+	held = userRole(t, s, userM.UserID, auditor.RoleID)
+	require.NotNil(t, held.ExpiresAt)
+	assert.True(t, held.ExpiresAt.Equal(expiresAt.Add(-time.Hour)))
+}
+
+// userRole 返回用户持有的角色分配，不存在时返回 nil.
+func userRole(t *testing.T, s store.IStore, userID, roleID string) *model.UserRoleM {
+	t.Helper()
+	userRoles, err := s.UserRole().List(context.Background(), where.F("user_id", userID, "role_id", roleID))
+	require.NoError(t, err)
+	if len(userRoles) == 0 {
+		return nil
+	}
+	return userRoles[0]
+}
@@ -2,13 +2,16 @@ package user_role
 
 import (
 	"context"
+	"time"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 	"log/slog"
 )
 
 // GetUserRoles 获取用户的角色和权限.
+// roles 和 permissionCodes 只包含当前生效的部分，assignments 包含全部角色分配及剩余有效期.
 func (b *userRoleBiz) GetUserRoles(ctx context.Context, rq *v1.GetUserRolesRequest) (*v1.GetUserRolesResponse, error) {
 	userID := rq.GetUserID()
 
@@ -25,8 +28,26 @@ func (b *userRoleBiz) GetUserRoles(ctx context.Context, rq *v1.GetUserRolesReque
 		permissionCodes = []string{}
 	}
 
+	// 获取全部角色分配，包括尚未生效的定时分配
+	userRoles, err := b.store.UserRole().List(ctx, where.F("user_id", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	assignments := make([]*v1.UserRoleAssignment, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		roleM, err := b.store.Role().Get(ctx, where.F("role_id", userRole.RoleID).L(1))
+		if err != nil {
+			slog.WarnContext(ctx, "Role not found", "roleID", userRole.RoleID)
+			continue
+		}
+		assignments = append(assignments, conversion.UserRoleModelToUserRoleAssignmentV1(userRole, roleM, now))
+	}
+
 	return &v1.GetUserRolesResponse{
-		Roles:          conversion.RoleModelListToRoleV1List(roles),
+		Roles:           conversion.RoleModelListToRoleV1List(roles),
 		PermissionCodes: permissionCodes,
+		Assignments:     assignments,
 	}, nil
 }
@@ -3,33 +3,35 @@ package user_role
 import (
 	"context"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
-	"log/slog"
 )
 
-// RemoveRoleFromUser 从用户移除角色.
+// RemoveRoleFromUser 从用户移除角色，事件订阅者会将用户当前生效的角色同步到 Casbin.
 func (b *userRoleBiz) RemoveRoleFromUser(ctx context.Context, rq *v1.RemoveRoleFromUserRequest) (*v1.RemoveRoleFromUserResponse, error) {
 	userID := rq.GetUserID()
 	roleID := rq.GetRoleID()
 
-	// 获取角色信息
-	roleM, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1))
-	if err != nil {
+	// 验证角色是否存在
+	if _, err := b.store.Role().Get(ctx, where.F("role_id", roleID).L(1)); err != nil {
 		return nil, errno.ErrRoleNotFound
 	}
 
 	// 从数据库中移除用户-角色关系
-	if err := b.store.UserRole().RemoveRole(ctx, userID, roleID); err != nil {
+	removed := &event.UserRoleRemoved{UserID: userID, RoleIDs: []string{roleID}}
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.UserRole().RemoveRole(ctx, userID, roleID); err != nil {
+			return err
+		}
+		return event.Record(ctx, b.store, removed)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// 从 Casbin 中删除用户-角色关系
-	casbinRole := "role::" + roleM.RoleCode
-	if _, err := b.authz.RemoveGroupingPolicy(userID, casbinRole); err != nil {
-		slog.WarnContext(ctx, "Failed to remove grouping policy from Casbin", "userID", userID, "role", casbinRole, "error", err)
-	}
+	event.Publish(ctx, b.bus, removed)
 
 	return &v1.RemoveRoleFromUserResponse{}, nil
 }
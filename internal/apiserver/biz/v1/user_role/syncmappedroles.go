@@ -0,0 +1,121 @@
+package user_role
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	accessrequest "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/access_request"
+	sodrule "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/sod_rule"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// SyncMappedRoles 按用户在外部身份源（OIDC IdP、LDAP 等）中所在的组同步 user_role，
+// 事务提交后发布角色变更事件，由事件订阅者同步 Casbin 角色.
+// mapping 为外部组到角色编码（RoleM.RoleCode）的映射，只有出现在 mapping 中的角色由外部身份源管理：
+// 用户所在组映射的角色会被授予，不再满足映射的角色会被移除，其他手动分配的角色保持不变.
+// 敏感角色只能通过授权申请审批后授予，外部身份源不会为用户新增敏感角色；
+// 新增后违反职责分离规则的角色同样不会授予.
+func SyncMappedRoles(ctx context.Context, store store.IStore, bus *eventbus.Bus, userID string, mapping map[string]string, groups []string) error {
+	if len(mapping) == 0 {
+		return nil
+	}
+
+	var managed, desired []string
+	for group, roleCode := range mapping {
+		if !slices.Contains(managed, roleCode) {
+			managed = append(managed, roleCode)
+		}
+		if slices.Contains(groups, group) && !slices.Contains(desired, roleCode) {
+			desired = append(desired, roleCode)
+		}
+	}
+
+	// 使用全部角色分配（包括定时分配）判断角色是否已分配，只增删受外部身份源管理的角色，
+	// 避免覆盖手动设置的角色有效期
+	userRoles, err := store.UserRole().List(ctx, where.F("user_id", userID))
+	if err != nil {
+		return err
+	}
+	assigned := make(map[string]bool, len(userRoles))
+	for _, userRole := range userRoles {
+		assigned[userRole.RoleID] = true
+	}
+
+	var (
+		added, removed []string
+		events         []event.Event
+	)
+	err = store.TX(ctx, func(ctx context.Context) error {
+		var grants []*model.RoleM
+		var addedIDs, removedIDs []string
+		for _, roleCode := range managed {
+			roleM, err := store.Role().GetByRoleCode(ctx, roleCode)
+			if err != nil {
+				if slices.Contains(desired, roleCode) {
+					slog.WarnContext(ctx, "Mapped role does not exist", "roleCode", roleCode)
+				}
+				continue
+			}
+
+			switch want := slices.Contains(desired, roleCode); {
+			case want && !assigned[roleM.RoleID]:
+				if accessrequest.IsSensitive(roleM) {
+					slog.WarnContext(ctx, "Mapped sensitive role requires approval", "userID", userID, "roleCode", roleCode)
+					continue
+				}
+				grants = append(grants, roleM)
+			case !want && assigned[roleM.RoleID]:
+				if err := store.UserRole().RemoveRole(ctx, userID, roleM.RoleID); err != nil {
+					return err
+				}
+				removed = append(removed, roleCode)
+				removedIDs = append(removedIDs, roleM.RoleID)
+			}
+		}
+
+		// 先移除不再满足映射的角色，再逐个授予新角色并检查职责分离规则
+		for _, roleM := range grants {
+			err := sodrule.CheckGrant(ctx, store, userID, roleM.RoleID)
+			if errors.Is(err, errno.ErrSoDConflict) {
+				slog.WarnContext(ctx, "Mapped role violates separation of duties", "userID", userID, "roleCode", roleM.RoleCode, "error", err)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := store.UserRole().Create(ctx, &model.UserRoleM{UserID: userID, RoleID: roleM.RoleID}); err != nil {
+				return err
+			}
+			added = append(added, roleM.RoleCode)
+			addedIDs = append(addedIDs, roleM.RoleID)
+		}
+
+		if len(addedIDs) > 0 {
+			events = append(events, &event.UserRolesAssigned{UserID: userID, RoleIDs: addedIDs})
+		}
+		if len(removedIDs) > 0 {
+			events = append(events, &event.UserRoleRemoved{UserID: userID, RoleIDs: removedIDs})
+		}
+		return event.Record(ctx, store, events...)
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	event.Publish(ctx, bus, events...)
+
+	slog.InfoContext(ctx, "Synchronized mapped roles", "userID", userID, "added", added, "removed", removed)
+
+	return nil
+}
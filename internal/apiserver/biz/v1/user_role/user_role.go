@@ -5,7 +5,7 @@ import (
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
-	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
 )
 
 // UserRoleBiz 定义处理用户角色请求所需的方法.
@@ -21,12 +21,12 @@ type UserRoleBiz interface {
 // userRoleBiz 是 UserRoleBiz 接口的实现.
 type userRoleBiz struct {
 	store store.IStore
-	authz *authz.Authz
+	bus   *eventbus.Bus
 }
 
 // 确保 userRoleBiz 实现了 UserRoleBiz 接口.
 var _ UserRoleBiz = (*userRoleBiz)(nil)
 
-func New(store store.IStore, authz *authz.Authz) *userRoleBiz {
-	return &userRoleBiz{store: store, authz: authz}
+func New(store store.IStore, bus *eventbus.Bus) *userRoleBiz {
+	return &userRoleBiz{store: store, bus: bus}
 }
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 敏感角色授权申请路由
+		rg := v1.Group("/access-requests")
+		rg.Use(handler.mws...)
+		rg.POST("", handler.CreateAccessRequest)                    // 创建授权申请
+		rg.GET("", handler.ListAccessRequests)                      // 查询授权申请列表
+		rg.GET(":requestID", handler.GetAccessRequest)              // 查询授权申请详情
+		rg.POST(":requestID/approve", handler.ApproveAccessRequest) // 批准授权申请
+		rg.POST(":requestID/reject", handler.RejectAccessRequest)   // 拒绝授权申请
+	})
+}
+
+// CreateAccessRequest 创建授权申请.
+func (h *Handler) CreateAccessRequest(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AccessRequestV1().Create, h.val.ValidateCreateAccessRequestRequest)
+}
+
+// ListAccessRequests 获取授权申请列表.
+func (h *Handler) ListAccessRequests(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AccessRequestV1().List, h.val.ValidateListAccessRequestsRequest)
+}
+
+// GetAccessRequest 获取授权申请详情.
+func (h *Handler) GetAccessRequest(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.AccessRequestV1().Get, h.val.ValidateGetAccessRequestRequest)
+}
+
+// ApproveAccessRequest 批准授权申请.
+func (h *Handler) ApproveAccessRequest(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.AccessRequestV1().Approve, h.val.ValidateApproveAccessRequestRequest)
+}
+
+// RejectAccessRequest 拒绝授权申请.
+func (h *Handler) RejectAccessRequest(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.AccessRequestV1().Reject, h.val.ValidateRejectAccessRequestRequest)
+}
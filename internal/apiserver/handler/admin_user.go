@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 管理员用户管理路由，权限由 Casbin 控制，不要求请求用户与目标用户一致
+		// 强制下线使用会话管理中的 DELETE /admin/users/:userID/sessions
+		rg := v1.Group("/admin/users")
+		rg.Use(handler.mws...)
+		rg.POST("", handler.AdminCreateUser)                    // 创建用户，不受注册模式限制
+		rg.GET("", handler.AdminListUsers)                      // 查询全部用户列表
+		rg.GET(":userID", handler.AdminGetUser)                 // 查询任意用户详情
+		rg.PUT(":userID", handler.AdminUpdateUser)              // 更新任意用户资料
+		rg.PUT(":userID/status", handler.UpdateUserStatus)      // 启用/禁用用户
+		rg.PUT(":userID/password", handler.ResetUserPassword)   // 重置用户密码
+		rg.POST(":userID/impersonate", handler.ImpersonateUser) // 模拟登录用户，需显式授予专用权限
+		rg.GET("/trash", handler.ListDeletedUsers)              // 查询回收站中的用户列表
+		rg.POST(":userID/restore", handler.RestoreUser)         // 从回收站恢复用户
+	})
+}
+
+// AdminCreateUser 管理员创建用户.
+func (h *Handler) AdminCreateUser(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.UserV1().AdminCreate, h.val.ValidateCreateUserRequest)
+}
+
+// AdminListUsers 管理员获取用户列表.
+func (h *Handler) AdminListUsers(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().AdminList, h.val.ValidateAdminListUserRequest)
+}
+
+// AdminGetUser 管理员获取用户详情.
+func (h *Handler) AdminGetUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().AdminGet, h.val.ValidateAdminGetUserRequest)
+}
+
+// AdminUpdateUser 管理员更新用户资料.
+func (h *Handler) AdminUpdateUser(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.UserV1().AdminUpdate, h.val.ValidateAdminUpdateUserRequest)
+}
+
+// UpdateUserStatus 管理员启用/禁用用户.
+func (h *Handler) UpdateUserStatus(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.UserV1().UpdateStatus, h.val.ValidateUpdateUserStatusRequest)
+}
+
+// ResetUserPassword 管理员重置用户密码.
+func (h *Handler) ResetUserPassword(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.UserV1().ResetPassword, h.val.ValidateResetUserPasswordRequest)
+}
+
+// ImpersonateUser 管理员模拟登录用户.
+func (h *Handler) ImpersonateUser(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.UserV1().Impersonate, h.val.ValidateImpersonateUserRequest)
+}
+
+// ListDeletedUsers 管理员获取回收站中的用户列表.
+func (h *Handler) ListDeletedUsers(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().ListDeleted, h.val.ValidateListDeletedUsersRequest)
+}
+
+// RestoreUser 管理员从回收站恢复用户.
+func (h *Handler) RestoreUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().Restore, h.val.ValidateRestoreUserRequest)
+}
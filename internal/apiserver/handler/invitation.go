@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 注册策略查询无需认证，便于前端决定是否展示注册入口
+		v1.GET("/registration/policy", handler.GetRegistrationPolicy)
+
+		// 邀请码管理路由
+		rg := v1.Group("/invitations")
+		rg.Use(handler.mws...)
+		rg.POST("", handler.CreateInvitation)        // 创建邀请码
+		rg.GET("", handler.ListInvitation)           // 查询邀请码列表
+		rg.DELETE(":code", handler.RevokeInvitation) // 作废邀请码
+
+		// 注册审核路由
+		admin := v1.Group("/admin/registrations")
+		admin.Use(handler.mws...)
+		admin.GET("", handler.ListPendingRegistrations)            // 查询待审核的注册申请
+		admin.POST(":userID/approve", handler.ApproveRegistration) // 审核通过
+		admin.POST(":userID/reject", handler.RejectRegistration)   // 拒绝注册
+	})
+}
+
+// GetRegistrationPolicy 获取当前的注册模式.
+func (h *Handler) GetRegistrationPolicy(c *gin.Context) {
+	core.HandleNoBodyRequest(c, h.biz.UserV1().GetRegistrationPolicy, h.val.ValidateGetRegistrationPolicyRequest)
+}
+
+// CreateInvitation 创建邀请码.
+func (h *Handler) CreateInvitation(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.InvitationV1().Create, h.val.ValidateCreateInvitationRequest)
+}
+
+// ListInvitation 获取邀请码列表.
+func (h *Handler) ListInvitation(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.InvitationV1().List, h.val.ValidateListInvitationRequest)
+}
+
+// RevokeInvitation 作废邀请码.
+func (h *Handler) RevokeInvitation(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.InvitationV1().Revoke, h.val.ValidateRevokeInvitationRequest)
+}
+
+// ListPendingRegistrations 获取待审核的注册申请列表.
+func (h *Handler) ListPendingRegistrations(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().ListPendingRegistrations, h.val.ValidateListPendingRegistrationsRequest)
+}
+
+// ApproveRegistration 审核通过注册申请.
+func (h *Handler) ApproveRegistration(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().ApproveRegistration, h.val.ValidateApproveRegistrationRequest)
+}
+
+// RejectRegistration 拒绝注册申请.
+func (h *Handler) RejectRegistration(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().RejectRegistration, h.val.ValidateRejectRegistrationRequest)
+}
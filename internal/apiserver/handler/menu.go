@@ -10,12 +10,14 @@ func init() {
 		// 菜单相关路由
 		rg := v1.Group("/menus")
 		rg.Use(handler.mws...)
-		rg.POST("", handler.CreateMenu)               // 创建菜单
-		rg.PUT(":menuID", handler.UpdateMenu)         // 更新菜单
-		rg.DELETE(":menuID", handler.DeleteMenu)      // 删除菜单
-		rg.GET(":menuID", handler.GetMenu)            // 查询菜单详情
-		rg.GET("", handler.ListMenu)                  // 查询菜单列表
-		rg.GET("/tree", handler.ListMenuTree)         // 获取菜单树
+		rg.POST("", handler.CreateMenu)                 // 创建菜单
+		rg.PUT(":menuID", handler.UpdateMenu)           // 更新菜单
+		rg.DELETE(":menuID", handler.DeleteMenu)        // 删除菜单
+		rg.GET(":menuID", handler.GetMenu)              // 查询菜单详情
+		rg.GET("", handler.ListMenu)                    // 查询菜单列表
+		rg.GET("/tree", handler.ListMenuTree)           // 获取菜单树
+		rg.GET("/trash", handler.ListDeletedMenus)      // 查询回收站中的菜单列表
+		rg.POST(":menuID/restore", handler.RestoreMenu) // 从回收站恢复菜单
 	})
 }
 
@@ -48,3 +50,13 @@ func (h *Handler) ListMenu(c *gin.Context) {
 func (h *Handler) ListMenuTree(c *gin.Context) {
 	core.HandleQueryRequest(c, h.biz.MenuV1().ListMenuTree, h.val.ValidateListMenuTreeRequest)
 }
+
+// ListDeletedMenus 列出回收站中的菜单.
+func (h *Handler) ListDeletedMenus(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.MenuV1().ListDeleted, h.val.ValidateListDeletedMenusRequest)
+}
+
+// RestoreMenu 从回收站恢复菜单.
+func (h *Handler) RestoreMenu(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.MenuV1().Restore, h.val.ValidateRestoreMenuRequest)
+}
@@ -10,12 +10,15 @@ func init() {
 		// 权限相关路由
 		rg := v1.Group("/permissions")
 		rg.Use(handler.mws...)
-		rg.POST("", handler.CreatePermission)           // 创建权限
-		rg.PUT(":permissionID", handler.UpdatePermission) // 更新权限
-		rg.DELETE(":permissionID", handler.DeletePermission) // 删除权限
-		rg.GET(":permissionID", handler.GetPermission)   // 查询权限详情
-		rg.GET("", handler.ListPermission)              // 查询权限列表
-		rg.GET("/tree", handler.ListPermissionTree)     // 获取权限树
+		rg.POST("", handler.CreatePermission)                       // 创建权限
+		rg.PUT(":permissionID", handler.UpdatePermission)           // 更新权限
+		rg.DELETE(":permissionID", handler.DeletePermission)        // 删除权限
+		rg.GET(":permissionID", handler.GetPermission)              // 查询权限详情
+		rg.GET("", handler.ListPermission)                          // 查询权限列表
+		rg.GET("/tree", handler.ListPermissionTree)                 // 获取权限树
+		rg.GET("/api-catalog", handler.GetAPICatalog)               // 获取 API 路由目录
+		rg.GET("/trash", handler.ListDeletedPermissions)            // 查询回收站中的权限列表
+		rg.POST(":permissionID/restore", handler.RestorePermission) // 从回收站恢复权限
 	})
 }
 
@@ -48,3 +51,18 @@ func (h *Handler) ListPermission(c *gin.Context) {
 func (h *Handler) ListPermissionTree(c *gin.Context) {
 	core.HandleQueryRequest(c, h.biz.PermissionV1().ListPermissionTree, h.val.ValidateListPermissionTreeRequest)
 }
+
+// GetAPICatalog 获取自动发现的 API 路由目录.
+func (h *Handler) GetAPICatalog(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PermissionV1().GetAPICatalog, h.val.ValidateGetAPICatalogRequest)
+}
+
+// ListDeletedPermissions 列出回收站中的权限.
+func (h *Handler) ListDeletedPermissions(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PermissionV1().ListDeleted, h.val.ValidateListDeletedPermissionsRequest)
+}
+
+// RestorePermission 从回收站恢复权限.
+func (h *Handler) RestorePermission(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PermissionV1().Restore, h.val.ValidateRestorePermissionRequest)
+}
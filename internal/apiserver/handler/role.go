@@ -10,13 +10,15 @@ func init() {
 		// 角色相关路由
 		rg := v1.Group("/roles")
 		rg.Use(handler.mws...)
-		rg.POST("", handler.CreateRole)                                  // 创建角色
-		rg.PUT(":roleID", handler.UpdateRole)                            // 更新角色
-		rg.DELETE(":roleID", handler.DeleteRole)                         // 删除角色
-		rg.GET(":roleID", handler.GetRole)                               // 查询角色详情
-		rg.GET("", handler.ListRole)                                     // 查询角色列表
-		rg.POST(":roleID/permissions", handler.AssignPermissionsToRole)  // 为角色分配权限
-		rg.GET(":roleID/permissions", handler.GetRolePermissions)        // 获取角色的权限列表
+		rg.POST("", handler.CreateRole)                                 // 创建角色
+		rg.PUT(":roleID", handler.UpdateRole)                           // 更新角色
+		rg.DELETE(":roleID", handler.DeleteRole)                        // 删除角色
+		rg.GET(":roleID", handler.GetRole)                              // 查询角色详情
+		rg.GET("", handler.ListRole)                                    // 查询角色列表
+		rg.GET("/trash", handler.ListDeletedRoles)                      // 查询回收站中的角色列表
+		rg.POST(":roleID/restore", handler.RestoreRole)                 // 从回收站恢复角色
+		rg.POST(":roleID/permissions", handler.AssignPermissionsToRole) // 为角色分配权限
+		rg.GET(":roleID/permissions", handler.GetRolePermissions)       // 获取角色的权限列表
 	})
 }
 
@@ -54,3 +56,13 @@ func (h *Handler) AssignPermissionsToRole(c *gin.Context) {
 func (h *Handler) GetRolePermissions(c *gin.Context) {
 	core.HandleUriRequest(c, h.biz.RoleV1().GetRolePermissions, h.val.ValidateGetRolePermissionsRequest)
 }
+
+// ListDeletedRoles 列出回收站中的角色.
+func (h *Handler) ListDeletedRoles(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.RoleV1().ListDeleted, h.val.ValidateListDeletedRolesRequest)
+}
+
+// RestoreRole 从回收站恢复角色.
+func (h *Handler) RestoreRole(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.RoleV1().Restore, h.val.ValidateRestoreRoleRequest)
+}
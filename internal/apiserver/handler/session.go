@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 当前用户的会话管理路由
+		rg := v1.Group("/users")
+		rg.Use(handler.mws...)
+		rg.GET(":userID/sessions", handler.ListSessions)                // 查询自己的会话列表
+		rg.DELETE(":userID/sessions", handler.RevokeOtherSessions)      // 撤销除当前会话外的其他会话
+		rg.DELETE(":userID/sessions/:sessionID", handler.RevokeSession) // 撤销指定会话
+
+		// 管理员会话管理路由
+		admin := v1.Group("/admin/users")
+		admin.Use(handler.mws...)
+		admin.GET(":userID/sessions", handler.ListUserSessions)                 // 查询指定用户的会话列表
+		admin.DELETE(":userID/sessions", handler.TerminateUserSessions)         // 终止指定用户的全部会话
+		admin.DELETE(":userID/sessions/:sessionID", handler.AdminRevokeSession) // 撤销指定用户的指定会话
+	})
+}
+
+// ListSessions 获取当前用户的会话列表.
+func (h *Handler) ListSessions(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().ListSessions, h.val.ValidateListSessionsRequest)
+}
+
+// RevokeSession 撤销当前用户的指定会话.
+func (h *Handler) RevokeSession(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().RevokeSession, h.val.ValidateRevokeSessionRequest)
+}
+
+// RevokeOtherSessions 撤销当前用户除本次请求所在会话外的全部会话.
+func (h *Handler) RevokeOtherSessions(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().RevokeOtherSessions, h.val.ValidateRevokeOtherSessionsRequest)
+}
+
+// ListUserSessions 管理员获取指定用户的会话列表.
+func (h *Handler) ListUserSessions(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().ListSessions, h.val.ValidateAdminListSessionsRequest)
+}
+
+// TerminateUserSessions 管理员终止指定用户的全部会话.
+func (h *Handler) TerminateUserSessions(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().TerminateUserSessions, h.val.ValidateTerminateUserSessionsRequest)
+}
+
+// AdminRevokeSession 管理员撤销指定用户的指定会话.
+func (h *Handler) AdminRevokeSession(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().RevokeSession, h.val.ValidateAdminRevokeSessionRequest)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 职责分离规则路由
+		rg := v1.Group("/sod-rules")
+		rg.Use(handler.mws...)
+		rg.POST("", handler.CreateSoDRule)               // 创建职责分离规则
+		rg.GET("", handler.ListSoDRules)                 // 查询职责分离规则列表
+		rg.PUT(":ruleID", handler.UpdateSoDRule)         // 更新职责分离规则
+		rg.DELETE(":ruleID", handler.DeleteSoDRule)      // 删除职责分离规则
+		rg.GET("/violations", handler.ListSoDViolations) // 查询违反职责分离规则的用户
+	})
+}
+
+// CreateSoDRule 创建职责分离规则.
+func (h *Handler) CreateSoDRule(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.SoDRuleV1().Create, h.val.ValidateCreateSoDRuleRequest)
+}
+
+// ListSoDRules 获取职责分离规则列表.
+func (h *Handler) ListSoDRules(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.SoDRuleV1().List, h.val.ValidateListSoDRulesRequest)
+}
+
+// UpdateSoDRule 更新职责分离规则.
+func (h *Handler) UpdateSoDRule(c *gin.Context) {
+	core.HandleAllRequest(c, h.biz.SoDRuleV1().Update, h.val.ValidateUpdateSoDRuleRequest)
+}
+
+// DeleteSoDRule 删除职责分离规则.
+func (h *Handler) DeleteSoDRule(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SoDRuleV1().Delete, h.val.ValidateDeleteSoDRuleRequest)
+}
+
+// ListSoDViolations 获取职责分离违规报告.
+func (h *Handler) ListSoDViolations(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.SoDRuleV1().ListViolations, h.val.ValidateListSoDViolationsRequest)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 单点登录路由，登录流程本身不需要认证
+		auth := v1.Group("/auth/oidc")
+		auth.GET("/providers", handler.ListOIDCProviders)    // 查询可用的单点登录 Provider
+		auth.GET("/callback", handler.OIDCCallback)          // 授权回调，完成登录并签发令牌
+		auth.GET("/:provider/login", handler.StartOIDCLogin) // 发起单点登录
+
+		// 外部身份管理路由
+		rg := v1.Group("/users")
+		rg.Use(handler.mws...)
+		rg.GET(":userID/identities", handler.ListUserIdentities)              // 查询已关联的外部身份
+		rg.POST(":userID/identities/:provider", handler.LinkUserIdentity)     // 发起关联外部身份
+		rg.DELETE(":userID/identities/:provider", handler.UnlinkUserIdentity) // 解除外部身份关联
+	})
+}
+
+// ListOIDCProviders 获取可用的单点登录 Provider 列表.
+func (h *Handler) ListOIDCProviders(c *gin.Context) {
+	core.HandleNoBodyRequest(c, h.biz.SSOV1().ListProviders, h.val.ValidateListOIDCProvidersRequest)
+}
+
+// StartOIDCLogin 发起单点登录.
+func (h *Handler) StartOIDCLogin(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SSOV1().StartLogin, h.val.ValidateStartOIDCLoginRequest)
+}
+
+// OIDCCallback 处理单点登录回调.
+func (h *Handler) OIDCCallback(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.SSOV1().Callback, h.val.ValidateOIDCCallbackRequest)
+}
+
+// ListUserIdentities 获取当前用户关联的外部身份列表.
+func (h *Handler) ListUserIdentities(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SSOV1().ListIdentities, h.val.ValidateListUserIdentitiesRequest)
+}
+
+// LinkUserIdentity 为当前用户发起关联外部身份的授权流程.
+func (h *Handler) LinkUserIdentity(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SSOV1().LinkIdentity, h.val.ValidateLinkUserIdentityRequest)
+}
+
+// UnlinkUserIdentity 解除当前用户的外部身份关联.
+func (h *Handler) UnlinkUserIdentity(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SSOV1().UnlinkIdentity, h.val.ValidateUnlinkUserIdentityRequest)
+}
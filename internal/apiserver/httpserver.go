@@ -2,9 +2,11 @@ package apiserver
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/handler"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/apicatalog"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/metrics"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	mw "github.com/clin211/gin-enterprise-template/internal/pkg/middleware/gin"
@@ -28,6 +30,10 @@ var _ server.Server = (*ginServer)(nil)
 func (c *ServerConfig) NewGinServer() (*ginServer, error) {
 	// 创建 Gin 引擎
 	engine := gin.New()
+	// 只信任配置的反向代理设置的 X-Forwarded-For 等请求头，防止客户端伪造 IP 绕过基于 IP 的授权条件
+	if err := engine.SetTrustedProxies(c.HTTPOptions.TrustedProxies); err != nil {
+		return nil, err
+	}
 
 	// 注册全局中间件，用于恢复 panic、设置 HTTP 头、添加请求 ID 等
 	engine.Use(
@@ -46,7 +52,15 @@ func (c *ServerConfig) NewGinServer() (*ginServer, error) {
 	// 注册 REST API 路由
 	c.InstallRESTAPI(engine)
 
-	httpsrv := server.NewHTTPServer(c.HTTPOptions, c.TLSOptions, engine)
+	// 将已注册的 API 路由同步为权限记录，失败时不影响服务启动
+	if err := c.biz.PermissionV1().SyncAPIRoutes(context.Background(), apicatalog.Discover(engine.Routes())); err != nil {
+		slog.Warn("Failed to synchronize api routes to permissions", "error", err)
+	}
+
+	httpsrv, err := server.NewHTTPServer(c.HTTPOptions, c.TLSOptions, engine)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ginServer{srv: httpsrv}, nil
 }
@@ -57,7 +71,7 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 	InstallGenericAPI(engine)
 
 	// 认证和授权中间件
-	authMiddlewares := []gin.HandlerFunc{mw.AuthnMiddleware(c.retriever), mw.AuthzMiddleware(c.authz)}
+	authMiddlewares := []gin.HandlerFunc{mw.AuthnMiddleware(c.retriever, c.tracker, c.certs), mw.AuthzMiddleware(c.authz)}
 
 	// 创建核心业务处理器
 	hdl := handler.NewHandler(c.biz, c.val, authMiddlewares...)
@@ -69,7 +83,7 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 	// 注册用户登录、令牌刷新接口
 	v1.POST("/auth/login", hdl.Login)
 	// 注意：refresh-token 使用专门的 RefreshAuthnMiddleware，接受 refresh token
-	v1.PUT("/auth/refresh-token", mw.RefreshAuthnMiddleware(c.retriever), hdl.RefreshToken)
+	v1.PUT("/auth/refresh-token", mw.RefreshAuthnMiddleware(c.retriever, c.tracker), hdl.RefreshToken)
 	// 注册资源路由
 	hdl.InstallAll(v1)
 }
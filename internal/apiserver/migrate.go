@@ -0,0 +1,82 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/migrations"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
+	"github.com/clin211/gin-enterprise-template/pkg/store/migrate"
+)
+
+// NewMigrator 创建用于执行数据库迁移的 Migrator，供 migrate 子命令使用. 返回的 close 函数用于关闭数据库连接.
+// 与 NewDB 不同，这里不会按启动模式检查或执行迁移.
+func (cfg *Config) NewMigrator() (*migrate.Migrator, func(), error) {
+	db, err := cfg.openDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, nil, err
+	}
+	return migrator, func() { _ = sqlDB.Close() }, nil
+}
+
+// migrateOnStartup 根据启动模式执行或检查数据库迁移.
+func (cfg *Config) migrateOnStartup(db *gorm.DB) error {
+	mode := genericoptions.MigrationModeAuto
+	if cfg.MigrationOptions != nil {
+		mode = cfg.MigrationOptions.Mode
+	}
+	if mode == genericoptions.MigrationModeOff {
+		return nil
+	}
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if mode == genericoptions.MigrationModeVerify {
+		return migrator.Verify(ctx)
+	}
+
+	applied, err := migrator.Up(ctx, 0)
+	if err != nil {
+		return err
+	}
+	if len(applied) > 0 {
+		slog.Info("Applied database migrations", "count", len(applied), "version", applied[len(applied)-1].Version)
+	}
+	return nil
+}
+
+// newMigrator 使用嵌入的、与 db 的数据库类型对应的迁移文件创建 Migrator.
+func newMigrator(db *gorm.DB) (*migrate.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	driver := db.Dialector.Name()
+	fsys, err := migrations.For(driver)
+	if err != nil {
+		return nil, err
+	}
+	files, err := migrate.Load(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	return migrate.New(sqlDB, files, migrate.WithDriver(driver))
+}
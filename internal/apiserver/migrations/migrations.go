@@ -0,0 +1,23 @@
+// Package migrations 包含 apiserver 的数据库迁移文件，文件在编译时嵌入到二进制中.
+// 每种数据库各有一个目录，新增迁移时需要在所有目录中添加相同版本号的文件.
+// 使用 "migrate create <name>" 子命令创建新的迁移文件.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+// files 包含所有数据库的迁移文件.
+//
+//go:embed postgres/*.sql mysql/*.sql sqlite/*.sql
+var files embed.FS
+
+// For 返回 driver 对应数据库的迁移文件.
+func For(driver string) (fs.FS, error) {
+	if _, err := fs.Stat(files, driver); err != nil {
+		return nil, fmt.Errorf("no migrations for database driver %q", driver)
+	}
+	return fs.Sub(files, driver)
+}
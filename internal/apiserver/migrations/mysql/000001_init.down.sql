@@ -0,0 +1,18 @@
+-- 回滚初始数据库结构. 表按外键引用的反方向删除.
+
+DROP TABLE IF EXISTS `sod_rule`;
+DROP TABLE IF EXISTS `access_request`;
+DROP TABLE IF EXISTS `oidc_auth_state`;
+DROP TABLE IF EXISTS `user_identity`;
+DROP TABLE IF EXISTS `invitation`;
+DROP TABLE IF EXISTS `user_session`;
+DROP TABLE IF EXISTS `user_role`;
+DROP TABLE IF EXISTS `user_login_log`;
+DROP TABLE IF EXISTS `user_config`;
+DROP TABLE IF EXISTS `user`;
+DROP TABLE IF EXISTS `role_permission`;
+DROP TABLE IF EXISTS `role`;
+DROP TABLE IF EXISTS `menu`;
+DROP TABLE IF EXISTS `permission`;
+DROP TABLE IF EXISTS `casbin_rule`;
+DROP TABLE IF EXISTS `audit_log`;
@@ -0,0 +1,362 @@
+-- 初始数据库结构（MySQL 8.0+）. 与 postgres/000001_init.up.sql 保持一致.
+-- MySQL 不支持部分索引，带条件的唯一索引通过虚拟生成列实现：条件不成立时生成列为 NULL，不参与唯一性检查.
+
+-- ----------------------------
+-- Table structure for audit_log
+-- ----------------------------
+CREATE TABLE `audit_log` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `user_id` char(36) NOT NULL COMMENT '操作用户UUID',
+  `actor_id` char(36) COMMENT '实际操作者UUID（管理员模拟登录时记录发起模拟的管理员，否则为空）',
+  `action` varchar(50) NOT NULL COMMENT '操作类型（如role_assign、permission_deny）',
+  `resource` varchar(200) COMMENT '操作的资源',
+  `details` json COMMENT '操作详情（JSONB格式，记录变更前后数据）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '操作时间',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='审计日志表，记录权限相关操作历史';
+CREATE INDEX `idx_audit_log_created_at` ON `audit_log` (`created_at` DESC);
+CREATE INDEX `idx_audit_log_user_id` ON `audit_log` (`user_id`);
+CREATE INDEX `idx_audit_log_actor_id` ON `audit_log` (`actor_id`);
+
+-- ----------------------------
+-- Table structure for casbin_rule
+-- ----------------------------
+CREATE TABLE `casbin_rule` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `ptype` varchar(100) NOT NULL COMMENT '规则类型（p=权限, g=角色继承）',
+  `v0` varchar(100) COMMENT '主体（用户/角色）',
+  `v1` varchar(100) COMMENT '资源（对象）',
+  `v2` varchar(100) COMMENT '动作（读/写等）',
+  `v3` varchar(100) COMMENT '扩展字段1（条件等）',
+  `v4` varchar(100) COMMENT '扩展字段2',
+  `v5` varchar(100) COMMENT '扩展字段3',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Casbin权限规则表，作为系统中唯一的权限控制机制，支持RBAC和ABAC策略';
+CREATE INDEX `idx_casbin_rule_g_v0` ON `casbin_rule` (`ptype`, `v0`);
+CREATE INDEX `idx_casbin_rule_ptype` ON `casbin_rule` (`ptype`);
+CREATE INDEX `idx_casbin_rule_ptype_v0_v1` ON `casbin_rule` (`ptype`, `v0`, `v1`);
+
+-- ----------------------------
+-- Table structure for menu
+-- ----------------------------
+CREATE TABLE `menu` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `menu_id` char(36) NOT NULL COMMENT '菜单业务唯一UUID',
+  `parent_id` char(36) COMMENT '父菜单UUID（用于构建菜单树）',
+  `menu_name` varchar(50) NOT NULL COMMENT '菜单名称',
+  `menu_code` varchar(50) NOT NULL COMMENT '菜单编码（唯一标识）',
+  `menu_type` enum('menu', 'page') NOT NULL COMMENT '菜单类型（menu=目录, page=页面）',
+  `icon` varchar(50) COMMENT '菜单图标',
+  `path` varchar(200) COMMENT '路由路径',
+  `component` varchar(200) COMMENT '前端组件路径（兼容vue-pure-admin）',
+  `permission_id` char(36) COMMENT '关联权限UUID（外键）',
+  `sort_order` int NOT NULL DEFAULT 0 COMMENT '排序序号（支持拖拽排序）',
+  `visible` smallint NOT NULL DEFAULT 1 COMMENT '是否可见（0=隐藏,1=显示）',
+  `status` smallint NOT NULL DEFAULT 0 COMMENT '菜单状态（0=启用,1=禁用）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  `deleted_at` datetime(6) COMMENT '软删除时间（NULL=未删除）',
+  `uk_menu_code_flag` tinyint(1) GENERATED ALWAYS AS (IF(`deleted_at` IS NULL, 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_menu_code 的生效标记，条件不成立时为 NULL',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `menu_menu_id_key` (`menu_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='菜单表，存储前端菜单和页面配置信息';
+CREATE INDEX `idx_menu_active` ON `menu` (`created_at` DESC);
+CREATE INDEX `idx_menu_deleted_at` ON `menu` (`deleted_at`);
+CREATE UNIQUE INDEX `uk_menu_code` ON `menu` (`menu_code`, `uk_menu_code_flag`);
+CREATE INDEX `idx_menu_parent_id` ON `menu` (`parent_id`);
+CREATE INDEX `idx_menu_parent_sort` ON `menu` (`parent_id`, `sort_order`);
+CREATE INDEX `idx_menu_path` ON `menu` (`path`);
+CREATE INDEX `idx_menu_permission_id` ON `menu` (`permission_id`);
+CREATE INDEX `idx_menu_status` ON `menu` (`status`);
+CREATE INDEX `idx_menu_type` ON `menu` (`menu_type`);
+CREATE INDEX `idx_menu_visible` ON `menu` (`visible`);
+
+-- ----------------------------
+-- Table structure for permission
+-- ----------------------------
+CREATE TABLE `permission` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `permission_id` char(36) NOT NULL COMMENT '权限业务唯一UUID',
+  `permission_name` varchar(100) NOT NULL COMMENT '权限名称',
+  `permission_code` varchar(100) NOT NULL COMMENT '权限编码（唯一标识）',
+  `resource_type` enum('menu', 'button', 'api') NOT NULL COMMENT '资源类型（menu=菜单, button=按钮, api=接口）',
+  `resource_path` varchar(200) COMMENT '资源路径（如 /system/user/list）',
+  `action` varchar(20) NOT NULL COMMENT 'HTTP动词或自定义操作（GET/POST/export等）',
+  `description` varchar(200) COMMENT '权限描述',
+  `parent_id` char(36) COMMENT '父权限UUID（用于构建权限树）',
+  `path` varchar(500) COMMENT '全路径（用于树形查询优化）',
+  `status` smallint NOT NULL DEFAULT 0 COMMENT '权限状态（0=启用,1=禁用）',
+  `conditions` json COMMENT 'ABAC生效条件（JSON：IP网段、星期/小时窗口、请求属性，NULL=无条件）',
+  `stale` tinyint(1) NOT NULL DEFAULT 0 COMMENT '自动发现的API路由是否已不存在（true=已失效）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  `deleted_at` datetime(6) COMMENT '软删除时间（NULL=未删除）',
+  `uk_permission_code_flag` tinyint(1) GENERATED ALWAYS AS (IF(`deleted_at` IS NULL, 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_permission_code 的生效标记，条件不成立时为 NULL',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `permission_permission_id_key` (`permission_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='权限表，存储系统资源和操作权限信息';
+CREATE INDEX `idx_permission_active` ON `permission` (`created_at` DESC);
+CREATE INDEX `idx_permission_deleted_at` ON `permission` (`deleted_at`);
+CREATE UNIQUE INDEX `uk_permission_code` ON `permission` (`permission_code`, `uk_permission_code_flag`);
+CREATE INDEX `idx_permission_parent_id` ON `permission` (`parent_id`);
+CREATE INDEX `idx_permission_path` ON `permission` (`path`);
+CREATE INDEX `idx_permission_resource_type` ON `permission` (`resource_type`);
+CREATE INDEX `idx_permission_status` ON `permission` (`status`);
+
+-- ----------------------------
+-- Table structure for role
+-- ----------------------------
+CREATE TABLE `role` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `role_id` char(36) NOT NULL COMMENT '角色业务唯一UUID',
+  `role_name` varchar(50) NOT NULL COMMENT '角色名称',
+  `role_code` varchar(50) NOT NULL COMMENT '角色编码（唯一标识，如super_admin、admin）',
+  `description` varchar(200) COMMENT '角色描述',
+  `status` smallint NOT NULL DEFAULT 0 COMMENT '角色状态（0=启用,1=禁用）',
+  `sort_order` int NOT NULL DEFAULT 0 COMMENT '排序序号',
+  `sensitive` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否为敏感角色（授予时需要审批，super_admin 始终视为敏感角色）',
+  `approver_role_ids` text COMMENT '可审批该角色授权申请的角色ID列表（JSON数组，为空时仅 super_admin 可审批）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  `deleted_at` datetime(6) COMMENT '软删除时间（NULL=未删除）',
+  `uk_role_code_flag` tinyint(1) GENERATED ALWAYS AS (IF(`deleted_at` IS NULL, 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_role_code 的生效标记，条件不成立时为 NULL',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `role_role_id_key` (`role_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='角色表，存储系统角色信息';
+CREATE INDEX `idx_role_active` ON `role` (`created_at` DESC);
+CREATE INDEX `idx_role_deleted_at` ON `role` (`deleted_at`);
+CREATE UNIQUE INDEX `uk_role_code` ON `role` (`role_code`, `uk_role_code_flag`);
+CREATE INDEX `idx_role_status` ON `role` (`status`);
+
+-- ----------------------------
+-- Table structure for role_permission
+-- ----------------------------
+CREATE TABLE `role_permission` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `role_id` char(36) NOT NULL COMMENT '角色UUID（外键）',
+  `permission_id` char(36) NOT NULL COMMENT '权限UUID（外键）',
+  `version` int NOT NULL DEFAULT 1 COMMENT '乐观锁版本号',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `starts_at` datetime(6) COMMENT '生效时间（为空表示立即生效）',
+  `expires_at` datetime(6) COMMENT '过期时间（为空表示永久有效，过期后由后台任务自动回收）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `role_permission_role_id_permission_id_key` (`role_id`, `permission_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='角色权限关联表，实现角色与权限的多对多关系';
+CREATE INDEX `idx_role_permission_permission_id` ON `role_permission` (`permission_id`);
+CREATE INDEX `idx_role_permission_role_id` ON `role_permission` (`role_id`);
+CREATE INDEX `idx_role_permission_expires_at` ON `role_permission` (`expires_at`);
+CREATE INDEX `idx_role_permission_starts_at` ON `role_permission` (`starts_at`);
+
+-- ----------------------------
+-- Table structure for user
+-- ----------------------------
+CREATE TABLE `user` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `user_id` char(36) NOT NULL COMMENT '用户业务唯一UUID',
+  `username` varchar(50) NOT NULL COMMENT '用户名（唯一，登录用）',
+  `password` varchar(255) NOT NULL COMMENT '密码哈希（bcrypt加密存储）',
+  `email` varchar(255) COMMENT '电子邮箱（唯一）',
+  `phone` varchar(20) COMMENT '手机号（唯一）',
+  `avatar` varchar(500) COMMENT '头像URL',
+  `nickname` varchar(100) NOT NULL DEFAULT '' COMMENT '用户昵称',
+  `gender` smallint NOT NULL DEFAULT 0 COMMENT '性别（0=未知,1=男,2=女）',
+  `status` smallint NOT NULL DEFAULT 0 COMMENT '用户状态（0=活跃,1=禁用,2=待审核）',
+  `last_login_at` datetime(6) COMMENT '最后登录时间',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  `description` text COMMENT '用户描述/简介',
+  `deleted_at` datetime(6) COMMENT '软删除时间（NULL=未删除）',
+  `uk_user_email_flag` tinyint(1) GENERATED ALWAYS AS (IF(`email` IS NOT NULL AND `deleted_at` IS NULL, 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_user_email 的生效标记，条件不成立时为 NULL',
+  `uk_user_phone_flag` tinyint(1) GENERATED ALWAYS AS (IF(`phone` IS NOT NULL AND `deleted_at` IS NULL, 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_user_phone 的生效标记，条件不成立时为 NULL',
+  `uk_user_username_flag` tinyint(1) GENERATED ALWAYS AS (IF(`deleted_at` IS NULL, 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_user_username 的生效标记，条件不成立时为 NULL',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uni_user_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户表，存储用户认证信息、基本资料和应用扩展';
+CREATE INDEX `idx_user_deleted_at` ON `user` (`deleted_at`);
+CREATE UNIQUE INDEX `uk_user_email` ON `user` (`email`, `uk_user_email_flag`);
+CREATE UNIQUE INDEX `uk_user_phone` ON `user` (`phone`, `uk_user_phone_flag`);
+CREATE UNIQUE INDEX `uk_user_username` ON `user` (`username`, `uk_user_username_flag`);
+CREATE INDEX `idx_user_status` ON `user` (`status`);
+CREATE INDEX `idx_user_status_last_login` ON `user` (`status`, `last_login_at` DESC);
+
+-- ----------------------------
+-- Table structure for user_config
+-- ----------------------------
+CREATE TABLE `user_config` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `user_id` char(36) NOT NULL COMMENT '用户UUID（外键）',
+  `config_key` varchar(100) NOT NULL COMMENT '配置键名（唯一组合）',
+  `config_value` json NOT NULL COMMENT '配置值（JSONB格式）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_config_user_id_config_key_key` (`user_id`, `config_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户个人配置表，存储用户偏好设置';
+CREATE INDEX `idx_user_config_user` ON `user_config` (`user_id`);
+
+-- ----------------------------
+-- Table structure for user_login_log
+-- ----------------------------
+CREATE TABLE `user_login_log` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `username` varchar(50) COMMENT '登录用户名',
+  `ip_address` varchar(64) COMMENT '登录IP地址',
+  `user_agent` varchar(1000) COMMENT '用户代理字符串',
+  `status` tinyint(1) NOT NULL COMMENT '登录状态（true=成功, false=失败）',
+  `error_message` text COMMENT '错误消息（失败时）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '登录尝试时间',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户登录日志表，记录登录尝试和安全信息';
+CREATE INDEX `idx_user_login_log_created` ON `user_login_log` (`created_at` DESC);
+CREATE INDEX `idx_user_login_log_status_created` ON `user_login_log` (`status`, `created_at` DESC);
+CREATE INDEX `idx_user_login_log_username` ON `user_login_log` (`username`);
+
+-- ----------------------------
+-- Table structure for user_role
+-- ----------------------------
+CREATE TABLE `user_role` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `user_id` char(36) NOT NULL COMMENT '用户UUID（外键）',
+  `role_id` char(36) NOT NULL COMMENT '角色UUID（外键）',
+  `assigned_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '分配时间',
+  `starts_at` datetime(6) COMMENT '生效时间（为空表示立即生效）',
+  `expires_at` datetime(6) COMMENT '过期时间（为空表示永久有效，过期后由后台任务自动回收）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_role_user_id_role_id_key` (`user_id`, `role_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户角色关联表，实现用户与角色的多对多关系';
+CREATE INDEX `idx_user_role_role_id` ON `user_role` (`role_id`);
+CREATE INDEX `idx_user_role_expires_at` ON `user_role` (`expires_at`);
+CREATE INDEX `idx_user_role_starts_at` ON `user_role` (`starts_at`);
+CREATE INDEX `idx_user_role_user_id` ON `user_role` (`user_id`);
+
+-- ----------------------------
+-- Table structure for user_session
+-- ----------------------------
+CREATE TABLE `user_session` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `session_id` char(36) NOT NULL COMMENT '会话业务唯一UUID（对应 token 中的 sid）',
+  `user_id` char(36) NOT NULL COMMENT '用户UUID（外键）',
+  `device_name` varchar(100) COMMENT '设备名称',
+  `ip_address` varchar(64) COMMENT '登录IP地址',
+  `user_agent` varchar(1000) COMMENT '用户代理字符串',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间（登录时间）',
+  `last_seen_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '最近活跃时间',
+  `expires_at` datetime(6) NOT NULL COMMENT '过期时间（与 Refresh Token 过期时间一致）',
+  `revoked_at` datetime(6) COMMENT '撤销时间（NULL=未撤销）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_session_session_id_key` (`session_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户会话表，每次登录（及其刷新令牌族）对应一条会话记录';
+CREATE INDEX `idx_user_session_user_id` ON `user_session` (`user_id`, `last_seen_at` DESC);
+CREATE INDEX `idx_user_session_active` ON `user_session` (`user_id`);
+
+-- ----------------------------
+-- Table structure for invitation
+-- ----------------------------
+CREATE TABLE `invitation` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `code` varchar(32) COMMENT '邀请码（唯一，由 id 经 pkg/id.NewCode 生成）',
+  `role_ids` text COMMENT '注册后预分配的角色ID列表（JSON数组）',
+  `max_uses` int NOT NULL DEFAULT 1 COMMENT '最大使用次数',
+  `used_count` int NOT NULL DEFAULT 0 COMMENT '已使用次数',
+  `expires_at` datetime(6) COMMENT '过期时间（NULL=永不过期）',
+  `revoked_at` datetime(6) COMMENT '撤销时间（NULL=未撤销）',
+  `remark` varchar(255) COMMENT '备注',
+  `created_by` char(36) COMMENT '创建人用户UUID',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `invitation_code_key` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='注册邀请码表';
+
+-- ----------------------------
+-- Table structure for user_identity
+-- ----------------------------
+CREATE TABLE `user_identity` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `user_id` char(36) NOT NULL COMMENT '用户UUID（外键）',
+  `provider` varchar(64) NOT NULL COMMENT 'OIDC Provider 名称',
+  `subject` varchar(255) NOT NULL COMMENT 'IdP 中的用户唯一标识（ID Token 的 sub）',
+  `email` varchar(255) COMMENT 'IdP 返回的邮箱',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间（关联时间）',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  `last_login_at` datetime(6) COMMENT '最近一次通过该身份登录的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_identity_provider_subject_key` (`provider`, `subject`),
+  UNIQUE KEY `user_identity_user_id_provider_key` (`user_id`, `provider`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户外部身份表，记录本地用户与 IdP 身份的关联关系';
+CREATE INDEX `idx_user_identity_user_id` ON `user_identity` (`user_id`);
+
+-- ----------------------------
+-- Table structure for oidc_auth_state
+-- ----------------------------
+CREATE TABLE `oidc_auth_state` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `state` varchar(64) NOT NULL COMMENT 'OAuth2 state 参数（唯一）',
+  `provider` varchar(64) NOT NULL COMMENT 'OIDC Provider 名称',
+  `code_verifier` varchar(128) NOT NULL COMMENT 'PKCE code_verifier',
+  `nonce` varchar(64) NOT NULL COMMENT 'ID Token nonce',
+  `link_user_id` char(36) COMMENT '关联身份流程的发起用户UUID（NULL=登录流程）',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `expires_at` datetime(6) NOT NULL COMMENT '过期时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `oidc_auth_state_state_key` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='OIDC 登录流程状态表，回调时一次性消费';
+CREATE INDEX `idx_oidc_auth_state_expires_at` ON `oidc_auth_state` (`expires_at`);
+
+-- ----------------------------
+-- Table structure for access_request
+-- ----------------------------
+CREATE TABLE `access_request` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `request_id` char(36) NOT NULL COMMENT '授权申请业务唯一UUID',
+  `user_id` char(36) NOT NULL COMMENT '被授予角色的用户UUID',
+  `role_id` char(36) NOT NULL COMMENT '申请授予的角色UUID',
+  `requester_id` char(36) NOT NULL COMMENT '发起申请的用户UUID',
+  `reason` varchar(255) COMMENT '申请理由',
+  `status` varchar(16) NOT NULL DEFAULT 'pending' COMMENT '申请状态（pending=待审批,approved=已批准,rejected=已拒绝,expired=已过期）',
+  `grant_starts_at` datetime(6) COMMENT '批准后角色的生效时间（NULL=批准后立即生效）',
+  `grant_expires_at` datetime(6) COMMENT '批准后角色的过期时间（NULL=永久有效）',
+  `reviewer_id` char(36) COMMENT '审批人用户UUID',
+  `review_comment` varchar(255) COMMENT '审批意见',
+  `reviewed_at` datetime(6) COMMENT '审批时间',
+  `expires_at` datetime(6) NOT NULL COMMENT '申请过期时间，过期后未审批的申请由后台任务标记为已过期',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  `uk_access_request_pending_flag` tinyint(1) GENERATED ALWAYS AS (IF(`status` = 'pending', 1, NULL)) VIRTUAL COMMENT '唯一索引 uk_access_request_pending 的生效标记，条件不成立时为 NULL',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `access_request_request_id_key` (`request_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='敏感角色授权申请表';
+CREATE INDEX `idx_access_request_status_expires_at` ON `access_request` (`status`, `expires_at`);
+CREATE UNIQUE INDEX `uk_access_request_pending` ON `access_request` (`user_id`, `role_id`, `uk_access_request_pending_flag`);
+
+-- ----------------------------
+-- Table structure for sod_rule
+-- ----------------------------
+CREATE TABLE `sod_rule` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列）',
+  `rule_id` char(36) NOT NULL COMMENT '职责分离规则业务唯一UUID',
+  `name` varchar(50) NOT NULL COMMENT '规则名称（唯一）',
+  `description` varchar(200) COMMENT '规则描述',
+  `role_ids` text NOT NULL COMMENT '互斥的角色ID集合（JSON数组）',
+  `max_roles` int NOT NULL DEFAULT 1 COMMENT '同一用户最多可同时持有集合中的角色数量',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `updated_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `sod_rule_rule_id_key` (`rule_id`),
+  UNIQUE KEY `sod_rule_name_key` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='静态职责分离规则表，限制同一用户同时持有互斥角色';
+
+-- ----------------------------
+-- Foreign Keys
+-- ----------------------------
+ALTER TABLE `menu` ADD CONSTRAINT `menu_parent_id_fkey` FOREIGN KEY (`parent_id`) REFERENCES `menu` (`menu_id`) ON DELETE CASCADE;
+ALTER TABLE `menu` ADD CONSTRAINT `menu_permission_id_fkey` FOREIGN KEY (`permission_id`) REFERENCES `permission` (`permission_id`) ON DELETE SET NULL;
+ALTER TABLE `role_permission` ADD CONSTRAINT `role_permission_permission_id_fkey` FOREIGN KEY (`permission_id`) REFERENCES `permission` (`permission_id`) ON DELETE CASCADE;
+ALTER TABLE `role_permission` ADD CONSTRAINT `role_permission_role_id_fkey` FOREIGN KEY (`role_id`) REFERENCES `role` (`role_id`) ON DELETE CASCADE;
+ALTER TABLE `user_config` ADD CONSTRAINT `user_config_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`user_id`) ON DELETE CASCADE;
+ALTER TABLE `user_role` ADD CONSTRAINT `user_role_role_id_fkey` FOREIGN KEY (`role_id`) REFERENCES `role` (`role_id`) ON DELETE CASCADE;
+ALTER TABLE `user_role` ADD CONSTRAINT `user_role_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`user_id`) ON DELETE CASCADE;
+ALTER TABLE `user_session` ADD CONSTRAINT `user_session_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`user_id`) ON DELETE CASCADE;
+ALTER TABLE `user_identity` ADD CONSTRAINT `user_identity_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`user_id`) ON DELETE CASCADE;
+ALTER TABLE `access_request` ADD CONSTRAINT `access_request_role_id_fkey` FOREIGN KEY (`role_id`) REFERENCES `role` (`role_id`) ON DELETE CASCADE;
+ALTER TABLE `access_request` ADD CONSTRAINT `access_request_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `user` (`user_id`) ON DELETE CASCADE;
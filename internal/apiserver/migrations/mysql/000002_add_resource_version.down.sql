@@ -0,0 +1,7 @@
+-- 删除资源的乐观锁版本号.
+
+ALTER TABLE `sod_rule` DROP COLUMN `version`;
+ALTER TABLE `user` DROP COLUMN `version`;
+ALTER TABLE `role` DROP COLUMN `version`;
+ALTER TABLE `permission` DROP COLUMN `version`;
+ALTER TABLE `menu` DROP COLUMN `version`;
@@ -0,0 +1,28 @@
+-- 创建领域事件发件箱. 业务变更和领域事件在同一个事务中写入，由后台任务按 ID 顺序发布到 Kafka.
+
+CREATE TABLE `outbox_event` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '内部主键ID（自增序列），决定事件的发布顺序',
+  `event_id` char(36) NOT NULL COMMENT '事件UUID，消费者据此去重',
+  `aggregate_type` varchar(50) NOT NULL COMMENT '聚合类型（如user、role）',
+  `aggregate_id` varchar(100) NOT NULL COMMENT '聚合ID，同一聚合的事件按顺序发布',
+  `event_type` varchar(100) NOT NULL COMMENT '事件类型（如user.created）',
+  `payload` json NOT NULL COMMENT '事件消息体（JSON格式）',
+  `status` smallint NOT NULL DEFAULT 0 COMMENT '状态（0=待发布, 1=已发布, 2=死信）',
+  `attempts` int NOT NULL DEFAULT 0 COMMENT '已尝试发布的次数',
+  `last_error` text COMMENT '最后一次发布失败的原因',
+  `next_attempt_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '下一次可以尝试发布的时间',
+  `published_at` datetime(6) COMMENT '发布或成为死信的时间',
+  `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '事件发生时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_outbox_event_event_id` (`event_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='领域事件发件箱表，记录待发布到Kafka的领域事件';
+CREATE INDEX `idx_outbox_event_status_id` ON `outbox_event` (`status`, `id`);
+
+CREATE TABLE `outbox_lease` (
+  `name` varchar(50) NOT NULL COMMENT '租约名称',
+  `holder` varchar(100) NOT NULL DEFAULT '' COMMENT '当前持有租约的实例标识',
+  `expires_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '租约过期时间',
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='发件箱发布租约表，多个实例中只有持有租约的实例发布事件';
+
+INSERT INTO `outbox_lease` (`name`, `holder`, `expires_at`) VALUES ('outbox_relay', '', '1970-01-01 00:00:01');
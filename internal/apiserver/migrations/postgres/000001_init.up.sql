@@ -1,189 +1,216 @@
-/*
- Navicat Premium Dump SQL
-
- Source Server         : local-postgresql
- Source Server Type    : PostgreSQL
- Source Server Version : 170007 (170007)
- Source Host           : localhost:5432
- Source Catalog        : template
- Source Schema         : public
-
- Target Server Type    : PostgreSQL
- Target Server Version : 170007 (170007)
- File Encoding         : 65001
-
- Date: 10/01/2026 16:47:03
-*/
-
+-- 初始数据库结构，由原 configs/template.sql 整理而来.
 
 -- ----------------------------
 -- Type structure for menu_type
 -- ----------------------------
-DROP TYPE IF EXISTS "public"."menu_type";
 CREATE TYPE "public"."menu_type" AS ENUM (
   'menu',
   'page'
 );
-ALTER TYPE "public"."menu_type" OWNER TO "postgres";
 COMMENT ON TYPE "public"."menu_type" IS '菜单类型枚举：menu=目录, page=页面';
 
 -- ----------------------------
 -- Type structure for resource_type
 -- ----------------------------
-DROP TYPE IF EXISTS "public"."resource_type";
 CREATE TYPE "public"."resource_type" AS ENUM (
   'menu',
-  'button'
+  'button',
+  'api'
 );
-ALTER TYPE "public"."resource_type" OWNER TO "postgres";
-COMMENT ON TYPE "public"."resource_type" IS '资源类型枚举：menu=菜单, button=按钮';
+COMMENT ON TYPE "public"."resource_type" IS '资源类型枚举：menu=菜单, button=按钮, api=接口';
 
 -- ----------------------------
 -- Sequence structure for audit_log_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."audit_log_id_seq";
-CREATE SEQUENCE "public"."audit_log_id_seq" 
+CREATE SEQUENCE "public"."audit_log_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."audit_log_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."audit_log_id_seq" IS '审计日志表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for casbin_rule_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."casbin_rule_id_seq";
-CREATE SEQUENCE "public"."casbin_rule_id_seq" 
+CREATE SEQUENCE "public"."casbin_rule_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."casbin_rule_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."casbin_rule_id_seq" IS 'Casbin规则表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for menu_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."menu_id_seq";
-CREATE SEQUENCE "public"."menu_id_seq" 
+CREATE SEQUENCE "public"."menu_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."menu_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."menu_id_seq" IS '菜单表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for permission_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."permission_id_seq";
-CREATE SEQUENCE "public"."permission_id_seq" 
+CREATE SEQUENCE "public"."permission_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."permission_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."permission_id_seq" IS '权限表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for role_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."role_id_seq";
-CREATE SEQUENCE "public"."role_id_seq" 
+CREATE SEQUENCE "public"."role_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."role_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."role_id_seq" IS '角色表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for role_permission_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."role_permission_id_seq";
-CREATE SEQUENCE "public"."role_permission_id_seq" 
+CREATE SEQUENCE "public"."role_permission_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."role_permission_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."role_permission_id_seq" IS '角色权限关联表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for user_config_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."user_config_id_seq";
-CREATE SEQUENCE "public"."user_config_id_seq" 
+CREATE SEQUENCE "public"."user_config_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."user_config_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."user_config_id_seq" IS '用户配置表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for user_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."user_id_seq";
-CREATE SEQUENCE "public"."user_id_seq" 
+CREATE SEQUENCE "public"."user_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."user_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."user_id_seq" IS '用户表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for user_login_log_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."user_login_log_id_seq";
-CREATE SEQUENCE "public"."user_login_log_id_seq" 
+CREATE SEQUENCE "public"."user_login_log_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."user_login_log_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."user_login_log_id_seq" IS '用户登录日志表内部ID序列';
 
 -- ----------------------------
 -- Sequence structure for user_role_id_seq
 -- ----------------------------
-DROP SEQUENCE IF EXISTS "public"."user_role_id_seq";
-CREATE SEQUENCE "public"."user_role_id_seq" 
+CREATE SEQUENCE "public"."user_role_id_seq"
 INCREMENT 1
 MINVALUE  1
 MAXVALUE 9223372036854775807
 START 1
 CACHE 1;
-ALTER SEQUENCE "public"."user_role_id_seq" OWNER TO "postgres";
 COMMENT ON SEQUENCE "public"."user_role_id_seq" IS '用户角色关联表内部ID序列';
 
+-- ----------------------------
+-- Sequence structure for user_session_id_seq
+-- ----------------------------
+CREATE SEQUENCE "public"."user_session_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."user_session_id_seq" IS '用户会话表内部ID序列';
+
+-- ----------------------------
+-- Sequence structure for invitation_id_seq
+-- ----------------------------
+CREATE SEQUENCE "public"."invitation_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."invitation_id_seq" IS '邀请码表内部ID序列';
+
+-- ----------------------------
+-- Sequence structure for user_identity_id_seq
+-- ----------------------------
+CREATE SEQUENCE "public"."user_identity_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."user_identity_id_seq" IS '用户外部身份表内部ID序列';
+
+-- ----------------------------
+-- Sequence structure for oidc_auth_state_id_seq
+-- ----------------------------
+CREATE SEQUENCE "public"."oidc_auth_state_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."oidc_auth_state_id_seq" IS 'OIDC 登录状态表内部ID序列';
+
+-- ----------------------------
+-- Sequence structure for access_request_id_seq
+-- ----------------------------
+CREATE SEQUENCE "public"."access_request_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."access_request_id_seq" IS '授权申请表内部ID序列';
+
+-- ----------------------------
+-- Sequence structure for sod_rule_id_seq
+-- ----------------------------
+CREATE SEQUENCE "public"."sod_rule_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."sod_rule_id_seq" IS '职责分离规则表内部ID序列';
+
 -- ----------------------------
 -- Table structure for audit_log
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."audit_log";
 CREATE TABLE "public"."audit_log" (
   "id" int8 NOT NULL DEFAULT nextval('audit_log_id_seq'::regclass),
   "user_id" uuid NOT NULL,
+  "actor_id" uuid,
   "action" varchar(50) COLLATE "pg_catalog"."default" NOT NULL,
   "resource" varchar(200) COLLATE "pg_catalog"."default",
   "details" jsonb,
   "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
 )
 ;
-ALTER TABLE "public"."audit_log" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."audit_log"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."audit_log"."user_id" IS '操作用户UUID';
+COMMENT ON COLUMN "public"."audit_log"."actor_id" IS '实际操作者UUID（管理员模拟登录时记录发起模拟的管理员，否则为空）';
 COMMENT ON COLUMN "public"."audit_log"."action" IS '操作类型（如role_assign、permission_deny）';
 COMMENT ON COLUMN "public"."audit_log"."resource" IS '操作的资源';
 COMMENT ON COLUMN "public"."audit_log"."details" IS '操作详情（JSONB格式，记录变更前后数据）';
@@ -193,7 +220,6 @@ COMMENT ON TABLE "public"."audit_log" IS '审计日志表，记录权限相关
 -- ----------------------------
 -- Table structure for casbin_rule
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."casbin_rule";
 CREATE TABLE "public"."casbin_rule" (
   "id" int8 NOT NULL DEFAULT nextval('casbin_rule_id_seq'::regclass),
   "ptype" varchar(100) COLLATE "pg_catalog"."default" NOT NULL,
@@ -205,7 +231,6 @@ CREATE TABLE "public"."casbin_rule" (
   "v5" varchar(100) COLLATE "pg_catalog"."default"
 )
 ;
-ALTER TABLE "public"."casbin_rule" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."casbin_rule"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."casbin_rule"."ptype" IS '规则类型（p=权限, g=角色继承）';
 COMMENT ON COLUMN "public"."casbin_rule"."v0" IS '主体（用户/角色）';
@@ -219,7 +244,6 @@ COMMENT ON TABLE "public"."casbin_rule" IS 'Casbin权限规则表，作为系统
 -- ----------------------------
 -- Table structure for menu
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."menu";
 CREATE TABLE "public"."menu" (
   "id" int8 NOT NULL DEFAULT nextval('menu_id_seq'::regclass),
   "menu_id" uuid NOT NULL DEFAULT gen_random_uuid(),
@@ -239,7 +263,6 @@ CREATE TABLE "public"."menu" (
   "deleted_at" timestamptz(6)
 )
 ;
-ALTER TABLE "public"."menu" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."menu"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."menu"."menu_id" IS '菜单业务唯一UUID';
 COMMENT ON COLUMN "public"."menu"."parent_id" IS '父菜单UUID（用于构建菜单树）';
@@ -261,7 +284,6 @@ COMMENT ON TABLE "public"."menu" IS '菜单表，存储前端菜单和页面配
 -- ----------------------------
 -- Table structure for permission
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."permission";
 CREATE TABLE "public"."permission" (
   "id" int8 NOT NULL DEFAULT nextval('permission_id_seq'::regclass),
   "permission_id" uuid NOT NULL DEFAULT gen_random_uuid(),
@@ -274,23 +296,26 @@ CREATE TABLE "public"."permission" (
   "parent_id" uuid,
   "path" varchar(500) COLLATE "pg_catalog"."default",
   "status" int2 NOT NULL DEFAULT 0,
+  "conditions" jsonb,
+  "stale" bool NOT NULL DEFAULT false,
   "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
   "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
   "deleted_at" timestamptz(6)
 )
 ;
-ALTER TABLE "public"."permission" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."permission"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."permission"."permission_id" IS '权限业务唯一UUID';
 COMMENT ON COLUMN "public"."permission"."permission_name" IS '权限名称';
 COMMENT ON COLUMN "public"."permission"."permission_code" IS '权限编码（唯一标识）';
-COMMENT ON COLUMN "public"."permission"."resource_type" IS '资源类型（menu=菜单, button=按钮）';
+COMMENT ON COLUMN "public"."permission"."resource_type" IS '资源类型（menu=菜单, button=按钮, api=接口）';
 COMMENT ON COLUMN "public"."permission"."resource_path" IS '资源路径（如 /system/user/list）';
 COMMENT ON COLUMN "public"."permission"."action" IS 'HTTP动词或自定义操作（GET/POST/export等）';
 COMMENT ON COLUMN "public"."permission"."description" IS '权限描述';
 COMMENT ON COLUMN "public"."permission"."parent_id" IS '父权限UUID（用于构建权限树）';
 COMMENT ON COLUMN "public"."permission"."path" IS '全路径（用于树形查询优化）';
 COMMENT ON COLUMN "public"."permission"."status" IS '权限状态（0=启用,1=禁用）';
+COMMENT ON COLUMN "public"."permission"."stale" IS '自动发现的API路由是否已不存在（true=已失效）';
+COMMENT ON COLUMN "public"."permission"."conditions" IS 'ABAC生效条件（JSON：IP网段、星期/小时窗口、请求属性，NULL=无条件）';
 COMMENT ON COLUMN "public"."permission"."created_at" IS '创建时间';
 COMMENT ON COLUMN "public"."permission"."updated_at" IS '更新时间';
 COMMENT ON COLUMN "public"."permission"."deleted_at" IS '软删除时间（NULL=未删除）';
@@ -299,7 +324,6 @@ COMMENT ON TABLE "public"."permission" IS '权限表，存储系统资源和操
 -- ----------------------------
 -- Table structure for role
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."role";
 CREATE TABLE "public"."role" (
   "id" int8 NOT NULL DEFAULT nextval('role_id_seq'::regclass),
   "role_id" uuid NOT NULL DEFAULT gen_random_uuid(),
@@ -308,12 +332,13 @@ CREATE TABLE "public"."role" (
   "description" varchar(200) COLLATE "pg_catalog"."default",
   "status" int2 NOT NULL DEFAULT 0,
   "sort_order" int4 NOT NULL DEFAULT 0,
+  "sensitive" bool NOT NULL DEFAULT false,
+  "approver_role_ids" text COLLATE "pg_catalog"."default",
   "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
   "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
   "deleted_at" timestamptz(6)
 )
 ;
-ALTER TABLE "public"."role" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."role"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."role"."role_id" IS '角色业务唯一UUID';
 COMMENT ON COLUMN "public"."role"."role_name" IS '角色名称';
@@ -321,6 +346,8 @@ COMMENT ON COLUMN "public"."role"."role_code" IS '角色编码（唯一标识，
 COMMENT ON COLUMN "public"."role"."description" IS '角色描述';
 COMMENT ON COLUMN "public"."role"."status" IS '角色状态（0=启用,1=禁用）';
 COMMENT ON COLUMN "public"."role"."sort_order" IS '排序序号';
+COMMENT ON COLUMN "public"."role"."sensitive" IS '是否为敏感角色（授予时需要审批，super_admin 始终视为敏感角色）';
+COMMENT ON COLUMN "public"."role"."approver_role_ids" IS '可审批该角色授权申请的角色ID列表（JSON数组，为空时仅 super_admin 可审批）';
 COMMENT ON COLUMN "public"."role"."created_at" IS '创建时间';
 COMMENT ON COLUMN "public"."role"."updated_at" IS '更新时间';
 COMMENT ON COLUMN "public"."role"."deleted_at" IS '软删除时间（NULL=未删除）';
@@ -329,27 +356,28 @@ COMMENT ON TABLE "public"."role" IS '角色表，存储系统角色信息';
 -- ----------------------------
 -- Table structure for role_permission
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."role_permission";
 CREATE TABLE "public"."role_permission" (
   "id" int8 NOT NULL DEFAULT nextval('role_permission_id_seq'::regclass),
   "role_id" uuid NOT NULL,
   "permission_id" uuid NOT NULL,
   "version" int4 NOT NULL DEFAULT 1,
-  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "starts_at" timestamptz(6),
+  "expires_at" timestamptz(6)
 )
 ;
-ALTER TABLE "public"."role_permission" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."role_permission"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."role_permission"."role_id" IS '角色UUID（外键）';
 COMMENT ON COLUMN "public"."role_permission"."permission_id" IS '权限UUID（外键）';
 COMMENT ON COLUMN "public"."role_permission"."version" IS '乐观锁版本号';
 COMMENT ON COLUMN "public"."role_permission"."created_at" IS '创建时间';
+COMMENT ON COLUMN "public"."role_permission"."starts_at" IS '生效时间（为空表示立即生效）';
+COMMENT ON COLUMN "public"."role_permission"."expires_at" IS '过期时间（为空表示永久有效，过期后由后台任务自动回收）';
 COMMENT ON TABLE "public"."role_permission" IS '角色权限关联表，实现角色与权限的多对多关系';
 
 -- ----------------------------
 -- Table structure for user
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."user";
 CREATE TABLE "public"."user" (
   "id" int8 NOT NULL DEFAULT nextval('user_id_seq'::regclass),
   "user_id" uuid NOT NULL DEFAULT gen_random_uuid(),
@@ -364,10 +392,10 @@ CREATE TABLE "public"."user" (
   "last_login_at" timestamptz(6),
   "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
   "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  "description" text COLLATE "pg_catalog"."default"
+  "description" text COLLATE "pg_catalog"."default",
+  "deleted_at" timestamptz(6)
 )
 ;
-ALTER TABLE "public"."user" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."user"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."user"."user_id" IS '用户业务唯一UUID';
 COMMENT ON COLUMN "public"."user"."username" IS '用户名（唯一，登录用）';
@@ -377,17 +405,17 @@ COMMENT ON COLUMN "public"."user"."phone" IS '手机号（唯一）';
 COMMENT ON COLUMN "public"."user"."avatar" IS '头像URL';
 COMMENT ON COLUMN "public"."user"."nickname" IS '用户昵称';
 COMMENT ON COLUMN "public"."user"."gender" IS '性别（0=未知,1=男,2=女）';
-COMMENT ON COLUMN "public"."user"."status" IS '用户状态（0=活跃,1=禁用）';
+COMMENT ON COLUMN "public"."user"."status" IS '用户状态（0=活跃,1=禁用,2=待审核）';
 COMMENT ON COLUMN "public"."user"."last_login_at" IS '最后登录时间';
 COMMENT ON COLUMN "public"."user"."created_at" IS '创建时间';
 COMMENT ON COLUMN "public"."user"."updated_at" IS '更新时间';
 COMMENT ON COLUMN "public"."user"."description" IS '用户描述/简介';
+COMMENT ON COLUMN "public"."user"."deleted_at" IS '软删除时间（NULL=未删除）';
 COMMENT ON TABLE "public"."user" IS '用户表，存储用户认证信息、基本资料和应用扩展';
 
 -- ----------------------------
 -- Table structure for user_config
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."user_config";
 CREATE TABLE "public"."user_config" (
   "id" int8 NOT NULL DEFAULT nextval('user_config_id_seq'::regclass),
   "user_id" uuid NOT NULL,
@@ -397,7 +425,6 @@ CREATE TABLE "public"."user_config" (
   "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
 )
 ;
-ALTER TABLE "public"."user_config" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."user_config"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."user_config"."user_id" IS '用户UUID（外键）';
 COMMENT ON COLUMN "public"."user_config"."config_key" IS '配置键名（唯一组合）';
@@ -409,7 +436,6 @@ COMMENT ON TABLE "public"."user_config" IS '用户个人配置表，存储用户
 -- ----------------------------
 -- Table structure for user_login_log
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."user_login_log";
 CREATE TABLE "public"."user_login_log" (
   "id" int8 NOT NULL DEFAULT nextval('user_login_log_id_seq'::regclass),
   "username" varchar(50) COLLATE "pg_catalog"."default",
@@ -420,7 +446,6 @@ CREATE TABLE "public"."user_login_log" (
   "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
 )
 ;
-ALTER TABLE "public"."user_login_log" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."user_login_log"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."user_login_log"."username" IS '登录用户名';
 COMMENT ON COLUMN "public"."user_login_log"."ip_address" IS '登录IP地址';
@@ -433,190 +458,286 @@ COMMENT ON TABLE "public"."user_login_log" IS '用户登录日志表，记录登
 -- ----------------------------
 -- Table structure for user_role
 -- ----------------------------
-DROP TABLE IF EXISTS "public"."user_role";
 CREATE TABLE "public"."user_role" (
   "id" int8 NOT NULL DEFAULT nextval('user_role_id_seq'::regclass),
   "user_id" uuid NOT NULL,
   "role_id" uuid NOT NULL,
-  "assigned_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+  "assigned_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "starts_at" timestamptz(6),
+  "expires_at" timestamptz(6)
 )
 ;
-ALTER TABLE "public"."user_role" OWNER TO "postgres";
 COMMENT ON COLUMN "public"."user_role"."id" IS '内部主键ID（自增序列）';
 COMMENT ON COLUMN "public"."user_role"."user_id" IS '用户UUID（外键）';
 COMMENT ON COLUMN "public"."user_role"."role_id" IS '角色UUID（外键）';
 COMMENT ON COLUMN "public"."user_role"."assigned_at" IS '分配时间';
+COMMENT ON COLUMN "public"."user_role"."starts_at" IS '生效时间（为空表示立即生效）';
+COMMENT ON COLUMN "public"."user_role"."expires_at" IS '过期时间（为空表示永久有效，过期后由后台任务自动回收）';
 COMMENT ON TABLE "public"."user_role" IS '用户角色关联表，实现用户与角色的多对多关系';
 
 -- ----------------------------
--- Function structure for uuid_generate_v1
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_generate_v1"();
-CREATE FUNCTION "public"."uuid_generate_v1"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_generate_v1'
-  LANGUAGE c VOLATILE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_generate_v1"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_generate_v1mc
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_generate_v1mc"();
-CREATE FUNCTION "public"."uuid_generate_v1mc"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_generate_v1mc'
-  LANGUAGE c VOLATILE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_generate_v1mc"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_generate_v3
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_generate_v3"("namespace" uuid, "name" text);
-CREATE FUNCTION "public"."uuid_generate_v3"("namespace" uuid, "name" text)
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_generate_v3'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_generate_v3"("namespace" uuid, "name" text) OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_generate_v4
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_generate_v4"();
-CREATE FUNCTION "public"."uuid_generate_v4"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_generate_v4'
-  LANGUAGE c VOLATILE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_generate_v4"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_generate_v5
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_generate_v5"("namespace" uuid, "name" text);
-CREATE FUNCTION "public"."uuid_generate_v5"("namespace" uuid, "name" text)
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_generate_v5'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_generate_v5"("namespace" uuid, "name" text) OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_nil
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_nil"();
-CREATE FUNCTION "public"."uuid_nil"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_nil'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_nil"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_ns_dns
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_ns_dns"();
-CREATE FUNCTION "public"."uuid_ns_dns"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_ns_dns'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_ns_dns"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_ns_oid
+-- Table structure for user_session
 -- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_ns_oid"();
-CREATE FUNCTION "public"."uuid_ns_oid"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_ns_oid'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_ns_oid"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_ns_url
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_ns_url"();
-CREATE FUNCTION "public"."uuid_ns_url"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_ns_url'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_ns_url"() OWNER TO "postgres";
-
--- ----------------------------
--- Function structure for uuid_ns_x500
--- ----------------------------
-DROP FUNCTION IF EXISTS "public"."uuid_ns_x500"();
-CREATE FUNCTION "public"."uuid_ns_x500"()
-  RETURNS "pg_catalog"."uuid" AS '$libdir/uuid-ossp', 'uuid_ns_x500'
-  LANGUAGE c IMMUTABLE STRICT
-  COST 1;
-ALTER FUNCTION "public"."uuid_ns_x500"() OWNER TO "postgres";
+CREATE TABLE "public"."user_session" (
+  "id" int8 NOT NULL DEFAULT nextval('user_session_id_seq'::regclass),
+  "session_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "device_name" varchar(100) COLLATE "pg_catalog"."default",
+  "ip_address" varchar(64) COLLATE "pg_catalog"."default",
+  "user_agent" varchar(1000) COLLATE "pg_catalog"."default",
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "last_seen_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "expires_at" timestamptz(6) NOT NULL,
+  "revoked_at" timestamptz(6)
+)
+;
+COMMENT ON COLUMN "public"."user_session"."id" IS '内部主键ID（自增序列）';
+COMMENT ON COLUMN "public"."user_session"."session_id" IS '会话业务唯一UUID（对应 token 中的 sid）';
+COMMENT ON COLUMN "public"."user_session"."user_id" IS '用户UUID（外键）';
+COMMENT ON COLUMN "public"."user_session"."device_name" IS '设备名称';
+COMMENT ON COLUMN "public"."user_session"."ip_address" IS '登录IP地址';
+COMMENT ON COLUMN "public"."user_session"."user_agent" IS '用户代理字符串';
+COMMENT ON COLUMN "public"."user_session"."created_at" IS '创建时间（登录时间）';
+COMMENT ON COLUMN "public"."user_session"."last_seen_at" IS '最近活跃时间';
+COMMENT ON COLUMN "public"."user_session"."expires_at" IS '过期时间（与 Refresh Token 过期时间一致）';
+COMMENT ON COLUMN "public"."user_session"."revoked_at" IS '撤销时间（NULL=未撤销）';
+COMMENT ON TABLE "public"."user_session" IS '用户会话表，每次登录（及其刷新令牌族）对应一条会话记录';
+
+-- ----------------------------
+-- Table structure for invitation
+-- ----------------------------
+CREATE TABLE "public"."invitation" (
+  "id" int8 NOT NULL DEFAULT nextval('invitation_id_seq'::regclass),
+  "code" varchar(32) COLLATE "pg_catalog"."default",
+  "role_ids" text COLLATE "pg_catalog"."default",
+  "max_uses" int4 NOT NULL DEFAULT 1,
+  "used_count" int4 NOT NULL DEFAULT 0,
+  "expires_at" timestamptz(6),
+  "revoked_at" timestamptz(6),
+  "remark" varchar(255) COLLATE "pg_catalog"."default",
+  "created_by" uuid,
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+)
+;
+COMMENT ON COLUMN "public"."invitation"."id" IS '内部主键ID（自增序列）';
+COMMENT ON COLUMN "public"."invitation"."code" IS '邀请码（唯一，由 id 经 pkg/id.NewCode 生成）';
+COMMENT ON COLUMN "public"."invitation"."role_ids" IS '注册后预分配的角色ID列表（JSON数组）';
+COMMENT ON COLUMN "public"."invitation"."max_uses" IS '最大使用次数';
+COMMENT ON COLUMN "public"."invitation"."used_count" IS '已使用次数';
+COMMENT ON COLUMN "public"."invitation"."expires_at" IS '过期时间（NULL=永不过期）';
+COMMENT ON COLUMN "public"."invitation"."revoked_at" IS '撤销时间（NULL=未撤销）';
+COMMENT ON COLUMN "public"."invitation"."remark" IS '备注';
+COMMENT ON COLUMN "public"."invitation"."created_by" IS '创建人用户UUID';
+COMMENT ON COLUMN "public"."invitation"."created_at" IS '创建时间';
+COMMENT ON COLUMN "public"."invitation"."updated_at" IS '更新时间';
+COMMENT ON TABLE "public"."invitation" IS '注册邀请码表';
+
+-- ----------------------------
+-- Table structure for user_identity
+-- ----------------------------
+CREATE TABLE "public"."user_identity" (
+  "id" int8 NOT NULL DEFAULT nextval('user_identity_id_seq'::regclass),
+  "user_id" uuid NOT NULL,
+  "provider" varchar(64) COLLATE "pg_catalog"."default" NOT NULL,
+  "subject" varchar(255) COLLATE "pg_catalog"."default" NOT NULL,
+  "email" varchar(255) COLLATE "pg_catalog"."default",
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "last_login_at" timestamptz(6)
+)
+;
+COMMENT ON COLUMN "public"."user_identity"."id" IS '内部主键ID（自增序列）';
+COMMENT ON COLUMN "public"."user_identity"."user_id" IS '用户UUID（外键）';
+COMMENT ON COLUMN "public"."user_identity"."provider" IS 'OIDC Provider 名称';
+COMMENT ON COLUMN "public"."user_identity"."subject" IS 'IdP 中的用户唯一标识（ID Token 的 sub）';
+COMMENT ON COLUMN "public"."user_identity"."email" IS 'IdP 返回的邮箱';
+COMMENT ON COLUMN "public"."user_identity"."created_at" IS '创建时间（关联时间）';
+COMMENT ON COLUMN "public"."user_identity"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "public"."user_identity"."last_login_at" IS '最近一次通过该身份登录的时间';
+COMMENT ON TABLE "public"."user_identity" IS '用户外部身份表，记录本地用户与 IdP 身份的关联关系';
+
+-- ----------------------------
+-- Table structure for oidc_auth_state
+-- ----------------------------
+CREATE TABLE "public"."oidc_auth_state" (
+  "id" int8 NOT NULL DEFAULT nextval('oidc_auth_state_id_seq'::regclass),
+  "state" varchar(64) COLLATE "pg_catalog"."default" NOT NULL,
+  "provider" varchar(64) COLLATE "pg_catalog"."default" NOT NULL,
+  "code_verifier" varchar(128) COLLATE "pg_catalog"."default" NOT NULL,
+  "nonce" varchar(64) COLLATE "pg_catalog"."default" NOT NULL,
+  "link_user_id" uuid,
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "expires_at" timestamptz(6) NOT NULL
+)
+;
+COMMENT ON COLUMN "public"."oidc_auth_state"."id" IS '内部主键ID（自增序列）';
+COMMENT ON COLUMN "public"."oidc_auth_state"."state" IS 'OAuth2 state 参数（唯一）';
+COMMENT ON COLUMN "public"."oidc_auth_state"."provider" IS 'OIDC Provider 名称';
+COMMENT ON COLUMN "public"."oidc_auth_state"."code_verifier" IS 'PKCE code_verifier';
+COMMENT ON COLUMN "public"."oidc_auth_state"."nonce" IS 'ID Token nonce';
+COMMENT ON COLUMN "public"."oidc_auth_state"."link_user_id" IS '关联身份流程的发起用户UUID（NULL=登录流程）';
+COMMENT ON COLUMN "public"."oidc_auth_state"."created_at" IS '创建时间';
+COMMENT ON COLUMN "public"."oidc_auth_state"."expires_at" IS '过期时间';
+COMMENT ON TABLE "public"."oidc_auth_state" IS 'OIDC 登录流程状态表，回调时一次性消费';
+
+-- ----------------------------
+-- Table structure for access_request
+-- ----------------------------
+CREATE TABLE "public"."access_request" (
+  "id" int8 NOT NULL DEFAULT nextval('access_request_id_seq'::regclass),
+  "request_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "role_id" uuid NOT NULL,
+  "requester_id" uuid NOT NULL,
+  "reason" varchar(255) COLLATE "pg_catalog"."default",
+  "status" varchar(16) COLLATE "pg_catalog"."default" NOT NULL DEFAULT 'pending'::character varying,
+  "grant_starts_at" timestamptz(6),
+  "grant_expires_at" timestamptz(6),
+  "reviewer_id" uuid,
+  "review_comment" varchar(255) COLLATE "pg_catalog"."default",
+  "reviewed_at" timestamptz(6),
+  "expires_at" timestamptz(6) NOT NULL,
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+)
+;
+COMMENT ON COLUMN "public"."access_request"."id" IS '内部主键ID（自增序列）';
+COMMENT ON COLUMN "public"."access_request"."request_id" IS '授权申请业务唯一UUID';
+COMMENT ON COLUMN "public"."access_request"."user_id" IS '被授予角色的用户UUID';
+COMMENT ON COLUMN "public"."access_request"."role_id" IS '申请授予的角色UUID';
+COMMENT ON COLUMN "public"."access_request"."requester_id" IS '发起申请的用户UUID';
+COMMENT ON COLUMN "public"."access_request"."reason" IS '申请理由';
+COMMENT ON COLUMN "public"."access_request"."status" IS '申请状态（pending=待审批,approved=已批准,rejected=已拒绝,expired=已过期）';
+COMMENT ON COLUMN "public"."access_request"."grant_starts_at" IS '批准后角色的生效时间（NULL=批准后立即生效）';
+COMMENT ON COLUMN "public"."access_request"."grant_expires_at" IS '批准后角色的过期时间（NULL=永久有效）';
+COMMENT ON COLUMN "public"."access_request"."reviewer_id" IS '审批人用户UUID';
+COMMENT ON COLUMN "public"."access_request"."review_comment" IS '审批意见';
+COMMENT ON COLUMN "public"."access_request"."reviewed_at" IS '审批时间';
+COMMENT ON COLUMN "public"."access_request"."expires_at" IS '申请过期时间，过期后未审批的申请由后台任务标记为已过期';
+COMMENT ON COLUMN "public"."access_request"."created_at" IS '创建时间';
+COMMENT ON COLUMN "public"."access_request"."updated_at" IS '更新时间';
+COMMENT ON TABLE "public"."access_request" IS '敏感角色授权申请表';
+
+-- ----------------------------
+-- Table structure for sod_rule
+-- ----------------------------
+CREATE TABLE "public"."sod_rule" (
+  "id" int8 NOT NULL DEFAULT nextval('sod_rule_id_seq'::regclass),
+  "rule_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "name" varchar(50) COLLATE "pg_catalog"."default" NOT NULL,
+  "description" varchar(200) COLLATE "pg_catalog"."default",
+  "role_ids" text COLLATE "pg_catalog"."default" NOT NULL,
+  "max_roles" int4 NOT NULL DEFAULT 1,
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+)
+;
+COMMENT ON COLUMN "public"."sod_rule"."id" IS '内部主键ID（自增序列）';
+COMMENT ON COLUMN "public"."sod_rule"."rule_id" IS '职责分离规则业务唯一UUID';
+COMMENT ON COLUMN "public"."sod_rule"."name" IS '规则名称（唯一）';
+COMMENT ON COLUMN "public"."sod_rule"."description" IS '规则描述';
+COMMENT ON COLUMN "public"."sod_rule"."role_ids" IS '互斥的角色ID集合（JSON数组）';
+COMMENT ON COLUMN "public"."sod_rule"."max_roles" IS '同一用户最多可同时持有集合中的角色数量';
+COMMENT ON COLUMN "public"."sod_rule"."created_at" IS '创建时间';
+COMMENT ON COLUMN "public"."sod_rule"."updated_at" IS '更新时间';
+COMMENT ON TABLE "public"."sod_rule" IS '静态职责分离规则表，限制同一用户同时持有互斥角色';
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."audit_log_id_seq"
 OWNED BY "public"."audit_log"."id";
-SELECT setval('"public"."audit_log_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."casbin_rule_id_seq"
 OWNED BY "public"."casbin_rule"."id";
-SELECT setval('"public"."casbin_rule_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."menu_id_seq"
 OWNED BY "public"."menu"."id";
-SELECT setval('"public"."menu_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."permission_id_seq"
 OWNED BY "public"."permission"."id";
-SELECT setval('"public"."permission_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."role_id_seq"
 OWNED BY "public"."role"."id";
-SELECT setval('"public"."role_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."role_permission_id_seq"
 OWNED BY "public"."role_permission"."id";
-SELECT setval('"public"."role_permission_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."user_config_id_seq"
 OWNED BY "public"."user_config"."id";
-SELECT setval('"public"."user_config_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."user_id_seq"
 OWNED BY "public"."user"."id";
-SELECT setval('"public"."user_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."user_login_log_id_seq"
 OWNED BY "public"."user_login_log"."id";
-SELECT setval('"public"."user_login_log_id_seq"', 1, false);
 
 -- ----------------------------
 -- Alter sequences owned by
 -- ----------------------------
 ALTER SEQUENCE "public"."user_role_id_seq"
 OWNED BY "public"."user_role"."id";
-SELECT setval('"public"."user_role_id_seq"', 1, false);
+
+-- ----------------------------
+-- Alter sequences owned by
+-- ----------------------------
+ALTER SEQUENCE "public"."user_session_id_seq"
+OWNED BY "public"."user_session"."id";
+
+-- ----------------------------
+-- Alter sequences owned by
+-- ----------------------------
+ALTER SEQUENCE "public"."invitation_id_seq"
+OWNED BY "public"."invitation"."id";
+
+-- ----------------------------
+-- Alter sequences owned by
+-- ----------------------------
+ALTER SEQUENCE "public"."user_identity_id_seq"
+OWNED BY "public"."user_identity"."id";
+
+-- ----------------------------
+-- Alter sequences owned by
+-- ----------------------------
+ALTER SEQUENCE "public"."oidc_auth_state_id_seq"
+OWNED BY "public"."oidc_auth_state"."id";
+
+-- ----------------------------
+-- Alter sequences owned by
+-- ----------------------------
+ALTER SEQUENCE "public"."access_request_id_seq"
+OWNED BY "public"."access_request"."id";
+
+-- ----------------------------
+-- Alter sequences owned by
+-- ----------------------------
+ALTER SEQUENCE "public"."sod_rule_id_seq"
+OWNED BY "public"."sod_rule"."id";
 
 -- ----------------------------
 -- Indexes structure for table audit_log
@@ -627,6 +748,9 @@ CREATE INDEX "idx_audit_log_created_at" ON "public"."audit_log" USING btree (
 CREATE INDEX "idx_audit_log_user_id" ON "public"."audit_log" USING btree (
   "user_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
 );
+CREATE INDEX "idx_audit_log_actor_id" ON "public"."audit_log" USING btree (
+  "actor_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
+) WHERE "actor_id" IS NOT NULL;
 
 -- ----------------------------
 -- Primary Key structure for table audit_log
@@ -660,7 +784,10 @@ ALTER TABLE "public"."casbin_rule" ADD CONSTRAINT "casbin_rule_pkey" PRIMARY KEY
 CREATE INDEX "idx_menu_active" ON "public"."menu" USING btree (
   "created_at" "pg_catalog"."timestamptz_ops" DESC NULLS FIRST
 ) WHERE deleted_at IS NULL;
-CREATE INDEX "idx_menu_code" ON "public"."menu" USING btree (
+CREATE INDEX "idx_menu_deleted_at" ON "public"."menu" USING btree (
+  "deleted_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_menu_code" ON "public"."menu" USING btree (
   "menu_code" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST
 ) WHERE deleted_at IS NULL;
 CREATE INDEX "idx_menu_parent_id" ON "public"."menu" USING btree (
@@ -690,7 +817,6 @@ CREATE INDEX "idx_menu_visible" ON "public"."menu" USING btree (
 -- Uniques structure for table menu
 -- ----------------------------
 ALTER TABLE "public"."menu" ADD CONSTRAINT "menu_menu_id_key" UNIQUE ("menu_id");
-ALTER TABLE "public"."menu" ADD CONSTRAINT "menu_menu_code_key" UNIQUE ("menu_code");
 
 -- ----------------------------
 -- Primary Key structure for table menu
@@ -703,7 +829,10 @@ ALTER TABLE "public"."menu" ADD CONSTRAINT "menu_pkey" PRIMARY KEY ("id");
 CREATE INDEX "idx_permission_active" ON "public"."permission" USING btree (
   "created_at" "pg_catalog"."timestamptz_ops" DESC NULLS FIRST
 ) WHERE deleted_at IS NULL;
-CREATE INDEX "idx_permission_code" ON "public"."permission" USING btree (
+CREATE INDEX "idx_permission_deleted_at" ON "public"."permission" USING btree (
+  "deleted_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_permission_code" ON "public"."permission" USING btree (
   "permission_code" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST
 ) WHERE deleted_at IS NULL;
 CREATE INDEX "idx_permission_parent_id" ON "public"."permission" USING btree (
@@ -723,7 +852,6 @@ CREATE INDEX "idx_permission_status" ON "public"."permission" USING btree (
 -- Uniques structure for table permission
 -- ----------------------------
 ALTER TABLE "public"."permission" ADD CONSTRAINT "permission_permission_id_key" UNIQUE ("permission_id");
-ALTER TABLE "public"."permission" ADD CONSTRAINT "permission_permission_code_key" UNIQUE ("permission_code");
 
 -- ----------------------------
 -- Primary Key structure for table permission
@@ -736,7 +864,10 @@ ALTER TABLE "public"."permission" ADD CONSTRAINT "permission_pkey" PRIMARY KEY (
 CREATE INDEX "idx_role_active" ON "public"."role" USING btree (
   "created_at" "pg_catalog"."timestamptz_ops" DESC NULLS FIRST
 ) WHERE deleted_at IS NULL;
-CREATE INDEX "idx_role_code" ON "public"."role" USING btree (
+CREATE INDEX "idx_role_deleted_at" ON "public"."role" USING btree (
+  "deleted_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_role_code" ON "public"."role" USING btree (
   "role_code" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST
 ) WHERE deleted_at IS NULL;
 CREATE INDEX "idx_role_status" ON "public"."role" USING btree (
@@ -747,7 +878,6 @@ CREATE INDEX "idx_role_status" ON "public"."role" USING btree (
 -- Uniques structure for table role
 -- ----------------------------
 ALTER TABLE "public"."role" ADD CONSTRAINT "role_role_id_key" UNIQUE ("role_id");
-ALTER TABLE "public"."role" ADD CONSTRAINT "role_role_code_key" UNIQUE ("role_code");
 
 -- ----------------------------
 -- Primary Key structure for table role
@@ -763,6 +893,12 @@ CREATE INDEX "idx_role_permission_permission_id" ON "public"."role_permission" U
 CREATE INDEX "idx_role_permission_role_id" ON "public"."role_permission" USING btree (
   "role_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
 );
+CREATE INDEX "idx_role_permission_expires_at" ON "public"."role_permission" USING btree (
+  "expires_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE "expires_at" IS NOT NULL;
+CREATE INDEX "idx_role_permission_starts_at" ON "public"."role_permission" USING btree (
+  "starts_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE "starts_at" IS NOT NULL;
 
 -- ----------------------------
 -- Uniques structure for table role_permission
@@ -777,12 +913,18 @@ ALTER TABLE "public"."role_permission" ADD CONSTRAINT "role_permission_pkey" PRI
 -- ----------------------------
 -- Indexes structure for table user
 -- ----------------------------
-CREATE INDEX "idx_user_email" ON "public"."user" USING btree (
+CREATE INDEX "idx_user_deleted_at" ON "public"."user" USING btree (
+  "deleted_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_user_email" ON "public"."user" USING btree (
   "email" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST
-) WHERE email IS NOT NULL;
-CREATE INDEX "idx_user_phone" ON "public"."user" USING btree (
+) WHERE email IS NOT NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX "uk_user_phone" ON "public"."user" USING btree (
   "phone" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST
-) WHERE phone IS NOT NULL;
+) WHERE phone IS NOT NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX "uk_user_username" ON "public"."user" USING btree (
+  "username" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST
+) WHERE deleted_at IS NULL;
 CREATE INDEX "idx_user_status" ON "public"."user" USING btree (
   "status" "pg_catalog"."int2_ops" ASC NULLS LAST
 );
@@ -795,9 +937,6 @@ CREATE INDEX "idx_user_status_last_login" ON "public"."user" USING btree (
 -- Uniques structure for table user
 -- ----------------------------
 ALTER TABLE "public"."user" ADD CONSTRAINT "uni_user_user_id" UNIQUE ("user_id");
-ALTER TABLE "public"."user" ADD CONSTRAINT "user_username_key" UNIQUE ("username");
-ALTER TABLE "public"."user" ADD CONSTRAINT "user_email_key" UNIQUE ("email");
-ALTER TABLE "public"."user" ADD CONSTRAINT "user_phone_key" UNIQUE ("phone");
 
 -- ----------------------------
 -- Primary Key structure for table user
@@ -846,6 +985,12 @@ ALTER TABLE "public"."user_login_log" ADD CONSTRAINT "user_login_log_pkey" PRIMA
 CREATE INDEX "idx_user_role_role_id" ON "public"."user_role" USING btree (
   "role_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
 );
+CREATE INDEX "idx_user_role_expires_at" ON "public"."user_role" USING btree (
+  "expires_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE "expires_at" IS NOT NULL;
+CREATE INDEX "idx_user_role_starts_at" ON "public"."user_role" USING btree (
+  "starts_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+) WHERE "starts_at" IS NOT NULL;
 CREATE INDEX "idx_user_role_user_id" ON "public"."user_role" USING btree (
   "user_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
 );
@@ -860,6 +1005,105 @@ ALTER TABLE "public"."user_role" ADD CONSTRAINT "user_role_user_id_role_id_key"
 -- ----------------------------
 ALTER TABLE "public"."user_role" ADD CONSTRAINT "user_role_pkey" PRIMARY KEY ("id");
 
+-- ----------------------------
+-- Indexes structure for table user_session
+-- ----------------------------
+CREATE INDEX "idx_user_session_user_id" ON "public"."user_session" USING btree (
+  "user_id" "pg_catalog"."uuid_ops" ASC NULLS LAST,
+  "last_seen_at" "pg_catalog"."timestamptz_ops" DESC NULLS FIRST
+);
+CREATE INDEX "idx_user_session_active" ON "public"."user_session" USING btree (
+  "user_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
+) WHERE revoked_at IS NULL;
+
+-- ----------------------------
+-- Uniques structure for table user_session
+-- ----------------------------
+ALTER TABLE "public"."user_session" ADD CONSTRAINT "user_session_session_id_key" UNIQUE ("session_id");
+
+-- ----------------------------
+-- Primary Key structure for table user_session
+-- ----------------------------
+ALTER TABLE "public"."user_session" ADD CONSTRAINT "user_session_pkey" PRIMARY KEY ("id");
+
+-- ----------------------------
+-- Uniques structure for table invitation
+-- ----------------------------
+ALTER TABLE "public"."invitation" ADD CONSTRAINT "invitation_code_key" UNIQUE ("code");
+
+-- ----------------------------
+-- Primary Key structure for table invitation
+-- ----------------------------
+ALTER TABLE "public"."invitation" ADD CONSTRAINT "invitation_pkey" PRIMARY KEY ("id");
+
+-- ----------------------------
+-- Indexes structure for table user_identity
+-- ----------------------------
+CREATE INDEX "idx_user_identity_user_id" ON "public"."user_identity" USING btree (
+  "user_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
+);
+
+-- ----------------------------
+-- Uniques structure for table user_identity
+-- ----------------------------
+ALTER TABLE "public"."user_identity" ADD CONSTRAINT "user_identity_provider_subject_key" UNIQUE ("provider", "subject");
+ALTER TABLE "public"."user_identity" ADD CONSTRAINT "user_identity_user_id_provider_key" UNIQUE ("user_id", "provider");
+
+-- ----------------------------
+-- Primary Key structure for table user_identity
+-- ----------------------------
+ALTER TABLE "public"."user_identity" ADD CONSTRAINT "user_identity_pkey" PRIMARY KEY ("id");
+
+-- ----------------------------
+-- Indexes structure for table oidc_auth_state
+-- ----------------------------
+CREATE INDEX "idx_oidc_auth_state_expires_at" ON "public"."oidc_auth_state" USING btree (
+  "expires_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+);
+
+-- ----------------------------
+-- Uniques structure for table oidc_auth_state
+-- ----------------------------
+ALTER TABLE "public"."oidc_auth_state" ADD CONSTRAINT "oidc_auth_state_state_key" UNIQUE ("state");
+
+-- ----------------------------
+-- Primary Key structure for table oidc_auth_state
+-- ----------------------------
+ALTER TABLE "public"."oidc_auth_state" ADD CONSTRAINT "oidc_auth_state_pkey" PRIMARY KEY ("id");
+
+-- ----------------------------
+-- Indexes structure for table access_request
+-- ----------------------------
+CREATE INDEX "idx_access_request_status_expires_at" ON "public"."access_request" USING btree (
+  "status" COLLATE "pg_catalog"."default" "pg_catalog"."text_ops" ASC NULLS LAST,
+  "expires_at" "pg_catalog"."timestamptz_ops" ASC NULLS LAST
+);
+CREATE UNIQUE INDEX "uk_access_request_pending" ON "public"."access_request" USING btree (
+  "user_id" "pg_catalog"."uuid_ops" ASC NULLS LAST,
+  "role_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
+) WHERE status::text = 'pending'::text;
+
+-- ----------------------------
+-- Uniques structure for table access_request
+-- ----------------------------
+ALTER TABLE "public"."access_request" ADD CONSTRAINT "access_request_request_id_key" UNIQUE ("request_id");
+
+-- ----------------------------
+-- Primary Key structure for table access_request
+-- ----------------------------
+ALTER TABLE "public"."access_request" ADD CONSTRAINT "access_request_pkey" PRIMARY KEY ("id");
+
+-- ----------------------------
+-- Uniques structure for table sod_rule
+-- ----------------------------
+ALTER TABLE "public"."sod_rule" ADD CONSTRAINT "sod_rule_rule_id_key" UNIQUE ("rule_id");
+ALTER TABLE "public"."sod_rule" ADD CONSTRAINT "sod_rule_name_key" UNIQUE ("name");
+
+-- ----------------------------
+-- Primary Key structure for table sod_rule
+-- ----------------------------
+ALTER TABLE "public"."sod_rule" ADD CONSTRAINT "sod_rule_pkey" PRIMARY KEY ("id");
+
 -- ----------------------------
 -- Foreign Keys structure for table menu
 -- ----------------------------
@@ -882,3 +1126,19 @@ ALTER TABLE "public"."user_config" ADD CONSTRAINT "user_config_user_id_fkey" FOR
 -- ----------------------------
 ALTER TABLE "public"."user_role" ADD CONSTRAINT "user_role_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "public"."role" ("role_id") ON DELETE CASCADE ON UPDATE NO ACTION;
 ALTER TABLE "public"."user_role" ADD CONSTRAINT "user_role_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("user_id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+-- ----------------------------
+-- Foreign Keys structure for table user_session
+-- ----------------------------
+ALTER TABLE "public"."user_session" ADD CONSTRAINT "user_session_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("user_id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+-- ----------------------------
+-- Foreign Keys structure for table user_identity
+-- ----------------------------
+ALTER TABLE "public"."user_identity" ADD CONSTRAINT "user_identity_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("user_id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+-- ----------------------------
+-- Foreign Keys structure for table access_request
+-- ----------------------------
+ALTER TABLE "public"."access_request" ADD CONSTRAINT "access_request_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "public"."role" ("role_id") ON DELETE CASCADE ON UPDATE NO ACTION;
+ALTER TABLE "public"."access_request" ADD CONSTRAINT "access_request_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("user_id") ON DELETE CASCADE ON UPDATE NO ACTION;
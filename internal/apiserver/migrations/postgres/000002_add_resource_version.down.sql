@@ -0,0 +1,7 @@
+-- 删除资源的乐观锁版本号.
+
+ALTER TABLE "public"."sod_rule" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "public"."user" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "public"."role" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "public"."permission" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "public"."menu" DROP COLUMN IF EXISTS "version";
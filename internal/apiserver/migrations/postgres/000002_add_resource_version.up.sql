@@ -0,0 +1,16 @@
+-- 为可修改的资源增加乐观锁版本号，每次更新时递增，更新请求可以携带读取时的版本号作为前置条件.
+
+ALTER TABLE "public"."menu" ADD COLUMN "version" int8 NOT NULL DEFAULT 1;
+COMMENT ON COLUMN "public"."menu"."version" IS '乐观锁版本号（每次更新递增）';
+
+ALTER TABLE "public"."permission" ADD COLUMN "version" int8 NOT NULL DEFAULT 1;
+COMMENT ON COLUMN "public"."permission"."version" IS '乐观锁版本号（每次更新递增）';
+
+ALTER TABLE "public"."role" ADD COLUMN "version" int8 NOT NULL DEFAULT 1;
+COMMENT ON COLUMN "public"."role"."version" IS '乐观锁版本号（每次更新递增）';
+
+ALTER TABLE "public"."user" ADD COLUMN "version" int8 NOT NULL DEFAULT 1;
+COMMENT ON COLUMN "public"."user"."version" IS '乐观锁版本号（每次更新递增）';
+
+ALTER TABLE "public"."sod_rule" ADD COLUMN "version" int8 NOT NULL DEFAULT 1;
+COMMENT ON COLUMN "public"."sod_rule"."version" IS '乐观锁版本号（每次更新递增）';
@@ -0,0 +1,5 @@
+-- 删除领域事件发件箱，序列归属于表的自增列，随表一起删除.
+
+DROP TABLE IF EXISTS "public"."outbox_lease";
+DROP TABLE IF EXISTS "public"."outbox_event";
+DROP SEQUENCE IF EXISTS "public"."outbox_event_id_seq";
@@ -0,0 +1,65 @@
+-- 创建领域事件发件箱. 业务变更和领域事件在同一个事务中写入，由后台任务按 ID 顺序发布到 Kafka.
+
+CREATE SEQUENCE "public"."outbox_event_id_seq"
+INCREMENT 1
+MINVALUE  1
+MAXVALUE 9223372036854775807
+START 1
+CACHE 1;
+COMMENT ON SEQUENCE "public"."outbox_event_id_seq" IS '领域事件发件箱表内部ID序列';
+
+CREATE TABLE "public"."outbox_event" (
+  "id" int8 NOT NULL DEFAULT nextval('outbox_event_id_seq'::regclass),
+  "event_id" uuid NOT NULL,
+  "aggregate_type" varchar(50) COLLATE "pg_catalog"."default" NOT NULL,
+  "aggregate_id" varchar(100) COLLATE "pg_catalog"."default" NOT NULL,
+  "event_type" varchar(100) COLLATE "pg_catalog"."default" NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" int2 NOT NULL DEFAULT 0,
+  "attempts" int4 NOT NULL DEFAULT 0,
+  "last_error" text COLLATE "pg_catalog"."default",
+  "next_attempt_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "published_at" timestamptz(6),
+  "created_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+)
+;
+COMMENT ON COLUMN "public"."outbox_event"."id" IS '内部主键ID（自增序列），决定事件的发布顺序';
+COMMENT ON COLUMN "public"."outbox_event"."event_id" IS '事件UUID，消费者据此去重';
+COMMENT ON COLUMN "public"."outbox_event"."aggregate_type" IS '聚合类型（如user、role）';
+COMMENT ON COLUMN "public"."outbox_event"."aggregate_id" IS '聚合ID，同一聚合的事件按顺序发布';
+COMMENT ON COLUMN "public"."outbox_event"."event_type" IS '事件类型（如user.created）';
+COMMENT ON COLUMN "public"."outbox_event"."payload" IS '事件消息体（JSON格式）';
+COMMENT ON COLUMN "public"."outbox_event"."status" IS '状态（0=待发布, 1=已发布, 2=死信）';
+COMMENT ON COLUMN "public"."outbox_event"."attempts" IS '已尝试发布的次数';
+COMMENT ON COLUMN "public"."outbox_event"."last_error" IS '最后一次发布失败的原因';
+COMMENT ON COLUMN "public"."outbox_event"."next_attempt_at" IS '下一次可以尝试发布的时间';
+COMMENT ON COLUMN "public"."outbox_event"."published_at" IS '发布或成为死信的时间';
+COMMENT ON COLUMN "public"."outbox_event"."created_at" IS '事件发生时间';
+COMMENT ON TABLE "public"."outbox_event" IS '领域事件发件箱表，记录待发布到Kafka的领域事件';
+
+ALTER SEQUENCE "public"."outbox_event_id_seq"
+OWNED BY "public"."outbox_event"."id";
+
+ALTER TABLE "public"."outbox_event" ADD CONSTRAINT "outbox_event_pkey" PRIMARY KEY ("id");
+CREATE UNIQUE INDEX "uk_outbox_event_event_id" ON "public"."outbox_event" USING btree (
+  "event_id" "pg_catalog"."uuid_ops" ASC NULLS LAST
+);
+CREATE INDEX "idx_outbox_event_status_id" ON "public"."outbox_event" USING btree (
+  "status" "pg_catalog"."int2_ops" ASC NULLS LAST,
+  "id" "pg_catalog"."int8_ops" ASC NULLS LAST
+);
+
+CREATE TABLE "public"."outbox_lease" (
+  "name" varchar(50) COLLATE "pg_catalog"."default" NOT NULL,
+  "holder" varchar(100) COLLATE "pg_catalog"."default" NOT NULL DEFAULT '',
+  "expires_at" timestamptz(6) NOT NULL DEFAULT CURRENT_TIMESTAMP
+)
+;
+COMMENT ON COLUMN "public"."outbox_lease"."name" IS '租约名称';
+COMMENT ON COLUMN "public"."outbox_lease"."holder" IS '当前持有租约的实例标识';
+COMMENT ON COLUMN "public"."outbox_lease"."expires_at" IS '租约过期时间';
+COMMENT ON TABLE "public"."outbox_lease" IS '发件箱发布租约表，多个实例中只有持有租约的实例发布事件';
+
+ALTER TABLE "public"."outbox_lease" ADD CONSTRAINT "outbox_lease_pkey" PRIMARY KEY ("name");
+
+INSERT INTO "public"."outbox_lease" ("name", "holder", "expires_at") VALUES ('outbox_relay', '', '1970-01-01 00:00:00+00');
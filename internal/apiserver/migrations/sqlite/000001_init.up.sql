@@ -0,0 +1,352 @@
+-- 初始数据库结构（SQLite 3.35+）. 与 postgres/000001_init.up.sql 保持一致.
+-- SQLite 只能在建表时声明外键和唯一约束，需要在连接上开启 foreign_keys.
+
+-- ----------------------------
+-- Table structure for audit_log
+-- ----------------------------
+-- 审计日志表，记录权限相关操作历史
+CREATE TABLE "audit_log" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "user_id" varchar(36) NOT NULL,
+  "actor_id" varchar(36),
+  "action" varchar(50) NOT NULL,
+  "resource" varchar(200),
+  "details" text,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "idx_audit_log_created_at" ON "audit_log" ("created_at" DESC);
+CREATE INDEX "idx_audit_log_user_id" ON "audit_log" ("user_id");
+CREATE INDEX "idx_audit_log_actor_id" ON "audit_log" ("actor_id") WHERE actor_id IS NOT NULL;
+
+-- ----------------------------
+-- Table structure for casbin_rule
+-- ----------------------------
+-- Casbin权限规则表，作为系统中唯一的权限控制机制，支持RBAC和ABAC策略
+-- 列类型与 gorm-adapter 在 SQLite 上期望的 text 一致，避免启动时 AutoMigrate 重建表
+CREATE TABLE "casbin_rule" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "ptype" text NOT NULL,
+  "v0" text,
+  "v1" text,
+  "v2" text,
+  "v3" text,
+  "v4" text,
+  "v5" text
+);
+CREATE INDEX "idx_casbin_rule_g_v0" ON "casbin_rule" ("ptype", "v0") WHERE ptype = 'g';
+CREATE INDEX "idx_casbin_rule_ptype" ON "casbin_rule" ("ptype");
+CREATE INDEX "idx_casbin_rule_ptype_v0_v1" ON "casbin_rule" ("ptype", "v0", "v1");
+
+-- ----------------------------
+-- Table structure for menu
+-- ----------------------------
+-- 菜单表，存储前端菜单和页面配置信息
+CREATE TABLE "menu" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "menu_id" varchar(36) NOT NULL,
+  "parent_id" varchar(36),
+  "menu_name" varchar(50) NOT NULL,
+  "menu_code" varchar(50) NOT NULL,
+  "menu_type" varchar(16) NOT NULL CHECK ("menu_type" IN ('menu', 'page')),
+  "icon" varchar(50),
+  "path" varchar(200),
+  "component" varchar(200),
+  "permission_id" varchar(36),
+  "sort_order" integer NOT NULL DEFAULT 0,
+  "visible" smallint NOT NULL DEFAULT 1,
+  "status" smallint NOT NULL DEFAULT 0,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "deleted_at" datetime,
+  CONSTRAINT "menu_menu_id_key" UNIQUE ("menu_id"),
+  CONSTRAINT "menu_parent_id_fkey" FOREIGN KEY ("parent_id") REFERENCES "menu" ("menu_id") ON DELETE CASCADE,
+  CONSTRAINT "menu_permission_id_fkey" FOREIGN KEY ("permission_id") REFERENCES "permission" ("permission_id") ON DELETE SET NULL
+);
+CREATE INDEX "idx_menu_active" ON "menu" ("created_at" DESC) WHERE deleted_at IS NULL;
+CREATE INDEX "idx_menu_deleted_at" ON "menu" ("deleted_at") WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_menu_code" ON "menu" ("menu_code") WHERE deleted_at IS NULL;
+CREATE INDEX "idx_menu_parent_id" ON "menu" ("parent_id");
+CREATE INDEX "idx_menu_parent_sort" ON "menu" ("parent_id", "sort_order") WHERE deleted_at IS NULL;
+CREATE INDEX "idx_menu_path" ON "menu" ("path");
+CREATE INDEX "idx_menu_permission_id" ON "menu" ("permission_id");
+CREATE INDEX "idx_menu_status" ON "menu" ("status");
+CREATE INDEX "idx_menu_type" ON "menu" ("menu_type");
+CREATE INDEX "idx_menu_visible" ON "menu" ("visible");
+
+-- ----------------------------
+-- Table structure for permission
+-- ----------------------------
+-- 权限表，存储系统资源和操作权限信息
+CREATE TABLE "permission" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "permission_id" varchar(36) NOT NULL,
+  "permission_name" varchar(100) NOT NULL,
+  "permission_code" varchar(100) NOT NULL,
+  "resource_type" varchar(16) NOT NULL CHECK ("resource_type" IN ('menu', 'button', 'api')),
+  "resource_path" varchar(200),
+  "action" varchar(20) NOT NULL,
+  "description" varchar(200),
+  "parent_id" varchar(36),
+  "path" varchar(500),
+  "status" smallint NOT NULL DEFAULT 0,
+  "conditions" text,
+  "stale" boolean NOT NULL DEFAULT 0,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "deleted_at" datetime,
+  CONSTRAINT "permission_permission_id_key" UNIQUE ("permission_id")
+);
+CREATE INDEX "idx_permission_active" ON "permission" ("created_at" DESC) WHERE deleted_at IS NULL;
+CREATE INDEX "idx_permission_deleted_at" ON "permission" ("deleted_at") WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_permission_code" ON "permission" ("permission_code") WHERE deleted_at IS NULL;
+CREATE INDEX "idx_permission_parent_id" ON "permission" ("parent_id");
+CREATE INDEX "idx_permission_path" ON "permission" ("path");
+CREATE INDEX "idx_permission_resource_type" ON "permission" ("resource_type");
+CREATE INDEX "idx_permission_status" ON "permission" ("status");
+
+-- ----------------------------
+-- Table structure for role
+-- ----------------------------
+-- 角色表，存储系统角色信息
+CREATE TABLE "role" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "role_id" varchar(36) NOT NULL,
+  "role_name" varchar(50) NOT NULL,
+  "role_code" varchar(50) NOT NULL,
+  "description" varchar(200),
+  "status" smallint NOT NULL DEFAULT 0,
+  "sort_order" integer NOT NULL DEFAULT 0,
+  "sensitive" boolean NOT NULL DEFAULT 0,
+  "approver_role_ids" text,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "deleted_at" datetime,
+  CONSTRAINT "role_role_id_key" UNIQUE ("role_id")
+);
+CREATE INDEX "idx_role_active" ON "role" ("created_at" DESC) WHERE deleted_at IS NULL;
+CREATE INDEX "idx_role_deleted_at" ON "role" ("deleted_at") WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_role_code" ON "role" ("role_code") WHERE deleted_at IS NULL;
+CREATE INDEX "idx_role_status" ON "role" ("status");
+
+-- ----------------------------
+-- Table structure for role_permission
+-- ----------------------------
+-- 角色权限关联表，实现角色与权限的多对多关系
+CREATE TABLE "role_permission" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "role_id" varchar(36) NOT NULL,
+  "permission_id" varchar(36) NOT NULL,
+  "version" integer NOT NULL DEFAULT 1,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "starts_at" datetime,
+  "expires_at" datetime,
+  CONSTRAINT "role_permission_role_id_permission_id_key" UNIQUE ("role_id", "permission_id"),
+  CONSTRAINT "role_permission_permission_id_fkey" FOREIGN KEY ("permission_id") REFERENCES "permission" ("permission_id") ON DELETE CASCADE,
+  CONSTRAINT "role_permission_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("role_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_role_permission_permission_id" ON "role_permission" ("permission_id");
+CREATE INDEX "idx_role_permission_role_id" ON "role_permission" ("role_id");
+CREATE INDEX "idx_role_permission_expires_at" ON "role_permission" ("expires_at") WHERE expires_at IS NOT NULL;
+CREATE INDEX "idx_role_permission_starts_at" ON "role_permission" ("starts_at") WHERE starts_at IS NOT NULL;
+
+-- ----------------------------
+-- Table structure for user
+-- ----------------------------
+-- 用户表，存储用户认证信息、基本资料和应用扩展
+CREATE TABLE "user" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "user_id" varchar(36) NOT NULL,
+  "username" varchar(50) NOT NULL,
+  "password" varchar(255) NOT NULL,
+  "email" varchar(255),
+  "phone" varchar(20),
+  "avatar" varchar(500),
+  "nickname" varchar(100) NOT NULL DEFAULT '',
+  "gender" smallint NOT NULL DEFAULT 0,
+  "status" smallint NOT NULL DEFAULT 0,
+  "last_login_at" datetime,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "description" text,
+  "deleted_at" datetime,
+  CONSTRAINT "uni_user_user_id" UNIQUE ("user_id")
+);
+CREATE INDEX "idx_user_deleted_at" ON "user" ("deleted_at") WHERE deleted_at IS NOT NULL;
+CREATE UNIQUE INDEX "uk_user_email" ON "user" ("email") WHERE email IS NOT NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX "uk_user_phone" ON "user" ("phone") WHERE phone IS NOT NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX "uk_user_username" ON "user" ("username") WHERE deleted_at IS NULL;
+CREATE INDEX "idx_user_status" ON "user" ("status");
+CREATE INDEX "idx_user_status_last_login" ON "user" ("status", "last_login_at" DESC);
+
+-- ----------------------------
+-- Table structure for user_config
+-- ----------------------------
+-- 用户个人配置表，存储用户偏好设置
+CREATE TABLE "user_config" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "user_id" varchar(36) NOT NULL,
+  "config_key" varchar(100) NOT NULL,
+  "config_value" text NOT NULL,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "user_config_user_id_config_key_key" UNIQUE ("user_id", "config_key"),
+  CONSTRAINT "user_config_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_user_config_user" ON "user_config" ("user_id");
+
+-- ----------------------------
+-- Table structure for user_login_log
+-- ----------------------------
+-- 用户登录日志表，记录登录尝试和安全信息
+CREATE TABLE "user_login_log" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "username" varchar(50),
+  "ip_address" varchar(64),
+  "user_agent" varchar(1000),
+  "status" boolean NOT NULL,
+  "error_message" text,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "idx_user_login_log_created" ON "user_login_log" ("created_at" DESC);
+CREATE INDEX "idx_user_login_log_status_created" ON "user_login_log" ("status", "created_at" DESC);
+CREATE INDEX "idx_user_login_log_username" ON "user_login_log" ("username") WHERE username IS NOT NULL;
+
+-- ----------------------------
+-- Table structure for user_role
+-- ----------------------------
+-- 用户角色关联表，实现用户与角色的多对多关系
+CREATE TABLE "user_role" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "user_id" varchar(36) NOT NULL,
+  "role_id" varchar(36) NOT NULL,
+  "assigned_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "starts_at" datetime,
+  "expires_at" datetime,
+  CONSTRAINT "user_role_user_id_role_id_key" UNIQUE ("user_id", "role_id"),
+  CONSTRAINT "user_role_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("role_id") ON DELETE CASCADE,
+  CONSTRAINT "user_role_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_user_role_role_id" ON "user_role" ("role_id");
+CREATE INDEX "idx_user_role_expires_at" ON "user_role" ("expires_at") WHERE expires_at IS NOT NULL;
+CREATE INDEX "idx_user_role_starts_at" ON "user_role" ("starts_at") WHERE starts_at IS NOT NULL;
+CREATE INDEX "idx_user_role_user_id" ON "user_role" ("user_id");
+
+-- ----------------------------
+-- Table structure for user_session
+-- ----------------------------
+-- 用户会话表，每次登录（及其刷新令牌族）对应一条会话记录
+CREATE TABLE "user_session" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "session_id" varchar(36) NOT NULL,
+  "user_id" varchar(36) NOT NULL,
+  "device_name" varchar(100),
+  "ip_address" varchar(64),
+  "user_agent" varchar(1000),
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "last_seen_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "expires_at" datetime NOT NULL,
+  "revoked_at" datetime,
+  CONSTRAINT "user_session_session_id_key" UNIQUE ("session_id"),
+  CONSTRAINT "user_session_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_user_session_user_id" ON "user_session" ("user_id", "last_seen_at" DESC);
+CREATE INDEX "idx_user_session_active" ON "user_session" ("user_id") WHERE revoked_at IS NULL;
+
+-- ----------------------------
+-- Table structure for invitation
+-- ----------------------------
+-- 注册邀请码表
+CREATE TABLE "invitation" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "code" varchar(32),
+  "role_ids" text,
+  "max_uses" integer NOT NULL DEFAULT 1,
+  "used_count" integer NOT NULL DEFAULT 0,
+  "expires_at" datetime,
+  "revoked_at" datetime,
+  "remark" varchar(255),
+  "created_by" varchar(36),
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "invitation_code_key" UNIQUE ("code")
+);
+
+-- ----------------------------
+-- Table structure for user_identity
+-- ----------------------------
+-- 用户外部身份表，记录本地用户与 IdP 身份的关联关系
+CREATE TABLE "user_identity" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "user_id" varchar(36) NOT NULL,
+  "provider" varchar(64) NOT NULL,
+  "subject" varchar(255) NOT NULL,
+  "email" varchar(255),
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "last_login_at" datetime,
+  CONSTRAINT "user_identity_provider_subject_key" UNIQUE ("provider", "subject"),
+  CONSTRAINT "user_identity_user_id_provider_key" UNIQUE ("user_id", "provider"),
+  CONSTRAINT "user_identity_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_user_identity_user_id" ON "user_identity" ("user_id");
+
+-- ----------------------------
+-- Table structure for oidc_auth_state
+-- ----------------------------
+-- OIDC 登录流程状态表，回调时一次性消费
+CREATE TABLE "oidc_auth_state" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "state" varchar(64) NOT NULL,
+  "provider" varchar(64) NOT NULL,
+  "code_verifier" varchar(128) NOT NULL,
+  "nonce" varchar(64) NOT NULL,
+  "link_user_id" varchar(36),
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "expires_at" datetime NOT NULL,
+  CONSTRAINT "oidc_auth_state_state_key" UNIQUE ("state")
+);
+CREATE INDEX "idx_oidc_auth_state_expires_at" ON "oidc_auth_state" ("expires_at");
+
+-- ----------------------------
+-- Table structure for access_request
+-- ----------------------------
+-- 敏感角色授权申请表
+CREATE TABLE "access_request" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "request_id" varchar(36) NOT NULL,
+  "user_id" varchar(36) NOT NULL,
+  "role_id" varchar(36) NOT NULL,
+  "requester_id" varchar(36) NOT NULL,
+  "reason" varchar(255),
+  "status" varchar(16) NOT NULL DEFAULT 'pending',
+  "grant_starts_at" datetime,
+  "grant_expires_at" datetime,
+  "reviewer_id" varchar(36),
+  "review_comment" varchar(255),
+  "reviewed_at" datetime,
+  "expires_at" datetime NOT NULL,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "access_request_request_id_key" UNIQUE ("request_id"),
+  CONSTRAINT "access_request_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("role_id") ON DELETE CASCADE,
+  CONSTRAINT "access_request_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_access_request_status_expires_at" ON "access_request" ("status", "expires_at");
+CREATE UNIQUE INDEX "uk_access_request_pending" ON "access_request" ("user_id", "role_id") WHERE status = 'pending';
+
+-- ----------------------------
+-- Table structure for sod_rule
+-- ----------------------------
+-- 静态职责分离规则表，限制同一用户同时持有互斥角色
+CREATE TABLE "sod_rule" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "rule_id" varchar(36) NOT NULL,
+  "name" varchar(50) NOT NULL,
+  "description" varchar(200),
+  "role_ids" text NOT NULL,
+  "max_roles" integer NOT NULL DEFAULT 1,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "sod_rule_rule_id_key" UNIQUE ("rule_id"),
+  CONSTRAINT "sod_rule_name_key" UNIQUE ("name")
+);
@@ -0,0 +1,7 @@
+-- 为可修改的资源增加乐观锁版本号，每次更新时递增，更新请求可以携带读取时的版本号作为前置条件.
+
+ALTER TABLE "menu" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
+ALTER TABLE "permission" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
+ALTER TABLE "role" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
+ALTER TABLE "user" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
+ALTER TABLE "sod_rule" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
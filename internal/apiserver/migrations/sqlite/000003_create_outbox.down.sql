@@ -0,0 +1,4 @@
+-- 删除领域事件发件箱.
+
+DROP TABLE IF EXISTS "outbox_lease";
+DROP TABLE IF EXISTS "outbox_event";
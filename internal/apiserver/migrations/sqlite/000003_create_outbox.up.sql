@@ -0,0 +1,28 @@
+-- 创建领域事件发件箱. 业务变更和领域事件在同一个事务中写入，由后台任务按 ID 顺序发布到 Kafka.
+
+-- 领域事件发件箱表，记录待发布到Kafka的领域事件
+CREATE TABLE "outbox_event" (
+  "id" integer PRIMARY KEY AUTOINCREMENT,
+  "event_id" varchar(36) NOT NULL,
+  "aggregate_type" varchar(50) NOT NULL,
+  "aggregate_id" varchar(100) NOT NULL,
+  "event_type" varchar(100) NOT NULL,
+  "payload" text NOT NULL,
+  "status" smallint NOT NULL DEFAULT 0,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" text,
+  "next_attempt_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "published_at" datetime,
+  "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX "uk_outbox_event_event_id" ON "outbox_event" ("event_id");
+CREATE INDEX "idx_outbox_event_status_id" ON "outbox_event" ("status", "id");
+
+-- 发件箱发布租约表，多个实例中只有持有租约的实例发布事件
+CREATE TABLE "outbox_lease" (
+  "name" varchar(50) PRIMARY KEY,
+  "holder" varchar(100) NOT NULL DEFAULT '',
+  "expires_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO "outbox_lease" ("name", "holder", "expires_at") VALUES ('outbox_relay', '', '1970-01-01 00:00:00');
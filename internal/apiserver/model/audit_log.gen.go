@@ -14,6 +14,7 @@ const TableNameAuditLogM = "audit_log"
 type AuditLogM struct {
 	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:内部主键ID（自增序列）" json:"id"`                     // 内部主键ID（自增序列）
 	UserID    string    `gorm:"column:user_id;not null;comment:操作用户UUID" json:"userId"`                                          // 操作用户UUID
+	ActorID   *string   `gorm:"column:actor_id;comment:实际操作者UUID（管理员模拟登录时记录发起模拟的管理员，否则为空）" json:"actorId"`          // 实际操作者UUID（管理员模拟登录时记录发起模拟的管理员，否则为空）
 	Action    string    `gorm:"column:action;not null;comment:操作类型（如role_assign、permission_deny）" json:"action"`            // 操作类型（如role_assign、permission_deny）
 	Resource  *string   `gorm:"column:resource;comment:操作的资源" json:"resource"`                                                    // 操作的资源
 	Details   []byte    `gorm:"column:details;comment:操作详情（JSONB格式，记录变更前后数据）" json:"details"`                              // 操作详情（JSONB格式，记录变更前后数据）
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"crypto/rand"
+
+	"gorm.io/gorm"
+)
+
+// invitationCodeChars 是邀请码使用的字符集，去掉了容易混淆的 0、1、I、O，共 32 个字符.
+const invitationCodeChars = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// invitationCodeLength 是邀请码的长度，每个字符 5 位，共 100 位随机数.
+const invitationCodeLength = 20
+
+// BeforeCreate 在创建数据库记录之前生成随机的邀请码。
+// 邀请码相当于注册凭证，因此使用 crypto/rand 生成，不能由自增 ID 推算。
+func (m *InvitationM) BeforeCreate(tx *gorm.DB) error {
+	code, err := newInvitationCode()
+	if err != nil {
+		return err
+	}
+	m.Code = &code
+
+	return nil
+}
+
+// newInvitationCode 生成一个随机邀请码.
+func newInvitationCode() (string, error) {
+	buf := make([]byte, invitationCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	// 字符集长度为 32，取低 5 位不会引入偏差
+	for i, b := range buf {
+		buf[i] = invitationCodeChars[b%byte(len(invitationCodeChars))]
+	}
+	return string(buf), nil
+}
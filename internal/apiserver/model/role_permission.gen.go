@@ -17,6 +17,8 @@ type RolePermissionM struct {
 	PermissionID string    `gorm:"column:permission_id;not null;comment:权限UUID（外键）" json:"permissionId"`                           // 权限UUID（外键）
 	Version      int32     `gorm:"column:version;not null;default:1;comment:乐观锁版本号" json:"version"`                                // 乐观锁版本号
 	CreatedAt    time.Time `gorm:"column:created_at;not null;default:current_timestamp;comment:创建时间" json:"createdAt"`             // 创建时间
+	StartsAt     *time.Time `gorm:"column:starts_at;comment:生效时间（为空表示立即生效）" json:"startsAt"`                                 // 生效时间（为空表示立即生效）
+	ExpiresAt    *time.Time `gorm:"column:expires_at;comment:过期时间（为空表示永久有效，过期后由后台任务自动回收）" json:"expiresAt"`          // 过期时间（为空表示永久有效，过期后由后台任务自动回收）
 }
 
 // TableName RolePermissionM's table name
@@ -5,8 +5,24 @@ import (
 	"gorm.io/gorm"
 )
 
-// BeforeCreate 在创建数据库记录之前加密明文密码。
+// keepPasswordHashKey 是标记保留已有密码哈希的 gorm 设置键。
+const keepPasswordHashKey = "model:keep_password_hash"
+
+// KeepPasswordHash 返回创建用户时保留已有密码哈希的 db，用于从其他系统导入用户等服务端可信的路径。
+// 哈希参数决定了每次登录校验的开销，因此 API 提交的密码不能使用，总是重新哈希。
+func KeepPasswordHash(db *gorm.DB) *gorm.DB {
+	return db.Set(keepPasswordHashKey, true)
+}
+
+// BeforeCreate 在创建数据库记录之前生成用户 UUID 并加密明文密码。
 func (m *UserM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.UserID)
+
+	// 可信的导入路径中密码已经是哈希值时直接保存，避免重复哈希导致无法登录
+	if _, keep := tx.Get(keepPasswordHashKey); keep && authn.IsHashed(m.Password) {
+		return nil
+	}
+
 	// 加密用户密码。
 	var err error
 	m.Password, err = authn.Encrypt(m.Password)
@@ -16,6 +16,8 @@ type UserRoleM struct {
 	UserID     string    `gorm:"column:user_id;not null;comment:用户UUID（外键）" json:"userId"`                                       // 用户UUID（外键）
 	RoleID     string    `gorm:"column:role_id;not null;comment:角色UUID（外键）" json:"roleId"`                                       // 角色UUID（外键）
 	AssignedAt time.Time `gorm:"column:assigned_at;not null;default:current_timestamp;comment:分配时间" json:"assignedAt"`         // 分配时间
+	StartsAt   *time.Time `gorm:"column:starts_at;comment:生效时间（为空表示立即生效）" json:"startsAt"`                                   // 生效时间（为空表示立即生效）
+	ExpiresAt  *time.Time `gorm:"column:expires_at;comment:过期时间（为空表示永久有效，过期后由后台任务自动回收）" json:"expiresAt"`            // 过期时间（为空表示永久有效，过期后由后台任务自动回收）
 }
 
 // TableName UserRoleM's table name
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 业务唯一 UUID 在应用中生成，不依赖 PostgreSQL 的 gen_random_uuid() 默认值，
+// 使 MySQL、SQLite 等数据库也能在创建记录后拿到 ID.
+
+// BeforeCreate 在创建数据库记录之前生成角色 UUID。
+func (m *RoleM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.RoleID)
+	return nil
+}
+
+// BeforeCreate 在创建数据库记录之前生成权限 UUID。
+func (m *PermissionM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.PermissionID)
+	return nil
+}
+
+// BeforeCreate 在创建数据库记录之前生成菜单 UUID。
+func (m *MenuM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.MenuID)
+	return nil
+}
+
+// BeforeCreate 在创建数据库记录之前生成会话 UUID。
+func (m *UserSessionM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.SessionID)
+	return nil
+}
+
+// BeforeCreate 在创建数据库记录之前生成授权申请 UUID。
+func (m *AccessRequestM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.RequestID)
+	return nil
+}
+
+// BeforeCreate 在创建数据库记录之前生成职责分离规则 UUID。
+func (m *SoDRuleM) BeforeCreate(tx *gorm.DB) error {
+	ensureUUID(&m.RuleID)
+	return nil
+}
+
+// ensureUUID 在 id 为空时生成新的 UUID，调用方显式指定的 ID（例如导入数据）保持不变。
+func ensureUUID(id *string) {
+	if *id == "" {
+		*id = uuid.NewString()
+	}
+}
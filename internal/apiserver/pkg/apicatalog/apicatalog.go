@@ -0,0 +1,211 @@
+// Package apicatalog 枚举已注册的 Gin 路由，并结合 proto 中的 google.api.http 绑定生成 API 路由目录，
+// 用于将 API 接口自动同步为权限记录.
+package apicatalog
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+const (
+	// ResourceType 是自动发现的 API 权限的资源类型.
+	ResourceType = "api"
+
+	// apiPrefix 是需要纳入目录的业务 API 路径前缀.
+	apiPrefix = "/v1/"
+	// routeCodePrefix 是 API 权限编码前缀，格式为 api:<METHOD>:<Gin 路由路径>.
+	routeCodePrefix = "api:"
+	// moduleCodePrefix 是模块父权限编码前缀，格式为 api:module:<模块>.
+	moduleCodePrefix = "api:module:"
+)
+
+// Route 描述一个已注册的 API 路由.
+type Route struct {
+	// Method 为 HTTP 方法
+	Method string
+	// Path 为 Gin 路由路径，如 /v1/users/:userID
+	Path string
+	// ResourcePath 为 Casbin keyMatch2 使用的资源路径，如 /v1/users/:userID
+	ResourcePath string
+	// Module 为所属模块，取 /v1 之后的第一段路径，如 users
+	Module string
+	// ModuleName 为模块显示名称，取自 proto 中 OpenAPI 的 tags
+	ModuleName string
+	// Operation 为对应的 proto RPC 方法名，未在 proto 中声明时为空
+	Operation string
+	// Summary 为接口摘要，取自 proto 中 OpenAPI 的 summary
+	Summary string
+}
+
+// Code 返回路由对应的权限编码.
+func (r *Route) Code() string {
+	return routeCodePrefix + r.Method + ":" + r.Path
+}
+
+// Name 返回路由对应的权限名称.
+func (r *Route) Name() string {
+	if r.Summary != "" {
+		return r.Summary
+	}
+	if r.Operation != "" {
+		return r.Operation
+	}
+	return r.Method + " " + r.Path
+}
+
+// ModuleCode 返回模块父权限的编码.
+func ModuleCode(module string) string {
+	return moduleCodePrefix + module
+}
+
+// IsModuleCode 判断权限编码是否为模块父权限编码.
+func IsModuleCode(code string) bool {
+	return strings.HasPrefix(code, moduleCodePrefix)
+}
+
+// ParseCode 从 API 权限编码中解析 HTTP 方法和 Gin 路由路径.
+func ParseCode(code string) (method, path string, ok bool) {
+	if !strings.HasPrefix(code, routeCodePrefix) || IsModuleCode(code) {
+		return "", "", false
+	}
+	method, path, ok = strings.Cut(strings.TrimPrefix(code, routeCodePrefix), ":")
+	return method, path, ok
+}
+
+// Discover 合并 Gin 路由和 proto HTTP 绑定生成 API 路由目录，仅包含 /v1 下的业务接口.
+func Discover(routes gin.RoutesInfo) []*Route {
+	bindings := httpBindings()
+	modules := make(map[string]string)
+
+	var result []*Route
+	for _, ri := range routes {
+		if !strings.HasPrefix(ri.Path, apiPrefix) {
+			continue
+		}
+
+		route := &Route{
+			Method:       ri.Method,
+			Path:         ri.Path,
+			ResourcePath: resourcePath(ri.Path),
+			Module:       strings.SplitN(strings.TrimPrefix(ri.Path, apiPrefix), "/", 2)[0],
+		}
+		if b, ok := bindings[ri.Method+" "+ri.Path]; ok {
+			route.Operation, route.Summary = b.operation, b.summary
+			if b.tag != "" && modules[route.Module] == "" {
+				modules[route.Module] = b.tag
+			}
+		}
+		result = append(result, route)
+	}
+
+	for _, route := range result {
+		route.ModuleName = modules[route.Module]
+		if route.ModuleName == "" {
+			route.ModuleName = route.Module
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+// binding 描述 proto 中声明的一条 HTTP 绑定.
+type binding struct {
+	operation string
+	summary   string
+	tag       string
+}
+
+// httpBindings 读取 apiserver proto 中所有 RPC 的 google.api.http 绑定，键为 "<METHOD> <Gin 路由路径>".
+func httpBindings() map[string]binding {
+	result := make(map[string]binding)
+
+	services := v1.File_apiserver_v1_apiserver_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			addBindings(result, methods.Get(j))
+		}
+	}
+	return result
+}
+
+// addBindings 将方法的 HTTP 绑定（包括 additional_bindings）加入 result.
+func addBindings(result map[string]binding, md protoreflect.MethodDescriptor) {
+	opts := md.Options()
+	rule, _ := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+	if rule == nil {
+		return
+	}
+
+	b := binding{operation: string(md.Name())}
+	if op, _ := proto.GetExtension(opts, options.E_Openapiv2Operation).(*options.Operation); op != nil {
+		b.summary = op.GetSummary()
+		if len(op.GetTags()) > 0 {
+			b.tag = op.GetTags()[0]
+		}
+	}
+
+	for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		if method, path := httpPattern(r); method != "" {
+			result[method+" "+ginPath(path)] = b
+		}
+	}
+}
+
+// httpPattern 返回 HTTP 绑定的方法和路径模板.
+func httpPattern(rule *annotations.HttpRule) (string, string) {
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "GET", p.Get
+	case *annotations.HttpRule_Post:
+		return "POST", p.Post
+	case *annotations.HttpRule_Put:
+		return "PUT", p.Put
+	case *annotations.HttpRule_Delete:
+		return "DELETE", p.Delete
+	case *annotations.HttpRule_Patch:
+		return "PATCH", p.Patch
+	case *annotations.HttpRule_Custom:
+		return strings.ToUpper(p.Custom.GetKind()), p.Custom.GetPath()
+	}
+	return "", ""
+}
+
+// ginPath 将 proto 路径模板（如 /v1/users/{userID}）转换为 Gin 路由路径（如 /v1/users/:userID）.
+func ginPath(template string) string {
+	segments := strings.Split(template, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			name, _, _ := strings.Cut(strings.Trim(seg, "{}"), "=")
+			segments[i] = ":" + name
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// resourcePath 将 Gin 路由路径转换为 Casbin keyMatch2 使用的资源路径.
+// :param 参数段保持不变，只匹配一段路径，因此对某个路由的授权不会覆盖其下的嵌套路由；
+// *param 通配段替换为 *，匹配任意后缀.
+func resourcePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, "*") {
+			segments[i] = "*"
+		}
+	}
+	return strings.Join(segments, "/")
+}
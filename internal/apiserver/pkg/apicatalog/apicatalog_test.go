@@ -0,0 +1,68 @@
+package apicatalog
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/db"
+)
+
+func TestResourcePath(t *testing.T) {
+	assert.Equal(t, "/v1/users", resourcePath("/v1/users"))
+	assert.Equal(t, "/v1/users/:userID", resourcePath("/v1/users/:userID"))
+	assert.Equal(t, "/v1/users/:userID/sessions", resourcePath("/v1/users/:userID/sessions"))
+	assert.Equal(t, "/v1/files/*", resourcePath("/v1/files/*filepath"))
+}
+
+func TestDiscover_NestedRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	noop := func(*gin.Context) {}
+	engine.GET("/v1/users/:userID", noop)
+	engine.DELETE("/v1/users/:userID", noop)
+	engine.GET("/v1/users/:userID/sessions", noop)
+	engine.DELETE("/v1/users/:userID/sessions/:sessionID", noop)
+
+	byCode := make(map[string]*Route)
+	for _, route := range Discover(engine.Routes()) {
+		byCode[route.Code()] = route
+	}
+	getUser := byCode["api:GET:/v1/users/:userID"]
+	deleteUser := byCode["api:DELETE:/v1/users/:userID"]
+	require.NotNil(t, getUser)
+	require.NotNil(t, deleteUser)
+
+	dbIns, err := db.NewSQLite(&db.SQLiteOptions{Path: filepath.Join(t.TempDir(), "authz.db")})
+	require.NoError(t, err)
+	a, err := authz.NewAuthz(dbIns)
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, err = a.AddPolicy("role::viewer", getUser.ResourcePath, getUser.Method, "allow")
+	require.NoError(t, err)
+	_, err = a.AddPolicy("role::viewer", deleteUser.ResourcePath, deleteUser.Method, "deny")
+	require.NoError(t, err)
+	_, err = a.AddGroupingPolicy("alice", "role::viewer")
+	require.NoError(t, err)
+
+	// 对某个目录项的授权只覆盖该路由，不覆盖其下的嵌套路由
+	allowed, err := a.ExplicitlyAllowed("alice", "/v1/users/u1", "GET")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, err = a.ExplicitlyAllowed("alice", "/v1/users/u1/sessions", "GET")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// 拒绝策略同样只作用于该路由
+	allowed, err = a.Authorize("alice", "/v1/users/u1", "DELETE")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	allowed, err = a.Authorize("alice", "/v1/users/u1/sessions/s1", "DELETE")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
@@ -0,0 +1,62 @@
+// Package audit 提供写入审计日志的辅助方法.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+)
+
+// 定义审计日志的操作类型.
+const (
+	// ActionUserImpersonate 表示管理员发起模拟登录.
+	ActionUserImpersonate = "user_impersonate"
+	// ActionAccessRequestCreate 表示发起敏感角色授权申请.
+	ActionAccessRequestCreate = "access_request_create"
+	// ActionAccessRequestApprove 表示批准授权申请并授予角色.
+	ActionAccessRequestApprove = "access_request_approve"
+	// ActionAccessRequestReject 表示拒绝授权申请.
+	ActionAccessRequestReject = "access_request_reject"
+	// ActionAccessRequestExpire 表示授权申请超时未审批而自动失效.
+	ActionAccessRequestExpire = "access_request_expire"
+	// ActionUserRolesAssign 表示为用户分配了角色.
+	ActionUserRolesAssign = "user_roles_assign"
+	// ActionUserRoleRemove 表示移除了用户的角色.
+	ActionUserRoleRemove = "user_role_remove"
+	// ActionUserDelete 表示删除了用户.
+	ActionUserDelete = "user_delete"
+	// ActionRolePermissionsChange 表示修改了角色的权限.
+	ActionRolePermissionsChange = "role_permissions_change"
+	// ActionRoleDelete 表示删除了角色.
+	ActionRoleDelete = "role_delete"
+)
+
+// Record 写入一条审计日志. 操作用户取自 contextx.UserID，
+// 模拟登录期间同时记录实际操作者 contextx.ActorID，保证审计记录可追溯到真实管理员.
+// details 会被序列化为 JSON，为 nil 时不记录详情.
+func Record(ctx context.Context, store store.IStore, action, resource string, details any) error {
+	auditM := &model.AuditLogM{
+		UserID:    contextx.UserID(ctx),
+		Action:    action,
+		CreatedAt: time.Now(),
+	}
+	if actorID := contextx.ActorID(ctx); actorID != "" {
+		auditM.ActorID = &actorID
+	}
+	if resource != "" {
+		auditM.Resource = &resource
+	}
+	if details != nil {
+		data, err := json.Marshal(details)
+		if err != nil {
+			return err
+		}
+		auditM.Details = data
+	}
+
+	return store.AuditLog().Create(ctx, auditM)
+}
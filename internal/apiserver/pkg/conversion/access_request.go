@@ -0,0 +1,25 @@
+package conversion
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// AccessRequestModelToAccessRequestV1 将模型层的 AccessRequestM 转换为 Protobuf 层的 AccessRequest.
+// roleModel 用于填充角色编码，为 nil 时角色编码为空.
+func AccessRequestModelToAccessRequestV1(accessRequestModel *model.AccessRequestM, roleModel *model.RoleM) *v1.AccessRequest {
+	var protoAccessRequest v1.AccessRequest
+	_ = core.CopyWithConverters(&protoAccessRequest, accessRequestModel)
+	protoAccessRequest.GrantStartsAt = timeToUnix(accessRequestModel.GrantStartsAt)
+	protoAccessRequest.GrantExpiresAt = timeToUnix(accessRequestModel.GrantExpiresAt)
+	protoAccessRequest.ExpiresAt = accessRequestModel.ExpiresAt.Unix()
+	if accessRequestModel.ReviewedAt != nil {
+		protoAccessRequest.ReviewedAt = accessRequestModel.ReviewedAt.Unix()
+	}
+	if roleModel != nil {
+		protoAccessRequest.RoleCode = roleModel.RoleCode
+	}
+	return &protoAccessRequest
+}
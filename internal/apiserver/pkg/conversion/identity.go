@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// UserIdentityModelToUserIdentityV1 将模型层的 UserIdentityM 转换为 Protobuf 层的 UserIdentity.
+func UserIdentityModelToUserIdentityV1(identityModel *model.UserIdentityM) *v1.UserIdentity {
+	var protoIdentity v1.UserIdentity
+	_ = core.CopyWithConverters(&protoIdentity, identityModel)
+	return &protoIdentity
+}
@@ -0,0 +1,40 @@
+package conversion
+
+import (
+	"encoding/json"
+
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// InvitationModelToInvitationV1 将模型层的 InvitationM 转换为 Protobuf 层的 Invitation.
+func InvitationModelToInvitationV1(invitationModel *model.InvitationM) *v1.Invitation {
+	var protoInvitation v1.Invitation
+	_ = core.CopyWithConverters(&protoInvitation, invitationModel)
+	protoInvitation.RoleIDs = InvitationRoleIDs(invitationModel)
+	protoInvitation.Revoked = invitationModel.RevokedAt != nil
+	if invitationModel.ExpiresAt != nil {
+		protoInvitation.ExpiresAt = invitationModel.ExpiresAt.Unix()
+	}
+	return &protoInvitation
+}
+
+// InvitationModelListToInvitationV1List 将邀请码模型列表转换为 Protobuf 列表.
+func InvitationModelListToInvitationV1List(invitations []*model.InvitationM) []*v1.Invitation {
+	result := make([]*v1.Invitation, len(invitations))
+	for i, invitation := range invitations {
+		result[i] = InvitationModelToInvitationV1(invitation)
+	}
+	return result
+}
+
+// InvitationRoleIDs 解析邀请码中以 JSON 数组保存的预分配角色 ID 列表.
+func InvitationRoleIDs(invitationModel *model.InvitationM) []string {
+	var roleIDs []string
+	if invitationModel.RoleIDs != nil && *invitationModel.RoleIDs != "" {
+		_ = json.Unmarshal([]byte(*invitationModel.RoleIDs), &roleIDs)
+	}
+	return roleIDs
+}
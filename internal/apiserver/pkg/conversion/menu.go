@@ -14,6 +14,7 @@ type MenuModel = model.MenuM
 func MenuModelToMenuV1(menuModel *model.MenuM) *v1.Menu {
 	var protoMenu v1.Menu
 	_ = core.CopyWithConverters(&protoMenu, menuModel)
+	protoMenu.DeletedAt = DeletedAtUnix(menuModel.DeletedAt)
 	return &protoMenu
 }
 
@@ -1,6 +1,7 @@
 package conversion
 
 import (
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
 	"github.com/clin211/gin-enterprise-template/pkg/core"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
@@ -14,6 +15,13 @@ type PermissionModel = model.PermissionM
 func PermissionModelToPermissionV1(permissionModel *model.PermissionM) *v1.Permission {
 	var protoPermission v1.Permission
 	_ = core.CopyWithConverters(&protoPermission, permissionModel)
+	protoPermission.DeletedAt = DeletedAtUnix(permissionModel.DeletedAt)
+	protoPermission.Conditions = nil
+	if permissionModel.Conditions != nil {
+		if cond, err := authz.ParseCondition(*permissionModel.Conditions); err == nil {
+			protoPermission.Conditions = PermissionConditionAuthzToV1(cond)
+		}
+	}
 	return &protoPermission
 }
 
@@ -21,9 +29,66 @@ func PermissionModelToPermissionV1(permissionModel *model.PermissionM) *v1.Permi
 func PermissionV1ToPermissionModel(protoPermission *v1.Permission) *model.PermissionM {
 	var permissionModel model.PermissionM
 	_ = core.CopyWithConverters(&permissionModel, protoPermission)
+	permissionModel.Conditions = PermissionConditionV1ToJSON(protoPermission.Conditions)
 	return &permissionModel
 }
 
+// PermissionConditionV1ToAuthz 将 Protobuf 层的 PermissionCondition 转换为授权条件.
+func PermissionConditionV1ToAuthz(protoCondition *v1.PermissionCondition) *authz.Condition {
+	if protoCondition == nil {
+		return nil
+	}
+
+	cond := &authz.Condition{
+		CIDRs:      protoCondition.GetCidrs(),
+		Timezone:   protoCondition.GetTimezone(),
+		Attributes: protoCondition.GetAttributes(),
+	}
+	for _, d := range protoCondition.GetWeekdays() {
+		cond.Weekdays = append(cond.Weekdays, int(d))
+	}
+	if protoCondition.StartHour != nil {
+		startHour := int(protoCondition.GetStartHour())
+		cond.StartHour = &startHour
+	}
+	if protoCondition.EndHour != nil {
+		endHour := int(protoCondition.GetEndHour())
+		cond.EndHour = &endHour
+	}
+	return cond
+}
+
+// PermissionConditionAuthzToV1 将授权条件转换为 Protobuf 层的 PermissionCondition.
+func PermissionConditionAuthzToV1(cond *authz.Condition) *v1.PermissionCondition {
+	protoCondition := &v1.PermissionCondition{
+		Cidrs:      cond.CIDRs,
+		Timezone:   cond.Timezone,
+		Attributes: cond.Attributes,
+	}
+	for _, d := range cond.Weekdays {
+		protoCondition.Weekdays = append(protoCondition.Weekdays, int32(d))
+	}
+	if cond.StartHour != nil {
+		startHour := int32(*cond.StartHour)
+		protoCondition.StartHour = &startHour
+	}
+	if cond.EndHour != nil {
+		endHour := int32(*cond.EndHour)
+		protoCondition.EndHour = &endHour
+	}
+	return protoCondition
+}
+
+// PermissionConditionV1ToJSON 将 PermissionCondition 编码为模型层存储的 JSON，空条件返回 nil.
+func PermissionConditionV1ToJSON(protoCondition *v1.PermissionCondition) *string {
+	cond := PermissionConditionV1ToAuthz(protoCondition)
+	if cond.IsEmpty() {
+		return nil
+	}
+	raw := cond.String()
+	return &raw
+}
+
 // PermissionModelListToPermissionV1List 将权限模型列表转换为 Protobuf 列表.
 func PermissionModelListToPermissionV1List(permissions []*model.PermissionM) []*v1.Permission {
 	result := make([]*v1.Permission, len(permissions))
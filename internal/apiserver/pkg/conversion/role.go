@@ -1,6 +1,8 @@
 package conversion
 
 import (
+	"encoding/json"
+
 	"github.com/clin211/gin-enterprise-template/pkg/core"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
@@ -14,6 +16,8 @@ type RoleModel = model.RoleM
 func RoleModelToRoleV1(roleModel *model.RoleM) *v1.Role {
 	var protoRole v1.Role
 	_ = core.CopyWithConverters(&protoRole, roleModel)
+	protoRole.DeletedAt = DeletedAtUnix(roleModel.DeletedAt)
+	protoRole.ApproverRoleIDs = RoleApproverRoleIDs(roleModel)
 	return &protoRole
 }
 
@@ -21,6 +25,7 @@ func RoleModelToRoleV1(roleModel *model.RoleM) *v1.Role {
 func RoleV1ToRoleModel(protoRole *v1.Role) *model.RoleM {
 	var roleModel model.RoleM
 	_ = core.CopyWithConverters(&roleModel, protoRole)
+	roleModel.ApproverRoleIDs = RoleApproverRoleIDsJSON(protoRole.GetApproverRoleIDs())
 	return &roleModel
 }
 
@@ -32,3 +37,22 @@ func RoleModelListToRoleV1List(roles []*model.RoleM) []*v1.Role {
 	}
 	return result
 }
+
+// RoleApproverRoleIDs 解析角色中以 JSON 数组保存的审批角色 ID 列表.
+func RoleApproverRoleIDs(roleModel *model.RoleM) []string {
+	var roleIDs []string
+	if roleModel.ApproverRoleIDs != nil && *roleModel.ApproverRoleIDs != "" {
+		_ = json.Unmarshal([]byte(*roleModel.ApproverRoleIDs), &roleIDs)
+	}
+	return roleIDs
+}
+
+// RoleApproverRoleIDsJSON 将审批角色 ID 列表编码为 JSON 数组，列表为空时返回 nil.
+func RoleApproverRoleIDsJSON(roleIDs []string) *string {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(roleIDs)
+	s := string(data)
+	return &s
+}
@@ -0,0 +1,17 @@
+package conversion
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// UserSessionModelToSessionV1 将模型层的 UserSessionM 转换为 Protobuf 层的 Session.
+// currentSessionID 为发起本次请求的会话 ID，用于标记当前会话.
+func UserSessionModelToSessionV1(sessionModel *model.UserSessionM, currentSessionID string) *v1.Session {
+	var protoSession v1.Session
+	_ = core.CopyWithConverters(&protoSession, sessionModel)
+	protoSession.Current = currentSessionID != "" && sessionModel.SessionID == currentSessionID
+	return &protoSession
+}
@@ -0,0 +1,47 @@
+package conversion
+
+import (
+	"encoding/json"
+
+	"github.com/clin211/gin-enterprise-template/pkg/core"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/sod"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// SoDRuleModelToSoDRuleV1 将模型层的 SoDRuleM 转换为 Protobuf 层的 SoDRule.
+func SoDRuleModelToSoDRuleV1(ruleModel *model.SoDRuleM) *v1.SoDRule {
+	var protoRule v1.SoDRule
+	_ = core.CopyWithConverters(&protoRule, ruleModel)
+	protoRule.RoleIDs = SoDRuleRoleIDs(ruleModel)
+	return &protoRule
+}
+
+// SoDRuleModelListToSoDRuleV1List 将职责分离规则模型列表转换为 Protobuf 列表.
+func SoDRuleModelListToSoDRuleV1List(rules []*model.SoDRuleM) []*v1.SoDRule {
+	result := make([]*v1.SoDRule, len(rules))
+	for i, rule := range rules {
+		result[i] = SoDRuleModelToSoDRuleV1(rule)
+	}
+	return result
+}
+
+// SoDRuleModelToSoDRule 将模型层的 SoDRuleM 转换为冲突检测使用的 sod.Rule.
+func SoDRuleModelToSoDRule(ruleModel *model.SoDRuleM) *sod.Rule {
+	return &sod.Rule{
+		ID:       ruleModel.RuleID,
+		Name:     ruleModel.Name,
+		RoleIDs:  SoDRuleRoleIDs(ruleModel),
+		MaxRoles: int(ruleModel.MaxRoles),
+	}
+}
+
+// SoDRuleRoleIDs 解析职责分离规则中以 JSON 数组保存的角色 ID 列表.
+func SoDRuleRoleIDs(ruleModel *model.SoDRuleM) []string {
+	var roleIDs []string
+	if ruleModel.RoleIDs != "" {
+		_ = json.Unmarshal([]byte(ruleModel.RoleIDs), &roleIDs)
+	}
+	return roleIDs
+}
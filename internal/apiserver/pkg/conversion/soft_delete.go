@@ -0,0 +1,11 @@
+package conversion
+
+import "gorm.io/gorm"
+
+// DeletedAtUnix 返回软删除时间的 Unix 时间戳（秒），未删除时返回 0.
+func DeletedAtUnix(deletedAt gorm.DeletedAt) int64 {
+	if !deletedAt.Valid {
+		return 0
+	}
+	return deletedAt.Time.Unix()
+}
@@ -11,6 +11,10 @@ import (
 func UserModelToUserV1(userModel *model.UserM) *v1.User {
 	var protoUser v1.User
 	_ = core.CopyWithConverters(&protoUser, userModel)
+	protoUser.DeletedAt = DeletedAtUnix(userModel.DeletedAt)
+	if userModel.LastLoginAt != nil {
+		protoUser.LastLoginAt = userModel.LastLoginAt.Unix()
+	}
 	return &protoUser
 }
 
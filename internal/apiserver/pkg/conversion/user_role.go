@@ -0,0 +1,44 @@
+package conversion
+
+import (
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+// UnixToTime 将可选的 Unix 时间戳（秒）转换为时间，未设置时返回 nil.
+func UnixToTime(unix *int64) *time.Time {
+	if unix == nil {
+		return nil
+	}
+	t := time.Unix(*unix, 0)
+	return &t
+}
+
+// timeToUnix 将可选的时间转换为 Unix 时间戳（秒），未设置时返回 nil.
+func timeToUnix(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	unix := t.Unix()
+	return &unix
+}
+
+// UserRoleModelToUserRoleAssignmentV1 将角色分配转换为 Protobuf 层的 UserRoleAssignment，
+// 并根据 now 计算该分配是否生效以及剩余有效期.
+func UserRoleModelToUserRoleAssignmentV1(userRoleModel *model.UserRoleM, roleModel *model.RoleM, now time.Time) *v1.UserRoleAssignment {
+	assignment := &v1.UserRoleAssignment{
+		Role:       RoleModelToRoleV1(roleModel),
+		AssignedAt: userRoleModel.AssignedAt.Unix(),
+		StartsAt:   timeToUnix(userRoleModel.StartsAt),
+		ExpiresAt:  timeToUnix(userRoleModel.ExpiresAt),
+		Active: (userRoleModel.StartsAt == nil || !userRoleModel.StartsAt.After(now)) &&
+			(userRoleModel.ExpiresAt == nil || userRoleModel.ExpiresAt.After(now)),
+	}
+	if userRoleModel.ExpiresAt != nil {
+		remaining := max(int64(userRoleModel.ExpiresAt.Sub(now).Seconds()), 0)
+		assignment.RemainingSeconds = &remaining
+	}
+	return assignment
+}
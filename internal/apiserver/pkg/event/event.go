@@ -0,0 +1,255 @@
+// Package event 定义 apiserver 的领域事件，并提供写入 outbox 和发布到进程内事件总线的辅助方法.
+// 事件应在业务变更所在的 store.TX 中通过 Record 写入，同一事务中记录用户、角色和授权变更的审计日志，
+// 事务提交后由 outbox.Relay 发布到 Kafka；同时业务方法在事务提交后通过 Publish 发布到事件总线，
+// 由订阅者同步 Casbin 等.
+package event
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/outbox"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/audit"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+)
+
+// 定义领域事件的聚合类型，同一聚合的事件按发生顺序发布.
+const (
+	AggregateUser = "user"
+	AggregateRole = "role"
+)
+
+// 定义领域事件的类型.
+const (
+	TypeUserCreated            = "user.created"
+	TypeUserUpdated            = "user.updated"
+	TypeUserStatusChanged      = "user.status_changed"
+	TypeUserDeleted            = "user.deleted"
+	TypeUserRolesAssigned      = "user.roles_assigned"
+	TypeUserRoleRemoved        = "user.role_removed"
+	TypeRoleCreated            = "role.created"
+	TypeRoleUpdated            = "role.updated"
+	TypeRoleDeleted            = "role.deleted"
+	TypeRolePermissionsChanged = "role.permissions_changed"
+)
+
+// 定义领域事件 metadata 中的键.
+const (
+	// MetadataOperatorID 是触发事件的用户 ID.
+	MetadataOperatorID = "operatorId"
+	// MetadataActorID 是模拟登录期间发起模拟的管理员 ID.
+	MetadataActorID = "actorId"
+)
+
+// Event 是 apiserver 的领域事件，事件本身会被序列化为 outbox 中事件的数据.
+// 只有事件的指针类型实现了 Event，订阅时应使用指针类型.
+type Event interface {
+	eventbus.Event
+	// Aggregate 返回事件所属聚合的类型和 ID.
+	Aggregate() (aggregateType, aggregateID string)
+}
+
+// UserCreated 表示用户被创建，包括自助注册、管理员创建和单点登录自动创建.
+type UserCreated struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	Status   int16  `json:"status"`
+}
+
+func (*UserCreated) EventType() string { return TypeUserCreated }
+
+func (e *UserCreated) Aggregate() (string, string) { return AggregateUser, e.UserID }
+
+// UserUpdated 表示用户资料被修改.
+type UserUpdated struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username,omitempty"`
+	// Fields 是被修改的字段
+	Fields []string `json:"fields,omitempty"`
+}
+
+func (*UserUpdated) EventType() string { return TypeUserUpdated }
+
+func (e *UserUpdated) Aggregate() (string, string) { return AggregateUser, e.UserID }
+
+// UserStatusChanged 表示用户被启用、禁用或审核通过.
+type UserStatusChanged struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username,omitempty"`
+	Status   int16  `json:"status"`
+}
+
+func (*UserStatusChanged) EventType() string { return TypeUserStatusChanged }
+
+func (e *UserStatusChanged) Aggregate() (string, string) { return AggregateUser, e.UserID }
+
+// UserDeleted 表示用户被删除.
+type UserDeleted struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username,omitempty"`
+}
+
+func (*UserDeleted) EventType() string { return TypeUserDeleted }
+
+func (e *UserDeleted) Aggregate() (string, string) { return AggregateUser, e.UserID }
+
+func (e *UserDeleted) audit() (string, string) { return audit.ActionUserDelete, userResource(e.UserID) }
+
+// UserRolesAssigned 表示为用户分配了角色.
+type UserRolesAssigned struct {
+	UserID string `json:"userId"`
+	// RoleIDs 是分配的角色
+	RoleIDs []string `json:"roleIds"`
+}
+
+func (*UserRolesAssigned) EventType() string { return TypeUserRolesAssigned }
+
+func (e *UserRolesAssigned) Aggregate() (string, string) { return AggregateUser, e.UserID }
+
+func (e *UserRolesAssigned) audit() (string, string) {
+	return audit.ActionUserRolesAssign, userResource(e.UserID)
+}
+
+// UserRoleRemoved 表示移除了用户的角色.
+type UserRoleRemoved struct {
+	UserID string `json:"userId"`
+	// RoleIDs 是移除的角色
+	RoleIDs []string `json:"roleIds"`
+}
+
+func (*UserRoleRemoved) EventType() string { return TypeUserRoleRemoved }
+
+func (e *UserRoleRemoved) Aggregate() (string, string) { return AggregateUser, e.UserID }
+
+func (e *UserRoleRemoved) audit() (string, string) {
+	return audit.ActionUserRoleRemove, userResource(e.UserID)
+}
+
+// RoleCreated 表示角色被创建.
+type RoleCreated struct {
+	RoleID   string `json:"roleId"`
+	RoleCode string `json:"roleCode"`
+}
+
+func (*RoleCreated) EventType() string { return TypeRoleCreated }
+
+func (e *RoleCreated) Aggregate() (string, string) { return AggregateRole, e.RoleID }
+
+// RoleUpdated 表示角色被修改.
+type RoleUpdated struct {
+	RoleID   string `json:"roleId"`
+	RoleCode string `json:"roleCode"`
+	// Fields 是被修改的字段
+	Fields []string `json:"fields,omitempty"`
+}
+
+func (*RoleUpdated) EventType() string { return TypeRoleUpdated }
+
+func (e *RoleUpdated) Aggregate() (string, string) { return AggregateRole, e.RoleID }
+
+// RoleDeleted 表示角色被删除.
+type RoleDeleted struct {
+	RoleID   string `json:"roleId"`
+	RoleCode string `json:"roleCode"`
+}
+
+func (*RoleDeleted) EventType() string { return TypeRoleDeleted }
+
+func (e *RoleDeleted) Aggregate() (string, string) { return AggregateRole, e.RoleID }
+
+func (e *RoleDeleted) audit() (string, string) { return audit.ActionRoleDelete, roleResource(e.RoleID) }
+
+// RolePermissionsChanged 表示角色的权限被修改.
+type RolePermissionsChanged struct {
+	RoleID   string `json:"roleId"`
+	RoleCode string `json:"roleCode"`
+	// PermissionIDs 是角色修改后的全部权限
+	PermissionIDs []string `json:"permissionIds"`
+}
+
+func (*RolePermissionsChanged) EventType() string { return TypeRolePermissionsChanged }
+
+func (e *RolePermissionsChanged) Aggregate() (string, string) { return AggregateRole, e.RoleID }
+
+func (e *RolePermissionsChanged) audit() (string, string) {
+	return audit.ActionRolePermissionsChange, roleResource(e.RoleID)
+}
+
+// audited 是需要记录审计日志的领域事件.
+type audited interface {
+	// audit 返回审计日志的操作类型和资源路径.
+	audit() (action, resource string)
+}
+
+// userResource 返回审计日志中用户的资源路径.
+func userResource(userID string) string {
+	return "/v1/users/" + userID
+}
+
+// roleResource 返回审计日志中角色的资源路径.
+func roleResource(roleID string) string {
+	return "/v1/roles/" + roleID
+}
+
+// enabled 表示是否记录领域事件，未启用 outbox 时不记录，避免事件在 outbox 中无限堆积.
+var enabled atomic.Bool
+
+// SetEnabled 设置是否记录领域事件.
+func SetEnabled(v bool) {
+	enabled.Store(v)
+}
+
+// Enabled 返回是否记录领域事件.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Record 在业务变更所在的事务中记录领域事件的审计日志，并将领域事件写入 outbox，未启用 outbox 时只记录审计日志.
+// 操作者取自 contextx.UserID，模拟登录期间同时记录实际操作者 contextx.ActorID，二者记录在事件的 metadata 中.
+func Record(ctx context.Context, store store.IStore, events ...Event) error {
+	for _, e := range events {
+		if a, ok := e.(audited); ok {
+			action, resource := a.audit()
+			if err := audit.Record(ctx, store, action, resource, e); err != nil {
+				return err
+			}
+		}
+	}
+	if !Enabled() || len(events) == 0 {
+		return nil
+	}
+
+	metadata := make(map[string]string, 2)
+	if userID := contextx.UserID(ctx); userID != "" {
+		metadata[MetadataOperatorID] = userID
+	}
+	if actorID := contextx.ActorID(ctx); actorID != "" {
+		metadata[MetadataActorID] = actorID
+	}
+	objs := make([]*outbox.Event, 0, len(events))
+	for _, e := range events {
+		aggregateType, aggregateID := e.Aggregate()
+		obj, err := outbox.NewEvent(aggregateType, aggregateID, e.EventType(), e, metadata)
+		if err != nil {
+			return err
+		}
+		objs = append(objs, obj)
+	}
+	return store.Outbox().Add(ctx, objs...)
+}
+
+// Publish 在事务提交后将领域事件发布到进程内的事件总线. 业务变更已经提交，
+// 同步订阅者重试后仍然失败时只记录日志，不返回给调用方.
+func Publish(ctx context.Context, bus *eventbus.Bus, events ...Event) {
+	evts := make([]eventbus.Event, 0, len(events))
+	for _, e := range events {
+		evts = append(evts, e)
+	}
+	if err := bus.Publish(ctx, evts...); err != nil {
+		slog.ErrorContext(ctx, "Failed to handle domain events after commit", "error", err)
+	}
+}
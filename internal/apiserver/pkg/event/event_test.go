@@ -0,0 +1,34 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/audit"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestRecord_AuditLog(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	// 未启用 outbox 时仍然在事务中记录审计日志
+	require.False(t, Enabled())
+	err := s.TX(ctx, func(ctx context.Context) error {
+		return Record(ctx, s,
+			&UserRolesAssigned{UserID: "event-audit-user", RoleIDs: []string{"r1"}},
+			&UserUpdated{UserID: "event-audit-user"},
+		)
+	})
+	require.NoError(t, err)
+
+	count, logs, err := s.AuditLog().List(ctx, where.F("resource", userResource("event-audit-user")))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
+	assert.Equal(t, audit.ActionUserRolesAssign, logs[0].Action)
+	assert.JSONEq(t, `{"userId":"event-audit-user","roleIds":["r1"]}`, string(logs[0].Details))
+}
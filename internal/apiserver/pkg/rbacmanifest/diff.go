@@ -0,0 +1,238 @@
+package rbacmanifest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Op 表示一项变更的操作类型.
+type Op string
+
+// 定义变更的操作类型.
+const (
+	OpCreate Op = "create"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+)
+
+// Kind 表示变更的资源类型.
+type Kind string
+
+// 定义变更的资源类型.
+const (
+	KindPermission     Kind = "permission"
+	KindMenu           Kind = "menu"
+	KindRole           Kind = "role"
+	KindRolePermission Kind = "role-permission"
+	KindUser           Kind = "user"
+	KindUserRole       Kind = "user-role"
+)
+
+// Change 描述将数据库当前状态变更为清单期望状态所需的一项变更.
+type Change struct {
+	Op   Op
+	Kind Kind
+	// Key 为资源编码，绑定关系为 <角色编码>/<权限编码> 或 <用户名>/<角色编码>
+	Key string
+	// Fields 为更新操作中发生变化的字段
+	Fields []string
+}
+
+// String 返回变更的可读描述，格式与 diff 类似：+ 新建，~ 更新，- 删除.
+func (c *Change) String() string {
+	switch c.Op {
+	case OpCreate:
+		return fmt.Sprintf("+ %s %s", c.Kind, c.Key)
+	case OpUpdate:
+		return fmt.Sprintf("~ %s %s (%s)", c.Kind, c.Key, strings.Join(c.Fields, ", "))
+	default:
+		return fmt.Sprintf("- %s %s", c.Kind, c.Key)
+	}
+}
+
+// BindingKey 返回绑定关系变更的 Key.
+func BindingKey(owner, target string) string {
+	return owner + "/" + target
+}
+
+// Diff 计算将 current 变更为 desired 所需的变更列表.
+// 新建和更新按依赖顺序排列在前（权限、菜单、角色、角色权限、用户、用户角色），删除按相反顺序排列在后.
+// prune 为 false 时只新增和更新，不删除任何资源和绑定；prune 为 true 时删除清单中未声明的权限、菜单、角色，
+// 以及清单中角色和用户未声明的绑定。用户永远不会被删除.
+func Diff(current, desired *Manifest, prune bool) []*Change {
+	var upserts, deletes []*Change
+
+	currentPermissions := indexBy(current.Permissions, func(p *Permission) string { return p.Code })
+	for _, want := range desired.Permissions {
+		upserts = appendUpsert(upserts, KindPermission, want.Code, currentPermissions[want.Code], want, permissionFields)
+	}
+
+	currentMenus := indexBy(current.Menus, func(m *Menu) string { return m.Code })
+	for _, want := range desired.Menus {
+		upserts = appendUpsert(upserts, KindMenu, want.Code, currentMenus[want.Code], want, menuFields)
+	}
+
+	currentRoles := indexBy(current.Roles, func(r *Role) string { return r.Code })
+	for _, want := range desired.Roles {
+		upserts = appendUpsert(upserts, KindRole, want.Code, currentRoles[want.Code], want, roleFields)
+	}
+	for _, want := range desired.Roles {
+		var have []string
+		if r, ok := currentRoles[want.Code]; ok {
+			have = r.Permissions
+		}
+		added, removed := diffBindings(have, want.Permissions)
+		upserts = appendBindings(upserts, OpCreate, KindRolePermission, want.Code, added)
+		if prune {
+			deletes = appendBindings(deletes, OpDelete, KindRolePermission, want.Code, removed)
+		}
+	}
+
+	currentUsers := indexBy(current.Users, func(u *User) string { return u.Username })
+	for _, want := range desired.Users {
+		upserts = appendUpsert(upserts, KindUser, want.Username, currentUsers[want.Username], want, userFields)
+	}
+	var userRoleDeletes []*Change
+	for _, want := range desired.Users {
+		var have []string
+		if u, ok := currentUsers[want.Username]; ok {
+			have = u.Roles
+		}
+		added, removed := diffBindings(have, want.Roles)
+		upserts = appendBindings(upserts, OpCreate, KindUserRole, want.Username, added)
+		if prune {
+			userRoleDeletes = appendBindings(userRoleDeletes, OpDelete, KindUserRole, want.Username, removed)
+		}
+	}
+
+	if !prune {
+		return upserts
+	}
+
+	desiredPermissions := indexBy(desired.Permissions, func(p *Permission) string { return p.Code })
+	desiredMenus := indexBy(desired.Menus, func(m *Menu) string { return m.Code })
+	desiredRoles := indexBy(desired.Roles, func(r *Role) string { return r.Code })
+	for _, r := range current.Roles {
+		if _, ok := desiredRoles[r.Code]; !ok {
+			deletes = append(deletes, &Change{Op: OpDelete, Kind: KindRole, Key: r.Code})
+		}
+	}
+	for _, m := range current.Menus {
+		if _, ok := desiredMenus[m.Code]; !ok {
+			deletes = append(deletes, &Change{Op: OpDelete, Kind: KindMenu, Key: m.Code})
+		}
+	}
+	for _, p := range current.Permissions {
+		if _, ok := desiredPermissions[p.Code]; !ok {
+			deletes = append(deletes, &Change{Op: OpDelete, Kind: KindPermission, Key: p.Code})
+		}
+	}
+
+	return append(append(upserts, userRoleDeletes...), deletes...)
+}
+
+// appendUpsert 在资源不存在时追加新建变更，在字段不一致时追加更新变更.
+func appendUpsert[T any](changes []*Change, kind Kind, key string, have, want *T, fields func(have, want *T) []string) []*Change {
+	if have == nil {
+		return append(changes, &Change{Op: OpCreate, Kind: kind, Key: key})
+	}
+	if changed := fields(have, want); len(changed) > 0 {
+		return append(changes, &Change{Op: OpUpdate, Kind: kind, Key: key, Fields: changed})
+	}
+	return changes
+}
+
+// appendBindings 为 owner 的每个绑定目标追加一项绑定变更.
+func appendBindings(changes []*Change, op Op, kind Kind, owner string, targets []string) []*Change {
+	for _, target := range targets {
+		changes = append(changes, &Change{Op: op, Kind: kind, Key: BindingKey(owner, target)})
+	}
+	return changes
+}
+
+// diffBindings 返回 want 中新增的和 have 中多出的绑定目标.
+func diffBindings(have, want []string) (added, removed []string) {
+	for _, target := range want {
+		if !slices.Contains(have, target) {
+			added = append(added, target)
+		}
+	}
+	for _, target := range have {
+		if !slices.Contains(want, target) {
+			removed = append(removed, target)
+		}
+	}
+	return added, removed
+}
+
+// permissionFields 返回权限中发生变化的字段.
+func permissionFields(have, want *Permission) []string {
+	var fields []string
+	fields = appendIf(fields, "name", have.Name != want.Name)
+	fields = appendIf(fields, "resourceType", have.ResourceType != want.ResourceType)
+	fields = appendIf(fields, "resourcePath", have.ResourcePath != want.ResourcePath)
+	fields = appendIf(fields, "action", have.Action != want.Action)
+	fields = appendIf(fields, "description", have.Description != want.Description)
+	fields = appendIf(fields, "parent", have.Parent != want.Parent)
+	fields = appendIf(fields, "disabled", have.Disabled != want.Disabled)
+	fields = appendIf(fields, "conditions", conditionString(have) != conditionString(want))
+	return fields
+}
+
+// menuFields 返回菜单中发生变化的字段.
+func menuFields(have, want *Menu) []string {
+	var fields []string
+	fields = appendIf(fields, "name", have.Name != want.Name)
+	fields = appendIf(fields, "type", have.Type != want.Type)
+	fields = appendIf(fields, "icon", have.Icon != want.Icon)
+	fields = appendIf(fields, "path", have.Path != want.Path)
+	fields = appendIf(fields, "component", have.Component != want.Component)
+	fields = appendIf(fields, "parent", have.Parent != want.Parent)
+	fields = appendIf(fields, "permission", have.Permission != want.Permission)
+	fields = appendIf(fields, "sortOrder", have.SortOrder != want.SortOrder)
+	fields = appendIf(fields, "hidden", have.Hidden != want.Hidden)
+	fields = appendIf(fields, "disabled", have.Disabled != want.Disabled)
+	return fields
+}
+
+// roleFields 返回角色中发生变化的字段，权限绑定单独比较.
+func roleFields(have, want *Role) []string {
+	var fields []string
+	fields = appendIf(fields, "name", have.Name != want.Name)
+	fields = appendIf(fields, "description", have.Description != want.Description)
+	fields = appendIf(fields, "sortOrder", have.SortOrder != want.SortOrder)
+	fields = appendIf(fields, "disabled", have.Disabled != want.Disabled)
+	return fields
+}
+
+// userFields 返回用户中发生变化的字段. 只比较清单中设置了的资料字段，密码只在创建时使用.
+func userFields(have, want *User) []string {
+	var fields []string
+	fields = appendIf(fields, "nickname", want.Nickname != "" && have.Nickname != want.Nickname)
+	fields = appendIf(fields, "email", want.Email != "" && have.Email != want.Email)
+	fields = appendIf(fields, "phone", want.Phone != "" && have.Phone != want.Phone)
+	return fields
+}
+
+func appendIf(fields []string, field string, changed bool) []string {
+	if changed {
+		return append(fields, field)
+	}
+	return fields
+}
+
+func conditionString(p *Permission) string {
+	if p.Conditions == nil {
+		return ""
+	}
+	return p.Conditions.String()
+}
+
+func indexBy[T any](items []*T, key func(*T) string) map[string]*T {
+	index := make(map[string]*T, len(items))
+	for _, item := range items {
+		index[key(item)] = item
+	}
+	return index
+}
@@ -0,0 +1,218 @@
+// Package rbacmanifest 定义声明式 RBAC 清单的格式，用于在代码仓库中维护角色、权限、菜单、
+// 角色权限绑定和初始用户，并计算清单与数据库当前状态之间的差异.
+package rbacmanifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+)
+
+// Manifest 描述一个环境期望的 RBAC 状态，各资源均以编码（用户以用户名）作为唯一标识.
+// 清单只管理永久有效的角色和权限分配，限时分配不会被导出，也不会被清理.
+type Manifest struct {
+	Permissions []*Permission `json:"permissions,omitempty"`
+	Menus       []*Menu       `json:"menus,omitempty"`
+	Roles       []*Role       `json:"roles,omitempty"`
+	Users       []*User       `json:"users,omitempty"`
+}
+
+// Permission 描述一个权限.
+type Permission struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	ResourceType string `json:"resourceType"`
+	ResourcePath string `json:"resourcePath,omitempty"`
+	Action       string `json:"action"`
+	Description  string `json:"description,omitempty"`
+	// Parent 为父权限编码
+	Parent     string           `json:"parent,omitempty"`
+	Disabled   bool             `json:"disabled,omitempty"`
+	Conditions *authz.Condition `json:"conditions,omitempty"`
+}
+
+// Menu 描述一个菜单.
+type Menu struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Icon      string `json:"icon,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Component string `json:"component,omitempty"`
+	// Parent 为父菜单编码
+	Parent string `json:"parent,omitempty"`
+	// Permission 为关联的权限编码
+	Permission string `json:"permission,omitempty"`
+	SortOrder  int32  `json:"sortOrder,omitempty"`
+	Hidden     bool   `json:"hidden,omitempty"`
+	Disabled   bool   `json:"disabled,omitempty"`
+}
+
+// Role 描述一个角色及其永久有效的权限.
+type Role struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	SortOrder   int32  `json:"sortOrder,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+	// Permissions 为角色拥有的权限编码
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// User 描述一个初始用户及其永久有效的角色.
+// 用户只会被创建，不会被清理；Password 仅在创建时使用，可以是明文或已有的密码哈希.
+type User struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+	Nickname string `json:"nickname,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+	// Roles 为用户拥有的角色编码
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Load 读取清单文件，支持 YAML 和 JSON 格式，读取后会进行校验.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := yaml.UnmarshalStrict(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+
+	return &m, nil
+}
+
+// Marshal 按 format 编码清单，format 为 json 时输出 JSON，否则输出 YAML.
+func (m *Manifest) Marshal(format string) ([]byte, error) {
+	if format == "json" {
+		data, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+	return yaml.Marshal(m)
+}
+
+// FormatOf 根据文件扩展名返回清单格式.
+func FormatOf(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return "json"
+	}
+	return "yaml"
+}
+
+// Validate 校验清单中的编码唯一且引用的资源均已在清单中声明.
+// 用户只能引用清单中声明的角色，角色只能引用清单中声明的权限.
+func (m *Manifest) Validate() error {
+	var errs []error
+
+	permissions := make(map[string]bool, len(m.Permissions))
+	for _, p := range m.Permissions {
+		switch {
+		case p.Code == "":
+			errs = append(errs, errors.New("permission code is required"))
+		case permissions[p.Code]:
+			errs = append(errs, fmt.Errorf("duplicate permission %q", p.Code))
+		case p.Name == "" || p.ResourceType == "" || p.Action == "":
+			errs = append(errs, fmt.Errorf("permission %q: name, resourceType and action are required", p.Code))
+		}
+		if p.Conditions != nil {
+			if err := p.Conditions.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("permission %q: %w", p.Code, err))
+			}
+		}
+		permissions[p.Code] = true
+	}
+	for _, p := range m.Permissions {
+		if p.Parent != "" && !permissions[p.Parent] {
+			errs = append(errs, fmt.Errorf("permission %q: unknown parent %q", p.Code, p.Parent))
+		}
+	}
+
+	menus := make(map[string]bool, len(m.Menus))
+	for _, menu := range m.Menus {
+		switch {
+		case menu.Code == "":
+			errs = append(errs, errors.New("menu code is required"))
+		case menus[menu.Code]:
+			errs = append(errs, fmt.Errorf("duplicate menu %q", menu.Code))
+		case menu.Name == "" || menu.Type == "":
+			errs = append(errs, fmt.Errorf("menu %q: name and type are required", menu.Code))
+		}
+		if menu.Permission != "" && !permissions[menu.Permission] {
+			errs = append(errs, fmt.Errorf("menu %q: unknown permission %q", menu.Code, menu.Permission))
+		}
+		menus[menu.Code] = true
+	}
+	for _, menu := range m.Menus {
+		if menu.Parent != "" && !menus[menu.Parent] {
+			errs = append(errs, fmt.Errorf("menu %q: unknown parent %q", menu.Code, menu.Parent))
+		}
+	}
+
+	roles := make(map[string]bool, len(m.Roles))
+	for _, r := range m.Roles {
+		switch {
+		case r.Code == "":
+			errs = append(errs, errors.New("role code is required"))
+		case roles[r.Code]:
+			errs = append(errs, fmt.Errorf("duplicate role %q", r.Code))
+		case r.Name == "":
+			errs = append(errs, fmt.Errorf("role %q: name is required", r.Code))
+		}
+		for _, code := range r.Permissions {
+			if !permissions[code] {
+				errs = append(errs, fmt.Errorf("role %q: unknown permission %q", r.Code, code))
+			}
+		}
+		roles[r.Code] = true
+	}
+
+	users := make(map[string]bool, len(m.Users))
+	for _, u := range m.Users {
+		switch {
+		case u.Username == "":
+			errs = append(errs, errors.New("username is required"))
+		case users[u.Username]:
+			errs = append(errs, fmt.Errorf("duplicate user %q", u.Username))
+		}
+		for _, code := range u.Roles {
+			if !roles[code] {
+				errs = append(errs, fmt.Errorf("user %q: unknown role %q", u.Username, code))
+			}
+		}
+		users[u.Username] = true
+	}
+
+	return errors.Join(errs...)
+}
+
+// Sort 按编码排序清单中的资源和绑定，保证导出结果稳定，便于通过 git 审阅差异.
+func (m *Manifest) Sort() {
+	sort.Slice(m.Permissions, func(i, j int) bool { return m.Permissions[i].Code < m.Permissions[j].Code })
+	sort.Slice(m.Menus, func(i, j int) bool { return m.Menus[i].Code < m.Menus[j].Code })
+	sort.Slice(m.Roles, func(i, j int) bool { return m.Roles[i].Code < m.Roles[j].Code })
+	sort.Slice(m.Users, func(i, j int) bool { return m.Users[i].Username < m.Users[j].Username })
+	for _, r := range m.Roles {
+		sort.Strings(r.Permissions)
+	}
+	for _, u := range m.Users {
+		sort.Strings(u.Roles)
+	}
+}
@@ -0,0 +1,148 @@
+package rbacmanifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+)
+
+func changeStrings(changes []*Change) []string {
+	out := make([]string, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, c.String())
+	}
+	return out
+}
+
+func sampleManifest() *Manifest {
+	return &Manifest{
+		Permissions: []*Permission{
+			{Code: "system", Name: "系统管理", ResourceType: "menu", Action: "*"},
+			{Code: "user:list", Name: "用户列表", ResourceType: "api", ResourcePath: "/v1/users", Action: "GET", Parent: "system"},
+		},
+		Menus: []*Menu{
+			{Code: "system", Name: "系统管理", Type: "menu", Permission: "system"},
+		},
+		Roles: []*Role{
+			{Code: "admin", Name: "管理员", Permissions: []string{"system", "user:list"}},
+		},
+		Users: []*User{
+			{Username: "admin", Password: "ChangeMe123!", Roles: []string{"admin"}},
+		},
+	}
+}
+
+// TestLoad 测试读取示例清单以及 YAML 与 JSON 格式的往返编码。
+func TestLoad(t *testing.T) {
+	m, err := Load("../../../../configs/rbac.yaml")
+	require.NoError(t, err)
+	assert.NotEmpty(t, m.Roles)
+	assert.NotNil(t, m.Permissions[2].Conditions)
+
+	for _, name := range []string{"rbac.yaml", "rbac.json"} {
+		path := filepath.Join(t.TempDir(), name)
+		data, err := m.Marshal(FormatOf(path))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		loaded, err := Load(path)
+		require.NoError(t, err)
+		assert.Empty(t, Diff(m, loaded, true), name)
+	}
+}
+
+// TestLoad_UnknownField 测试清单中的未知字段会被拒绝。
+func TestLoad_UnknownField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rbac.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("roles:\n  - code: admin\n    name: admin\n    permission: [x]\n"), 0o600))
+
+	_, err := Load(path)
+	assert.Error(t, err)
+}
+
+// TestManifest_Validate 测试清单校验。
+func TestManifest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(m *Manifest)
+		wantErr bool
+	}{
+		{name: "合法清单", mutate: func(m *Manifest) {}},
+		{name: "重复权限", mutate: func(m *Manifest) { m.Permissions = append(m.Permissions, m.Permissions[0]) }, wantErr: true},
+		{name: "未知父权限", mutate: func(m *Manifest) { m.Permissions[1].Parent = "missing" }, wantErr: true},
+		{name: "菜单引用未知权限", mutate: func(m *Manifest) { m.Menus[0].Permission = "missing" }, wantErr: true},
+		{name: "角色引用未知权限", mutate: func(m *Manifest) { m.Roles[0].Permissions = []string{"missing"} }, wantErr: true},
+		{name: "用户引用未知角色", mutate: func(m *Manifest) { m.Users[0].Roles = []string{"missing"} }, wantErr: true},
+		{
+			name:    "非法条件",
+			mutate:  func(m *Manifest) { m.Permissions[1].Conditions = &authz.Condition{Weekdays: []int{7}} },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := sampleManifest()
+			tt.mutate(m)
+			assert.Equal(t, tt.wantErr, m.Validate() != nil)
+		})
+	}
+}
+
+// TestDiff 测试清单差异计算。
+func TestDiff(t *testing.T) {
+	t.Run("空库全部新建", func(t *testing.T) {
+		changes := Diff(&Manifest{}, sampleManifest(), false)
+		assert.Equal(t, []string{
+			"+ permission system",
+			"+ permission user:list",
+			"+ menu system",
+			"+ role admin",
+			"+ role-permission admin/system",
+			"+ role-permission admin/user:list",
+			"+ user admin",
+			"+ user-role admin/admin",
+		}, changeStrings(changes))
+	})
+
+	t.Run("状态一致时没有变更", func(t *testing.T) {
+		current := sampleManifest()
+		current.Users[0].Password = ""
+		assert.Empty(t, Diff(current, sampleManifest(), true))
+	})
+
+	t.Run("更新字段", func(t *testing.T) {
+		desired := sampleManifest()
+		desired.Permissions[1].Action = "POST"
+		desired.Permissions[1].Conditions = &authz.Condition{CIDRs: []string{"10.0.0.0/8"}}
+		desired.Roles[0].Name = "超级管理员"
+		desired.Users[0].Nickname = "Admin"
+		assert.Equal(t, []string{
+			"~ permission user:list (action, conditions)",
+			"~ role admin (name)",
+			"~ user admin (nickname)",
+		}, changeStrings(Diff(sampleManifest(), desired, false)))
+	})
+
+	t.Run("仅在 prune 时删除", func(t *testing.T) {
+		current := sampleManifest()
+		current.Permissions = append(current.Permissions, &Permission{Code: "legacy", Name: "legacy", ResourceType: "api", Action: "GET"})
+		current.Roles = append(current.Roles, &Role{Code: "guest", Name: "访客"})
+		current.Roles[0].Permissions = append(current.Roles[0].Permissions, "legacy")
+		current.Users[0].Roles = append(current.Users[0].Roles, "guest")
+		current.Users = append(current.Users, &User{Username: "alice", Roles: []string{"guest"}})
+
+		assert.Empty(t, Diff(current, sampleManifest(), false))
+		assert.Equal(t, []string{
+			"- user-role admin/guest",
+			"- role-permission admin/legacy",
+			"- role guest",
+			"- permission legacy",
+		}, changeStrings(Diff(current, sampleManifest(), true)))
+	})
+}
@@ -0,0 +1,38 @@
+// Package sod 实现静态职责分离（Separation of Duties）规则的冲突检测.
+package sod
+
+import (
+	"slices"
+)
+
+// Rule 表示一条静态职责分离规则：同一用户最多只能同时持有 RoleIDs 中的 MaxRoles 个角色.
+type Rule struct {
+	ID       string
+	Name     string
+	RoleIDs  []string
+	MaxRoles int
+}
+
+// Violation 表示一组角色违反了某条规则.
+type Violation struct {
+	Rule *Rule
+	// RoleIDs 是同时持有的、属于该规则的角色 ID，按规则中的顺序排列
+	RoleIDs []string
+}
+
+// Check 返回同时持有 roleIDs 时违反的全部规则，未违反任何规则时返回 nil.
+func Check(rules []*Rule, roleIDs []string) []*Violation {
+	var violations []*Violation
+	for _, rule := range rules {
+		var held []string
+		for _, roleID := range rule.RoleIDs {
+			if slices.Contains(roleIDs, roleID) && !slices.Contains(held, roleID) {
+				held = append(held, roleID)
+			}
+		}
+		if len(held) > rule.MaxRoles {
+			violations = append(violations, &Violation{Rule: rule, RoleIDs: held})
+		}
+	}
+	return violations
+}
@@ -0,0 +1,40 @@
+package sod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheck 测试职责分离规则的冲突检测.
+func TestCheck(t *testing.T) {
+	payment := &Rule{ID: "r1", Name: "payment", RoleIDs: []string{"creator", "approver"}, MaxRoles: 1}
+	audit := &Rule{ID: "r2", Name: "audit", RoleIDs: []string{"auditor", "admin", "operator"}, MaxRoles: 2}
+	rules := []*Rule{payment, audit}
+
+	tests := []struct {
+		name    string
+		roleIDs []string
+		want    []*Violation
+	}{
+		{name: "没有角色", roleIDs: nil},
+		{name: "只持有一个互斥角色", roleIDs: []string{"creator", "viewer"}},
+		{name: "持有两个互斥角色", roleIDs: []string{"approver", "viewer", "creator"}, want: []*Violation{{Rule: payment, RoleIDs: []string{"creator", "approver"}}}},
+		{name: "重复角色只计一次", roleIDs: []string{"creator", "creator"}},
+		{name: "未超过上限", roleIDs: []string{"auditor", "operator"}},
+		{
+			name:    "同时违反多条规则",
+			roleIDs: []string{"creator", "approver", "auditor", "admin", "operator"},
+			want: []*Violation{
+				{Rule: payment, RoleIDs: []string{"creator", "approver"}},
+				{Rule: audit, RoleIDs: []string{"auditor", "admin", "operator"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Check(rules, tt.roleIDs))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"context"
+	"time"
+
+	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateAccessRequestRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"RequestID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("requestID cannot be empty")
+			}
+			return nil
+		},
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"RoleID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("roleID cannot be empty")
+			}
+			return nil
+		},
+		"Reason": func(value any) error {
+			if len(value.(string)) > 255 {
+				return errno.ErrInvalidArgument.WithMessage("reason cannot exceed 255 characters")
+			}
+			return nil
+		},
+		"Comment": func(value any) error {
+			if len(value.(string)) > 255 {
+				return errno.ErrInvalidArgument.WithMessage("comment cannot exceed 255 characters")
+			}
+			return nil
+		},
+		"Status": func(value any) error {
+			switch value.(string) {
+			case known.AccessRequestPending, known.AccessRequestApproved, known.AccessRequestRejected, known.AccessRequestExpired:
+				return nil
+			}
+			return errno.ErrInvalidArgument.WithMessage("status must be one of pending, approved, rejected, expired")
+		},
+	}
+}
+
+// ValidateCreateAccessRequestRequest 校验 CreateAccessRequestRequest 结构体的有效性.
+func (v *Validator) ValidateCreateAccessRequestRequest(ctx context.Context, rq *v1.CreateAccessRequestRequest) error {
+	if rq.GrantExpiresAt != nil {
+		if rq.GetGrantExpiresAt() <= time.Now().Unix() {
+			return errno.ErrInvalidArgument.WithMessage("grantExpiresAt must be in the future")
+		}
+		if rq.GrantStartsAt != nil && rq.GetGrantStartsAt() >= rq.GetGrantExpiresAt() {
+			return errno.ErrInvalidArgument.WithMessage("grantStartsAt must be before grantExpiresAt")
+		}
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessRequestRules())
+}
+
+// ValidateListAccessRequestsRequest 校验 ListAccessRequestsRequest 结构体的有效性.
+func (v *Validator) ValidateListAccessRequestsRequest(ctx context.Context, rq *v1.ListAccessRequestsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessRequestRules())
+}
+
+// ValidateGetAccessRequestRequest 校验 GetAccessRequestRequest 结构体的有效性.
+func (v *Validator) ValidateGetAccessRequestRequest(ctx context.Context, rq *v1.GetAccessRequestRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessRequestRules())
+}
+
+// ValidateApproveAccessRequestRequest 校验 ApproveAccessRequestRequest 结构体的有效性.
+func (v *Validator) ValidateApproveAccessRequestRequest(ctx context.Context, rq *v1.ApproveAccessRequestRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessRequestRules())
+}
+
+// ValidateRejectAccessRequestRequest 校验 RejectAccessRequestRequest 结构体的有效性.
+func (v *Validator) ValidateRejectAccessRequestRequest(ctx context.Context, rq *v1.RejectAccessRequestRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAccessRequestRules())
+}
@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"context"
+	"time"
+
+	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateInvitationRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"Code": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("code cannot be empty")
+			}
+			return nil
+		},
+		"MaxUses": func(value any) error {
+			if value.(int32) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("maxUses cannot be negative")
+			}
+			return nil
+		},
+		"ExpiresAt": func(value any) error {
+			if expiresAt := value.(int64); expiresAt != 0 && expiresAt <= time.Now().Unix() {
+				return errno.ErrInvalidArgument.WithMessage("expiresAt must be in the future")
+			}
+			return nil
+		},
+		"Remark": func(value any) error {
+			if len(value.(string)) > 255 {
+				return errno.ErrInvalidArgument.WithMessage("remark cannot exceed 255 characters")
+			}
+			return nil
+		},
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateInvitationRequest 校验 CreateInvitationRequest 结构体的有效性.
+func (v *Validator) ValidateCreateInvitationRequest(ctx context.Context, rq *v1.CreateInvitationRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateInvitationRules())
+}
+
+// ValidateListInvitationRequest 校验 ListInvitationRequest 结构体的有效性.
+func (v *Validator) ValidateListInvitationRequest(ctx context.Context, rq *v1.ListInvitationRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateInvitationRules())
+}
+
+// ValidateRevokeInvitationRequest 校验 RevokeInvitationRequest 结构体的有效性.
+func (v *Validator) ValidateRevokeInvitationRequest(ctx context.Context, rq *v1.RevokeInvitationRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateInvitationRules())
+}
+
+// ValidateGetRegistrationPolicyRequest 校验 GetRegistrationPolicyRequest 结构体的有效性.
+func (v *Validator) ValidateGetRegistrationPolicyRequest(ctx context.Context, rq *v1.GetRegistrationPolicyRequest) error {
+	return nil
+}
+
+// ValidateListPendingRegistrationsRequest 校验 ListPendingRegistrationsRequest 结构体的有效性.
+func (v *Validator) ValidateListPendingRegistrationsRequest(ctx context.Context, rq *v1.ListPendingRegistrationsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateInvitationRules())
+}
+
+// ValidateApproveRegistrationRequest 校验 ApproveRegistrationRequest 结构体的有效性.
+func (v *Validator) ValidateApproveRegistrationRequest(ctx context.Context, rq *v1.ApproveRegistrationRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateInvitationRules())
+}
+
+// ValidateRejectRegistrationRequest 校验 RejectRegistrationRequest 结构体的有效性.
+func (v *Validator) ValidateRejectRegistrationRequest(ctx context.Context, rq *v1.RejectRegistrationRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateInvitationRules())
+}
@@ -107,7 +107,11 @@ func (v *Validator) ValidateCreateMenuRequest(ctx context.Context, rq *v1.Create
 
 // ValidateUpdateMenuRequest 校验更新菜单请求.
 func (v *Validator) ValidateUpdateMenuRequest(ctx context.Context, rq *v1.UpdateMenuRequest) error {
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidateMenuRules(), "MenuID")
+	fields, err := validateUpdateMask(rq, rq.GetUpdateMask(), "menuID", "menuName")
+	if err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateMenuRules(), append(fields, "MenuID")...)
 }
 
 // ValidateDeleteMenuRequest 校验删除菜单请求.
@@ -134,3 +138,13 @@ func (v *Validator) ValidateListMenuTreeRequest(ctx context.Context, rq *v1.List
 func (v *Validator) ValidateGetUserMenuTreeRequest(ctx context.Context, rq *v1.GetUserMenuTreeRequest) error {
 	return nil // 该请求从 JWT 获取用户 ID，无需额外验证
 }
+
+// ValidateListDeletedMenusRequest 校验回收站菜单列表请求.
+func (v *Validator) ValidateListDeletedMenusRequest(ctx context.Context, rq *v1.ListDeletedMenusRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateMenuRules(), "PageToken", "PageSize")
+}
+
+// ValidateRestoreMenuRequest 校验恢复菜单请求.
+func (v *Validator) ValidateRestoreMenuRequest(ctx context.Context, rq *v1.RestoreMenuRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMenuRules())
+}
@@ -5,6 +5,7 @@ import (
 
 	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
 
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/conversion"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 )
@@ -37,8 +38,8 @@ func (v *Validator) ValidatePermissionRules() genericvalidation.Rules {
 		},
 		"ResourceType": func(value any) error {
 			rtype := value.(string)
-			if rtype != "menu" && rtype != "button" {
-				return errno.ErrInvalidArgument.WithMessage("resourceType must be 'menu' or 'button'")
+			if rtype != "menu" && rtype != "button" && rtype != "api" {
+				return errno.ErrInvalidArgument.WithMessage("resourceType must be 'menu', 'button' or 'api'")
 			}
 			return nil
 		},
@@ -100,12 +101,22 @@ func (v *Validator) ValidatePermissionRules() genericvalidation.Rules {
 
 // ValidateCreatePermissionRequest 校验创建权限请求.
 func (v *Validator) ValidateCreatePermissionRequest(ctx context.Context, rq *v1.CreatePermissionRequest) error {
-	return genericvalidation.ValidateAllFields(rq, v.ValidatePermissionRules())
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePermissionRules()); err != nil {
+		return err
+	}
+	return validatePermissionCondition(rq.GetConditions())
 }
 
 // ValidateUpdatePermissionRequest 校验更新权限请求.
 func (v *Validator) ValidateUpdatePermissionRequest(ctx context.Context, rq *v1.UpdatePermissionRequest) error {
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePermissionRules(), "PermissionID")
+	fields, err := validateUpdateMask(rq, rq.GetUpdateMask(), "permissionID", "permissionName")
+	if err != nil {
+		return err
+	}
+	if err := genericvalidation.ValidateSelectedFields(rq, v.ValidatePermissionRules(), append(fields, "PermissionID")...); err != nil {
+		return err
+	}
+	return validatePermissionCondition(rq.GetConditions())
 }
 
 // ValidateDeletePermissionRequest 校验删除权限请求.
@@ -127,3 +138,32 @@ func (v *Validator) ValidateListPermissionRequest(ctx context.Context, rq *v1.Li
 func (v *Validator) ValidateListPermissionTreeRequest(ctx context.Context, rq *v1.ListPermissionTreeRequest) error {
 	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePermissionRules(), "Level", "ResourceType", "Status")
 }
+
+// validatePermissionCondition 校验权限的 ABAC 生效条件.
+func validatePermissionCondition(protoCondition *v1.PermissionCondition) error {
+	if protoCondition == nil {
+		return nil
+	}
+	if len(protoCondition.GetCidrs()) > 50 || len(protoCondition.GetAttributes()) > 20 {
+		return errno.ErrInvalidArgument.WithMessage("conditions can have at most 50 cidrs and 20 attributes")
+	}
+	if err := conversion.PermissionConditionV1ToAuthz(protoCondition).Validate(); err != nil {
+		return errno.ErrInvalidArgument.WithMessage("invalid conditions: " + err.Error())
+	}
+	return nil
+}
+
+// ValidateGetAPICatalogRequest 校验获取 API 路由目录请求.
+func (v *Validator) ValidateGetAPICatalogRequest(ctx context.Context, rq *v1.GetAPICatalogRequest) error {
+	return nil
+}
+
+// ValidateListDeletedPermissionsRequest 校验回收站权限列表请求.
+func (v *Validator) ValidateListDeletedPermissionsRequest(ctx context.Context, rq *v1.ListDeletedPermissionsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePermissionRules(), "PageToken", "PageSize")
+}
+
+// ValidateRestorePermissionRequest 校验恢复权限请求.
+func (v *Validator) ValidateRestorePermissionRequest(ctx context.Context, rq *v1.RestorePermissionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePermissionRules())
+}
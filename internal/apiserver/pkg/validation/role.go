@@ -2,6 +2,7 @@ package validation
 
 import (
 	"context"
+	"fmt"
 
 	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
 
@@ -106,7 +107,11 @@ func (v *Validator) ValidateCreateRoleRequest(ctx context.Context, rq *v1.Create
 
 // ValidateUpdateRoleRequest 校验更新角色请求.
 func (v *Validator) ValidateUpdateRoleRequest(ctx context.Context, rq *v1.UpdateRoleRequest) error {
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidateRoleRules(), "RoleID")
+	fields, err := validateUpdateMask(rq, rq.GetUpdateMask(), "roleID", "roleName")
+	if err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateRoleRules(), append(fields, "RoleID")...)
 }
 
 // ValidateDeleteRoleRequest 校验删除角色请求.
@@ -126,6 +131,23 @@ func (v *Validator) ValidateListRoleRequest(ctx context.Context, rq *v1.ListRole
 
 // ValidateAssignPermissionsToRoleRequest 校验分配权限请求.
 func (v *Validator) ValidateAssignPermissionsToRoleRequest(ctx context.Context, rq *v1.AssignPermissionsToRoleRequest) error {
+	seen := make(map[string]bool, len(rq.GetPermissionIDs())+len(rq.GetAssignments()))
+	for _, permissionID := range rq.GetPermissionIDs() {
+		seen[permissionID] = true
+	}
+	for _, assignment := range rq.GetAssignments() {
+		if assignment.GetPermissionID() == "" {
+			return errno.ErrInvalidArgument.WithMessage("assignments.permissionID cannot be empty")
+		}
+		if seen[assignment.GetPermissionID()] {
+			return errno.ErrInvalidArgument.WithMessage(fmt.Sprintf("permission `%s` is assigned more than once", assignment.GetPermissionID()))
+		}
+		seen[assignment.GetPermissionID()] = true
+		if err := isValidAssignmentPeriod(assignment.StartsAt, assignment.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
 	return genericvalidation.ValidateSelectedFields(rq, v.ValidateRoleRules(), "RoleID", "PermissionIDs", "Mode")
 }
 
@@ -133,3 +155,13 @@ func (v *Validator) ValidateAssignPermissionsToRoleRequest(ctx context.Context,
 func (v *Validator) ValidateGetRolePermissionsRequest(ctx context.Context, rq *v1.GetRolePermissionsRequest) error {
 	return genericvalidation.ValidateAllFields(rq, v.ValidateRoleRules())
 }
+
+// ValidateListDeletedRolesRequest 校验回收站角色列表请求.
+func (v *Validator) ValidateListDeletedRolesRequest(ctx context.Context, rq *v1.ListDeletedRolesRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateRoleRules(), "PageToken", "PageSize")
+}
+
+// ValidateRestoreRoleRequest 校验恢复角色请求.
+func (v *Validator) ValidateRestoreRoleRequest(ctx context.Context, rq *v1.RestoreRoleRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateRoleRules())
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateSessionRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"SessionID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("sessionID cannot be empty")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateListSessionsRequest 校验获取会话列表请求，只允许查看自己的会话.
+func (v *Validator) ValidateListSessionsRequest(ctx context.Context, rq *v1.ListSessionsRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
+
+// ValidateRevokeSessionRequest 校验撤销会话请求，只允许撤销自己的会话.
+func (v *Validator) ValidateRevokeSessionRequest(ctx context.Context, rq *v1.RevokeSessionRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
+
+// ValidateRevokeOtherSessionsRequest 校验撤销其他会话请求，只允许操作自己的会话.
+func (v *Validator) ValidateRevokeOtherSessionsRequest(ctx context.Context, rq *v1.RevokeOtherSessionsRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
+
+// ValidateTerminateUserSessionsRequest 校验终止用户全部会话请求（管理员接口，权限由 Casbin 控制）.
+func (v *Validator) ValidateTerminateUserSessionsRequest(ctx context.Context, rq *v1.TerminateUserSessionsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
+
+// ValidateAdminListSessionsRequest 校验管理员获取用户会话列表请求（权限由 Casbin 控制）.
+func (v *Validator) ValidateAdminListSessionsRequest(ctx context.Context, rq *v1.ListSessionsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
+
+// ValidateAdminRevokeSessionRequest 校验管理员撤销用户会话请求（权限由 Casbin 控制）.
+func (v *Validator) ValidateAdminRevokeSessionRequest(ctx context.Context, rq *v1.RevokeSessionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSessionRules())
+}
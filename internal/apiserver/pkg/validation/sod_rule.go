@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"context"
+	"slices"
+
+	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateSoDRuleRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"RuleID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("ruleID cannot be empty")
+			}
+			return nil
+		},
+		"Name": func(value any) error {
+			if name := value.(string); len(name) == 0 || len(name) > 50 {
+				return errno.ErrInvalidArgument.WithMessage("name must be between 1 and 50 characters")
+			}
+			return nil
+		},
+		"Description": func(value any) error {
+			if len(value.(string)) > 200 {
+				return errno.ErrInvalidArgument.WithMessage("description must be less than 200 characters")
+			}
+			return nil
+		},
+		"MaxRoles": func(value any) error {
+			if value.(int32) < 1 {
+				return errno.ErrInvalidArgument.WithMessage("maxRoles must be at least 1")
+			}
+			return nil
+		},
+	}
+}
+
+// validateSoDRuleRoleIDs 校验互斥角色集合：至少包含两个不重复的角色，且数量大于 maxRoles.
+func validateSoDRuleRoleIDs(roleIDs []string, maxRoles int32) error {
+	seen := make(map[string]bool, len(roleIDs))
+	for _, roleID := range roleIDs {
+		if roleID == "" {
+			return errno.ErrInvalidArgument.WithMessage("roleIDs cannot contain empty values")
+		}
+		if seen[roleID] {
+			return errno.ErrInvalidArgument.WithMessage("roleIDs cannot contain duplicates")
+		}
+		seen[roleID] = true
+	}
+	if len(roleIDs) < 2 {
+		return errno.ErrInvalidArgument.WithMessage("roleIDs must contain at least 2 roles")
+	}
+	if int(maxRoles) >= len(roleIDs) {
+		return errno.ErrInvalidArgument.WithMessage("maxRoles must be less than the number of roleIDs")
+	}
+	return nil
+}
+
+// ValidateCreateSoDRuleRequest 校验 CreateSoDRuleRequest 结构体的有效性.
+func (v *Validator) ValidateCreateSoDRuleRequest(ctx context.Context, rq *v1.CreateSoDRuleRequest) error {
+	if err := validateSoDRuleRoleIDs(rq.GetRoleIDs(), rq.GetMaxRoles()); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSoDRuleRules())
+}
+
+// ValidateUpdateSoDRuleRequest 校验 UpdateSoDRuleRequest 结构体的有效性.
+// maxRoles 与角色数量的关系依赖已保存的规则，由业务层校验.
+func (v *Validator) ValidateUpdateSoDRuleRequest(ctx context.Context, rq *v1.UpdateSoDRuleRequest) error {
+	fields, err := validateUpdateMask(rq, rq.GetUpdateMask(), "ruleID", "name", "maxRoles")
+	if err != nil {
+		return err
+	}
+	if slices.Contains(fields, "RoleIDs") {
+		if err := validateSoDRuleRoleIDs(rq.GetRoleIDs(), 1); err != nil {
+			return err
+		}
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSoDRuleRules())
+}
+
+// ValidateDeleteSoDRuleRequest 校验 DeleteSoDRuleRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteSoDRuleRequest(ctx context.Context, rq *v1.DeleteSoDRuleRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSoDRuleRules())
+}
+
+// ValidateListSoDRulesRequest 校验 ListSoDRulesRequest 结构体的有效性.
+func (v *Validator) ValidateListSoDRulesRequest(ctx context.Context, rq *v1.ListSoDRulesRequest) error {
+	return nil
+}
+
+// ValidateListSoDViolationsRequest 校验 ListSoDViolationsRequest 结构体的有效性.
+func (v *Validator) ValidateListSoDViolationsRequest(ctx context.Context, rq *v1.ListSoDViolationsRequest) error {
+	return nil
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateSSORules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"Provider": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("provider cannot be empty")
+			}
+			return nil
+		},
+		"State": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("state cannot be empty")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateListOIDCProvidersRequest 校验 ListOIDCProvidersRequest 结构体的有效性.
+func (v *Validator) ValidateListOIDCProvidersRequest(ctx context.Context, rq *v1.ListOIDCProvidersRequest) error {
+	return nil
+}
+
+// ValidateStartOIDCLoginRequest 校验 StartOIDCLoginRequest 结构体的有效性.
+func (v *Validator) ValidateStartOIDCLoginRequest(ctx context.Context, rq *v1.StartOIDCLoginRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSSORules())
+}
+
+// ValidateOIDCCallbackRequest 校验 OIDCCallbackRequest 结构体的有效性. IdP 返回错误时可以没有授权码.
+func (v *Validator) ValidateOIDCCallbackRequest(ctx context.Context, rq *v1.OIDCCallbackRequest) error {
+	if rq.GetCode() == "" && rq.GetError() == "" {
+		return errno.ErrInvalidArgument.WithMessage("code cannot be empty")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSSORules())
+}
+
+// ValidateListUserIdentitiesRequest 校验获取外部身份列表请求，只允许查看自己的外部身份.
+func (v *Validator) ValidateListUserIdentitiesRequest(ctx context.Context, rq *v1.ListUserIdentitiesRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSSORules())
+}
+
+// ValidateLinkUserIdentityRequest 校验关联外部身份请求，只允许为自己关联.
+func (v *Validator) ValidateLinkUserIdentityRequest(ctx context.Context, rq *v1.LinkUserIdentityRequest) error {
+	if err := denyImpersonation(ctx); err != nil {
+		return err
+	}
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSSORules())
+}
+
+// ValidateUnlinkUserIdentityRequest 校验解除外部身份关联请求，只允许解除自己的关联.
+func (v *Validator) ValidateUnlinkUserIdentityRequest(ctx context.Context, rq *v1.UnlinkUserIdentityRequest) error {
+	if err := denyImpersonation(ctx); err != nil {
+		return err
+	}
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSSORules())
+}
@@ -3,11 +3,13 @@ package validation
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
 
 	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
 	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
 )
 
@@ -48,6 +50,38 @@ func (v *Validator) ValidateUserRules() genericvalidation.Rules {
 		"Phone": func(value any) error {
 			return isValidPhone(value.(string))
 		},
+		"Avatar": func(value any) error {
+			if len(value.(string)) > 512 {
+				return errno.ErrInvalidArgument.WithMessage("avatar must be at most 512 characters")
+			}
+			return nil
+		},
+		"Gender": func(value any) error {
+			gender := int16(value.(int32))
+			if gender != known.GenderUnknown && gender != known.GenderMale && gender != known.GenderFemale {
+				return errno.ErrInvalidArgument.WithMessage("gender must be 0 (unknown), 1 (male) or 2 (female)")
+			}
+			return nil
+		},
+		"Status": func(value any) error {
+			status := int16(value.(int32))
+			if status != known.UserStatusActive && status != known.UserStatusDisabled {
+				return errno.ErrInvalidArgument.WithMessage("status must be 0 (active) or 1 (disabled)")
+			}
+			return nil
+		},
+		"Description": func(value any) error {
+			if len([]rune(value.(string))) > 500 {
+				return errno.ErrInvalidArgument.WithMessage("description must be at most 500 characters")
+			}
+			return nil
+		},
+		"DeviceName": func(value any) error {
+			if len(value.(string)) > 128 {
+				return errno.ErrInvalidArgument.WithMessage("deviceName must be at most 128 characters")
+			}
+			return nil
+		},
 		"Limit": func(value any) error {
 			if value.(int64) <= 0 {
 				return errno.ErrInvalidArgument.WithMessage("limit must be greater than 0")
@@ -62,11 +96,22 @@ func (v *Validator) ValidateUserRules() genericvalidation.Rules {
 
 // ValidateLogin 校验修改密码请求.
 func (v *Validator) ValidateLoginRequest(ctx context.Context, rq *v1.LoginRequest) error {
-	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+	rules := v.ValidateUserRules()
+	// 登录时不校验密码复杂度：LDAP 等外部认证后端的密码遵循目录服务自身的密码策略
+	rules["Password"] = func(value any) error {
+		if value.(string) == "" {
+			return errno.ErrInvalidArgument.WithMessage("password cannot be empty")
+		}
+		return nil
+	}
+	return genericvalidation.ValidateAllFields(rq, rules)
 }
 
 // ValidateChangePasswordRequest 校验 ChangePasswordRequest 结构体的有效性.
 func (v *Validator) ValidateChangePasswordRequest(ctx context.Context, rq *v1.ChangePasswordRequest) error {
+	if err := denyImpersonation(ctx); err != nil {
+		return err
+	}
 	if rq.GetUserID() != contextx.UserID(ctx) {
 		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
 	}
@@ -79,11 +124,21 @@ func (v *Validator) ValidateCreateUserRequest(ctx context.Context, rq *v1.Create
 }
 
 // ValidateUpdateUserRequest 校验更新用户请求.
+// 邮箱和手机号用于找回密码和 SSO 账号关联，模拟登录期间不允许修改.
 func (v *Validator) ValidateUpdateUserRequest(ctx context.Context, rq *v1.UpdateUserRequest) error {
 	if rq.GetUserID() != contextx.UserID(ctx) {
 		return errno.ErrPermissionDenied.WithMessage(fmt.Sprintf("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID()))
 	}
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "UserID")
+	fields, err := validateUpdateMask(rq, rq.GetUpdateMask(), "userID", "username")
+	if err != nil {
+		return err
+	}
+	if slices.Contains(fields, "Email") || slices.Contains(fields, "Phone") {
+		if err := denyImpersonation(ctx); err != nil {
+			return err
+		}
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "UserID", "Avatar", "Gender", "Description")
 }
 
 // ValidateDeleteUserRequest 校验 DeleteUserRequest 结构体的有效性.
@@ -103,3 +158,58 @@ func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *v1.GetUserRe
 func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *v1.ListUserRequest) error {
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
+
+// ValidateAdminGetUserRequest 校验管理员获取用户请求（权限由 Casbin 控制，不要求是本人）.
+func (v *Validator) ValidateAdminGetUserRequest(ctx context.Context, rq *v1.GetUserRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateAdminListUserRequest 校验管理员获取用户列表请求.
+func (v *Validator) ValidateAdminListUserRequest(ctx context.Context, rq *v1.ListUserRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateAdminUpdateUserRequest 校验管理员更新用户请求.
+func (v *Validator) ValidateAdminUpdateUserRequest(ctx context.Context, rq *v1.AdminUpdateUserRequest) error {
+	if _, err := validateUpdateMask(rq, rq.GetUpdateMask(), "userID", "username"); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateUpdateUserStatusRequest 校验启用/禁用用户请求.
+func (v *Validator) ValidateUpdateUserStatusRequest(ctx context.Context, rq *v1.UpdateUserStatusRequest) error {
+	if rq.GetUserID() == contextx.UserID(ctx) && int16(rq.GetStatus()) == known.UserStatusDisabled {
+		return errno.ErrPermissionDenied.WithMessage("You cannot disable yourself")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateResetUserPasswordRequest 校验管理员重置用户密码请求.
+func (v *Validator) ValidateResetUserPasswordRequest(ctx context.Context, rq *v1.ResetUserPasswordRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateImpersonateUserRequest 校验管理员模拟登录用户请求，不允许模拟自己，也不允许在模拟登录期间再次发起模拟.
+func (v *Validator) ValidateImpersonateUserRequest(ctx context.Context, rq *v1.ImpersonateUserRequest) error {
+	if err := denyImpersonation(ctx); err != nil {
+		return err
+	}
+	if rq.GetUserID() == contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("You cannot impersonate yourself")
+	}
+	if len([]rune(rq.GetReason())) > 200 {
+		return errno.ErrInvalidArgument.WithMessage("reason must be at most 200 characters")
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "UserID")
+}
+
+// ValidateListDeletedUsersRequest 校验回收站用户列表请求.
+func (v *Validator) ValidateListDeletedUsersRequest(ctx context.Context, rq *v1.ListDeletedUsersRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "PageToken", "PageSize")
+}
+
+// ValidateRestoreUserRequest 校验恢复用户请求.
+func (v *Validator) ValidateRestoreUserRequest(ctx context.Context, rq *v1.RestoreUserRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"context"
+	"fmt"
 
 	genericvalidation "github.com/clin211/gin-enterprise-template/pkg/validation"
 
@@ -24,22 +25,42 @@ func (v *Validator) ValidateUserRoleRules() genericvalidation.Rules {
 			return nil
 		},
 		"RoleIDs": func(value any) error {
-			// 检查角色 ID 列表是否为空
-			roleIDs, ok := value.([]string)
-			if !ok {
+			if _, ok := value.([]string); !ok {
 				return errno.ErrInvalidArgument.WithMessage("roleIDs must be a string array")
 			}
-			if len(roleIDs) == 0 {
-				return errno.ErrInvalidArgument.WithMessage("roleIDs cannot be empty")
-			}
 			return nil
 		},
 	}
 }
 
 // ValidateAssignRolesToUserRequest 校验分配角色请求.
+// roleIDs 与 assignments 不能同时为空，同一个角色只能分配一次.
 func (v *Validator) ValidateAssignRolesToUserRequest(ctx context.Context, rq *v1.AssignRolesToUserRequest) error {
-	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRoleRules())
+	if len(rq.GetRoleIDs()) == 0 && len(rq.GetAssignments()) == 0 {
+		return errno.ErrInvalidArgument.WithMessage("roleIDs and assignments cannot both be empty")
+	}
+
+	seen := make(map[string]bool, len(rq.GetRoleIDs())+len(rq.GetAssignments()))
+	for _, roleID := range rq.GetRoleIDs() {
+		if seen[roleID] {
+			return errno.ErrInvalidArgument.WithMessage(fmt.Sprintf("role `%s` is assigned more than once", roleID))
+		}
+		seen[roleID] = true
+	}
+	for _, assignment := range rq.GetAssignments() {
+		if assignment.GetRoleID() == "" {
+			return errno.ErrInvalidArgument.WithMessage("assignments.roleID cannot be empty")
+		}
+		if seen[assignment.GetRoleID()] {
+			return errno.ErrInvalidArgument.WithMessage(fmt.Sprintf("role `%s` is assigned more than once", assignment.GetRoleID()))
+		}
+		seen[assignment.GetRoleID()] = true
+		if err := isValidAssignmentPeriod(assignment.StartsAt, assignment.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRoleRules(), "UserID", "RoleIDs")
 }
 
 // ValidateGetUserRolesRequest 校验获取用户角色请求.
@@ -1,12 +1,17 @@
 package validation
 
 import (
+	"context"
 	"regexp"
+	"time"
 
 	"github.com/google/wire"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/pkg/fieldmask"
 )
 
 // Validator 是一个实现自定义验证逻辑的结构体。
@@ -35,6 +40,19 @@ func New(store store.IStore) *Validator {
 	return &Validator{store: store}
 }
 
+// validateUpdateMask 校验更新请求的 updateMask，返回需要修改的字段在请求结构体中的字段名。
+// idField 是资源 ID 字段，与 version、updateMask 一样不能被修改，required 中的字段不能被清空。
+func validateUpdateMask(rq proto.Message, paths []string, idField string, required ...string) ([]string, error) {
+	mask, err := fieldmask.New(rq, paths, idField, "version", "updateMask")
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	if err := mask.Require(rq, required...); err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage(err.Error())
+	}
+	return mask.GoNames(rq), nil
+}
+
 // isValidUsername 验证用户名是否有效。
 func isValidUsername(username string) bool {
 	// 验证长度
@@ -96,3 +114,30 @@ func isValidPhone(phone string) error {
 
 	return nil
 }
+
+// denyImpersonation 拒绝模拟登录期间发起的敏感操作（修改凭据、再次模拟登录等），
+// 这些操作必须由用户本人完成.
+func denyImpersonation(ctx context.Context) error {
+	if contextx.Impersonating(ctx) {
+		return errno.ErrImpersonationForbidden
+	}
+	return nil
+}
+
+// isValidAssignmentPeriod 校验限时授权的有效期（Unix 时间戳，秒）：
+// 过期时间必须晚于当前时间，同时设置生效时间和过期时间时过期时间必须晚于生效时间.
+func isValidAssignmentPeriod(startsAt, expiresAt *int64) error {
+	if startsAt != nil && *startsAt <= 0 {
+		return errno.ErrInvalidArgument.WithMessage("startsAt must be a positive unix timestamp")
+	}
+	if expiresAt == nil {
+		return nil
+	}
+	if *expiresAt <= time.Now().Unix() {
+		return errno.ErrInvalidArgument.WithMessage("expiresAt must be in the future")
+	}
+	if startsAt != nil && *expiresAt <= *startsAt {
+		return errno.ErrInvalidArgument.WithMessage("expiresAt must be later than startsAt")
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package apiserver
+
+import (
+	rbacv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/rbac"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+)
+
+// NewRBACBiz 创建用于导入导出 RBAC 清单的业务实例，供命令行工具在不启动服务器的情况下使用.
+// 启用 Watcher 时策略变更会广播给运行中的服务实例. 返回的 close 函数用于释放授权器.
+func (cfg *Config) NewRBACBiz() (rbacv1.RBACBiz, func(), error) {
+	db, err := cfg.NewDB()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts, err := ProvideAuthzOptions(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	authorizer, err := ProvideAuthz(db, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rbacv1.New(store.NewStore(db, nil), authorizer), authorizer.Close, nil
+}
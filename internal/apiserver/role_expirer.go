@@ -0,0 +1,198 @@
+package apiserver
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	rolev1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/role"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/audit"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+)
+
+// roleExpirerInterval 是后台任务检查限时授权的间隔，也是定时授权生效或过期后同步到 Casbin 的最长延迟.
+const roleExpirerInterval = 30 * time.Second
+
+// RoleExpirer 定义一个限时授权同步器. 用来在角色分配、权限分配到达生效时间时授予 Casbin 策略，
+// 并在过期时回收策略、删除分配记录，无需管理员手动移除临时授权. 同时将超时未审批的授权申请标记为已过期.
+type RoleExpirer struct {
+	store store.IStore
+	authz *authz.Authz
+
+	// lastRun 记录上一次检查的时间，生效时间晚于该时间的分配需要授予
+	lastRun time.Time
+}
+
+// NewRoleExpirer 创建 RoleExpirer 实例.
+func NewRoleExpirer(store store.IStore, authz *authz.Authz) *RoleExpirer {
+	return &RoleExpirer{store: store, authz: authz}
+}
+
+// Run 周期性地同步限时授权，直到 ctx 被取消. 启动时会授予所有已到生效时间的分配，弥补服务停机期间错过的变更.
+func (e *RoleExpirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(roleExpirerInterval)
+	defer ticker.Stop()
+
+	for {
+		e.reconcile(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconcile 授予在 (lastRun, now] 之间生效的授权，回收在 now 之前过期的授权.
+// 单次失败只记录日志，未处理的授权会在下一次检查时重试.
+func (e *RoleExpirer) reconcile(ctx context.Context, now time.Time) {
+	roles := make(map[string]*model.RoleM)
+	getRole := func(roleID string) *model.RoleM {
+		if roleM, ok := roles[roleID]; ok {
+			return roleM
+		}
+		roleM, err := e.store.Role().Get(ctx, where.F("role_id", roleID).L(1))
+		if err != nil {
+			slog.WarnContext(ctx, "Role not found", "roleID", roleID, "error", err)
+		}
+		roles[roleID] = roleM
+		return roleM
+	}
+
+	succeeded := e.reconcileUserRoles(ctx, now, getRole)
+	if !e.reconcileRolePermissions(ctx, now, getRole) {
+		succeeded = false
+	}
+	e.expireAccessRequests(ctx, now)
+
+	// 查询失败时保留 lastRun，下一次检查会重新授予本次遗漏的分配
+	if succeeded {
+		e.lastRun = now
+	}
+}
+
+// reconcileUserRoles 同步限时的用户-角色关系（Casbin g 规则），返回是否成功查询到全部待处理的分配.
+func (e *RoleExpirer) reconcileUserRoles(ctx context.Context, now time.Time, getRole func(string) *model.RoleM) bool {
+	started, err := e.store.UserRole().ListStarted(ctx, e.lastRun, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list started user roles", "error", err)
+		return false
+	}
+	for _, userRole := range started {
+		roleM := getRole(userRole.RoleID)
+		if roleM == nil {
+			continue
+		}
+		if _, err := e.authz.AddGroupingPolicy(userRole.UserID, "role::"+roleM.RoleCode); err != nil {
+			slog.ErrorContext(ctx, "Failed to add grouping policy", "userID", userRole.UserID, "role", roleM.RoleCode, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "Granted scheduled role", "userID", userRole.UserID, "role", roleM.RoleCode)
+	}
+
+	expired, err := e.store.UserRole().ListExpired(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list expired user roles", "error", err)
+		return false
+	}
+	for _, userRole := range expired {
+		// 只有实际删除了分配记录的实例负责回收，避免多实例重复处理或误删重新分配的角色
+		removed, err := e.store.UserRole().RemoveExpired(ctx, userRole.ID, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to remove expired user role", "userID", userRole.UserID, "roleID", userRole.RoleID, "error", err)
+			continue
+		}
+		roleM := getRole(userRole.RoleID)
+		if !removed || roleM == nil {
+			continue
+		}
+		if _, err := e.authz.RemoveGroupingPolicy(userRole.UserID, "role::"+roleM.RoleCode); err != nil {
+			slog.ErrorContext(ctx, "Failed to remove grouping policy", "userID", userRole.UserID, "role", roleM.RoleCode, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "Revoked expired role", "userID", userRole.UserID, "role", roleM.RoleCode)
+	}
+
+	return true
+}
+
+// reconcileRolePermissions 同步限时的角色-权限关系（Casbin p 规则），返回是否成功查询到全部待处理的分配.
+// 多个权限可能对应同一条策略，因此按角色整体重建策略，而不是逐条增删.
+func (e *RoleExpirer) reconcileRolePermissions(ctx context.Context, now time.Time, getRole func(string) *model.RoleM) bool {
+	changed := make(map[string]bool)
+
+	started, err := e.store.Role().ListStartedPermissions(ctx, e.lastRun, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list started role permissions", "error", err)
+		return false
+	}
+	for _, rolePermission := range started {
+		changed[rolePermission.RoleID] = true
+	}
+
+	expired, err := e.store.Role().ListExpiredPermissions(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list expired role permissions", "error", err)
+		return false
+	}
+	for _, rolePermission := range expired {
+		removed, err := e.store.Role().RemoveExpiredPermission(ctx, rolePermission.ID, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to remove expired role permission", "roleID", rolePermission.RoleID, "permissionID", rolePermission.PermissionID, "error", err)
+			continue
+		}
+		if removed {
+			changed[rolePermission.RoleID] = true
+		}
+	}
+
+	for roleID := range changed {
+		roleM := getRole(roleID)
+		if roleM == nil {
+			continue
+		}
+		if err := rolev1.SyncPolicies(ctx, e.store, e.authz, roleM); err != nil {
+			slog.ErrorContext(ctx, "Failed to sync role policies", "role", roleM.RoleCode, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "Synchronized scheduled role permissions", "role", roleM.RoleCode)
+	}
+
+	return true
+}
+
+// expireAccessRequests 将超时未审批的授权申请标记为已过期，并以申请人身份记录审计日志.
+func (e *RoleExpirer) expireAccessRequests(ctx context.Context, now time.Time) {
+	expired, err := e.store.AccessRequest().ListExpired(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list expired access requests", "error", err)
+		return
+	}
+	for _, accessRequest := range expired {
+		err := e.store.TX(ctx, func(ctx context.Context) error {
+			// 只有实际更新了状态的实例负责记录审计日志，避免多实例重复记录
+			updated, err := e.store.AccessRequest().Expire(ctx, accessRequest.ID, now)
+			if err != nil || !updated {
+				return err
+			}
+			details := map[string]any{
+				"userID":    accessRequest.UserID,
+				"roleID":    accessRequest.RoleID,
+				"expiresAt": accessRequest.ExpiresAt.Unix(),
+			}
+			resource := "/v1/access-requests/" + accessRequest.RequestID
+			return audit.Record(contextx.WithUserID(ctx, accessRequest.RequesterID), e.store, audit.ActionAccessRequestExpire, resource, details)
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to expire access request", "requestID", accessRequest.RequestID, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "Expired access request", "requestID", accessRequest.RequestID, "userID", accessRequest.UserID)
+	}
+}
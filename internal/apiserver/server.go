@@ -2,19 +2,30 @@ package apiserver
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/clin211/gin-enterprise-template/pkg/authn"
 	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	genericdb "github.com/clin211/gin-enterprise-template/pkg/db"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/mtls"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
 	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
+	"github.com/clin211/gin-enterprise-template/pkg/outbox"
 	"github.com/clin211/gin-enterprise-template/pkg/server"
-	"github.com/clin211/gin-enterprise-template/pkg/store/registry"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	genericcache "github.com/clin211/gin-enterprise-template/pkg/store/cache"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/biz"
+	userv1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/user"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/validation"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
@@ -25,17 +36,39 @@ import (
 
 // Config 包含应用程序相关的配置。
 type Config struct {
-	JWTOptions        *genericoptions.JWTOptions
-	TLSOptions        *genericoptions.TLSOptions
-	HTTPOptions       *genericoptions.HTTPOptions
-	PostgreSQLOptions *genericoptions.PostgreSQLOptions
-	RedisOptions      *genericoptions.RedisOptions
+	JWTOptions          *genericoptions.JWTOptions
+	TLSOptions          *genericoptions.TLSOptions
+	HTTPOptions         *genericoptions.HTTPOptions
+	DatabaseOptions     *genericoptions.DatabaseOptions
+	PostgreSQLOptions   *genericoptions.PostgreSQLOptions
+	MySQLOptions        *genericoptions.MySQLOptions
+	SQLiteOptions       *genericoptions.SQLiteOptions
+	RedisOptions        *genericoptions.RedisOptions
+	RegistrationOptions *genericoptions.RegistrationOptions
+	PasswordOptions     *genericoptions.PasswordOptions
+	OIDCOptions         *genericoptions.OIDCOptions
+	LDAPOptions         *genericoptions.LDAPOptions
+	AuthzOptions        *genericoptions.AuthzOptions
+	TrashOptions        *genericoptions.TrashOptions
+	MigrationOptions    *genericoptions.MigrationOptions
+	CacheOptions        *genericoptions.CacheOptions
+	KafkaOptions        *genericoptions.KafkaOptions
+	OutboxOptions       *genericoptions.OutboxOptions
 }
 
 // Server 表示 Web 服务器。
 type Server struct {
-	cfg *ServerConfig
-	srv server.Server
+	cfg     *ServerConfig
+	srv     server.Server
+	expirer *RoleExpirer
+	purger  *TrashPurger
+	// replicas 为空表示未配置只读副本
+	replicas *genericdb.ReplicaSet
+	// cache 为空表示未启用读缓存
+	cache *genericcache.Cache
+	// relay 为空表示未启用 outbox
+	relay *outbox.Relay
+	bus   *eventbus.Bus
 }
 
 // ServerConfig 包含服务器的核心依赖和配置。
@@ -44,6 +77,8 @@ type ServerConfig struct {
 	biz       biz.IBiz
 	val       *validation.Validator
 	retriever mw.UserRetriever
+	tracker   mw.SessionTracker
+	certs     mw.ClientCertResolver
 	authz     *authz.Authz
 }
 
@@ -59,7 +94,21 @@ func (cfg *Config) NewServer(ctx context.Context) (*Server, error) {
 		cfg.JWTOptions.AccessExpiration,
 		cfg.JWTOptions.RefreshExpiration,
 		token.WithIdentityKey(known.XUserID),
+		token.WithImpersonationExpiration(cfg.JWTOptions.ImpersonationExpiration),
 	)
+
+	// 模拟登录期间的日志统一追加实际操作者 ID
+	slog.SetDefault(slog.New(contextx.NewLogHandler(slog.Default().Handler())))
+
+	// 只有启用 outbox 时才记录领域事件
+	event.SetEnabled(cfg.OutboxOptions != nil && cfg.OutboxOptions.Enabled)
+
+	// 设置新密码使用的哈希算法，已有哈希会在用户登录时按需重新哈希
+	if cfg.PasswordOptions != nil {
+		authn.SetDefaultHasher(cfg.PasswordOptions.NewHasher())
+		authn.SetHashLimits(cfg.PasswordOptions.HashLimits())
+	}
+
 	// 创建核心服务器实例。
 	return NewServer(cfg)
 }
@@ -70,6 +119,22 @@ func (s *Server) Run(ctx context.Context) error {
 	// 在后台启动服务。
 	go s.srv.RunOrDie()
 
+	// 在后台同步限时角色和权限分配，随 ctx 取消而退出。
+	go s.expirer.Run(ctx)
+
+	// 在后台清理回收站中超过保留期的记录，随 ctx 取消而退出。
+	go s.purger.Run(ctx)
+
+	// 在后台检查只读副本的健康状态，剔除和恢复副本，随 ctx 取消而退出。
+	if s.replicas != nil {
+		go s.replicas.Run(ctx)
+	}
+
+	// 在后台将 outbox 中的领域事件发布到 Kafka，随 ctx 取消而退出。
+	if s.relay != nil {
+		go s.relay.Run(ctx)
+	}
+
 	// 阻塞直到上下文被取消或终止。
 	// 以下代码用于在服务器关闭时执行一些清理任务。
 	<-ctx.Done()
@@ -77,27 +142,82 @@ func (s *Server) Run(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	s.srv.GracefulStop(ctx)
+	// 等待异步订阅者处理完已发布的领域事件
+	if err := s.bus.Close(ctx); err != nil {
+		slog.Error("Failed to drain event bus", "error", err)
+	}
+	s.cfg.authz.Close()
+	if s.cache != nil {
+		s.cache.Close()
+	}
 
 	slog.Info("Server exited successfully.")
 
 	return nil
 }
 
-// NewDB 创建并返回一个用于 PostgreSQL 的 *gorm.DB 实例。
+// NewDB 根据配置的数据库类型创建并返回 *gorm.DB 实例。
 func (cfg *Config) NewDB() (*gorm.DB, error) {
-	slog.Info("Initializing database connection", "type", "postgresql")
-	db, err := cfg.PostgreSQLOptions.NewDB()
+	db, err := cfg.openDB()
 	if err != nil {
 		slog.Error("Failed to create database connection", "error", err)
 		return nil, err
 	}
 
-	// 自动迁移数据库模式
-	if err := registry.Migrate(db); err != nil {
+	// 按启动模式执行或检查数据库迁移，数据库结构落后时拒绝启动
+	if err := cfg.migrateOnStartup(db); err != nil {
 		slog.Error("Failed to migrate database schema", "error", err)
 		return nil, err
 	}
 
+	// 将唯一约束、外键约束等数据库错误转换为业务错误，业务层不再需要在写入前检查冲突
+	if err := db.Use(genericstore.ErrorTranslator{}); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// openDB 连接 database.driver 指定的数据库，不执行迁移。
+func (cfg *Config) openDB() (*gorm.DB, error) {
+	driver := genericdb.DriverPostgres
+	if cfg.DatabaseOptions != nil {
+		driver = cfg.DatabaseOptions.Driver
+	}
+
+	slog.Info("Initializing database connection", "type", driver)
+	switch driver {
+	case genericdb.DriverPostgres:
+		return cfg.newPostgreSQL()
+	case genericdb.DriverMySQL:
+		return cfg.MySQLOptions.NewDB()
+	case genericdb.DriverSQLite:
+		return cfg.SQLiteOptions.NewDB()
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+}
+
+// newPostgreSQL 连接 PostgreSQL，配置了只读副本时，调用方写入后的一段时间内其读请求仍使用主库.
+func (cfg *Config) newPostgreSQL() (*gorm.DB, error) {
+	db, err := cfg.PostgreSQLOptions.NewDB()
+	if err != nil || len(cfg.PostgreSQLOptions.Replicas) == 0 {
+		return db, err
+	}
+
+	// 已登录的请求按用户粘滞，匿名请求只在本次请求内粘滞
+	rw := genericdb.NewReadYourWrites(cfg.PostgreSQLOptions.ReadYourWritesWindow, func(ctx context.Context) string {
+		if userID := contextx.UserID(ctx); userID != "" {
+			return "user:" + userID
+		}
+		if requestID := contextx.RequestID(ctx); requestID != "" {
+			return "request:" + requestID
+		}
+		return ""
+	})
+	if err := db.Use(rw); err != nil {
+		return nil, err
+	}
 	return db, nil
 }
 
@@ -111,11 +231,115 @@ func (r *UserRetriever) GetUser(ctx context.Context, userID string) (*model.User
 	return r.store.User().Get(ctx, where.F("user_id", userID))
 }
 
+// ClientCertResolver 根据配置将客户端证书的主体映射为服务账号用户.
+type ClientCertResolver struct {
+	store store.IStore
+	tls   *genericoptions.TLSOptions
+}
+
+// ResolveClientCert 根据客户端证书获取服务账号用户，证书主体未映射时返回 nil.
+func (r *ClientCertResolver) ResolveClientCert(ctx context.Context, cert *x509.Certificate) (*model.UserM, error) {
+	if r.tls == nil || r.tls.ClientAuth == nil {
+		return nil, nil
+	}
+
+	subject := mtls.Subject(cert, r.tls.ClientAuth.SubjectSource)
+	username, ok := r.tls.ClientAuth.ServiceAccounts[subject]
+	if !ok {
+		return nil, nil
+	}
+	return r.store.User().Get(ctx, where.F("username", username))
+}
+
 // ProvideDB 根据配置提供数据库实例。
 func ProvideDB(cfg *Config) (*gorm.DB, error) {
 	return cfg.NewDB()
 }
 
+// ProvideReplicaSet 提供数据库的只读副本集合，未配置只读副本时返回 nil。
+func ProvideReplicaSet(db *gorm.DB) *genericdb.ReplicaSet {
+	return genericdb.ReplicasOf(db)
+}
+
+// ProvideCache 根据配置提供 store 层的读缓存，未启用时返回 nil。
+func ProvideCache(cfg *Config, db *gorm.DB) (*genericcache.Cache, error) {
+	if cfg.CacheOptions == nil || !cfg.CacheOptions.Enabled {
+		return nil, nil
+	}
+	if !cfg.CacheOptions.Redis {
+		return cfg.CacheOptions.NewCache(context.Background(), db, nil)
+	}
+
+	rdb, err := ProvideRedis(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.CacheOptions.NewCache(context.Background(), db, rdb)
+}
+
+// ProvideOutboxRelay 根据配置提供将领域事件发布到 Kafka 的 Relay，未启用 outbox 时返回 nil。
+func ProvideOutboxRelay(cfg *Config, store store.IStore) (*outbox.Relay, error) {
+	if cfg.OutboxOptions == nil || !cfg.OutboxOptions.Enabled {
+		return nil, nil
+	}
+
+	writer, err := cfg.KafkaOptions.SyncWriter()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.OutboxOptions.NewRelay(store.Outbox(), writer), nil
+}
+
+// ProvideAuthz 提供授权器。授权策略始终从主库加载，避免副本复制延迟导致策略变更后仍使用旧策略。
+func ProvideAuthz(db *gorm.DB, opts []authz.Option) (*authz.Authz, error) {
+	return authz.NewAuthz(genericdb.Primary(db), opts...)
+}
+
+// ProvideOIDCRegistry 根据配置提供 OIDC Provider 注册表。
+func ProvideOIDCRegistry(cfg *Config) *oidc.Registry {
+	if cfg.OIDCOptions == nil {
+		return oidc.NewRegistry(nil)
+	}
+	return cfg.OIDCOptions.NewRegistry()
+}
+
+// ProvideAuthenticator 根据配置提供用户名密码认证链：先使用本地数据库认证，启用 LDAP 时再尝试 LDAP 认证。
+func ProvideAuthenticator(cfg *Config, store store.IStore, authz *authz.Authz, bus *eventbus.Bus) (userv1.Authenticator, error) {
+	authenticators := []userv1.Authenticator{userv1.NewLocalAuthenticator(store)}
+	if cfg.LDAPOptions != nil && cfg.LDAPOptions.Enabled {
+		client, err := cfg.LDAPOptions.NewClient()
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, userv1.NewLDAPAuthenticator(store, authz, bus, client, cfg.LDAPOptions))
+	}
+	return userv1.NewChain(authenticators...), nil
+}
+
+// ProvideAuthzOptions 根据配置提供授权器选项，启用 Watcher 时通过 Redis 在实例之间同步策略变更。
+func ProvideAuthzOptions(cfg *Config) ([]authz.Option, error) {
+	// casbin_rule 表由数据库迁移维护，不需要适配器自动迁移
+	opts := append(authz.DefaultOptions(), authz.WithoutAutoMigrate())
+	if cfg.AuthzOptions == nil {
+		return opts, nil
+	}
+
+	opts = append(opts, authz.WithAutoLoadPolicyTime(cfg.AuthzOptions.AutoLoadInterval))
+	if !cfg.AuthzOptions.Watcher {
+		return opts, nil
+	}
+
+	rdb, err := ProvideRedis(cfg)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := authz.NewWatcher(context.Background(), rdb, cfg.AuthzOptions.Channel)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, authz.WithWatcher(watcher)), nil
+}
+
 // ProvideRedis 根据配置提供 redis 实例。
 func ProvideRedis(cfg *Config) (*redis.Client, error) {
 	return cfg.RedisOptions.NewClient()
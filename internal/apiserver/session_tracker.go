@@ -0,0 +1,126 @@
+package apiserver
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+)
+
+// sessionCheckInterval 是同一会话两次查库校验之间的最小间隔，也是会话被撤销后 Access Token 最长的失效延迟.
+const sessionCheckInterval = 30 * time.Second
+
+// maxTrackedSessions 是缓存的会话数量上限，超过后淘汰最早通过校验的会话，被淘汰的会话下次访问时重新查库.
+const maxTrackedSessions = 10000
+
+// SessionTracker 定义一个会话校验器. 用来校验 token 所属的会话是否有效并记录会话活跃时间.
+type SessionTracker struct {
+	store store.IStore
+	// size 是缓存的会话数量上限
+	size int
+
+	mu sync.Mutex
+	// checked 记录会话最近一次通过查库校验的时间，order 按校验时间从早到晚排列
+	checked map[string]*list.Element
+	order   *list.List
+}
+
+// checkedSession 是 SessionTracker 中的一个缓存项.
+type checkedSession struct {
+	sessionID string
+	at        time.Time
+}
+
+// NewSessionTracker 创建 SessionTracker 实例.
+func NewSessionTracker(store store.IStore) *SessionTracker {
+	return newSessionTracker(store, maxTrackedSessions)
+}
+
+// newSessionTracker 创建最多缓存 size 个会话校验结果的 SessionTracker.
+func newSessionTracker(store store.IStore, size int) *SessionTracker {
+	return &SessionTracker{store: store, size: size, checked: make(map[string]*list.Element), order: list.New()}
+}
+
+// Touch 校验会话是否有效并记录会话的最近活跃时间.
+// 在 sessionCheckInterval 内重复访问同一会话时直接使用缓存结果，避免每个请求都访问数据库.
+func (t *SessionTracker) Touch(ctx context.Context, userID, sessionID string) error {
+	now := time.Now()
+
+	if last, ok := t.lastChecked(sessionID); ok && now.Sub(last) < sessionCheckInterval {
+		return nil
+	}
+
+	if err := t.Validate(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	if err := t.store.UserSession().Touch(ctx, sessionID, now); err != nil {
+		slog.WarnContext(ctx, "Failed to update session last seen time", "sessionID", sessionID, "error", err)
+	}
+
+	t.remember(sessionID, now)
+	return nil
+}
+
+// Validate 直接查询数据库校验会话是否属于该用户且未被撤销、未过期.
+func (t *SessionTracker) Validate(ctx context.Context, userID, sessionID string) error {
+	if sessionID == "" {
+		return errno.ErrSessionRevoked.WithMessage("Token is not bound to any session, please login again.")
+	}
+
+	whr := where.F("session_id", sessionID, "user_id", userID).Q("revoked_at IS NULL AND expires_at > ?", time.Now())
+	if _, err := t.store.UserSession().Get(ctx, whr); err != nil {
+		t.forget(sessionID)
+		return errno.ErrSessionRevoked
+	}
+
+	return nil
+}
+
+// lastChecked 返回会话最近一次通过查库校验的时间.
+func (t *SessionTracker) lastChecked(sessionID string) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elem, ok := t.checked[sessionID]
+	if !ok {
+		return time.Time{}, false
+	}
+	return elem.Value.(*checkedSession).at, true
+}
+
+// remember 记录会话通过校验的时间，超出容量时淘汰最早通过校验的会话.
+func (t *SessionTracker) remember(sessionID string, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if elem, ok := t.checked[sessionID]; ok {
+		elem.Value.(*checkedSession).at = at
+		t.order.MoveToBack(elem)
+		return
+	}
+
+	t.checked[sessionID] = t.order.PushBack(&checkedSession{sessionID: sessionID, at: at})
+	for t.order.Len() > t.size {
+		oldest := t.order.Front()
+		t.order.Remove(oldest)
+		delete(t.checked, oldest.Value.(*checkedSession).sessionID)
+	}
+}
+
+// forget 删除会话的缓存校验结果.
+func (t *SessionTracker) forget(sessionID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if elem, ok := t.checked[sessionID]; ok {
+		t.order.Remove(elem)
+		delete(t.checked, sessionID)
+	}
+}
@@ -0,0 +1,78 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+)
+
+// createSession 为用户创建一个有效的会话.
+func createSession(t *testing.T, s store.IStore, userID string) *model.UserSessionM {
+	t.Helper()
+
+	now := time.Now()
+	sessionM := &model.UserSessionM{UserID: userID, CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, s.UserSession().Create(context.Background(), sessionM))
+	return sessionM
+}
+
+func TestSessionTracker_Touch(t *testing.T) {
+	s := storetest.New(t)
+	ctx := context.Background()
+	userM := storetest.CreateUser(t, s, "tracker-touch")
+	sessionM := createSession(t, s, userM.UserID)
+	tracker := NewSessionTracker(s)
+
+	require.NoError(t, tracker.Touch(ctx, userM.UserID, sessionM.SessionID))
+	_, ok := tracker.lastChecked(sessionM.SessionID)
+	assert.True(t, ok)
+
+	// 会话不属于该用户、未绑定会话时拒绝
+	assert.ErrorIs(t, tracker.Touch(ctx, "other-user", createSession(t, s, userM.UserID).SessionID), errno.ErrSessionRevoked)
+	assert.ErrorIs(t, tracker.Touch(ctx, userM.UserID, ""), errno.ErrSessionRevoked)
+
+	// 撤销后在校验间隔内仍使用缓存结果，直接校验立即生效并清除缓存
+	_, err := s.UserSession().Revoke(ctx, where.F("session_id", sessionM.SessionID))
+	require.NoError(t, err)
+	assert.NoError(t, tracker.Touch(ctx, userM.UserID, sessionM.SessionID))
+	assert.ErrorIs(t, tracker.Validate(ctx, userM.UserID, sessionM.SessionID), errno.ErrSessionRevoked)
+	_, ok = tracker.lastChecked(sessionM.SessionID)
+	assert.False(t, ok)
+	assert.ErrorIs(t, tracker.Touch(ctx, userM.UserID, sessionM.SessionID), errno.ErrSessionRevoked)
+}
+
+func TestSessionTracker_Bounded(t *testing.T) {
+	tracker := newSessionTracker(nil, 3)
+	now := time.Now()
+
+	for i := range 5 {
+		tracker.remember(fmt.Sprintf("session-%d", i), now)
+	}
+	assert.Len(t, tracker.checked, 3)
+	assert.Equal(t, 3, tracker.order.Len())
+
+	// 最早通过校验的会话被淘汰，重新校验的会话移到末尾
+	_, ok := tracker.lastChecked("session-1")
+	assert.False(t, ok)
+	tracker.remember("session-2", now)
+	tracker.remember("session-5", now)
+	_, ok = tracker.lastChecked("session-2")
+	assert.True(t, ok)
+	_, ok = tracker.lastChecked("session-3")
+	assert.False(t, ok)
+
+	tracker.forget("session-2")
+	assert.Len(t, tracker.checked, 2)
+	assert.Equal(t, 2, tracker.order.Len())
+}
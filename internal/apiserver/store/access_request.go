@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+)
+
+// AccessRequestStore 定义了 access_request 模块在 store 层所实现的方法.
+type AccessRequestStore interface {
+	Create(ctx context.Context, obj *model.AccessRequestM) error
+	Get(ctx context.Context, opts *where.Options) (*model.AccessRequestM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.AccessRequestM, error)
+
+	AccessRequestExpansion
+}
+
+// AccessRequestExpansion 定义了授权申请操作的附加方法.
+type AccessRequestExpansion interface {
+	// Decide 将待审批且未过期的申请更新为 status，返回是否实际更新（申请已被处理或已过期时返回 false）
+	Decide(ctx context.Context, obj *model.AccessRequestM, status string, now time.Time) (bool, error)
+	// ListExpired 获取在 now 之前已过期但仍处于待审批状态的申请
+	ListExpired(ctx context.Context, now time.Time) ([]*model.AccessRequestM, error)
+	// Expire 将已过期的待审批申请标记为已过期，返回是否实际更新（并发处理时返回 false）
+	Expire(ctx context.Context, id int64, now time.Time) (bool, error)
+}
+
+// accessRequestStore 是 AccessRequestStore 接口的实现。
+type accessRequestStore struct {
+	*genericstore.Store[model.AccessRequestM]
+	core *datastore
+}
+
+// 确保 accessRequestStore 实现了 AccessRequestStore 接口。
+var _ AccessRequestStore = (*accessRequestStore)(nil)
+
+// newAccessRequestStore 创建 accessRequestStore 的实例。
+func newAccessRequestStore(store *datastore) *accessRequestStore {
+	return &accessRequestStore{
+		Store: genericstore.NewStore[model.AccessRequestM](store, storelogger.NewLogger()),
+		core:  store,
+	}
+}
+
+// Decide 将待审批且未过期的申请更新为 status，同时写入 obj 中的审批人、审批意见和审批时间.
+// 通过带条件的 UPDATE 保证同一申请只会被处理一次.
+func (s *accessRequestStore) Decide(ctx context.Context, obj *model.AccessRequestM, status string, now time.Time) (bool, error) {
+	result := s.core.DB(ctx).
+		Model(&model.AccessRequestM{}).
+		Where("id = ? AND status = ? AND expires_at > ?", obj.ID, known.AccessRequestPending, now).
+		Updates(map[string]any{
+			"status":         status,
+			"reviewer_id":    obj.ReviewerID,
+			"review_comment": obj.ReviewComment,
+			"reviewed_at":    now,
+			"updated_at":     now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	obj.Status = status
+	obj.ReviewedAt = &now
+	obj.UpdatedAt = now
+	return true, nil
+}
+
+// ListExpired 获取在 now 之前已过期但仍处于待审批状态的申请
+func (s *accessRequestStore) ListExpired(ctx context.Context, now time.Time) ([]*model.AccessRequestM, error) {
+	var accessRequests []*model.AccessRequestM
+	err := s.core.DB(ctx).
+		Where("status = ? AND expires_at <= ?", known.AccessRequestPending, now).
+		Find(&accessRequests).Error
+	return accessRequests, err
+}
+
+// Expire 将已过期的待审批申请标记为已过期
+func (s *accessRequestStore) Expire(ctx context.Context, id int64, now time.Time) (bool, error) {
+	result := s.core.DB(ctx).
+		Model(&model.AccessRequestM{}).
+		Where("id = ? AND status = ? AND expires_at <= ?", id, known.AccessRequestPending, now).
+		Updates(map[string]any{"status": known.AccessRequestExpired, "updated_at": now})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,39 @@
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// AuditLogStore 定义了 audit_log 模块在 store 层所实现的方法.
+// 审计日志只允许追加，不提供更新和删除.
+type AuditLogStore interface {
+	Create(ctx context.Context, obj *model.AuditLogM) error
+	Get(ctx context.Context, opts *where.Options) (*model.AuditLogM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.AuditLogM, error)
+
+	AuditLogExpansion
+}
+
+// AuditLogExpansion 定义了审计日志操作的附加方法.
+type AuditLogExpansion interface{}
+
+// auditLogStore 是 AuditLogStore 接口的实现。
+type auditLogStore struct {
+	*genericstore.Store[model.AuditLogM]
+}
+
+// 确保 auditLogStore 实现了 AuditLogStore 接口。
+var _ AuditLogStore = (*auditLogStore)(nil)
+
+// newAuditLogStore 创建 auditLogStore 的实例。
+func newAuditLogStore(store *datastore) *auditLogStore {
+	return &auditLogStore{
+		Store: genericstore.NewStore[model.AuditLogM](store, storelogger.NewLogger()),
+	}
+}
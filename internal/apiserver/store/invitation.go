@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// InvitationStore 定义了 invitation 模块在 store 层所实现的方法.
+type InvitationStore interface {
+	Create(ctx context.Context, obj *model.InvitationM) error
+	Get(ctx context.Context, opts *where.Options) (*model.InvitationM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.InvitationM, error)
+
+	InvitationExpansion
+}
+
+// InvitationExpansion 定义了邀请码操作的附加方法.
+type InvitationExpansion interface {
+	// Consume 原子地占用一次邀请码的使用次数，邀请码不存在、已撤销、已过期或次数已用完时返回 gorm.ErrRecordNotFound
+	Consume(ctx context.Context, code string) (*model.InvitationM, error)
+	// Revoke 撤销邀请码，返回被撤销的记录数
+	Revoke(ctx context.Context, code string) (int64, error)
+}
+
+// invitationStore 是 InvitationStore 接口的实现。
+type invitationStore struct {
+	*genericstore.Store[model.InvitationM]
+	core *datastore
+}
+
+// 确保 invitationStore 实现了 InvitationStore 接口。
+var _ InvitationStore = (*invitationStore)(nil)
+
+// newInvitationStore 创建 invitationStore 的实例。
+func newInvitationStore(store *datastore) *invitationStore {
+	return &invitationStore{
+		Store: genericstore.NewStore[model.InvitationM](store, storelogger.NewLogger()),
+		core:  store,
+	}
+}
+
+// Consume 原子地占用一次邀请码的使用次数.
+// 通过带条件的 UPDATE 保证并发注册时使用次数不会超过 max_uses.
+func (s *invitationStore) Consume(ctx context.Context, code string) (*model.InvitationM, error) {
+	result := s.core.DB(ctx).
+		Model(&model.InvitationM{}).
+		Where("code = ? AND revoked_at IS NULL AND used_count < max_uses", code).
+		Where("(expires_at IS NULL OR expires_at > ?)", time.Now()).
+		Update("used_count", gorm.Expr("used_count + 1"))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.Get(ctx, where.F("code", code))
+}
+
+// Revoke 撤销邀请码，返回被撤销的记录数
+func (s *invitationStore) Revoke(ctx context.Context, code string) (int64, error) {
+	result := s.core.DB(ctx).
+		Model(&model.InvitationM{}).
+		Where("code = ? AND revoked_at IS NULL", code).
+		Update("revoked_at", time.Now())
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,39 @@
+package store_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/pkg/id"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestInvitationStore_RandomCode(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	first, second := &model.InvitationM{MaxUses: 1}, &model.InvitationM{MaxUses: 1}
+	require.NoError(t, s.Invitation().Create(ctx, first))
+	require.NoError(t, s.Invitation().Create(ctx, second))
+	require.Equal(t, first.ID+1, second.ID)
+
+	for _, invitationM := range []*model.InvitationM{first, second} {
+		require.NotNil(t, invitationM.Code)
+		assert.Regexp(t, regexp.MustCompile(`^[2-9A-HJ-NP-Z]{20}$`), *invitationM.Code)
+		// 邀请码不能由自增 ID 推算
+		assert.NotEqual(t, id.NewCode(uint64(invitationM.ID)), *invitationM.Code)
+
+		storedM, err := s.Invitation().Get(ctx, where.F("id", invitationM.ID))
+		require.NoError(t, err)
+		assert.Equal(t, invitationM.Code, storedM.Code)
+	}
+
+	// 相邻 ID 的邀请码各自随机生成
+	assert.NotEqual(t, *first.Code, *second.Code)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
 	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
@@ -14,9 +15,14 @@ import (
 type MenuStore interface {
 	Create(ctx context.Context, obj *model.MenuM) error
 	Update(ctx context.Context, obj *model.MenuM) error
+	UpdateFields(ctx context.Context, obj *model.MenuM, fields ...string) error
 	Delete(ctx context.Context, opts *where.Options) error
 	Get(ctx context.Context, opts *where.Options) (*model.MenuM, error)
 	List(ctx context.Context, opts *where.Options) (int64, []*model.MenuM, error)
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.MenuM, error)
+	GetDeleted(ctx context.Context, opts *where.Options) (*model.MenuM, error)
+	Restore(ctx context.Context, opts *where.Options) (bool, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
 
 	MenuExpansion
 }
@@ -67,7 +73,7 @@ func (s *menuStore) ListTree(ctx context.Context, opts *where.Options) ([]*model
 
 	// 按照父菜单和排序顺序获取所有菜单
 	if err := s.core.DB(ctx, opts).
-		Order("parent_id NULLS LAST, sort_order ASC").
+		Order(s.core.dialect.NullsLast("parent_id") + ", sort_order ASC").
 		Find(&menus).Error; err != nil {
 		return nil, err
 	}
@@ -97,13 +103,15 @@ func (s *menuStore) GetChildren(ctx context.Context, parentID string) ([]*model.
 func (s *menuStore) GetUserMenus(ctx context.Context, userID string) ([]*model.MenuM, error) {
 	var menus []*model.MenuM
 
-	// 通过用户角色获取权限，再获取对应的菜单
+	// 通过用户角色获取权限，再获取对应的菜单，关联的权限和角色被软删除后菜单不再可见
 	query := s.core.DB(ctx).
 		Joins("INNER JOIN role_permission ON menu.permission_id = role_permission.permission_id").
 		Joins("INNER JOIN user_role ON role_permission.role_id = user_role.role_id").
+		Joins("INNER JOIN permission ON role_permission.permission_id = permission.permission_id AND permission.deleted_at IS NULL").
+		Joins("INNER JOIN role ON user_role.role_id = role.role_id AND role.deleted_at IS NULL").
 		Where("user_role.user_id = ?", userID).
 		Where("menu.status = ? AND menu.visible = ?", 0, 1). // 0=启用, 1=可见
-		Order("parent_id NULLS LAST, sort_order ASC")
+		Order(s.core.dialect.NullsLast("menu.parent_id") + ", menu.sort_order ASC")
 
 	if err := query.Find(&menus).Error; err != nil {
 		return nil, err
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// OIDCAuthStateStore 定义了 oidc_auth_state 模块在 store 层所实现的方法.
+type OIDCAuthStateStore interface {
+	Create(ctx context.Context, obj *model.OIDCAuthStateM) error
+
+	OIDCAuthStateExpansion
+}
+
+// OIDCAuthStateExpansion 定义了 OIDC 登录状态操作的附加方法.
+type OIDCAuthStateExpansion interface {
+	// Consume 一次性消费未过期的登录状态，状态不存在或已过期时返回 gorm.ErrRecordNotFound
+	Consume(ctx context.Context, state string) (*model.OIDCAuthStateM, error)
+	// PurgeExpired 清理已过期的登录状态，返回清理的记录数
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// oidcAuthStateStore 是 OIDCAuthStateStore 接口的实现。
+type oidcAuthStateStore struct {
+	*genericstore.Store[model.OIDCAuthStateM]
+	core *datastore
+}
+
+// 确保 oidcAuthStateStore 实现了 OIDCAuthStateStore 接口。
+var _ OIDCAuthStateStore = (*oidcAuthStateStore)(nil)
+
+// newOIDCAuthStateStore 创建 oidcAuthStateStore 的实例。
+func newOIDCAuthStateStore(store *datastore) *oidcAuthStateStore {
+	return &oidcAuthStateStore{
+		Store: genericstore.NewStore[model.OIDCAuthStateM](store, storelogger.NewLogger()),
+		core:  store,
+	}
+}
+
+// Consume 一次性消费未过期的登录状态.
+// 支持 RETURNING 的数据库通过 DELETE ... RETURNING 保证同一个 state 只能被一次回调使用，
+// 其他数据库先查询再按主键删除，只有实际删除了记录的回调才能使用该 state.
+func (s *oidcAuthStateStore) Consume(ctx context.Context, state string) (*model.OIDCAuthStateM, error) {
+	if !s.core.dialect.SupportsReturning() {
+		return s.consumeWithoutReturning(ctx, state)
+	}
+
+	var states []*model.OIDCAuthStateM
+	result := s.core.DB(ctx).
+		Model(&states).
+		Clauses(clause.Returning{}).
+		Where("state = ? AND expires_at > ?", state, time.Now()).
+		Delete(&states)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(states) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return states[0], nil
+}
+
+// consumeWithoutReturning 在不支持 RETURNING 的数据库上一次性消费未过期的登录状态.
+func (s *oidcAuthStateStore) consumeWithoutReturning(ctx context.Context, state string) (*model.OIDCAuthStateM, error) {
+	var obj model.OIDCAuthStateM
+	if err := s.core.DB(ctx).Where("state = ? AND expires_at > ?", state, time.Now()).First(&obj).Error; err != nil {
+		return nil, err
+	}
+
+	result := s.core.DB(ctx).Where("id = ?", obj.ID).Delete(&model.OIDCAuthStateM{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 已被并发的回调消费
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &obj, nil
+}
+
+// PurgeExpired 清理已过期的登录状态.
+func (s *oidcAuthStateStore) PurgeExpired(ctx context.Context) (int64, error) {
+	result := s.core.DB(ctx).
+		Where("expires_at <= ?", time.Now()).
+		Delete(&model.OIDCAuthStateM{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,160 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	genericdb "github.com/clin211/gin-enterprise-template/pkg/db"
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	"github.com/clin211/gin-enterprise-template/pkg/outbox"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// outbox_event 表中事件的状态.
+const (
+	outboxStatusPending   int16 = 0
+	outboxStatusPublished int16 = 1
+	outboxStatusDead      int16 = 2
+)
+
+// outboxLeaseName 是发布 outbox 事件的租约名称，对应的记录由数据库迁移创建.
+const outboxLeaseName = "outbox_relay"
+
+// OutboxStore 定义了 outbox 模块在 store 层所实现的方法.
+type OutboxStore interface {
+	// Add 写入领域事件，应在业务变更所在的事务中调用.
+	Add(ctx context.Context, events ...*outbox.Event) error
+
+	OutboxExpansion
+}
+
+// OutboxExpansion 定义了 Relay 发布事件所需的附加方法.
+type OutboxExpansion interface {
+	outbox.Store
+}
+
+// outboxStore 是 OutboxStore 接口的实现。
+type outboxStore struct {
+	*genericstore.Store[model.OutboxEventM]
+	core *datastore
+}
+
+// 确保 outboxStore 实现了 OutboxStore 接口。
+var _ OutboxStore = (*outboxStore)(nil)
+
+// newOutboxStore 创建 outboxStore 的实例。
+func newOutboxStore(store *datastore) *outboxStore {
+	return &outboxStore{
+		Store: genericstore.NewStore[model.OutboxEventM](store, storelogger.NewLogger()),
+		core:  store,
+	}
+}
+
+// Add 写入领域事件，应在业务变更所在的事务中调用.
+func (s *outboxStore) Add(ctx context.Context, events ...*outbox.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	objs := make([]*model.OutboxEventM, 0, len(events))
+	for _, event := range events {
+		objs = append(objs, &model.OutboxEventM{
+			EventID:       event.EventID,
+			AggregateType: event.AggregateType,
+			AggregateID:   event.AggregateID,
+			EventType:     event.EventType,
+			Payload:       string(event.Payload),
+			Status:        outboxStatusPending,
+			NextAttemptAt: event.NextAttemptAt,
+			CreatedAt:     event.CreatedAt,
+		})
+	}
+	return s.core.DB(ctx).Create(&objs).Error
+}
+
+// Acquire 获取或续期发布事件的租约，租约未过期时只有持有者可以续期.
+func (s *outboxStore) Acquire(ctx context.Context, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	result := s.core.DB(ctx).
+		Model(&model.OutboxLeaseM{}).
+		Where("name = ? AND (holder = ? OR expires_at < ?)", outboxLeaseName, holder, now).
+		Updates(map[string]any{"holder": holder, "expires_at": now.Add(ttl)})
+	return result.RowsAffected == 1, result.Error
+}
+
+// Pending 按 ID 升序返回最多 limit 条在 now 之前到达发布时间、且同一聚合中没有更早的未发布事件的事件.
+// 刚提交的事件可能还没有复制到只读副本，因此始终从主库读取.
+func (s *outboxStore) Pending(ctx context.Context, now time.Time, limit int) ([]*outbox.Event, error) {
+	var objs []*model.OutboxEventM
+	if err := genericdb.Primary(s.core.DB(ctx)).
+		Where("status = ? AND next_attempt_at <= ?", outboxStatusPending, now).
+		Where("NOT EXISTS (SELECT 1 FROM outbox_event AS prev WHERE prev.status = ? AND prev.aggregate_type = outbox_event.aggregate_type "+
+			"AND prev.aggregate_id = outbox_event.aggregate_id AND prev.id < outbox_event.id)", outboxStatusPending).
+		Order("id").
+		Limit(limit).
+		Find(&objs).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]*outbox.Event, 0, len(objs))
+	for _, obj := range objs {
+		events = append(events, &outbox.Event{
+			ID:            obj.ID,
+			EventID:       obj.EventID,
+			AggregateType: obj.AggregateType,
+			AggregateID:   obj.AggregateID,
+			EventType:     obj.EventType,
+			Payload:       []byte(obj.Payload),
+			Attempts:      int(obj.Attempts),
+			NextAttemptAt: obj.NextAttemptAt,
+			CreatedAt:     obj.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// MarkPublished 将事件标记为已发布.
+func (s *outboxStore) MarkPublished(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.events(ctx, ids...).Updates(map[string]any{
+		"status":       outboxStatusPublished,
+		"published_at": time.Now(),
+	}).Error
+}
+
+// MarkFailed 记录一次发布失败，事件在 next 之后重试.
+func (s *outboxStore) MarkFailed(ctx context.Context, id int64, attempts int, next time.Time, lastError string) error {
+	return s.events(ctx, id).Updates(map[string]any{
+		"attempts":        attempts,
+		"next_attempt_at": next,
+		"last_error":      lastError,
+	}).Error
+}
+
+// MarkDead 将事件标记为死信，不再重试.
+func (s *outboxStore) MarkDead(ctx context.Context, id int64, attempts int, lastError string) error {
+	return s.events(ctx, id).Updates(map[string]any{
+		"status":       outboxStatusDead,
+		"attempts":     attempts,
+		"last_error":   lastError,
+		"published_at": time.Now(),
+	}).Error
+}
+
+// Purge 删除在 before 之前发布或成为死信的事件，返回删除的数量.
+func (s *outboxStore) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result := s.core.DB(ctx).
+		Where("status IN ? AND published_at < ?", []int16{outboxStatusPublished, outboxStatusDead}, before).
+		Delete(&model.OutboxEventM{})
+	return result.RowsAffected, result.Error
+}
+
+// events 返回用于更新指定事件的 *gorm.DB.
+func (s *outboxStore) events(ctx context.Context, ids ...int64) *gorm.DB {
+	return s.core.DB(ctx).Model(&model.OutboxEventM{}).Where("id IN ?", ids)
+}
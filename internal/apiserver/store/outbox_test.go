@@ -0,0 +1,71 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/pkg/outbox"
+)
+
+func TestOutboxStore_Pending(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	var events []*outbox.Event
+	for _, aggregateID := range []string{"a1", "a1", "a2", "a3"} {
+		event, err := outbox.NewEvent("outbox-test", aggregateID, "test.changed", nil, nil)
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	require.NoError(t, s.Outbox().Add(ctx, events...))
+
+	pending := func(now time.Time) []string {
+		t.Helper()
+		events, err := s.Outbox().Pending(ctx, now, 100)
+		require.NoError(t, err)
+		var ids []string
+		for _, event := range events {
+			if event.AggregateType == "outbox-test" {
+				ids = append(ids, event.AggregateID+"/"+event.EventID)
+			}
+		}
+		return ids
+	}
+
+	now := time.Now().Add(time.Second)
+	got, err := s.Outbox().Pending(ctx, now, 100)
+	require.NoError(t, err)
+	byEventID := make(map[string]*outbox.Event)
+	for _, event := range got {
+		byEventID[event.EventID] = event
+	}
+	first, a2 := byEventID[events[0].EventID], byEventID[events[2].EventID]
+	require.NotNil(t, first)
+	require.NotNil(t, a2)
+
+	// 每个聚合只返回最早的事件
+	assert.Equal(t, []string{
+		"a1/" + events[0].EventID,
+		"a2/" + events[2].EventID,
+		"a3/" + events[3].EventID,
+	}, pending(now))
+
+	// 退避中的事件不返回，也阻塞同一聚合的后续事件
+	require.NoError(t, s.Outbox().MarkFailed(ctx, first.ID, 1, now.Add(time.Minute), "broker unavailable"))
+	assert.Equal(t, []string{
+		"a2/" + events[2].EventID,
+		"a3/" + events[3].EventID,
+	}, pending(now))
+
+	// 前一条事件发布后返回后续事件
+	require.NoError(t, s.Outbox().MarkPublished(ctx, first.ID, a2.ID))
+	assert.Equal(t, []string{
+		"a1/" + events[1].EventID,
+		"a3/" + events[3].EventID,
+	}, pending(now))
+}
@@ -2,9 +2,10 @@ package store
 
 import (
 	"context"
+	"time"
 
 	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
-	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	genericcache "github.com/clin211/gin-enterprise-template/pkg/store/cache"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
@@ -14,9 +15,14 @@ import (
 type PermissionStore interface {
 	Create(ctx context.Context, obj *model.PermissionM) error
 	Update(ctx context.Context, obj *model.PermissionM) error
+	UpdateFields(ctx context.Context, obj *model.PermissionM, fields ...string) error
 	Delete(ctx context.Context, opts *where.Options) error
 	Get(ctx context.Context, opts *where.Options) (*model.PermissionM, error)
 	List(ctx context.Context, opts *where.Options) (int64, []*model.PermissionM, error)
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.PermissionM, error)
+	GetDeleted(ctx context.Context, opts *where.Options) (*model.PermissionM, error)
+	Restore(ctx context.Context, opts *where.Options) (bool, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
 
 	PermissionExpansion
 }
@@ -33,7 +39,7 @@ type PermissionExpansion interface {
 
 // permissionStore 是 PermissionStore 接口的实现。
 type permissionStore struct {
-	*genericstore.Store[model.PermissionM]
+	*genericcache.Store[model.PermissionM]
 	core *datastore
 }
 
@@ -43,7 +49,7 @@ var _ PermissionStore = (*permissionStore)(nil)
 // newPermissionStore 创建 permissionStore 的实例。
 func newPermissionStore(store *datastore) *permissionStore {
 	return &permissionStore{
-		Store: genericstore.NewStore[model.PermissionM](store, storelogger.NewLogger()),
+		Store: genericcache.NewStore[model.PermissionM](store, storelogger.NewLogger(), store.cache),
 		core:  store,
 	}
 }
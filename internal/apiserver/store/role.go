@@ -2,9 +2,10 @@ package store
 
 import (
 	"context"
+	"time"
 
 	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
-	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	genericcache "github.com/clin211/gin-enterprise-template/pkg/store/cache"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
@@ -15,9 +16,14 @@ import (
 type RoleStore interface {
 	Create(ctx context.Context, obj *model.RoleM) error
 	Update(ctx context.Context, obj *model.RoleM) error
+	UpdateFields(ctx context.Context, obj *model.RoleM, fields ...string) error
 	Delete(ctx context.Context, opts *where.Options) error
 	Get(ctx context.Context, opts *where.Options) (*model.RoleM, error)
 	List(ctx context.Context, opts *where.Options) (int64, []*model.RoleM, error)
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.RoleM, error)
+	GetDeleted(ctx context.Context, opts *where.Options) (*model.RoleM, error)
+	Restore(ctx context.Context, opts *where.Options) (bool, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
 
 	RoleExpansion
 }
@@ -26,17 +32,37 @@ type RoleStore interface {
 type RoleExpansion interface {
 	// GetByRoleCode 根据角色编码获取角色
 	GetByRoleCode(ctx context.Context, roleCode string) (*model.RoleM, error)
-	// AssignPermissions 为角色分配权限
+	// AssignPermissions 为角色分配永久有效的权限
 	AssignPermissions(ctx context.Context, roleID string, permissionIDs []string) error
-	// GetPermissions 获取角色的权限列表
+	// ReplacePermissions 使用 rolePermissions 覆盖角色的全部权限分配，支持设置有效期
+	ReplacePermissions(ctx context.Context, roleID string, rolePermissions []*model.RolePermissionM) error
+	// GetPermissions 获取角色的权限列表（包括尚未生效的定时分配）
 	GetPermissions(ctx context.Context, roleID string) ([]*model.PermissionM, error)
+	// GetEffectivePermissions 获取角色当前生效的权限列表
+	GetEffectivePermissions(ctx context.Context, roleID string) ([]*model.PermissionM, error)
 	// RemovePermissions 移除角色的所有权限
 	RemovePermissions(ctx context.Context, roleID string) error
+	// ListStartedPermissions 获取生效时间在 (after, now] 之间且尚未过期的权限分配
+	ListStartedPermissions(ctx context.Context, after, now time.Time) ([]*model.RolePermissionM, error)
+	// ListExpiredPermissions 获取在 now 之前已过期的权限分配
+	ListExpiredPermissions(ctx context.Context, now time.Time) ([]*model.RolePermissionM, error)
+	// RemoveExpiredPermission 删除已过期的权限分配，返回是否实际删除
+	RemoveExpiredPermission(ctx context.Context, id int64, now time.Time) (bool, error)
+	// ListByPermission 获取被分配了指定权限的角色列表
+	ListByPermission(ctx context.Context, permissionID string) ([]*model.RoleM, error)
+	// ListPermanentPermissions 获取所有角色的永久有效的权限分配
+	ListPermanentPermissions(ctx context.Context) ([]*model.RolePermissionM, error)
+	// AddPermission 为角色追加一个永久有效的权限
+	AddPermission(ctx context.Context, roleID, permissionID string) error
+	// RemovePermanentPermission 移除角色的指定永久有效的权限，保留限时分配
+	RemovePermanentPermission(ctx context.Context, roleID, permissionID string) error
+	// RemovePermissionAssignments 移除所有角色对指定权限的分配
+	RemovePermissionAssignments(ctx context.Context, permissionID string) error
 }
 
 // roleStore 是 RoleStore 接口的实现。
 type roleStore struct {
-	*genericstore.Store[model.RoleM]
+	*genericcache.Store[model.RoleM]
 	core *datastore
 }
 
@@ -46,7 +72,7 @@ var _ RoleStore = (*roleStore)(nil)
 // newRoleStore 创建 roleStore 的实例。
 func newRoleStore(store *datastore) *roleStore {
 	return &roleStore{
-		Store: genericstore.NewStore[model.RoleM](store, storelogger.NewLogger()),
+		Store: genericcache.NewStore[model.RoleM](store, storelogger.NewLogger(), store.cache),
 		core:  store,
 	}
 }
@@ -60,9 +86,18 @@ func (s *roleStore) GetByRoleCode(ctx context.Context, roleCode string) (*model.
 	return &obj, nil
 }
 
-// AssignPermissions 为角色分配权限（覆盖模式）。
-// 使用事务确保删除和插入操作的原子性，避免并发竞态条件。
+// AssignPermissions 为角色分配永久有效的权限（覆盖模式）。
 func (s *roleStore) AssignPermissions(ctx context.Context, roleID string, permissionIDs []string) error {
+	rolePermissions := make([]*model.RolePermissionM, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		rolePermissions = append(rolePermissions, &model.RolePermissionM{PermissionID: permissionID})
+	}
+	return s.ReplacePermissions(ctx, roleID, rolePermissions)
+}
+
+// ReplacePermissions 使用 rolePermissions 覆盖角色的全部权限分配（覆盖模式）。
+// 使用事务确保删除和插入操作的原子性，避免并发竞态条件。
+func (s *roleStore) ReplacePermissions(ctx context.Context, roleID string, rolePermissions []*model.RolePermissionM) error {
 	// 使用事务确保删除和插入操作的原子性
 	return s.core.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		// 先删除现有权限
@@ -71,13 +106,9 @@ func (s *roleStore) AssignPermissions(ctx context.Context, roleID string, permis
 		}
 
 		// 批量插入新权限
-		if len(permissionIDs) > 0 {
-			var rolePermissions []interface{}
-			for _, permissionID := range permissionIDs {
-				rolePermissions = append(rolePermissions, &model.RolePermissionM{
-					RoleID:       roleID,
-					PermissionID: permissionID,
-				})
+		if len(rolePermissions) > 0 {
+			for _, rolePermission := range rolePermissions {
+				rolePermission.RoleID = roleID
 			}
 			if err := tx.Create(rolePermissions).Error; err != nil {
 				return err
@@ -106,7 +137,98 @@ func (s *roleStore) GetPermissions(ctx context.Context, roleID string) ([]*model
 	return permissions, nil
 }
 
+// GetEffectivePermissions 获取角色当前生效的权限列表
+func (s *roleStore) GetEffectivePermissions(ctx context.Context, roleID string) ([]*model.PermissionM, error) {
+	var permissions []*model.PermissionM
+
+	subQuery := s.core.DB(ctx).Table("role_permission").
+		Select("permission_id").
+		Where("role_id = ?", roleID).
+		Where(effectiveAt("role_permission", time.Now()))
+
+	if err := s.core.DB(ctx).
+		Where("permission_id IN (?)", subQuery).
+		Find(&permissions).Error; err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
 // RemovePermissions 移除角色的所有权限
 func (s *roleStore) RemovePermissions(ctx context.Context, roleID string) error {
 	return s.core.DB(ctx).Where("role_id = ?", roleID).Delete(&model.RolePermissionM{}).Error
 }
+
+// ListStartedPermissions 获取生效时间在 (after, now] 之间且尚未过期的权限分配
+func (s *roleStore) ListStartedPermissions(ctx context.Context, after, now time.Time) ([]*model.RolePermissionM, error) {
+	var rolePermissions []*model.RolePermissionM
+	if err := s.core.DB(ctx).
+		Where("starts_at > ? AND starts_at <= ?", after, now).
+		Where("(expires_at IS NULL OR expires_at > ?)", now).
+		Find(&rolePermissions).Error; err != nil {
+		return nil, err
+	}
+	return rolePermissions, nil
+}
+
+// ListExpiredPermissions 获取在 now 之前已过期的权限分配
+func (s *roleStore) ListExpiredPermissions(ctx context.Context, now time.Time) ([]*model.RolePermissionM, error) {
+	var rolePermissions []*model.RolePermissionM
+	if err := s.core.DB(ctx).Where("expires_at <= ?", now).Find(&rolePermissions).Error; err != nil {
+		return nil, err
+	}
+	return rolePermissions, nil
+}
+
+// RemoveExpiredPermission 删除已过期的权限分配，返回是否实际删除
+func (s *roleStore) RemoveExpiredPermission(ctx context.Context, id int64, now time.Time) (bool, error) {
+	result := s.core.DB(ctx).Where("id = ? AND expires_at <= ?", id, now).Delete(&model.RolePermissionM{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListByPermission 获取被分配了指定权限的角色列表
+func (s *roleStore) ListByPermission(ctx context.Context, permissionID string) ([]*model.RoleM, error) {
+	var roles []*model.RoleM
+
+	subQuery := s.core.DB(ctx).Table("role_permission").
+		Select("role_id").
+		Where("permission_id = ?", permissionID)
+
+	if err := s.core.DB(ctx).
+		Where("role_id IN (?)", subQuery).
+		Find(&roles).Error; err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// ListPermanentPermissions 获取所有角色的永久有效的权限分配
+func (s *roleStore) ListPermanentPermissions(ctx context.Context) ([]*model.RolePermissionM, error) {
+	var rolePermissions []*model.RolePermissionM
+	if err := s.core.DB(ctx).
+		Where("starts_at IS NULL AND expires_at IS NULL").
+		Find(&rolePermissions).Error; err != nil {
+		return nil, err
+	}
+	return rolePermissions, nil
+}
+
+// AddPermission 为角色追加一个永久有效的权限
+func (s *roleStore) AddPermission(ctx context.Context, roleID, permissionID string) error {
+	return s.core.DB(ctx).Create(&model.RolePermissionM{RoleID: roleID, PermissionID: permissionID}).Error
+}
+
+// RemovePermanentPermission 移除角色的指定永久有效的权限，保留限时分配
+func (s *roleStore) RemovePermanentPermission(ctx context.Context, roleID, permissionID string) error {
+	return s.core.DB(ctx).
+		Where("role_id = ? AND permission_id = ?", roleID, permissionID).
+		Where("starts_at IS NULL AND expires_at IS NULL").
+		Delete(&model.RolePermissionM{}).Error
+}
+
+// RemovePermissionAssignments 移除所有角色对指定权限的分配
+func (s *roleStore) RemovePermissionAssignments(ctx context.Context, permissionID string) error {
+	return s.core.DB(ctx).Where("permission_id = ?", permissionID).Delete(&model.RolePermissionM{}).Error
+}
@@ -0,0 +1,41 @@
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// SoDRuleStore 定义了 sod_rule 模块在 store 层所实现的方法.
+type SoDRuleStore interface {
+	Create(ctx context.Context, obj *model.SoDRuleM) error
+	Update(ctx context.Context, obj *model.SoDRuleM) error
+	UpdateFields(ctx context.Context, obj *model.SoDRuleM, fields ...string) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.SoDRuleM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.SoDRuleM, error)
+
+	SoDRuleExpansion
+}
+
+// SoDRuleExpansion 定义了职责分离规则操作的附加方法.
+type SoDRuleExpansion interface{}
+
+// sodRuleStore 是 SoDRuleStore 接口的实现。
+type sodRuleStore struct {
+	*genericstore.Store[model.SoDRuleM]
+}
+
+// 确保 sodRuleStore 实现了 SoDRuleStore 接口。
+var _ SoDRuleStore = (*sodRuleStore)(nil)
+
+// newSoDRuleStore 创建 sodRuleStore 的实例。
+func newSoDRuleStore(store *datastore) *sodRuleStore {
+	return &sodRuleStore{
+		Store: genericstore.NewStore[model.SoDRuleM](store, storelogger.NewLogger()),
+	}
+}
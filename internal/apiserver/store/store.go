@@ -2,11 +2,17 @@ package store
 
 import (
 	"context"
+	"log/slog"
 	"sync"
 
+	genericdb "github.com/clin211/gin-enterprise-template/pkg/db"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	genericcache "github.com/clin211/gin-enterprise-template/pkg/store/cache"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 	"github.com/google/wire"
 	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
 )
 
 // ProviderSet 是 Wire 提供者集，用于声明依赖注入规则。
@@ -34,6 +40,14 @@ type IStore interface {
 	Permission() PermissionStore
 	Menu() MenuStore
 	UserRole() UserRoleStore
+	UserSession() UserSessionStore
+	Invitation() InvitationStore
+	UserIdentity() UserIdentityStore
+	OIDCAuthState() OIDCAuthStateStore
+	AuditLog() AuditLogStore
+	AccessRequest() AccessRequestStore
+	SoDRule() SoDRuleStore
+	Outbox() OutboxStore
 }
 
 // transactionKey 是用于在 context.Context 中存储事务上下文的键。
@@ -42,6 +56,10 @@ type transactionKey struct{}
 // datastore 是 IStore 的具体实现。
 type datastore struct {
 	core *gorm.DB
+	// dialect 用于拼接不同数据库之间不可移植的 SQL 片段
+	dialect genericdb.Dialect
+	// cache 为空表示不缓存读请求，启用时缓存用户、角色和权限的 Get 和 List
+	cache *genericcache.Cache
 
 	// 可以根据需要添加其他数据库实例。
 	// 示例：fake *gorm.DB
@@ -51,11 +69,18 @@ type datastore struct {
 var _ IStore = (*datastore)(nil)
 
 // NewStore 初始化 IStore 类型的单例实例。
-// 它使用 sync.Once 确保 datastore 只创建一次。
-func NewStore(db *gorm.DB) *datastore {
+// 它使用 sync.Once 确保 datastore 只创建一次。cache 为 nil 时不缓存读请求。
+func NewStore(db *gorm.DB, cache *genericcache.Cache) *datastore {
 	// 仅初始化一次单例 datastore 实例。
 	once.Do(func() {
-		S = &datastore{db}
+		S = &datastore{core: db, dialect: genericdb.DialectOf(db), cache: cache}
+		if cache == nil {
+			return
+		}
+		// 启动时注册缓存的表，本实例写入这些表时即使还没有读取过，也会使所有实例的缓存失效
+		if err := cache.Register(&model.UserM{}, &model.RoleM{}, &model.PermissionM{}); err != nil {
+			slog.Warn("Failed to register cached models", "error", err)
+		}
 	})
 
 	return S
@@ -64,6 +89,7 @@ func NewStore(db *gorm.DB) *datastore {
 // DB 根据输入条件（wheres）过滤数据库实例。
 // 如果未提供条件，函数将从上下文返回数据库实例
 //（事务实例或核心数据库实例）。
+// 配置了只读副本时，核心数据库实例上的查询路由到副本，写入和事务实例上的操作使用主库。
 func (store *datastore) DB(ctx context.Context, wheres ...where.Where) *gorm.DB {
 	db := store.core
 	// 尝试从上下文中检索事务实例。
@@ -83,14 +109,23 @@ func (store *datastore) DB(ctx context.Context, wheres ...where.Where) *gorm.DB
 func (ds *datastore) FakeDB(ctx context.Context) *gorm.DB { return nil }
 
 // TX 启动一个新的事务实例。
+// SQLite 同一时间只允许一个连接写入，而授权器在回调中通过独立的连接写入 Casbin 策略，
+// 开启事务会使两者互相等待直到超时，因此不开启事务，回调中的写操作各自提交.
+// SQLite 仅用于本地开发和测试，生产环境请使用 PostgreSQL 或 MySQL.
+// 提交时返回的序列化失败等数据库错误同样会被转换为业务错误.
 // nolint: fatcontext
 func (store *datastore) TX(ctx context.Context, fn func(ctx context.Context) error) error {
-	return store.core.WithContext(ctx).Transaction(
+	if store.dialect.SingleWriter() {
+		return fn(ctx)
+	}
+
+	err := store.core.WithContext(ctx).Transaction(
 		func(tx *gorm.DB) error {
 			ctx = context.WithValue(ctx, transactionKey{}, tx)
 			return fn(ctx)
 		},
 	)
+	return genericstore.TranslateError(err)
 }
 
 // User 返回一个实现了 UserStore 接口的实例.
@@ -117,3 +152,43 @@ func (store *datastore) Menu() MenuStore {
 func (store *datastore) UserRole() UserRoleStore {
 	return newUserRoleStore(store)
 }
+
+// UserSession 返回一个实现了 UserSessionStore 接口的实例.
+func (store *datastore) UserSession() UserSessionStore {
+	return newUserSessionStore(store)
+}
+
+// Invitation 返回一个实现了 InvitationStore 接口的实例.
+func (store *datastore) Invitation() InvitationStore {
+	return newInvitationStore(store)
+}
+
+// UserIdentity 返回一个实现了 UserIdentityStore 接口的实例.
+func (store *datastore) UserIdentity() UserIdentityStore {
+	return newUserIdentityStore(store)
+}
+
+// OIDCAuthState 返回一个实现了 OIDCAuthStateStore 接口的实例.
+func (store *datastore) OIDCAuthState() OIDCAuthStateStore {
+	return newOIDCAuthStateStore(store)
+}
+
+// AuditLog 返回一个实现了 AuditLogStore 接口的实例.
+func (store *datastore) AuditLog() AuditLogStore {
+	return newAuditLogStore(store)
+}
+
+// AccessRequest 返回一个实现了 AccessRequestStore 接口的实例.
+func (store *datastore) AccessRequest() AccessRequestStore {
+	return newAccessRequestStore(store)
+}
+
+// SoDRule 返回一个实现了 SoDRuleStore 接口的实例.
+func (store *datastore) SoDRule() SoDRuleStore {
+	return newSoDRuleStore(store)
+}
+
+// Outbox 返回一个实现了 OutboxStore 接口的实例.
+func (store *datastore) Outbox() OutboxStore {
+	return newOutboxStore(store)
+}
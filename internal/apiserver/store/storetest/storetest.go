@@ -0,0 +1,94 @@
+// Package storetest 为依赖 store.IStore 的测试提供执行过全部迁移的 SQLite 数据库.
+package storetest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/pkg/db"
+	"github.com/clin211/gin-enterprise-template/pkg/store/migrate"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/migrations"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+)
+
+var (
+	once sync.Once
+	ds   store.IStore
+	err  error
+)
+
+// New 返回使用临时 SQLite 数据库的 IStore.
+// store.NewStore 是单例，同一个测试二进制中的测试共享该数据库，测试应使用互不冲突的数据.
+func New(t testing.TB) store.IStore {
+	t.Helper()
+
+	once.Do(func() {
+		var dir string
+		if dir, err = os.MkdirTemp("", "apiserver-store-"); err != nil {
+			return
+		}
+		dbIns, openErr := db.NewSQLite(&db.SQLiteOptions{Path: filepath.Join(dir, "apiserver.db")})
+		if err = openErr; err != nil {
+			return
+		}
+		if err = migrateUp(dbIns); err != nil {
+			return
+		}
+		ds = store.NewStore(dbIns, nil)
+	})
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	return ds
+}
+
+// migrateUp 执行全部 SQLite 迁移.
+func migrateUp(dbIns *gorm.DB) error {
+	sqlDB, err := dbIns.DB()
+	if err != nil {
+		return err
+	}
+	fsys, err := migrations.For(migrate.DriverSQLite)
+	if err != nil {
+		return err
+	}
+	list, err := migrate.Load(fsys)
+	if err != nil {
+		return err
+	}
+	m, err := migrate.New(sqlDB, list, migrate.WithDriver(migrate.DriverSQLite))
+	if err != nil {
+		return err
+	}
+	_, err = m.Up(context.Background(), 0)
+	return err
+}
+
+// CreateUser 创建用户名为 username 的活跃用户.
+func CreateUser(t testing.TB, s store.IStore, username string) *model.UserM {
+	t.Helper()
+
+	userM := &model.UserM{Username: username, Password: "Passw0rd!123", Nickname: username}
+	if err := s.User().Create(context.Background(), userM); err != nil {
+		t.Fatalf("failed to create user %s: %v", username, err)
+	}
+	return userM
+}
+
+// CreateRole 创建编码为 roleCode 的角色，sensitive 为 true 时授予该角色需要审批.
+func CreateRole(t testing.TB, s store.IStore, roleCode string, sensitive bool) *model.RoleM {
+	t.Helper()
+
+	roleM := &model.RoleM{RoleCode: roleCode, RoleName: roleCode, Sensitive: sensitive}
+	if err := s.Role().Create(context.Background(), roleM); err != nil {
+		t.Fatalf("failed to create role %s: %v", roleCode, err)
+	}
+	return roleM
+}
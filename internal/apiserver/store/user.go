@@ -3,9 +3,10 @@ package store
 
 import (
 	"context"
+	"time"
 
 	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
-	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	genericcache "github.com/clin211/gin-enterprise-template/pkg/store/cache"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
@@ -15,28 +16,67 @@ import (
 type UserStore interface {
 	Create(ctx context.Context, obj *model.UserM) error
 	Update(ctx context.Context, obj *model.UserM) error
+	UpdateFields(ctx context.Context, obj *model.UserM, fields ...string) error
 	Delete(ctx context.Context, opts *where.Options) error
 	Get(ctx context.Context, opts *where.Options) (*model.UserM, error)
 	List(ctx context.Context, opts *where.Options) (int64, []*model.UserM, error)
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.UserM, error)
+	GetDeleted(ctx context.Context, opts *where.Options) (*model.UserM, error)
+	Restore(ctx context.Context, opts *where.Options) (bool, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
 
 	UserExpansion
 }
 
 // UserExpansion 定义了用户操作的附加方法.
 // nolint: iface
-type UserExpansion interface{}
+type UserExpansion interface {
+	// UpdateLastLoginAt 更新用户的最后登录时间，不使用户缓存失效
+	UpdateLastLoginAt(ctx context.Context, userID string, at time.Time) error
+	// UpdatePassword 只更新用户的密码哈希并使用户缓存失效，不递增版本号
+	UpdatePassword(ctx context.Context, userID string, password string) error
+	// Import 创建用户，密码已经是哈希值时直接保存，只能用于服务端可信的导入路径
+	Import(ctx context.Context, obj *model.UserM) error
+}
 
 // userStore 是 UserStore 接口的实现。
 type userStore struct {
-	*genericstore.Store[model.UserM]
+	*genericcache.Store[model.UserM]
+	core *datastore
 }
 
 // 确保 userStore 实现了 UserStore 接口。
 var _ UserStore = (*userStore)(nil)
 
 // newUserStore 创建 userStore 的实例。
+// 用户记录包含密码哈希，只缓存在本地，不写入 Redis.
 func newUserStore(store *datastore) *userStore {
 	return &userStore{
-		Store: genericstore.NewStore[model.UserM](store, storelogger.NewLogger()),
+		Store: genericcache.NewStore[model.UserM](store, storelogger.NewLogger(), store.cache, genericcache.WithLocalOnly()),
+		core:  store,
 	}
 }
+
+// UpdateLastLoginAt 只更新用户的最后登录时间. 每次登录都会更新该列，使用户缓存失效会导致缓存形同虚设，
+// 缓存中的最后登录时间在缓存过期或用户的其他信息变更后刷新.
+func (s *userStore) UpdateLastLoginAt(ctx context.Context, userID string, at time.Time) error {
+	return genericcache.SkipInvalidation(s.core.DB(ctx)).
+		Model(&model.UserM{}).
+		Where("user_id = ?", userID).
+		UpdateColumn("last_login_at", at).Error
+}
+
+// UpdatePassword 只更新用户的密码哈希，用于登录时透明地重新哈希. 重新哈希不改变用户可见的信息，
+// 因此不递增版本号，避免与同时进行的资料修改冲突.
+func (s *userStore) UpdatePassword(ctx context.Context, userID string, password string) error {
+	return s.core.DB(ctx).
+		Model(&model.UserM{}).
+		Where("user_id = ?", userID).
+		UpdateColumn("password", password).Error
+}
+
+// Import 创建用户并保留已有的密码哈希，明文密码仍会被哈希. 由 Create 创建的用户总是重新哈希密码，
+// 防止通过 API 写入参数任意的哈希值.
+func (s *userStore) Import(ctx context.Context, obj *model.UserM) error {
+	return model.KeepPasswordHash(s.core.DB(ctx)).Create(obj).Error
+}
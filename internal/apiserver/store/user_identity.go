@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// UserIdentityStore 定义了 user_identity 模块在 store 层所实现的方法.
+type UserIdentityStore interface {
+	Create(ctx context.Context, obj *model.UserIdentityM) error
+	Update(ctx context.Context, obj *model.UserIdentityM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserIdentityM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.UserIdentityM, error)
+
+	UserIdentityExpansion
+}
+
+// UserIdentityExpansion 定义了用户外部身份操作的附加方法.
+type UserIdentityExpansion interface{}
+
+// userIdentityStore 是 UserIdentityStore 接口的实现。
+type userIdentityStore struct {
+	*genericstore.Store[model.UserIdentityM]
+}
+
+// 确保 userIdentityStore 实现了 UserIdentityStore 接口。
+var _ UserIdentityStore = (*userIdentityStore)(nil)
+
+// newUserIdentityStore 创建 userIdentityStore 的实例。
+func newUserIdentityStore(store *datastore) *userIdentityStore {
+	return &userIdentityStore{
+		Store: genericstore.NewStore[model.UserIdentityM](store, storelogger.NewLogger()),
+	}
+}
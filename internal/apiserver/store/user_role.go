@@ -2,12 +2,18 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
 	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
 )
 
 // UserRoleStore 定义了 user_role 模块在 store 层所实现的方法.
@@ -24,16 +30,30 @@ type UserRoleStore interface {
 
 // UserRoleExpansion 定义了用户角色操作的附加方法.
 type UserRoleExpansion interface {
-	// AssignRoles 为用户分配角色（覆盖模式）
+	// AssignRoles 为用户分配永久有效的角色（覆盖模式）
 	AssignRoles(ctx context.Context, userID string, roleIDs []string) error
-	// GetUserRoles 获取用户的角色列表（含角色详情）
+	// ReplaceRoles 使用 userRoles 覆盖用户的全部角色分配，支持设置有效期
+	ReplaceRoles(ctx context.Context, userID string, userRoles []*model.UserRoleM) error
+	// GetUserRoles 获取用户当前生效的角色列表（含角色详情）
 	GetUserRoles(ctx context.Context, userID string) ([]*model.RoleM, error)
 	// RemoveRole 从用户移除指定角色
 	RemoveRole(ctx context.Context, userID, roleID string) error
 	// RemoveAllRoles 移除用户的所有角色
 	RemoveAllRoles(ctx context.Context, userID string) error
-	// GetUserPermissions 获取用户的所有权限编码
+	// GetUserPermissions 获取用户当前生效的所有权限编码
 	GetUserPermissions(ctx context.Context, userID string) ([]string, error)
+	// ListStarted 获取活跃用户生效时间在 (after, now] 之间且尚未过期的角色分配
+	ListStarted(ctx context.Context, after, now time.Time) ([]*model.UserRoleM, error)
+	// ListEffectiveByRole 获取活跃用户当前生效的指定角色分配
+	ListEffectiveByRole(ctx context.Context, roleID string) ([]*model.UserRoleM, error)
+	// ListExpired 获取在 now 之前已过期的角色分配
+	ListExpired(ctx context.Context, now time.Time) ([]*model.UserRoleM, error)
+	// RemoveExpired 删除已过期的角色分配，返回是否实际删除（并发删除或已重新分配时返回 false）
+	RemoveExpired(ctx context.Context, id int64, now time.Time) (bool, error)
+	// ListAssignedRoleIDs 获取用户未过期的角色分配（包括尚未生效的分配）对应的角色 ID，不包括已删除的角色
+	ListAssignedRoleIDs(ctx context.Context, userID string) ([]string, error)
+	// LockUser 在事务中锁定用户记录，使同一用户的并发角色授予依次执行
+	LockUser(ctx context.Context, userID string) error
 }
 
 // userRoleStore 是 UserRoleStore 接口的实现。
@@ -53,21 +73,26 @@ func newUserRoleStore(store *datastore) *userRoleStore {
 	}
 }
 
-// AssignRoles 为用户分配角色（覆盖模式）
+// AssignRoles 为用户分配永久有效的角色（覆盖模式）
 func (s *userRoleStore) AssignRoles(ctx context.Context, userID string, roleIDs []string) error {
+	userRoles := make([]*model.UserRoleM, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		userRoles = append(userRoles, &model.UserRoleM{RoleID: roleID})
+	}
+	return s.ReplaceRoles(ctx, userID, userRoles)
+}
+
+// ReplaceRoles 使用 userRoles 覆盖用户的全部角色分配（覆盖模式）
+func (s *userRoleStore) ReplaceRoles(ctx context.Context, userID string, userRoles []*model.UserRoleM) error {
 	// 先删除现有角色
 	if err := s.RemoveAllRoles(ctx, userID); err != nil {
 		return err
 	}
 
 	// 批量插入新角色
-	if len(roleIDs) > 0 {
-		var userRoles []interface{}
-		for _, roleID := range roleIDs {
-			userRoles = append(userRoles, &model.UserRoleM{
-				UserID: userID,
-				RoleID: roleID,
-			})
+	if len(userRoles) > 0 {
+		for _, userRole := range userRoles {
+			userRole.UserID = userID
 		}
 		if err := s.core.DB(ctx).Create(userRoles).Error; err != nil {
 			return err
@@ -85,6 +110,7 @@ func (s *userRoleStore) GetUserRoles(ctx context.Context, userID string) ([]*mod
 	if err := s.core.DB(ctx).
 		Joins("INNER JOIN user_role ON role.role_id = user_role.role_id").
 		Where("user_role.user_id = ?", userID).
+		Where(effectiveAt("user_role", time.Now())).
 		Find(&roles).Error; err != nil {
 		return nil, err
 	}
@@ -118,17 +144,108 @@ func (s *userRoleStore) List(ctx context.Context, opts *where.Options) ([]*model
 // GetUserPermissions 获取用户的所有权限编码
 func (s *userRoleStore) GetUserPermissions(ctx context.Context, userID string) ([]string, error) {
 	var permissionCodes []string
+	now := time.Now()
 
 	// 通过用户角色 -> 角色权限 -> 权限的路径查询
 	if err := s.core.DB(ctx).
 		Table("permission").
 		Joins("INNER JOIN role_permission ON permission.permission_id = role_permission.permission_id").
 		Joins("INNER JOIN user_role ON role_permission.role_id = user_role.role_id").
+		Joins("INNER JOIN role ON user_role.role_id = role.role_id").
 		Where("user_role.user_id = ?", userID).
+		Where(effectiveAt("user_role", now)).
+		Where(effectiveAt("role_permission", now)).
 		Where("permission.status = ?", 0). // 0=启用
+		// 直接查询表时不会自动过滤软删除记录，需要排除已删除的权限和角色
+		Where("permission.deleted_at IS NULL AND role.deleted_at IS NULL").
 		Pluck("permission.permission_code", &permissionCodes).Error; err != nil {
 		return nil, err
 	}
 
 	return permissionCodes, nil
 }
+
+// ListStarted 获取活跃用户生效时间在 (after, now] 之间且尚未过期的角色分配
+func (s *userRoleStore) ListStarted(ctx context.Context, after, now time.Time) ([]*model.UserRoleM, error) {
+	var userRoles []*model.UserRoleM
+	if err := s.core.DB(ctx).
+		Scopes(activeUsers).
+		Where("user_role.starts_at > ? AND user_role.starts_at <= ?", after, now).
+		Where("(user_role.expires_at IS NULL OR user_role.expires_at > ?)", now).
+		Find(&userRoles).Error; err != nil {
+		return nil, err
+	}
+	return userRoles, nil
+}
+
+// ListEffectiveByRole 获取活跃用户当前生效的指定角色分配
+func (s *userRoleStore) ListEffectiveByRole(ctx context.Context, roleID string) ([]*model.UserRoleM, error) {
+	var userRoles []*model.UserRoleM
+	if err := s.core.DB(ctx).
+		Scopes(activeUsers).
+		Where("user_role.role_id = ?", roleID).
+		Where(effectiveAt("user_role", time.Now())).
+		Find(&userRoles).Error; err != nil {
+		return nil, err
+	}
+	return userRoles, nil
+}
+
+// ListExpired 获取在 now 之前已过期的角色分配
+func (s *userRoleStore) ListExpired(ctx context.Context, now time.Time) ([]*model.UserRoleM, error) {
+	var userRoles []*model.UserRoleM
+	if err := s.core.DB(ctx).Where("expires_at <= ?", now).Find(&userRoles).Error; err != nil {
+		return nil, err
+	}
+	return userRoles, nil
+}
+
+// RemoveExpired 删除已过期的角色分配，返回是否实际删除
+func (s *userRoleStore) RemoveExpired(ctx context.Context, id int64, now time.Time) (bool, error) {
+	result := s.core.DB(ctx).Where("id = ? AND expires_at <= ?", id, now).Delete(&model.UserRoleM{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListAssignedRoleIDs 获取用户未过期的角色分配（包括尚未生效的分配）对应的角色 ID，不包括已删除的角色
+func (s *userRoleStore) ListAssignedRoleIDs(ctx context.Context, userID string) ([]string, error) {
+	var roleIDs []string
+	if err := s.core.DB(ctx).
+		Table("user_role").
+		Joins("INNER JOIN role ON user_role.role_id = role.role_id").
+		Where("user_role.user_id = ? AND role.deleted_at IS NULL", userID).
+		Where("user_role.expires_at IS NULL OR user_role.expires_at > ?", time.Now()).
+		Pluck("user_role.role_id", &roleIDs).Error; err != nil {
+		return nil, err
+	}
+	return roleIDs, nil
+}
+
+// LockUser 使用 SELECT ... FOR UPDATE 锁定用户记录直到事务结束.
+// SQLite 同一时间只允许一个写事务，不需要也不支持行锁.
+func (s *userRoleStore) LockUser(ctx context.Context, userID string) error {
+	if s.core.dialect.SingleWriter() {
+		return nil
+	}
+	var ids []int64
+	return s.core.DB(ctx).
+		Model(&model.UserM{}).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("user_id = ?", userID).
+		Pluck("id", &ids).Error
+}
+
+// activeUsers 只保留属于活跃用户的角色分配. 已删除、被禁用或待审核的用户不授予 Casbin 中的角色关系，
+// 用户被恢复、启用或审核通过时按当前生效的分配重新授予.
+func activeUsers(db *gorm.DB) *gorm.DB {
+	return db.Joins("INNER JOIN ? ON user_role.user_id = ?.user_id AND ?.deleted_at IS NULL AND ?.status = ?",
+		userTable, userTable, userTable, userTable, known.UserStatusActive)
+}
+
+// userTable 是用户表，user 在 PostgreSQL 中是保留字，拼接 SQL 时需要转义.
+var userTable = clause.Table{Name: model.TableNameUserM}
+
+// effectiveAt 返回筛选 table 中在 at 时刻处于有效期内的授权记录的查询条件，
+// starts_at 为空表示立即生效，expires_at 为空表示永久有效.
+func effectiveAt(table string, at time.Time) (string, time.Time, time.Time) {
+	return fmt.Sprintf("(%[1]s.starts_at IS NULL OR %[1]s.starts_at <= ?) AND (%[1]s.expires_at IS NULL OR %[1]s.expires_at > ?)", table), at, at
+}
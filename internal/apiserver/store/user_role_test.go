@@ -0,0 +1,49 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestUserRoleStore_ActiveUsersOnly(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	roleM := storetest.CreateRole(t, s, "active-only", false)
+	active := storetest.CreateUser(t, s, "active-only-active")
+	disabled := storetest.CreateUser(t, s, "active-only-disabled")
+	deleted := storetest.CreateUser(t, s, "active-only-deleted")
+
+	disabled.Status = known.UserStatusDisabled
+	require.NoError(t, s.User().UpdateFields(ctx, disabled, "Status"))
+	require.NoError(t, s.User().Delete(ctx, where.F("user_id", deleted.UserID)))
+
+	startsAt := time.Now().Add(-time.Minute)
+	for _, userM := range []*model.UserM{active, disabled, deleted} {
+		require.NoError(t, s.UserRole().Create(ctx, &model.UserRoleM{UserID: userM.UserID, RoleID: roleM.RoleID, StartsAt: &startsAt}))
+	}
+
+	effective, err := s.UserRole().ListEffectiveByRole(ctx, roleM.RoleID)
+	require.NoError(t, err)
+	require.Len(t, effective, 1)
+	assert.Equal(t, active.UserID, effective[0].UserID)
+
+	started, err := s.UserRole().ListStarted(ctx, startsAt.Add(-time.Second), time.Now())
+	require.NoError(t, err)
+	var userIDs []string
+	for _, userRole := range started {
+		if userRole.RoleID == roleM.RoleID {
+			userIDs = append(userIDs, userRole.UserID)
+		}
+	}
+	assert.Equal(t, []string{active.UserID}, userIDs)
+}
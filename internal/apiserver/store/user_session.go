@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	storelogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/store"
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+)
+
+// UserSessionStore 定义了 user_session 模块在 store 层所实现的方法.
+type UserSessionStore interface {
+	Create(ctx context.Context, obj *model.UserSessionM) error
+	Update(ctx context.Context, obj *model.UserSessionM) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserSessionM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.UserSessionM, error)
+
+	UserSessionExpansion
+}
+
+// UserSessionExpansion 定义了用户会话操作的附加方法.
+type UserSessionExpansion interface {
+	// ListActive 获取用户当前有效（未撤销且未过期）的会话列表，按最近活跃时间倒序
+	ListActive(ctx context.Context, userID string) ([]*model.UserSessionM, error)
+	// Touch 更新会话的最近活跃时间
+	Touch(ctx context.Context, sessionID string, at time.Time) error
+	// Revoke 撤销满足条件且尚未撤销的会话，返回被撤销的会话数量
+	Revoke(ctx context.Context, opts *where.Options) (int64, error)
+}
+
+// userSessionStore 是 UserSessionStore 接口的实现。
+type userSessionStore struct {
+	*genericstore.Store[model.UserSessionM]
+	core *datastore
+}
+
+// 确保 userSessionStore 实现了 UserSessionStore 接口。
+var _ UserSessionStore = (*userSessionStore)(nil)
+
+// newUserSessionStore 创建 userSessionStore 的实例。
+func newUserSessionStore(store *datastore) *userSessionStore {
+	return &userSessionStore{
+		Store: genericstore.NewStore[model.UserSessionM](store, storelogger.NewLogger()),
+		core:  store,
+	}
+}
+
+// ListActive 获取用户当前有效（未撤销且未过期）的会话列表，按最近活跃时间倒序
+func (s *userSessionStore) ListActive(ctx context.Context, userID string) ([]*model.UserSessionM, error) {
+	var sessions []*model.UserSessionM
+	if err := s.core.DB(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Touch 更新会话的最近活跃时间
+func (s *userSessionStore) Touch(ctx context.Context, sessionID string, at time.Time) error {
+	return s.core.DB(ctx).
+		Model(&model.UserSessionM{}).
+		Where("session_id = ? AND revoked_at IS NULL", sessionID).
+		Update("last_seen_at", at).Error
+}
+
+// Revoke 撤销满足条件且尚未撤销的会话，返回被撤销的会话数量
+func (s *userSessionStore) Revoke(ctx context.Context, opts *where.Options) (int64, error) {
+	result := s.core.DB(ctx, opts).
+		Model(&model.UserSessionM{}).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", time.Now())
+	return result.RowsAffected, result.Error
+}
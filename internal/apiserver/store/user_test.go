@@ -0,0 +1,45 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store/storetest"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/pkg/authn"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+func TestUserStore_PasswordHash(t *testing.T) {
+	ctx := context.Background()
+	s := storetest.New(t)
+
+	hashed, err := authn.NewBcryptHasher(bcrypt.MinCost).Hash("imported123")
+	require.NoError(t, err)
+
+	stored := func(username string) string {
+		t.Helper()
+		userM, err := s.User().Get(ctx, where.F("username", username))
+		require.NoError(t, err)
+		return userM.Password
+	}
+
+	// 通过 Create 提交的哈希值被当作明文密码重新哈希
+	require.NoError(t, s.User().Create(ctx, &model.UserM{Username: "password-hash-create", Password: hashed, Status: known.UserStatusActive}))
+	password := stored("password-hash-create")
+	assert.NotEqual(t, hashed, password)
+	assert.NoError(t, authn.Compare(password, hashed))
+
+	// 导入时保留已有的哈希值
+	require.NoError(t, s.User().Import(ctx, &model.UserM{Username: "password-hash-import", Password: hashed, Status: known.UserStatusActive}))
+	assert.Equal(t, hashed, stored("password-hash-import"))
+
+	// 导入的明文密码仍然会被哈希
+	require.NoError(t, s.User().Import(ctx, &model.UserM{Username: "password-hash-plain", Password: "plain123", Status: known.UserStatusActive}))
+	assert.NoError(t, authn.Compare(stored("password-hash-plain"), "plain123"))
+}
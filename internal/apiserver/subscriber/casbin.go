@@ -0,0 +1,155 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	rolev1 "github.com/clin211/gin-enterprise-template/internal/apiserver/biz/v1/role"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/event"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// casbinRolePrefix 是 Casbin 中角色的前缀，角色格式为 role::roleCode.
+const casbinRolePrefix = "role::"
+
+// 同步 Casbin 失败后的重试次数和重试间隔.
+const (
+	casbinSyncRetries       = 2
+	casbinSyncRetryInterval = 100 * time.Millisecond
+)
+
+// CasbinSync 在角色权限和用户角色变更后同步 Casbin 策略.
+// 同步订阅，每次同步都按数据库中的当前状态重建，失败后重试，仍然失败时由发布者记录日志.
+type CasbinSync struct {
+	store store.IStore
+	authz *authz.Authz
+}
+
+var _ eventbus.Subscriber = (*CasbinSync)(nil)
+
+// NewCasbinSync 创建 CasbinSync 订阅者.
+func NewCasbinSync(store store.IStore, authz *authz.Authz) *CasbinSync {
+	return &CasbinSync{store: store, authz: authz}
+}
+
+// Subscribe 实现 eventbus.Subscriber 接口.
+func (s *CasbinSync) Subscribe(bus *eventbus.Bus) {
+	retry := eventbus.WithRetry(casbinSyncRetries, casbinSyncRetryInterval)
+	eventbus.Subscribe(bus, "casbin", s.syncRolePermissions, retry)
+	eventbus.Subscribe(bus, "casbin", s.removeRole, retry)
+	eventbus.Subscribe(bus, "casbin", s.removeUser, retry)
+	eventbus.Subscribe(bus, "casbin", func(ctx context.Context, e *event.UserRolesAssigned) error {
+		return s.syncUserRoles(ctx, e.UserID)
+	}, retry)
+	eventbus.Subscribe(bus, "casbin", func(ctx context.Context, e *event.UserRoleRemoved) error {
+		return s.syncUserRoles(ctx, e.UserID)
+	}, retry)
+	// 用户被禁用期间生效的限时角色不会由后台任务授予，重新启用时补齐
+	eventbus.Subscribe(bus, "casbin", func(ctx context.Context, e *event.UserStatusChanged) error {
+		if e.Status != known.UserStatusActive {
+			return nil
+		}
+		return s.syncUserRoles(ctx, e.UserID)
+	}, retry)
+}
+
+// syncRolePermissions 按角色当前生效的权限重建角色的策略，尚未生效的权限由后台任务在生效时授予.
+func (s *CasbinSync) syncRolePermissions(ctx context.Context, e *event.RolePermissionsChanged) error {
+	roleM, err := s.store.Role().Get(ctx, where.F("role_id", e.RoleID).L(1))
+	if err != nil {
+		// 角色已被并发删除时，删除角色的事件会清理其策略
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if err := rolev1.SyncPolicies(ctx, s.store, s.authz, roleM); err != nil {
+		return fmt.Errorf("failed to sync permissions to casbin: %w", err)
+	}
+	return nil
+}
+
+// removeRole 从 Casbin 中删除角色的全部策略和角色关系.
+func (s *CasbinSync) removeRole(ctx context.Context, e *event.RoleDeleted) error {
+	casbinRole := casbinRolePrefix + e.RoleCode
+	if _, err := s.authz.RemoveFilteredPolicy(0, casbinRole); err != nil {
+		slog.WarnContext(ctx, "Failed to remove policies", "role", casbinRole, "error", err)
+	}
+	if _, err := s.authz.RemoveConditionalPolicies(casbinRole); err != nil {
+		slog.WarnContext(ctx, "Failed to remove conditional policies", "role", casbinRole, "error", err)
+	}
+	if _, err := s.authz.RemoveFilteredGroupingPolicy(1, casbinRole); err != nil {
+		slog.WarnContext(ctx, "Failed to remove grouping policy", "role", casbinRole, "error", err)
+	}
+	return nil
+}
+
+// removeUser 从 Casbin 中删除用户的全部角色关系.
+func (s *CasbinSync) removeUser(ctx context.Context, e *event.UserDeleted) error {
+	if _, err := s.authz.RemoveFilteredGroupingPolicy(0, e.UserID); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove grouping policies for user", "user", e.UserID, "error", err)
+		return errno.ErrRemoveRole.WithMessage(err.Error())
+	}
+	return nil
+}
+
+// syncUserRoles 使用户在 Casbin 中的角色关系与当前生效的角色分配一致，普通用户角色不受影响.
+// 待审核的用户在审核通过时才授予角色，已删除的用户不再授予角色.
+func (s *CasbinSync) syncUserRoles(ctx context.Context, userID string) error {
+	userM, err := s.store.User().Get(ctx, where.F("user_id", userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if userM.Status == known.UserStatusPending {
+		return nil
+	}
+
+	roles, err := s.store.UserRole().GetUserRoles(ctx, userID)
+	if err != nil {
+		return err
+	}
+	want := make(map[string]bool, len(roles))
+	for _, roleM := range roles {
+		want[casbinRolePrefix+roleM.RoleCode] = true
+	}
+
+	current, err := s.authz.GetRolesForUser(userID)
+	if err != nil {
+		return err
+	}
+	for _, casbinRole := range current {
+		if !strings.HasPrefix(casbinRole, casbinRolePrefix) {
+			continue
+		}
+		if want[casbinRole] {
+			delete(want, casbinRole)
+			continue
+		}
+		if _, err := s.authz.RemoveGroupingPolicy(userID, casbinRole); err != nil {
+			slog.ErrorContext(ctx, "Failed to remove grouping policy", "userID", userID, "role", casbinRole, "error", err)
+			return errno.ErrRemoveRole.WithMessage(err.Error())
+		}
+	}
+	for casbinRole := range want {
+		if _, err := s.authz.AddGroupingPolicy(userID, casbinRole); err != nil {
+			slog.ErrorContext(ctx, "Failed to add grouping policy", "userID", userID, "role", casbinRole, "error", err)
+			return errno.ErrAddRole.WithMessage(err.Error())
+		}
+	}
+	return nil
+}
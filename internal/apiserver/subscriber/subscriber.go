@@ -0,0 +1,25 @@
+// Package subscriber 包含 apiserver 领域事件的进程内订阅者.
+// 业务方法在事务提交后将领域事件发布到事件总线，订阅者据此同步 Casbin 等，
+// 避免这些横切逻辑散落在各个业务方法中. 审计日志需要与业务变更一同提交，由 event.Record 在事务中写入.
+package subscriber
+
+import (
+	"github.com/google/wire"
+
+	"github.com/clin211/gin-enterprise-template/pkg/eventbus"
+)
+
+// ProviderSet 是 Wire 提供者集，用于声明依赖注入规则.
+// 新增订阅者时，在此处添加其构造函数，并作为 NewBus 的参数注册到事件总线.
+var ProviderSet = wire.NewSet(NewCasbinSync, NewBus)
+
+// NewBus 创建事件总线并注册全部订阅者.
+func NewBus(casbin *CasbinSync) (*eventbus.Bus, error) {
+	bus, err := eventbus.New()
+	if err != nil {
+		return nil, err
+	}
+
+	bus.Register(casbin)
+	return bus, nil
+}
@@ -0,0 +1,76 @@
+package apiserver
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+)
+
+// TrashPurger 定义一个回收站清理器. 用来彻底删除在回收站中超过保留期的菜单、权限、角色和用户，
+// 关联的角色分配、权限分配、会话等记录由数据库外键级联删除. 软删除时已经回收了 Casbin 策略，清理时无需再同步.
+type TrashPurger struct {
+	store store.IStore
+
+	// retention 是已删除记录的保留时长，为 0 时不清理
+	retention time.Duration
+	// interval 是两次清理之间的间隔
+	interval time.Duration
+}
+
+// NewTrashPurger 创建 TrashPurger 实例.
+func NewTrashPurger(cfg *Config, store store.IStore) *TrashPurger {
+	p := &TrashPurger{store: store}
+	if cfg.TrashOptions != nil {
+		p.retention = cfg.TrashOptions.Retention
+		p.interval = cfg.TrashOptions.PurgeInterval
+	}
+	return p
+}
+
+// Run 周期性地清理回收站，直到 ctx 被取消. 未配置保留期时直接返回.
+func (p *TrashPurger) Run(ctx context.Context) {
+	if p.retention <= 0 || p.interval <= 0 {
+		slog.InfoContext(ctx, "Trash purging is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx, time.Now().Add(-p.retention))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge 彻底删除在 before 之前被删除的记录. 单次失败只记录日志，未清理的记录会在下一次检查时重试.
+// 先清理菜单和权限，再清理角色和用户，与外键的引用方向保持一致.
+func (p *TrashPurger) purge(ctx context.Context, before time.Time) {
+	purgers := []struct {
+		resource string
+		purge    func(ctx context.Context, before time.Time) (int64, error)
+	}{
+		{"menu", p.store.Menu().Purge},
+		{"permission", p.store.Permission().Purge},
+		{"role", p.store.Role().Purge},
+		{"user", p.store.User().Purge},
+	}
+
+	for _, purger := range purgers {
+		purged, err := purger.purge(ctx, before)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to purge deleted records", "resource", purger.resource, "error", err)
+			continue
+		}
+		if purged > 0 {
+			slog.InfoContext(ctx, "Purged deleted records", "resource", purger.resource, "count", purged, "before", before)
+		}
+	}
+}
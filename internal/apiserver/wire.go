@@ -4,12 +4,12 @@
 package apiserver
 
 import (
-	"github.com/clin211/gin-enterprise-template/pkg/authz"
 	"github.com/google/wire"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/biz"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/validation"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/subscriber"
 	mw "github.com/clin211/gin-enterprise-template/internal/pkg/middleware/gin"
 )
 
@@ -20,13 +20,31 @@ func NewServer(*Config) (*Server, error) {
 		wire.Struct(new(ServerConfig), "*"), // * 表示注入全部字段
 		wire.Struct(new(Server), "*"),
 		wire.NewSet(store.ProviderSet, biz.ProviderSet),
+		subscriber.ProviderSet,
 		ProvideDB, // 提供数据库实例
+		ProvideReplicaSet,
+		ProvideCache,
+		ProvideOutboxRelay,
+		wire.FieldsOf(new(*Config), "TLSOptions", "RegistrationOptions", "OIDCOptions"),
+		ProvideOIDCRegistry,
+		ProvideAuthenticator,
 		validation.ProviderSet,
 		wire.NewSet(
 			wire.Struct(new(UserRetriever), "*"),
 			wire.Bind(new(mw.UserRetriever), new(*UserRetriever)),
 		),
-		authz.ProviderSet,
+		wire.NewSet(
+			wire.Struct(new(ClientCertResolver), "*"),
+			wire.Bind(new(mw.ClientCertResolver), new(*ClientCertResolver)),
+		),
+		NewRoleExpirer,
+		NewTrashPurger,
+		wire.NewSet(
+			NewSessionTracker,
+			wire.Bind(new(mw.SessionTracker), new(*SessionTracker)),
+		),
+		ProvideAuthz,
+		ProvideAuthzOptions,
 	)
 	return nil, nil
 }
@@ -10,7 +10,7 @@ import (
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/biz"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/pkg/validation"
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/store"
-	"github.com/clin211/gin-enterprise-template/pkg/authz"
+	"github.com/clin211/gin-enterprise-template/internal/apiserver/subscriber"
 )
 
 // Injectors from wire.go:
@@ -21,31 +21,71 @@ func NewServer(config *Config) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	datastore := store.NewStore(db)
-	v := authz.DefaultOptions()
-	authzAuthz, err := authz.NewAuthz(db, v...)
+	cache, err := ProvideCache(config, db)
 	if err != nil {
 		return nil, err
 	}
-	bizBiz := biz.NewBiz(datastore, authzAuthz)
+	datastore := store.NewStore(db, cache)
+	v, err := ProvideAuthzOptions(config)
+	if err != nil {
+		return nil, err
+	}
+	authz, err := ProvideAuthz(db, v)
+	if err != nil {
+		return nil, err
+	}
+	registrationOptions := config.RegistrationOptions
+	oidcOptions := config.OIDCOptions
+	registry := ProvideOIDCRegistry(config)
+	casbinSync := subscriber.NewCasbinSync(datastore, authz)
+	bus, err := subscriber.NewBus(casbinSync)
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := ProvideAuthenticator(config, datastore, authz, bus)
+	if err != nil {
+		return nil, err
+	}
+	bizBiz := biz.NewBiz(datastore, authz, registrationOptions, oidcOptions, registry, authenticator, bus)
 	validator := validation.New(datastore)
 	userRetriever := &UserRetriever{
 		store: datastore,
 	}
+	sessionTracker := NewSessionTracker(datastore)
+	tlsOptions := config.TLSOptions
+	clientCertResolver := &ClientCertResolver{
+		store: datastore,
+		tls:   tlsOptions,
+	}
 	serverConfig := &ServerConfig{
 		Config:    config,
 		biz:       bizBiz,
 		val:       validator,
 		retriever: userRetriever,
-		authz:     authzAuthz,
+		tracker:   sessionTracker,
+		certs:     clientCertResolver,
+		authz:     authz,
 	}
 	server, err := NewWebServer(serverConfig)
 	if err != nil {
 		return nil, err
 	}
+	roleExpirer := NewRoleExpirer(datastore, authz)
+	trashPurger := NewTrashPurger(config, datastore)
+	replicaSet := ProvideReplicaSet(db)
+	relay, err := ProvideOutboxRelay(config, datastore)
+	if err != nil {
+		return nil, err
+	}
 	apiserverServer := &Server{
-		cfg: serverConfig,
-		srv: server,
+		cfg:      serverConfig,
+		srv:      server,
+		expirer:  roleExpirer,
+		purger:   trashPurger,
+		replicas: replicaSet,
+		cache:    cache,
+		relay:    relay,
+		bus:      bus,
 	}
 	return apiserverServer, nil
 }
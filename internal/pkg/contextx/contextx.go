@@ -16,6 +16,14 @@ type (
 	requestIDKey struct{}
 	// traceIDKey 是用于在 context 中存储追踪 ID 的键
 	traceIDKey struct{}
+	// sessionIDKey 定义会话 ID 的 context 键。
+	sessionIDKey struct{}
+	// clientIPKey 定义客户端 IP 的 context 键。
+	clientIPKey struct{}
+	// userAgentKey 定义客户端 User-Agent 的 context 键。
+	userAgentKey struct{}
+	// actorIDKey 定义模拟登录时实际操作者 ID 的 context 键。
+	actorIDKey struct{}
 )
 
 // WithUserID 将用户 ID 存储到 context 中。
@@ -72,3 +80,53 @@ func TraceID(ctx context.Context) string {
 	traceID, _ := ctx.Value(traceIDKey{}).(string)
 	return traceID
 }
+
+// WithSessionID 将会话 ID 存储到 context 中。
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+// SessionID 从 context 中检索会话 ID。
+func SessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey{}).(string)
+	return sessionID
+}
+
+// WithClientIP 将客户端 IP 存储到 context 中。
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// ClientIP 从 context 中检索客户端 IP。
+func ClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPKey{}).(string)
+	return clientIP
+}
+
+// WithUserAgent 将客户端 User-Agent 存储到 context 中。
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey{}, userAgent)
+}
+
+// UserAgent 从 context 中检索客户端 User-Agent。
+func UserAgent(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey{}).(string)
+	return userAgent
+}
+
+// WithActorID 将模拟登录时实际操作者（管理员）的用户 ID 存储到 context 中。
+func WithActorID(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorIDKey{}, actorID)
+}
+
+// ActorID 从 context 中检索实际操作者 ID，非模拟登录的请求返回空字符串。
+// 模拟登录期间 UserID 返回被模拟用户的 ID，ActorID 返回发起模拟的管理员 ID。
+func ActorID(ctx context.Context) string {
+	actorID, _ := ctx.Value(actorIDKey{}).(string)
+	return actorID
+}
+
+// Impersonating 判断当前请求是否处于模拟登录状态。
+func Impersonating(ctx context.Context) bool {
+	return ActorID(ctx) != ""
+}
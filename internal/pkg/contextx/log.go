@@ -0,0 +1,37 @@
+package contextx
+
+import (
+	"context"
+	"log/slog"
+)
+
+// logHandler 在日志记录中追加模拟登录的实际操作者，保证模拟期间产生的每条日志都可追溯到真实管理员。
+type logHandler struct {
+	slog.Handler
+}
+
+// NewLogHandler 包装 h，当 context 中存在 ActorID 时为日志记录追加 actorID 属性。
+func NewLogHandler(h slog.Handler) slog.Handler {
+	if _, ok := h.(*logHandler); ok {
+		return h
+	}
+	return &logHandler{Handler: h}
+}
+
+// Handle 实现 slog.Handler 接口。
+func (h *logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if actorID := ActorID(ctx); actorID != "" {
+		r.AddAttrs(slog.String("actorID", actorID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs 实现 slog.Handler 接口。
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup 实现 slog.Handler 接口。
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	return &logHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,63 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrAccessRequestNotFound 表示授权申请不存在.
+	ErrAccessRequestNotFound = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"AccessRequest.NotFound",
+		"授权申请不存在。",
+	)
+
+	// ErrAccessRequestNotPending 表示授权申请已被处理或已过期，不能再审批.
+	ErrAccessRequestNotPending = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"AccessRequest.NotPending",
+		"授权申请已被处理或已过期。",
+	)
+
+	// ErrAccessRequestAlreadyPending 表示该用户已有同一角色的待审批申请.
+	ErrAccessRequestAlreadyPending = errorsx.NewBizError(
+		errorsx.CodeAlreadyExists,
+		"AccessRequest.AlreadyPending",
+		"该用户已有此角色的待审批申请。",
+	)
+
+	// ErrAccessRequestRoleNotSensitive 表示申请的角色不是敏感角色，可直接分配无需审批.
+	ErrAccessRequestRoleNotSensitive = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"AccessRequest.RoleNotSensitive",
+		"该角色不是敏感角色，无需申请，可直接分配。",
+	)
+
+	// ErrAccessRequestRoleAlreadyGranted 表示用户已拥有申请的角色.
+	ErrAccessRequestRoleAlreadyGranted = errorsx.NewBizError(
+		errorsx.CodeAlreadyExists,
+		"AccessRequest.RoleAlreadyGranted",
+		"该用户已拥有此角色。",
+	)
+
+	// ErrAccessRequestApprovalRequired 表示敏感角色只能通过授权申请审批后授予.
+	ErrAccessRequestApprovalRequired = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"AccessRequest.ApprovalRequired",
+		"敏感角色只能通过授权申请审批后授予。",
+	)
+
+	// ErrAccessRequestSelfApproval 表示申请人或被授予人试图审批自己的申请.
+	ErrAccessRequestSelfApproval = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"AccessRequest.SelfApproval",
+		"不能审批自己发起或授予自己的申请。",
+	)
+
+	// ErrAccessRequestNotApprover 表示当前用户不具备审批该角色申请的角色.
+	ErrAccessRequestNotApprover = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"AccessRequest.NotApprover",
+		"当前用户无权审批该角色的授权申请。",
+	)
+)
@@ -15,6 +15,10 @@ var (
 	CodeUserInvalidUsername     = errorsx.CodeUserInvalidUsername
 	CodeUserInvalidPassword     = errorsx.CodeUserInvalidPassword
 	CodeUserPermissionDenied    = errorsx.CodeUserPermissionDenied
+	CodeConflict                = errorsx.CodeConflict
+	CodeFailedPrecondition      = errorsx.CodeFailedPrecondition
+	CodeAborted                 = errorsx.CodeAborted
+	CodeAlreadyExists           = errorsx.CodeAlreadyExists
 
 	CodePostNotFound         = errorsx.CodePostNotFound
 	CodePostAlreadyPublished = errorsx.CodePostAlreadyPublished
@@ -44,13 +48,16 @@ var (
 	// 预定义错误
 	OK = errorsx.OK
 
-	ErrInternal         = errorsx.ErrInternal
-	ErrNotFound         = errorsx.ErrNotFound
-	ErrBind             = errorsx.ErrBind
-	ErrInvalidArgument  = errorsx.ErrInvalidArgument
-	ErrUnauthenticated  = errorsx.ErrUnauthenticated
-	ErrPermissionDenied = errorsx.ErrPermissionDenied
-	ErrOperationFailed  = errorsx.ErrOperationFailed
+	ErrInternal           = errorsx.ErrInternal
+	ErrNotFound           = errorsx.ErrNotFound
+	ErrBind               = errorsx.ErrBind
+	ErrInvalidArgument    = errorsx.ErrInvalidArgument
+	ErrUnauthenticated    = errorsx.ErrUnauthenticated
+	ErrPermissionDenied   = errorsx.ErrPermissionDenied
+	ErrOperationFailed    = errorsx.ErrOperationFailed
+	ErrAlreadyExists      = errorsx.ErrAlreadyExists
+	ErrFailedPrecondition = errorsx.ErrFailedPrecondition
+	ErrAborted            = errorsx.ErrAborted
 
 	// 数据库错误
 	ErrDBRead    = errorsx.NewBizError(errorsx.CodeDatabaseReadFailed, "Database.ReadFailed", "数据库读取失败。")
@@ -69,6 +76,7 @@ var (
 
 	// 通用业务错误
 	ErrPageNotFound       = errorsx.NewBizError(errorsx.CodeUserNotFound, "NotFound.PageNotFound", "页面未找到。")
+	ErrConflict           = errorsx.NewBizError(errorsx.CodeConflict, "Resource.Conflict", "资源已被其他请求修改，请重新获取后再试。")
 	ErrServiceUnavailable = errorsx.NewBizError(errorsx.CodeServiceUnavailable, "Service.Unavailable", "服务暂时不可用。")
 	ErrTooManyRequests    = errorsx.NewBizError(errorsx.CodeTooManyRequests, "Service.TooManyRequests", "请求过多，请稍后再试。")
 
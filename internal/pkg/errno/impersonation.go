@@ -0,0 +1,21 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrImpersonationNotAllowed 表示当前用户未被显式授予模拟登录权限，或目标用户不允许被模拟.
+	ErrImpersonationNotAllowed = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"Impersonation.NotAllowed",
+		"您没有模拟登录该用户的权限。",
+	)
+
+	// ErrImpersonationForbidden 表示该操作在模拟登录期间被禁止，例如修改密码或再次发起模拟登录.
+	ErrImpersonationForbidden = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"Impersonation.Forbidden",
+		"模拟登录期间不允许执行该操作。",
+	)
+)
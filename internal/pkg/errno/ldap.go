@@ -0,0 +1,28 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrLDAPUnavailable 表示目录服务暂时无法访问或服务账号配置错误.
+	ErrLDAPUnavailable = errorsx.NewBizError(
+		errorsx.CodeServiceUnavailable,
+		"LDAP.Unavailable",
+		"目录服务暂时不可用，请稍后重试。",
+	)
+
+	// ErrLDAPUserNotProvisioned 表示目录用户未关联任何本地用户且不允许自动创建用户.
+	ErrLDAPUserNotProvisioned = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"LDAP.UserNotProvisioned",
+		"该目录账号尚未开通，请联系管理员。",
+	)
+
+	// ErrLDAPUserConflict 表示目录用户的用户名已被未关联该目录账号的本地用户占用.
+	ErrLDAPUserConflict = errorsx.NewBizError(
+		errorsx.CodeUserAlreadyExists,
+		"LDAP.UserConflict",
+		"该用户名已被本地账号占用，请联系管理员关联目录账号。",
+	)
+)
@@ -0,0 +1,56 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrOIDCProviderNotFound 表示请求的 OIDC Provider 未配置.
+	ErrOIDCProviderNotFound = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"OIDC.ProviderNotFound",
+		"单点登录提供方不存在。",
+	)
+
+	// ErrOIDCProviderUnavailable 表示 OIDC Provider 暂时无法访问（Discovery 或 JWKS 获取失败）.
+	ErrOIDCProviderUnavailable = errorsx.NewBizError(
+		errorsx.CodeServiceUnavailable,
+		"OIDC.ProviderUnavailable",
+		"单点登录提供方暂时不可用，请稍后重试。",
+	)
+
+	// ErrOIDCStateInvalid 表示登录流程的 state 不存在、已使用或已过期.
+	ErrOIDCStateInvalid = errorsx.NewBizError(
+		errorsx.CodeAuthTokenInvalid,
+		"OIDC.StateInvalid",
+		"登录流程已失效，请重新发起登录。",
+	)
+
+	// ErrOIDCLoginFailed 表示授权码换取令牌或 ID Token 校验失败.
+	ErrOIDCLoginFailed = errorsx.NewBizError(
+		errorsx.CodeAuthUnauthenticated,
+		"OIDC.LoginFailed",
+		"单点登录失败。",
+	)
+
+	// ErrOIDCUserNotProvisioned 表示外部身份未关联任何用户且 Provider 不允许自动创建用户.
+	ErrOIDCUserNotProvisioned = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"OIDC.UserNotProvisioned",
+		"该企业账号尚未开通，请联系管理员。",
+	)
+
+	// ErrIdentityAlreadyLinked 表示外部身份已关联到其他用户，或用户已关联该 Provider 的其他身份.
+	ErrIdentityAlreadyLinked = errorsx.NewBizError(
+		errorsx.CodeUserAlreadyExists,
+		"Identity.AlreadyLinked",
+		"该外部身份已被关联。",
+	)
+
+	// ErrIdentityNotFound 表示用户未关联指定 Provider 的外部身份.
+	ErrIdentityNotFound = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"Identity.NotFound",
+		"外部身份不存在。",
+	)
+)
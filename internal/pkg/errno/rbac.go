@@ -20,6 +20,9 @@ var (
 	// ErrPermissionHasChildren 权限有子权限
 	ErrPermissionHasChildren = errorsx.NewCompat(400, "Permission.HasChildren", "Permission has children, cannot delete.")
 
+	// ErrPermissionParentDeleted 父权限已被删除，需要先恢复父权限
+	ErrPermissionParentDeleted = errorsx.NewCompat(409, "Permission.ParentDeleted", "Parent permission is deleted, restore it first.")
+
 	// ErrMenuAlreadyExists 菜单已存在
 	ErrMenuAlreadyExists = errorsx.NewCompat(409, "Menu.AlreadyExists", "Menu already exists.")
 
@@ -28,4 +31,7 @@ var (
 
 	// ErrMenuHasChildren 菜单有子菜单
 	ErrMenuHasChildren = errorsx.NewCompat(400, "Menu.HasChildren", "Menu has children, cannot delete.")
+
+	// ErrMenuParentDeleted 父菜单已被删除，需要先恢复父菜单
+	ErrMenuParentDeleted = errorsx.NewCompat(409, "Menu.ParentDeleted", "Parent menu is deleted, restore it first.")
 )
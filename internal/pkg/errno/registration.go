@@ -0,0 +1,49 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrRegistrationClosed 表示系统已关闭自助注册.
+	ErrRegistrationClosed = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"Registration.Closed",
+		"系统已关闭自助注册，请联系管理员创建账号。",
+	)
+
+	// ErrInvitationRequired 表示当前注册模式要求提供邀请码.
+	ErrInvitationRequired = errorsx.NewBizError(
+		errorsx.CodeUserInvalidCredentials,
+		"Registration.InvitationRequired",
+		"当前仅允许持邀请码注册。",
+	)
+
+	// ErrInvitationInvalid 表示邀请码不存在、已撤销、已过期或使用次数已用完.
+	ErrInvitationInvalid = errorsx.NewBizError(
+		errorsx.CodeUserInvalidCredentials,
+		"Invitation.Invalid",
+		"邀请码无效、已过期或已被使用。",
+	)
+
+	// ErrInvitationNotFound 表示邀请码不存在或已被撤销.
+	ErrInvitationNotFound = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"Invitation.NotFound",
+		"邀请码不存在或已被撤销。",
+	)
+
+	// ErrRegistrationNotPending 表示用户不处于待审核状态.
+	ErrRegistrationNotPending = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"Registration.NotPending",
+		"该用户不存在或不处于待审核状态。",
+	)
+
+	// ErrUserPendingApproval 表示用户注册尚未通过审核.
+	ErrUserPendingApproval = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"User.PendingApproval",
+		"账号正在等待管理员审核。",
+	)
+)
@@ -0,0 +1,21 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrSessionNotFound 表示会话不存在或已被撤销.
+	ErrSessionNotFound = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"Session.NotFound",
+		"会话不存在或已被撤销。",
+	)
+
+	// ErrSessionRevoked 表示 token 所属的会话已被撤销或已过期，需要重新登录.
+	ErrSessionRevoked = errorsx.NewBizError(
+		errorsx.CodeAuthTokenInvalid,
+		"Session.Revoked",
+		"会话已失效，请重新登录。",
+	)
+)
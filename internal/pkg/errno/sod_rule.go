@@ -0,0 +1,28 @@
+package errno
+
+import (
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+var (
+	// ErrSoDRuleNotFound 表示职责分离规则不存在.
+	ErrSoDRuleNotFound = errorsx.NewBizError(
+		errorsx.CodeUserNotFound,
+		"SoDRule.NotFound",
+		"职责分离规则不存在。",
+	)
+
+	// ErrSoDRuleAlreadyExists 表示同名的职责分离规则已存在.
+	ErrSoDRuleAlreadyExists = errorsx.NewBizError(
+		errorsx.CodeAlreadyExists,
+		"SoDRule.AlreadyExists",
+		"同名的职责分离规则已存在。",
+	)
+
+	// ErrSoDConflict 表示分配的角色组合违反职责分离规则.
+	ErrSoDConflict = errorsx.NewBizError(
+		errorsx.CodeUserPermissionDenied,
+		"SoD.Conflict",
+		"角色组合违反职责分离规则。",
+	)
+)
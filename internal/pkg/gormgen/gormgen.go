@@ -0,0 +1,163 @@
+// Package gormgen 提供 gen-gorm-model、gen-resource 等代码生成工具共用的数据库连接选项和 gorm.io/gen 生成器配置.
+//
+// 连接参数与 apiserver 使用相同的配置段（database、postgresql、mysql、sqlite），
+// 可以通过 --config 读取 apiserver 的配置文件，也可以通过 APP_ 前缀的环境变量或命令行标志设置，
+// 优先级为：命令行标志 > 环境变量 > 配置文件 > 默认值.
+package gormgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	genericdb "github.com/clin211/gin-enterprise-template/pkg/db"
+	genericoptions "github.com/clin211/gin-enterprise-template/pkg/options"
+)
+
+// envPrefix 是环境变量前缀，与 apiserver 保持一致.
+const envPrefix = "APP"
+
+// Options 包含代码生成工具连接数据库所需的选项.
+type Options struct {
+	// ConfigFile 是 apiserver 配置文件的路径，为空时只使用环境变量和命令行标志
+	ConfigFile string `json:"-" mapstructure:"-"`
+
+	DatabaseOptions   *genericoptions.DatabaseOptions   `json:"database" mapstructure:"database"`
+	PostgreSQLOptions *genericoptions.PostgreSQLOptions `json:"postgresql" mapstructure:"postgresql"`
+	MySQLOptions      *genericoptions.MySQLOptions      `json:"mysql" mapstructure:"mysql"`
+	SQLiteOptions     *genericoptions.SQLiteOptions     `json:"sqlite" mapstructure:"sqlite"`
+
+	// flags 是数据库相关的标志，用于在读取配置后重新应用命令行中显式设置的值
+	flags *pflag.FlagSet
+}
+
+// NewOptions 创建带有默认参数的 Options.
+func NewOptions() *Options {
+	return &Options{
+		DatabaseOptions:   genericoptions.NewDatabaseOptions(),
+		PostgreSQLOptions: genericoptions.NewPostgreSQLOptions(),
+		MySQLOptions:      genericoptions.NewMySQLOptions(),
+		SQLiteOptions:     genericoptions.NewSQLiteOptions(),
+	}
+}
+
+// AddFlags 将配置文件和数据库连接相关的标志添加到指定的 FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ConfigFile, "config", "c", o.ConfigFile, "Path to the apiserver configuration file to read database settings from.")
+
+	o.flags = pflag.NewFlagSet("database", pflag.ContinueOnError)
+	o.DatabaseOptions.AddFlags(o.flags, "database")
+	o.PostgreSQLOptions.AddFlags(o.flags, "postgresql")
+	o.MySQLOptions.AddFlags(o.flags, "mysql")
+	o.SQLiteOptions.AddFlags(o.flags, "sqlite")
+	fs.AddFlagSet(o.flags)
+}
+
+// Complete 在解析命令行标志后读取配置文件和环境变量，命令行中显式设置的标志优先.
+func (o *Options) Complete() error {
+	v := viper.New()
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.AutomaticEnv()
+	if o.ConfigFile != "" {
+		v.SetConfigFile(o.ConfigFile)
+		if err := v.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	if o.flags == nil {
+		return v.Unmarshal(o)
+	}
+	// 绑定标志使未出现在配置文件中的键也能从环境变量读取
+	if err := v.BindPFlags(o.flags); err != nil {
+		return err
+	}
+	if err := v.Unmarshal(o); err != nil {
+		return fmt.Errorf("failed to unmarshal configuration: %w", err)
+	}
+
+	// 部分标志名与配置项不同（例如 mysql.host），重新应用显式设置的标志保证其优先
+	var err error
+	o.flags.VisitAll(func(f *pflag.Flag) {
+		if f.Changed && err == nil {
+			err = f.Value.Set(f.Value.String())
+		}
+	})
+	return err
+}
+
+// Validate 验证所选数据库的连接选项.
+func (o *Options) Validate() error {
+	errs := o.DatabaseOptions.Validate()
+	switch o.DatabaseOptions.Driver {
+	case genericdb.DriverPostgres:
+		errs = append(errs, o.PostgreSQLOptions.Validate()...)
+	case genericdb.DriverMySQL:
+		errs = append(errs, o.MySQLOptions.Validate()...)
+	case genericdb.DriverSQLite:
+		errs = append(errs, o.SQLiteOptions.Validate()...)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid database options: %v", errs)
+	}
+	return nil
+}
+
+// NewDB 根据所选的数据库类型创建数据库连接.
+func (o *Options) NewDB() (*gorm.DB, error) {
+	switch o.DatabaseOptions.Driver {
+	case genericdb.DriverPostgres:
+		return o.PostgreSQLOptions.NewDB()
+	case genericdb.DriverMySQL:
+		return o.MySQLOptions.NewDB()
+	case genericdb.DriverSQLite:
+		return o.SQLiteOptions.NewDB()
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", o.DatabaseOptions.Driver)
+	}
+}
+
+// NewGenerator 创建将模型生成到 modelPkgPath 目录的生成器，并应用本项目的模型约定.
+func NewGenerator(modelPkgPath string, db *gorm.DB) *gen.Generator {
+	g := gen.NewGenerator(gen.Config{
+		Mode:              gen.WithDefaultQuery | gen.WithQueryInterface | gen.WithoutContext,
+		ModelPkgPath:      modelPkgPath,
+		WithUnitTest:      true,
+		FieldNullable:     true,  // 对于数据库中可空的字段，使用指针类型。
+		FieldSignable:     false, // 禁用无符号属性以提高兼容性。
+		FieldWithIndexTag: false, // 不包含 GORM 的索引标签。
+		FieldWithTypeTag:  false, // 不包含 GORM 的类型标签。
+	})
+	g.UseDB(db)
+
+	// 为特定字段自定义 GORM 标签
+	g.WithOpts(
+		// 将下划线连接起来的单词使用小驼峰的形式写入 json tag 中
+		gen.FieldJSONTagWithNS(func(columnName string) (tagContent string) {
+			// 只对包含下划线的字段名进行转换
+			if strings.Contains(columnName, "_") {
+				return lo.CamelCase(columnName)
+			}
+			return columnName
+		}),
+		// 为时间戳字段设置 PostgreSQL 默认值
+		gen.FieldGORMTag("created_at", func(tag field.GormTag) field.GormTag {
+			tag.Set("default", "current_timestamp")
+			return tag
+		}),
+		gen.FieldGORMTag("updated_at", func(tag field.GormTag) field.GormTag {
+			tag.Set("default", "current_timestamp")
+			return tag
+		}),
+		// 软删除字段使用 gorm.DeletedAt，查询时自动过滤已删除记录，Delete 时只标记删除时间
+		gen.FieldType("deleted_at", "gorm.DeletedAt"),
+	)
+	return g
+}
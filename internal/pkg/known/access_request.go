@@ -0,0 +1,13 @@
+package known
+
+// 定义授权申请状态。
+const (
+	// AccessRequestPending 表示申请等待审批。
+	AccessRequestPending = "pending"
+	// AccessRequestApproved 表示申请已批准，角色已授予。
+	AccessRequestApproved = "approved"
+	// AccessRequestRejected 表示申请已被拒绝。
+	AccessRequestRejected = "rejected"
+	// AccessRequestExpired 表示申请在过期前未被审批，已自动失效。
+	AccessRequestExpired = "expired"
+)
@@ -5,4 +5,6 @@ const (
 	RoleUser = "role::user"
 	// 管理员的角色。
 	RoleAdmin = "role::admin"
+	// 超级管理员的角色。授予该角色始终需要审批，未配置审批角色的敏感角色也由该角色审批。
+	RoleSuperAdmin = "role::super_admin"
 )
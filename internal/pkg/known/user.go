@@ -0,0 +1,24 @@
+package known
+
+// 定义用户状态。
+const (
+	// UserStatusActive 表示用户处于活跃状态，可以正常登录和访问。
+	UserStatusActive int16 = 0
+	// UserStatusDisabled 表示用户已被管理员禁用，无法登录且已签发的令牌立即失效。
+	UserStatusDisabled int16 = 1
+	// UserStatusPending 表示用户已注册但尚未通过管理员审核，无法登录。
+	UserStatusPending int16 = 2
+)
+
+// 定义用户性别。
+const (
+	// GenderUnknown 表示未知性别。
+	GenderUnknown int16 = 0
+	// GenderMale 表示男性。
+	GenderMale int16 = 1
+	// GenderFemale 表示女性。
+	GenderFemale int16 = 2
+)
+
+// IdentityProviderLDAP 是 LDAP 认证后端在 user_identity 表中使用的 Provider 名称，OIDC Provider 不能使用该名称。
+const IdentityProviderLDAP = "ldap"
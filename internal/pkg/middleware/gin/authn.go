@@ -2,16 +2,19 @@ package gin
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"log/slog"
 
 	"github.com/clin211/gin-enterprise-template/pkg/core"
+	"github.com/clin211/gin-enterprise-template/pkg/mtls"
 	"github.com/clin211/gin-enterprise-template/pkg/token"
 	"github.com/gin-gonic/gin"
 
 	"github.com/clin211/gin-enterprise-template/internal/apiserver/model"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
 	"github.com/clin211/gin-enterprise-template/internal/pkg/errno"
+	"github.com/clin211/gin-enterprise-template/internal/pkg/known"
 )
 
 // UserRetriever 是用于根据用户名获取用户的接口。
@@ -20,12 +23,49 @@ type UserRetriever interface {
 	GetUser(ctx context.Context, userID string) (*model.UserM, error)
 }
 
+// SessionTracker 是用于校验 token 所属会话是否仍然有效的接口。
+type SessionTracker interface {
+	// Touch 校验会话是否有效并记录会话的最近活跃时间，实现可以缓存校验结果以降低数据库压力
+	Touch(ctx context.Context, userID, sessionID string) error
+	// Validate 不使用缓存，直接校验会话是否有效
+	Validate(ctx context.Context, userID, sessionID string) error
+}
+
+// ClientCertResolver 是用于根据已校验的客户端证书获取服务账号用户的接口。
+type ClientCertResolver interface {
+	// ResolveClientCert 返回客户端证书映射的服务账号用户，证书未映射到任何用户时返回 nil
+	ResolveClientCert(ctx context.Context, cert *x509.Certificate) (*model.UserM, error)
+}
+
 // AuthnMiddleware 是一个认证中间件，用于从 gin.Context 中提取 token 并验证 token 是否合法。
-// 只接受 Access Token（token_type="access"）。
-func AuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
+// 只接受 Access Token（token_type="access"），并要求 token 所属的会话未被撤销。
+// 管理员模拟登录签发的 token 会额外在 context 中记录实际操作者（contextx.ActorID）。
+// 请求未携带 token 但提供了映射到服务账号的客户端证书（mTLS）时，以该服务账号的身份认证。
+// token.WithSkipPaths 等选项配置的路径不进行认证。
+func AuthnMiddleware(retriever UserRetriever, tracker SessionTracker, resolver ClientCertResolver) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if token.SkipRequest(c) {
+			c.Next()
+			return
+		}
+
+		if c.GetHeader("Authorization") == "" {
+			if cert := mtls.PeerCertificate(c.Request.TLS); cert != nil && resolver != nil {
+				user, err := resolver.ResolveClientCert(c, cert)
+				if err != nil {
+					core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage(err.Error()))
+					c.Abort()
+					return
+				}
+				if user != nil {
+					authenticateServiceAccount(c, user)
+					return
+				}
+			}
+		}
+
 		// 解析 JWT Token
-		userID, err := token.ParseRequest(c)
+		userID, claims, err := token.ParseRequestClaims(c)
 		if err != nil {
 			core.WriteResponse(c, nil, errno.ErrTokenInvalid.WithMessage(err.Error()))
 			c.Abort()
@@ -34,6 +74,13 @@ func AuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
 
 		slog.Info("Token parsing successful", "userID", userID)
 
+		sessionID := token.ClaimString(claims, token.ClaimSessionID)
+		if err := tracker.Touch(c, userID, sessionID); err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
+			return
+		}
+
 		user, err := retriever.GetUser(c, userID)
 		if err != nil {
 			core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage(err.Error()))
@@ -41,17 +88,61 @@ func AuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
 			return
 		}
 
+		// 被禁用的用户即使持有未过期的令牌也不允许访问
+		if user.Status == known.UserStatusDisabled {
+			core.WriteResponse(c, nil, errno.ErrUserDisabled)
+			c.Abort()
+			return
+		}
+
 		ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
 		ctx = contextx.WithUsername(ctx, user.Username)
+		ctx = contextx.WithSessionID(ctx, sessionID)
+
+		// 模拟登录的 token 通过 act 声明携带实际操作者，操作者被禁用后模拟令牌立即失效
+		if actorID := token.Actor(claims); actorID != "" {
+			actor, err := retriever.GetUser(c, actorID)
+			if err != nil {
+				core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage(err.Error()))
+				c.Abort()
+				return
+			}
+			if actor.Status != known.UserStatusActive {
+				core.WriteResponse(c, nil, errno.ErrUserDisabled)
+				c.Abort()
+				return
+			}
+
+			ctx = contextx.WithActorID(ctx, actor.UserID)
+			slog.InfoContext(ctx, "Impersonated request", "method", c.Request.Method, "path", c.Request.URL.Path)
+		}
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
 	}
 }
 
+// authenticateServiceAccount 以客户端证书映射的服务账号身份继续处理请求，服务账号请求不属于任何会话。
+func authenticateServiceAccount(c *gin.Context, user *model.UserM) {
+	// 被禁用的服务账号不允许访问
+	if user.Status == known.UserStatusDisabled {
+		core.WriteResponse(c, nil, errno.ErrUserDisabled)
+		c.Abort()
+		return
+	}
+
+	slog.Info("Client certificate authentication successful", "userID", user.UserID)
+
+	ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
+	ctx = contextx.WithUsername(ctx, user.Username)
+	c.Request = c.Request.WithContext(ctx)
+
+	c.Next()
+}
+
 // RefreshAuthnMiddleware 是一个专门用于刷新令牌的认证中间件。
-// 只接受 Refresh Token（token_type="refresh"）。
-func RefreshAuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
+// 只接受 Refresh Token（token_type="refresh"），并要求 token 所属的会话未被撤销。
+func RefreshAuthnMiddleware(retriever UserRetriever, tracker SessionTracker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 从 Authorization header 获取 token
 		header := c.Request.Header.Get("Authorization")
@@ -71,7 +162,7 @@ func RefreshAuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
 		}
 
 		// 验证是 refresh token 并解析 userID
-		userID, err := token.ParseRefreshToken(tokenString)
+		userID, claims, err := token.ParseRefreshTokenClaims(tokenString)
 		if err != nil {
 			slog.ErrorContext(c, "Failed to parse refresh token", "error", err)
 			core.WriteResponse(c, nil, errno.ErrTokenInvalid.WithMessage(err.Error()))
@@ -81,6 +172,14 @@ func RefreshAuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
 
 		slog.Info("Refresh token parsing successful", "userID", userID)
 
+		// 刷新令牌时直接查库校验会话，保证被撤销的会话无法续期
+		sessionID := token.ClaimString(claims, token.ClaimSessionID)
+		if err := tracker.Validate(c, userID, sessionID); err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
+			return
+		}
+
 		user, err := retriever.GetUser(c, userID)
 		if err != nil {
 			core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage(err.Error()))
@@ -88,8 +187,16 @@ func RefreshAuthnMiddleware(retriever UserRetriever) gin.HandlerFunc {
 			return
 		}
 
+		// 被禁用的用户即使持有未过期的令牌也不允许访问
+		if user.Status == known.UserStatusDisabled {
+			core.WriteResponse(c, nil, errno.ErrUserDisabled)
+			c.Abort()
+			return
+		}
+
 		ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
 		ctx = contextx.WithUsername(ctx, user.Username)
+		ctx = contextx.WithSessionID(ctx, sessionID)
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
@@ -3,7 +3,10 @@ package gin
 import (
 	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
 
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
 	"github.com/clin211/gin-enterprise-template/pkg/core"
 	"github.com/gin-gonic/gin"
 
@@ -13,7 +16,7 @@ import (
 
 // Authorizer 用于定义授权接口的实现。
 type Authorizer interface {
-	Authorize(subject, object, action string) (bool, error)
+	AuthorizeRequest(subject, object, action string, rc *authz.RequestContext) (bool, error)
 }
 
 // AuthzMiddleware 是一个 Gin 中间件，用于进行请求授权。
@@ -27,7 +30,7 @@ func AuthzMiddleware(authorizer Authorizer) gin.HandlerFunc {
 		slog.Info("Build authorize context", "subject", subject, "object", object, "action", action)
 
 		// 调用授权接口进行验证
-		if allowed, err := authorizer.Authorize(subject, object, action); err != nil || !allowed {
+		if allowed, err := authorizer.AuthorizeRequest(subject, object, action, requestContext(c)); err != nil || !allowed {
 			core.WriteResponse(c, nil, errno.ErrPermissionDenied.WithMessage(
 				fmt.Sprintf("access denied: subject=%s, object=%s, action=%s, reason=%v",
 					subject,
@@ -42,3 +45,31 @@ func AuthzMiddleware(authorizer Authorizer) gin.HandlerFunc {
 		c.Next() // 继续处理请求
 	}
 }
+
+// requestContext 构建用于评估策略条件的请求上下文.
+// 请求属性只取自服务端可信的来源（认证结果、会话和路由），不读取客户端可以任意设置的请求头：
+//   - user.id、user.name：当前用户
+//   - session.id：当前会话，客户端证书认证的服务账号没有会话
+//   - impersonating、actor.id：是否处于模拟登录及实际操作者
+//   - route：匹配的路由模板，例如 /v1/users/:userID
+//   - param.<name>：路由参数
+func requestContext(c *gin.Context) *authz.RequestContext {
+	ctx := c.Request.Context()
+	attrs := map[string]string{
+		"user.id":       contextx.UserID(ctx),
+		"user.name":     contextx.Username(ctx),
+		"session.id":    contextx.SessionID(ctx),
+		"impersonating": strconv.FormatBool(contextx.Impersonating(ctx)),
+		"actor.id":      contextx.ActorID(ctx),
+		"route":         c.FullPath(),
+	}
+	for _, param := range c.Params {
+		attrs["param."+param.Key] = param.Value
+	}
+
+	return &authz.RequestContext{
+		IP:         c.ClientIP(),
+		Time:       time.Now(),
+		Attributes: attrs,
+	}
+}
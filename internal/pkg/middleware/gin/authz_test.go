@@ -0,0 +1,61 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+
+	"github.com/clin211/gin-enterprise-template/internal/pkg/contextx"
+)
+
+func TestRequestContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(trustedProxies []string) *authz.RequestContext {
+		engine := gin.New()
+		require.NoError(t, engine.SetTrustedProxies(trustedProxies))
+
+		var rc *authz.RequestContext
+		engine.GET("/v1/users/:userID", func(c *gin.Context) {
+			ctx := contextx.WithUserID(c.Request.Context(), "user-1")
+			c.Request = c.Request.WithContext(contextx.WithSessionID(ctx, "session-1"))
+			rc = requestContext(c)
+		})
+
+		rq := httptest.NewRequest(http.MethodGet, "/v1/users/user-2", nil)
+		rq.RemoteAddr = "10.0.0.1:34567"
+		rq.Header.Set("X-Forwarded-For", "192.168.1.1")
+		rq.Header.Set("X-Client-IP", "192.168.1.2")
+		rq.Header.Set("User.ID", "admin")
+		engine.ServeHTTP(httptest.NewRecorder(), rq)
+		return rc
+	}
+
+	// 未配置受信任代理时忽略转发请求头
+	rc := serve(nil)
+	require.NotNil(t, rc)
+	assert.Equal(t, "10.0.0.1", rc.IP)
+
+	// 请求属性不受客户端请求头影响
+	userID, _ := rc.Attribute("user.id")
+	assert.Equal(t, "user-1", userID)
+	sessionID, _ := rc.Attribute("session.id")
+	assert.Equal(t, "session-1", sessionID)
+	impersonating, _ := rc.Attribute("impersonating")
+	assert.Equal(t, "false", impersonating)
+	route, _ := rc.Attribute("route")
+	assert.Equal(t, "/v1/users/:userID", route)
+	param, _ := rc.Attribute("param.userID")
+	assert.Equal(t, "user-2", param)
+	_, ok := rc.Attribute("x-forwarded-for")
+	assert.False(t, ok)
+
+	// 请求来自受信任代理时使用 X-Forwarded-For
+	assert.Equal(t, "192.168.1.1", serve([]string{"10.0.0.0/8"}).IP)
+}
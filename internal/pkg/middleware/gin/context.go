@@ -19,6 +19,10 @@ func Context() gin.HandlerFunc {
 
 		// 将 traceID 存储到新的 context 中，并更新请求的 context
 		ctx := contextx.WithTraceID(c.Request.Context(), traceID)
+		// 记录客户端 IP 和 User-Agent，供会话管理、审计等业务逻辑使用.
+		// 客户端 IP 只采信受信任代理设置的转发请求头
+		ctx = contextx.WithClientIP(ctx, c.ClientIP())
+		ctx = contextx.WithUserAgent(ctx, c.Request.UserAgent())
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
@@ -0,0 +1,48 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+)
+
+func (x *AccessRequest) Default() {
+}
+
+func (x *CreateAccessRequestRequest) Default() {
+}
+
+func (x *CreateAccessRequestResponse) Default() {
+}
+
+func (x *ListAccessRequestsRequest) Default() {
+}
+
+func (x *ListAccessRequestsResponse) Default() {
+}
+
+func (x *GetAccessRequestRequest) Default() {
+}
+
+func (x *GetAccessRequestResponse) Default() {
+}
+
+func (x *ApproveAccessRequestRequest) Default() {
+}
+
+func (x *ApproveAccessRequestResponse) Default() {
+}
+
+func (x *RejectAccessRequestRequest) Default() {
+}
+
+func (x *RejectAccessRequestResponse) Default() {
+}
@@ -0,0 +1,51 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+)
+
+func (x *AdminUpdateUserRequest) Default() {
+}
+
+func (x *AdminUpdateUserResponse) Default() {
+}
+
+func (x *UpdateUserStatusRequest) Default() {
+}
+
+func (x *UpdateUserStatusResponse) Default() {
+}
+
+func (x *ResetUserPasswordRequest) Default() {
+}
+
+func (x *ResetUserPasswordResponse) Default() {
+}
+
+func (x *ImpersonateUserRequest) Default() {
+}
+
+func (x *ImpersonateUserResponse) Default() {
+}
+
+func (x *ListDeletedUsersRequest) Default() {
+}
+
+func (x *ListDeletedUsersResponse) Default() {
+}
+
+func (x *RestoreUserRequest) Default() {
+}
+
+func (x *RestoreUserResponse) Default() {
+}
@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.30.0
+// source: apiserver/v1/admin_user.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdminUpdateUserRequest 表示管理员更新用户请求，可以修改用户的全部资料字段
+type AdminUpdateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// username 表示可选的用户名称
+	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// nickname 表示可选的用户昵称
+	Nickname *string `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	// email 表示可选的用户电子邮箱
+	Email *string `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	// phone 表示可选的用户手机号
+	Phone *string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// avatar 表示可选的用户头像 URL
+	Avatar *string `protobuf:"bytes,6,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	// gender 表示可选的用户性别（0=未知,1=男,2=女）
+	Gender *int32 `protobuf:"varint,7,opt,name=gender,proto3,oneof" json:"gender,omitempty"`
+	// status 表示可选的用户状态（0=活跃,1=禁用）
+	Status *int32 `protobuf:"varint,8,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// description 表示可选的用户描述/简介
+	Description *string `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// version 表示可选的用户版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version *int64 `protobuf:"varint,10,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示需要修改的字段名列表（如 nickname、status），为空时修改所有已设置的字段；
+	// 设置后只修改列出的字段，列出但未传值的字段会被清空
+	UpdateMask    []string `protobuf:"bytes,11,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateUserRequest) Reset() {
+	*x = AdminUpdateUserRequest{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateUserRequest) ProtoMessage() {}
+
+func (x *AdminUpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminUpdateUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetGender() int32 {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
+	}
+	return 0
+}
+
+func (x *AdminUpdateUserRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *AdminUpdateUserRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *AdminUpdateUserRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// AdminUpdateUserResponse 表示管理员更新用户响应
+type AdminUpdateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的用户版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateUserResponse) Reset() {
+	*x = AdminUpdateUserResponse{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateUserResponse) ProtoMessage() {}
+
+func (x *AdminUpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminUpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminUpdateUserResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// UpdateUserStatusRequest 表示启用/禁用用户请求
+type UpdateUserStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// status 表示用户状态（0=活跃,1=禁用），禁用用户会同时终止其全部会话
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// version 表示可选的用户版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version       *int64 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserStatusRequest) Reset() {
+	*x = UpdateUserStatusRequest{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserStatusRequest) ProtoMessage() {}
+
+func (x *UpdateUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateUserStatusRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdateUserStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateUserStatusRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// UpdateUserStatusResponse 表示启用/禁用用户响应
+type UpdateUserStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的用户版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserStatusResponse) Reset() {
+	*x = UpdateUserStatusResponse{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserStatusResponse) ProtoMessage() {}
+
+func (x *UpdateUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateUserStatusResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ResetUserPasswordRequest 表示管理员重置用户密码请求
+type ResetUserPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// newPassword 表示新密码
+	NewPassword   string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserPasswordRequest) Reset() {
+	*x = ResetUserPasswordRequest{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserPasswordRequest) ProtoMessage() {}
+
+func (x *ResetUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResetUserPasswordRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ResetUserPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// ResetUserPasswordResponse 表示管理员重置用户密码响应
+type ResetUserPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserPasswordResponse) Reset() {
+	*x = ResetUserPasswordResponse{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserPasswordResponse) ProtoMessage() {}
+
+func (x *ResetUserPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetUserPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{5}
+}
+
+// ImpersonateUserRequest 表示管理员模拟登录用户请求
+type ImpersonateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示被模拟的用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// reason 表示模拟登录的原因，会写入审计日志
+	Reason        *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateUserRequest) Reset() {
+	*x = ImpersonateUserRequest{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserRequest) ProtoMessage() {}
+
+func (x *ImpersonateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImpersonateUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ImpersonateUserRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// ImpersonateUserResponse 表示管理员模拟登录用户响应
+type ImpersonateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accessToken 表示以被模拟用户身份签发的短期访问令牌，携带 act 声明且不可刷新
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// expireAt 表示访问令牌的过期时间
+	ExpireAt string `protobuf:"bytes,2,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	// userID 表示被模拟的用户 ID
+	UserID string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// actorID 表示发起模拟的管理员用户 ID
+	ActorID       string `protobuf:"bytes,4,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateUserResponse) Reset() {
+	*x = ImpersonateUserResponse{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserResponse) ProtoMessage() {}
+
+func (x *ImpersonateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImpersonateUserResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImpersonateUserResponse) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return ""
+}
+
+func (x *ImpersonateUserResponse) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ImpersonateUserResponse) GetActorID() string {
+	if x != nil {
+		return x.ActorID
+	}
+	return ""
+}
+
+// ListDeletedUsersRequest 表示回收站用户列表请求
+type ListDeletedUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedUsersRequest) Reset() {
+	*x = ListDeletedUsersRequest{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedUsersRequest) ProtoMessage() {}
+
+func (x *ListDeletedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDeletedUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeletedUsersRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListDeletedUsersResponse 表示回收站用户列表响应
+type ListDeletedUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示回收站中的用户总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// users 表示已删除的用户列表
+	Users []*User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	// pageToken 表示下一页游标
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedUsersResponse) Reset() {
+	*x = ListDeletedUsersResponse{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedUsersResponse) ProtoMessage() {}
+
+func (x *ListDeletedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDeletedUsersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDeletedUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListDeletedUsersResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// RestoreUserRequest 表示从回收站恢复用户请求
+type RestoreUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// RestoreUserResponse 表示从回收站恢复用户响应
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_admin_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_admin_user_proto_rawDescGZIP(), []int{11}
+}
+
+var File_apiserver_v1_admin_user_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_admin_user_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapiserver/v1/admin_user.proto\x12\fapiserver.v1\x1a\x17apiserver/v1/user.proto\"\xd0\x03\n" +
+	"\x16AdminUpdateUserRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x04 \x01(\tH\x02R\x05email\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x05 \x01(\tH\x03R\x05phone\x88\x01\x01\x12\x1b\n" +
+	"\x06avatar\x18\x06 \x01(\tH\x04R\x06avatar\x88\x01\x01\x12\x1b\n" +
+	"\x06gender\x18\a \x01(\x05H\x05R\x06gender\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\b \x01(\x05H\x06R\x06status\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\t \x01(\tH\aR\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03H\bR\aversion\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\v \x03(\tR\n" +
+	"updateMaskB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_nicknameB\b\n" +
+	"\x06_emailB\b\n" +
+	"\x06_phoneB\t\n" +
+	"\a_avatarB\t\n" +
+	"\a_genderB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_version\"3\n" +
+	"\x17AdminUpdateUserResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"t\n" +
+	"\x17UpdateUserStatusRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"4\n" +
+	"\x18UpdateUserStatusResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"T\n" +
+	"\x18ResetUserPasswordRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\"\x1b\n" +
+	"\x19ResetUserPasswordResponse\"X\n" +
+	"\x16ImpersonateUserRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\x89\x01\n" +
+	"\x17ImpersonateUserResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x1a\n" +
+	"\bexpireAt\x18\x02 \x01(\tR\bexpireAt\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\tR\x06userID\x12\x18\n" +
+	"\aactorID\x18\x04 \x01(\tR\aactorID\"S\n" +
+	"\x17ListDeletedUsersRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x82\x01\n" +
+	"\x18ListDeletedUsersResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12(\n" +
+	"\x05users\x18\x02 \x03(\v2\x12.apiserver.v1.UserR\x05users\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\",\n" +
+	"\x12RestoreUserRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x15\n" +
+	"\x13RestoreUserResponseBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+
+var (
+	file_apiserver_v1_admin_user_proto_rawDescOnce sync.Once
+	file_apiserver_v1_admin_user_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_admin_user_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_admin_user_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_admin_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_admin_user_proto_rawDesc), len(file_apiserver_v1_admin_user_proto_rawDesc)))
+	})
+	return file_apiserver_v1_admin_user_proto_rawDescData
+}
+
+var file_apiserver_v1_admin_user_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_apiserver_v1_admin_user_proto_goTypes = []any{
+	(*AdminUpdateUserRequest)(nil),    // 0: apiserver.v1.AdminUpdateUserRequest
+	(*AdminUpdateUserResponse)(nil),   // 1: apiserver.v1.AdminUpdateUserResponse
+	(*UpdateUserStatusRequest)(nil),   // 2: apiserver.v1.UpdateUserStatusRequest
+	(*UpdateUserStatusResponse)(nil),  // 3: apiserver.v1.UpdateUserStatusResponse
+	(*ResetUserPasswordRequest)(nil),  // 4: apiserver.v1.ResetUserPasswordRequest
+	(*ResetUserPasswordResponse)(nil), // 5: apiserver.v1.ResetUserPasswordResponse
+	(*ImpersonateUserRequest)(nil),    // 6: apiserver.v1.ImpersonateUserRequest
+	(*ImpersonateUserResponse)(nil),   // 7: apiserver.v1.ImpersonateUserResponse
+	(*ListDeletedUsersRequest)(nil),   // 8: apiserver.v1.ListDeletedUsersRequest
+	(*ListDeletedUsersResponse)(nil),  // 9: apiserver.v1.ListDeletedUsersResponse
+	(*RestoreUserRequest)(nil),        // 10: apiserver.v1.RestoreUserRequest
+	(*RestoreUserResponse)(nil),       // 11: apiserver.v1.RestoreUserResponse
+	(*User)(nil),                      // 12: apiserver.v1.User
+}
+var file_apiserver_v1_admin_user_proto_depIdxs = []int32{
+	12, // 0: apiserver.v1.ListDeletedUsersResponse.users:type_name -> apiserver.v1.User
+	1,  // [1:1] is the sub-list for method output_type
+	1,  // [1:1] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_admin_user_proto_init() }
+func file_apiserver_v1_admin_user_proto_init() {
+	if File_apiserver_v1_admin_user_proto != nil {
+		return
+	}
+	file_apiserver_v1_user_proto_init()
+	file_apiserver_v1_admin_user_proto_msgTypes[0].OneofWrappers = []any{}
+	file_apiserver_v1_admin_user_proto_msgTypes[2].OneofWrappers = []any{}
+	file_apiserver_v1_admin_user_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_admin_user_proto_rawDesc), len(file_apiserver_v1_admin_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_admin_user_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_admin_user_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_admin_user_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_admin_user_proto = out.File
+	file_apiserver_v1_admin_user_proto_goTypes = nil
+	file_apiserver_v1_admin_user_proto_depIdxs = nil
+}
@@ -27,7 +27,7 @@ var File_apiserver_v1_apiserver_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"\n" +
-	"\x1capiserver/v1/apiserver.proto\x12\fapiserver.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aapiserver/v1/healthz.proto\x1a\x17apiserver/v1/user.proto\x1a\x17apiserver/v1/menu.proto\x1a\x1dapiserver/v1/permission.proto\x1a\x17apiserver/v1/role.proto\x1a\x1capiserver/v1/user_role.proto2\xf5+\n" +
+	"\x1capiserver/v1/apiserver.proto\x12\fapiserver.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aapiserver/v1/healthz.proto\x1a\x17apiserver/v1/user.proto\x1a\x17apiserver/v1/menu.proto\x1a\x1dapiserver/v1/permission.proto\x1a\x17apiserver/v1/role.proto\x1a\x1capiserver/v1/user_role.proto\x1a\x1aapiserver/v1/session.proto\x1a\x1dapiserver/v1/admin_user.proto\x1a\x1dapiserver/v1/invitation.proto\x1a\x17apiserver/v1/oidc.proto\x1a!apiserver/v1/access_request.proto\x1a\x1bapiserver/v1/sod_rule.proto2\x94\x7f\n" +
 	"\vBlogService\x12\x91\x01\n" +
 	"\aHealthz\x12\x16.google.protobuf.Empty\x1a\x1d.apiserver.v1.HealthzResponse\"O\x92A<\n" +
 	"\f服务治理\x12\f健康检查\x1a\x1e检查服务是否健康运行\x82\xd3\xe4\x93\x02\n" +
@@ -61,7 +61,11 @@ const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"DeleteMenu\x12\x1f.apiserver.v1.DeleteMenuRequest\x1a .apiserver.v1.DeleteMenuResponse\"W\x92A:\n" +
 	"\f菜单管理\x12\f删除菜单\x1a\x1c根据菜单 ID 删除菜单\x82\xd3\xe4\x93\x02\x14*\x12/v1/menus/{menuID}\x12\x90\x01\n" +
 	"\tListMenus\x12\x1d.apiserver.v1.ListMenuRequest\x1a\x1e.apiserver.v1.ListMenuResponse\"D\x92A0\n" +
-	"\f菜单管理\x12\f列表菜单\x1a\x12获取菜单列表\x82\xd3\xe4\x93\x02\v\x12\t/v1/menus\x12\xa6\x01\n" +
+	"\f菜单管理\x12\f列表菜单\x1a\x12获取菜单列表\x82\xd3\xe4\x93\x02\v\x12\t/v1/menus\x12\xe0\x01\n" +
+	"\x10ListDeletedMenus\x12%.apiserver.v1.ListDeletedMenusRequest\x1a&.apiserver.v1.ListDeletedMenusResponse\"}\x92Ac\n" +
+	"\f菜单管理\x12\x1b获取回收站菜单列表\x1a6获取已删除但尚未被彻底清除的菜单列表\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/menus/trash\x12\xe3\x01\n" +
+	"\vRestoreMenu\x12 .apiserver.v1.RestoreMenuRequest\x1a!.apiserver.v1.RestoreMenuResponse\"\x8e\x01\x92Af\n" +
+	"\f菜单管理\x12\f恢复菜单\x1aH从回收站恢复菜单，父菜单已删除时需要先恢复父菜单\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/menus/{menuID}/restore\x12\xa6\x01\n" +
 	"\fListMenuTree\x12!.apiserver.v1.ListMenuTreeRequest\x1a\".apiserver.v1.ListMenuTreeResponse\"O\x92A6\n" +
 	"\f菜单管理\x12\x0f列表菜单树\x1a\x15获取菜单树结构\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/menus/tree\x12\xc3\x01\n" +
 	"\x0fGetUserMenuTree\x12$.apiserver.v1.GetUserMenuTreeRequest\x1a%.apiserver.v1.GetUserMenuTreeResponse\"c\x92AE\n" +
@@ -75,9 +79,15 @@ const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"\x10DeletePermission\x12%.apiserver.v1.DeletePermissionRequest\x1a&.apiserver.v1.DeletePermissionResponse\"c\x92A:\n" +
 	"\f权限管理\x12\f删除权限\x1a\x1c根据权限 ID 删除权限\x82\xd3\xe4\x93\x02 *\x1e/v1/permissions/{permissionID}\x12\xa8\x01\n" +
 	"\x0fListPermissions\x12#.apiserver.v1.ListPermissionRequest\x1a$.apiserver.v1.ListPermissionResponse\"J\x92A0\n" +
-	"\f权限管理\x12\f列表权限\x1a\x12获取权限列表\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/permissions\x12\xbe\x01\n" +
+	"\f权限管理\x12\f列表权限\x1a\x12获取权限列表\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/permissions\x12\xf9\x01\n" +
+	"\x16ListDeletedPermissions\x12+.apiserver.v1.ListDeletedPermissionsRequest\x1a,.apiserver.v1.ListDeletedPermissionsResponse\"\x83\x01\x92Ac\n" +
+	"\f权限管理\x12\x1b获取回收站权限列表\x1a6获取已删除但尚未被彻底清除的权限列表\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/permissions/trash\x12\x92\x02\n" +
+	"\x11RestorePermission\x12&.apiserver.v1.RestorePermissionRequest\x1a'.apiserver.v1.RestorePermissionResponse\"\xab\x01\x92Aw\n" +
+	"\f权限管理\x12\f恢复权限\x1aY从回收站恢复权限，并重新同步持有该权限的角色在 Casbin 中的策略\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/permissions/{permissionID}/restore\x12\xbe\x01\n" +
 	"\x12ListPermissionTree\x12'.apiserver.v1.ListPermissionTreeRequest\x1a(.apiserver.v1.ListPermissionTreeResponse\"U\x92A6\n" +
-	"\f权限管理\x12\x0f列表权限树\x1a\x15获取权限树结构\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/permissions/tree\x12\x9e\x01\n" +
+	"\f权限管理\x12\x0f列表权限树\x1a\x15获取权限树结构\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/permissions/tree\x12\x87\x02\n" +
+	"\rGetAPICatalog\x12\".apiserver.v1.GetAPICatalogRequest\x1a#.apiserver.v1.GetAPICatalogResponse\"\xac\x01\x92A\x85\x01\n" +
+	"\f权限管理\x12\x17获取 API 路由目录\x1a\\获取服务启动时自动发现的 API 路由，按模块分组，供配置权限时选择\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/permissions/api-catalog\x12\x9e\x01\n" +
 	"\n" +
 	"CreateRole\x12\x1f.apiserver.v1.CreateRoleRequest\x1a .apiserver.v1.CreateRoleResponse\"M\x92A6\n" +
 	"\f角色管理\x12\f创建角色\x1a\x18创建一个新的角色\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/roles\x12\xa5\x01\n" +
@@ -90,7 +100,11 @@ const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"DeleteRole\x12\x1f.apiserver.v1.DeleteRoleRequest\x1a .apiserver.v1.DeleteRoleResponse\"W\x92A:\n" +
 	"\f角色管理\x12\f删除角色\x1a\x1c根据角色 ID 删除角色\x82\xd3\xe4\x93\x02\x14*\x12/v1/roles/{roleID}\x12\x90\x01\n" +
 	"\tListRoles\x12\x1d.apiserver.v1.ListRoleRequest\x1a\x1e.apiserver.v1.ListRoleResponse\"D\x92A0\n" +
-	"\f角色管理\x12\f列表角色\x1a\x12获取角色列表\x82\xd3\xe4\x93\x02\v\x12\t/v1/roles\x12\xe9\x01\n" +
+	"\f角色管理\x12\f列表角色\x1a\x12获取角色列表\x82\xd3\xe4\x93\x02\v\x12\t/v1/roles\x12\xe0\x01\n" +
+	"\x10ListDeletedRoles\x12%.apiserver.v1.ListDeletedRolesRequest\x1a&.apiserver.v1.ListDeletedRolesResponse\"}\x92Ac\n" +
+	"\f角色管理\x12\x1b获取回收站角色列表\x1a6获取已删除但尚未被彻底清除的角色列表\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/roles/trash\x12\xf2\x01\n" +
+	"\vRestoreRole\x12 .apiserver.v1.RestoreRoleRequest\x1a!.apiserver.v1.RestoreRoleResponse\"\x9d\x01\x92Au\n" +
+	"\f角色管理\x12\f恢复角色\x1aW从回收站恢复角色，并重新同步该角色的权限策略和用户角色关系\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/roles/{roleID}/restore\x12\xe9\x01\n" +
 	"\x17AssignPermissionsToRole\x12,.apiserver.v1.AssignPermissionsToRoleRequest\x1a-.apiserver.v1.AssignPermissionsToRoleResponse\"q\x92AE\n" +
 	"\f角色管理\x12\x15给角色分配权限\x1a\x1e为角色分配或更新权限\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/roles/{roleID}/permissions\x12\xd1\x01\n" +
 	"\x12GetRolePermissions\x12'.apiserver.v1.GetRolePermissionsRequest\x1a(.apiserver.v1.GetRolePermissionsResponse\"h\x92A?\n" +
@@ -100,7 +114,79 @@ const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"\fGetUserRoles\x12!.apiserver.v1.GetUserRolesRequest\x1a\".apiserver.v1.GetUserRolesResponse\"k\x92AH\n" +
 	"\f用户管理\x12\x12获取用户角色\x1a$获取用户的角色列表和权限\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/users/{userID}/roles\x12\xd7\x01\n" +
 	"\x12RemoveRoleFromUser\x12'.apiserver.v1.RemoveRoleFromUserRequest\x1a(.apiserver.v1.RemoveRoleFromUserResponse\"n\x92AB\n" +
-	"\f用户管理\x12\x15从用户移除角色\x1a\x1b从用户移除指定角色\x82\xd3\xe4\x93\x02#*!/v1/users/{userID}/roles/{roleID}B\x8a\x05\x92A\xc5\x04\x12\x9a\x04\n" +
+	"\f用户管理\x12\x15从用户移除角色\x1a\x1b从用户移除指定角色\x82\xd3\xe4\x93\x02#*!/v1/users/{userID}/roles/{roleID}\x12\xf7\x01\n" +
+	"\x13CreateAccessRequest\x12(.apiserver.v1.CreateAccessRequestRequest\x1a).apiserver.v1.CreateAccessRequestResponse\"\x8a\x01\x92Ai\n" +
+	"\f授权申请\x12\x12创建授权申请\x1aE申请为用户授予敏感角色，审批通过后角色才会生效\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/access-requests\x12\xeb\x01\n" +
+	"\x12ListAccessRequests\x12'.apiserver.v1.ListAccessRequestsRequest\x1a(.apiserver.v1.ListAccessRequestsResponse\"\x81\x01\x92Ac\n" +
+	"\f授权申请\x12\x18获取授权申请列表\x1a9分页获取授权申请，支持按状态和用户过滤\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/access-requests\x12\xde\x01\n" +
+	"\x10GetAccessRequest\x12%.apiserver.v1.GetAccessRequestRequest\x1a&.apiserver.v1.GetAccessRequestResponse\"{\x92AQ\n" +
+	"\f授权申请\x12\x18获取授权申请详情\x1a'获取指定授权申请的详细信息\x82\xd3\xe4\x93\x02!\x12\x1f/v1/access-requests/{requestID}\x12\xad\x02\n" +
+	"\x14ApproveAccessRequest\x12).apiserver.v1.ApproveAccessRequestRequest\x1a*.apiserver.v1.ApproveAccessRequestResponse\"\xbd\x01\x92A\x87\x01\n" +
+	"\f授权申请\x12\x12批准授权申请\x1ac批准待审批的授权申请并授予角色，申请人和被授予人不能审批自己的申请\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/access-requests/{requestID}/approve\x12\xe2\x01\n" +
+	"\x13RejectAccessRequest\x12(.apiserver.v1.RejectAccessRequestRequest\x1a).apiserver.v1.RejectAccessRequestResponse\"v\x92AB\n" +
+	"\f授权申请\x12\x12拒绝授权申请\x1a\x1e拒绝待审批的授权申请\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/access-requests/{requestID}/reject\x12\xeb\x01\n" +
+	"\rCreateSoDRule\x12\".apiserver.v1.CreateSoDRuleRequest\x1a#.apiserver.v1.CreateSoDRuleResponse\"\x90\x01\x92Au\n" +
+	"\f职责分离\x12\x18创建职责分离规则\x1aK创建静态职责分离规则，限制同一用户同时持有互斥角色\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/sod-rules\x12\xbd\x01\n" +
+	"\fListSoDRules\x12!.apiserver.v1.ListSoDRulesRequest\x1a\".apiserver.v1.ListSoDRulesResponse\"f\x92AN\n" +
+	"\f职责分离\x12\x1e获取职责分离规则列表\x1a\x1e分页获取职责分离规则\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/sod-rules\x12\x84\x02\n" +
+	"\rUpdateSoDRule\x12\".apiserver.v1.UpdateSoDRuleRequest\x1a#.apiserver.v1.UpdateSoDRuleResponse\"\xa9\x01\x92A\x84\x01\n" +
+	"\f职责分离\x12\x18更新职责分离规则\x1aZ更新职责分离规则，已有的违规分配不受影响，可通过违规报告查看\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/v1/sod-rules/{ruleID}\x12\xbd\x01\n" +
+	"\rDeleteSoDRule\x12\".apiserver.v1.DeleteSoDRuleRequest\x1a#.apiserver.v1.DeleteSoDRuleResponse\"c\x92AB\n" +
+	"\f职责分离\x12\x18删除职责分离规则\x1a\x18删除职责分离规则\x82\xd3\xe4\x93\x02\x18*\x16/v1/sod-rules/{ruleID}\x12\xf6\x01\n" +
+	"\x11ListSoDViolations\x12&.apiserver.v1.ListSoDViolationsRequest\x1a'.apiserver.v1.ListSoDViolationsResponse\"\x8f\x01\x92Al\n" +
+	"\f职责分离\x12\x1e获取职责分离违规报告\x1a<列出现有角色分配中违反职责分离规则的用户\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/sod-rules/violations\x12\x8c\x02\n" +
+	"\fListSessions\x12!.apiserver.v1.ListSessionsRequest\x1a\".apiserver.v1.ListSessionsResponse\"\xb4\x01\x92A\x8d\x01\n" +
+	"\f会话管理\x12\x18获取用户会话列表\x1ac获取用户当前有效的登录会话（设备、IP、User-Agent、登录和最近活跃时间）\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{userID}/sessions\x12\xf0\x01\n" +
+	"\rRevokeSession\x12\".apiserver.v1.RevokeSessionRequest\x1a#.apiserver.v1.RevokeSessionResponse\"\x95\x01\x92Ac\n" +
+	"\f会话管理\x12\f撤销会话\x1aE撤销用户的指定会话，该会话签发的令牌将立即失效\x82\xd3\xe4\x93\x02)*'/v1/users/{userID}/sessions/{sessionID}\x12\xf6\x01\n" +
+	"\x13RevokeOtherSessions\x12(.apiserver.v1.RevokeOtherSessionsRequest\x1a).apiserver.v1.RevokeOtherSessionsResponse\"\x89\x01\x92Ac\n" +
+	"\f会话管理\x12\x12撤销其他会话\x1a?撤销当前用户除本次请求所在会话外的全部会话\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/users/{userID}/sessions\x12\xf3\x01\n" +
+	"\x15TerminateUserSessions\x12*.apiserver.v1.TerminateUserSessionsRequest\x1a+.apiserver.v1.TerminateUserSessionsResponse\"\x80\x01\x92AT\n" +
+	"\f会话管理\x12\x18终止用户全部会话\x1a*管理员终止指定用户的全部会话\x82\xd3\xe4\x93\x02#*!/v1/admin/users/{userID}/sessions\x12\xf9\x01\n" +
+	"\x0fAdminCreateUser\x12\x1f.apiserver.v1.CreateUserRequest\x1a .apiserver.v1.CreateUserResponse\"\xa2\x01\x92A\x84\x01\n" +
+	"\x1b用户管理（管理员）\x12\x15管理员创建用户\x1aN管理员创建用户，不受注册模式限制，创建的用户立即可用\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/admin/users\x12\xc8\x01\n" +
+	"\x0eAdminListUsers\x12\x1d.apiserver.v1.ListUserRequest\x1a\x1e.apiserver.v1.ListUserResponse\"w\x92A]\n" +
+	"\x1b用户管理（管理员）\x12\x1b管理员获取用户列表\x1a!管理员分页获取全部用户\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/users\x12\xf6\x01\n" +
+	"\x10ListDeletedUsers\x12%.apiserver.v1.ListDeletedUsersRequest\x1a&.apiserver.v1.ListDeletedUsersResponse\"\x92\x01\x92Ar\n" +
+	"\x1b用户管理（管理员）\x12\x1b获取回收站用户列表\x1a6获取已删除但尚未被彻底清除的用户列表\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/admin/users/trash\x12\xef\x01\n" +
+	"\vRestoreUser\x12 .apiserver.v1.RestoreUserRequest\x1a!.apiserver.v1.RestoreUserResponse\"\x9a\x01\x92Al\n" +
+	"\x1b用户管理（管理员）\x12\f恢复用户\x1a?从回收站恢复用户，并重新同步用户的角色关系\x82\xd3\xe4\x93\x02%:\x01*\" /v1/admin/users/{userID}/restore\x12\xd7\x01\n" +
+	"\fAdminGetUser\x12\x1c.apiserver.v1.GetUserRequest\x1a\x1d.apiserver.v1.GetUserResponse\"\x89\x01\x92Af\n" +
+	"\x1b用户管理（管理员）\x12\x1b管理员获取用户详情\x1a*管理员获取任意用户的详细信息\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/admin/users/{userID}\x12\x92\x02\n" +
+	"\x0fAdminUpdateUser\x12$.apiserver.v1.AdminUpdateUserRequest\x1a%.apiserver.v1.AdminUpdateUserResponse\"\xb1\x01\x92A\x8a\x01\n" +
+	"\x1b用户管理（管理员）\x12\x1b管理员更新用户信息\x1aN管理员更新任意用户的资料，包括状态、头像、性别和描述\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/admin/users/{userID}\x12\x90\x02\n" +
+	"\x10UpdateUserStatus\x12%.apiserver.v1.UpdateUserStatusRequest\x1a&.apiserver.v1.UpdateUserStatusResponse\"\xac\x01\x92A\x7f\n" +
+	"\x1b用户管理（管理员）\x12\x13启用/禁用用户\x1aK管理员启用或禁用用户，禁用时会终止该用户的全部会话\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/admin/users/{userID}/status\x12\x88\x02\n" +
+	"\x11ResetUserPassword\x12&.apiserver.v1.ResetUserPasswordRequest\x1a'.apiserver.v1.ResetUserPasswordResponse\"\xa1\x01\x92Ar\n" +
+	"\x1b用户管理（管理员）\x12\x12重置用户密码\x1a?管理员重置用户密码，并终止该用户的全部会话\x82\xd3\xe4\x93\x02&:\x01*\x1a!/v1/admin/users/{userID}/password\x12\xe4\x02\n" +
+	"\x0fImpersonateUser\x12$.apiserver.v1.ImpersonateUserRequest\x1a%.apiserver.v1.ImpersonateUserResponse\"\x83\x02\x92A\xd0\x01\n" +
+	"\x1b用户管理（管理员）\x12\x12模拟登录用户\x1a\x9c\x01拥有模拟登录专用权限的管理员以目标用户身份获取短期访问令牌，令牌携带实际操作者，期间的操作均记录审计日志\x82\xd3\xe4\x93\x02):\x01*\"$/v1/admin/users/{userID}/impersonate\x12\xee\x01\n" +
+	"\x15GetRegistrationPolicy\x12*.apiserver.v1.GetRegistrationPolicyRequest\x1a+.apiserver.v1.GetRegistrationPolicyResponse\"|\x92AZ\n" +
+	"\f注册管理\x12\x12获取注册策略\x1a6获取当前的用户自助注册模式，无需认证\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/registration/policy\x12\xf0\x01\n" +
+	"\x10CreateInvitation\x12%.apiserver.v1.CreateInvitationRequest\x1a&.apiserver.v1.CreateInvitationResponse\"\x8c\x01\x92Ao\n" +
+	"\f注册管理\x12\x0f创建邀请码\x1aN创建注册邀请码，可预分配角色并设置使用次数和过期时间\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/invitations\x12\xb9\x01\n" +
+	"\x0eListInvitation\x12#.apiserver.v1.ListInvitationRequest\x1a$.apiserver.v1.ListInvitationResponse\"\\\x92AB\n" +
+	"\f注册管理\x12\x15获取邀请码列表\x1a\x1b分页获取邀请码列表\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/invitations\x12\xd5\x01\n" +
+	"\x10RevokeInvitation\x12%.apiserver.v1.RevokeInvitationRequest\x1a&.apiserver.v1.RevokeInvitationResponse\"r\x92AQ\n" +
+	"\f注册管理\x12\x0f撤销邀请码\x1a0撤销邀请码，撤销后无法再用于注册\x82\xd3\xe4\x93\x02\x18*\x16/v1/invitations/{code}\x12\xfa\x01\n" +
+	"\x18ListPendingRegistrations\x12-.apiserver.v1.ListPendingRegistrationsRequest\x1a..apiserver.v1.ListPendingRegistrationsResponse\"\x7f\x92A]\n" +
+	"\f注册管理\x12\x1b获取待审核注册列表\x1a0获取等待管理员审核的注册用户列表\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/admin/registrations\x12\x95\x02\n" +
+	"\x13ApproveRegistration\x12(.apiserver.v1.ApproveRegistrationRequest\x1a).apiserver.v1.ApproveRegistrationResponse\"\xa8\x01\x92Ar\n" +
+	"\f注册管理\x12\x12审核通过注册\x1aN审核通过注册申请，用户状态变为活跃并授予普通用户角色\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/admin/registrations/{userID}/approve\x12\xea\x01\n" +
+	"\x12RejectRegistration\x12'.apiserver.v1.RejectRegistrationRequest\x1a(.apiserver.v1.RejectRegistrationResponse\"\x80\x01\x92AK\n" +
+	"\f注册管理\x12\f拒绝注册\x1a-拒绝注册申请并删除该待审核用户\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/admin/registrations/{userID}/reject\x12\x84\x02\n" +
+	"\x11ListOIDCProviders\x12&.apiserver.v1.ListOIDCProvidersRequest\x1a'.apiserver.v1.ListOIDCProvidersResponse\"\x9d\x01\x92A{\n" +
+	"\f单点登录\x12\"获取单点登录 Provider 列表\x1aG获取已配置的 OIDC Provider，用于展示企业账号登录入口\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/auth/oidc/providers\x12\xfc\x01\n" +
+	"\x0eStartOIDCLogin\x12#.apiserver.v1.StartOIDCLoginRequest\x1a$.apiserver.v1.StartOIDCLoginResponse\"\x9e\x01\x92Au\n" +
+	"\f单点登录\x12\x12发起单点登录\x1aQ生成基于 PKCE 的授权地址，客户端需要将用户重定向到该地址\x82\xd3\xe4\x93\x02 \x12\x1e/v1/auth/oidc/{provider}/login\x12\x87\x02\n" +
+	"\fOIDCCallback\x12!.apiserver.v1.OIDCCallbackRequest\x1a\".apiserver.v1.OIDCCallbackResponse\"\xaf\x01\x92A\x8d\x01\n" +
+	"\f单点登录\x12\x12单点登录回调\x1ai使用授权码换取并校验 ID Token，登录、自动创建或关联用户后签发本系统的令牌\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/auth/oidc/callback\x12\xe2\x01\n" +
+	"\x12ListUserIdentities\x12'.apiserver.v1.ListUserIdentitiesRequest\x1a(.apiserver.v1.ListUserIdentitiesResponse\"y\x92AQ\n" +
+	"\f单点登录\x12\x18获取外部身份列表\x1a'获取当前用户关联的外部身份\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/users/{userID}/identities\x12\x90\x02\n" +
+	"\x10LinkUserIdentity\x12%.apiserver.v1.LinkUserIdentityRequest\x1a$.apiserver.v1.StartOIDCLoginResponse\"\xae\x01\x92Ax\n" +
+	"\f单点登录\x12\x12关联外部身份\x1aT为当前用户发起关联外部身份的授权流程，回调完成后建立关联\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/users/{userID}/identities/{provider}\x12\xfb\x01\n" +
+	"\x12UnlinkUserIdentity\x12'.apiserver.v1.UnlinkUserIdentityRequest\x1a(.apiserver.v1.UnlinkUserIdentityResponse\"\x91\x01\x92A^\n" +
+	"\f单点登录\x12\x18解除外部身份关联\x1a4解除当前用户与指定 Provider 身份的关联\x82\xd3\xe4\x93\x02**(/v1/users/{userID}/identities/{provider}B\x8a\x05\x92A\xc5\x04\x12\x9a\x04\n" +
 	"\x13Blog Service API v1\x12\x8f\x03Blog 服务提供文章、分类、标签、评论、用户等模块的 RESTful API：\n" +
 	"- 用户认证与权限控制\n" +
 	"- 文章发布、编辑、删除、草稿与置顶\n" +
@@ -113,137 +199,302 @@ const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"\x1egin-enterprise-template 项目\x122https://github.com/clin211/gin-enterprise-template\x1a\x16767425412lin@gmail.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ?github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1b\x06proto3"
 
 var file_apiserver_v1_apiserver_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),                   // 0: google.protobuf.Empty
-	(*LoginRequest)(nil),                    // 1: apiserver.v1.LoginRequest
-	(*RefreshTokenRequest)(nil),             // 2: apiserver.v1.RefreshTokenRequest
-	(*CreateUserRequest)(nil),               // 3: apiserver.v1.CreateUserRequest
-	(*GetUserRequest)(nil),                  // 4: apiserver.v1.GetUserRequest
-	(*UpdateUserRequest)(nil),               // 5: apiserver.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),               // 6: apiserver.v1.DeleteUserRequest
-	(*ListUserRequest)(nil),                 // 7: apiserver.v1.ListUserRequest
-	(*CreateMenuRequest)(nil),               // 8: apiserver.v1.CreateMenuRequest
-	(*GetMenuRequest)(nil),                  // 9: apiserver.v1.GetMenuRequest
-	(*UpdateMenuRequest)(nil),               // 10: apiserver.v1.UpdateMenuRequest
-	(*DeleteMenuRequest)(nil),               // 11: apiserver.v1.DeleteMenuRequest
-	(*ListMenuRequest)(nil),                 // 12: apiserver.v1.ListMenuRequest
-	(*ListMenuTreeRequest)(nil),             // 13: apiserver.v1.ListMenuTreeRequest
-	(*GetUserMenuTreeRequest)(nil),          // 14: apiserver.v1.GetUserMenuTreeRequest
-	(*CreatePermissionRequest)(nil),         // 15: apiserver.v1.CreatePermissionRequest
-	(*GetPermissionRequest)(nil),            // 16: apiserver.v1.GetPermissionRequest
-	(*UpdatePermissionRequest)(nil),         // 17: apiserver.v1.UpdatePermissionRequest
-	(*DeletePermissionRequest)(nil),         // 18: apiserver.v1.DeletePermissionRequest
-	(*ListPermissionRequest)(nil),           // 19: apiserver.v1.ListPermissionRequest
-	(*ListPermissionTreeRequest)(nil),       // 20: apiserver.v1.ListPermissionTreeRequest
-	(*CreateRoleRequest)(nil),               // 21: apiserver.v1.CreateRoleRequest
-	(*GetRoleRequest)(nil),                  // 22: apiserver.v1.GetRoleRequest
-	(*UpdateRoleRequest)(nil),               // 23: apiserver.v1.UpdateRoleRequest
-	(*DeleteRoleRequest)(nil),               // 24: apiserver.v1.DeleteRoleRequest
-	(*ListRoleRequest)(nil),                 // 25: apiserver.v1.ListRoleRequest
-	(*AssignPermissionsToRoleRequest)(nil),  // 26: apiserver.v1.AssignPermissionsToRoleRequest
-	(*GetRolePermissionsRequest)(nil),       // 27: apiserver.v1.GetRolePermissionsRequest
-	(*AssignRolesToUserRequest)(nil),        // 28: apiserver.v1.AssignRolesToUserRequest
-	(*GetUserRolesRequest)(nil),             // 29: apiserver.v1.GetUserRolesRequest
-	(*RemoveRoleFromUserRequest)(nil),       // 30: apiserver.v1.RemoveRoleFromUserRequest
-	(*HealthzResponse)(nil),                 // 31: apiserver.v1.HealthzResponse
-	(*LoginResponse)(nil),                   // 32: apiserver.v1.LoginResponse
-	(*RefreshTokenResponse)(nil),            // 33: apiserver.v1.RefreshTokenResponse
-	(*CreateUserResponse)(nil),              // 34: apiserver.v1.CreateUserResponse
-	(*GetUserResponse)(nil),                 // 35: apiserver.v1.GetUserResponse
-	(*UpdateUserResponse)(nil),              // 36: apiserver.v1.UpdateUserResponse
-	(*DeleteUserResponse)(nil),              // 37: apiserver.v1.DeleteUserResponse
-	(*ListUserResponse)(nil),                // 38: apiserver.v1.ListUserResponse
-	(*CreateMenuResponse)(nil),              // 39: apiserver.v1.CreateMenuResponse
-	(*GetMenuResponse)(nil),                 // 40: apiserver.v1.GetMenuResponse
-	(*UpdateMenuResponse)(nil),              // 41: apiserver.v1.UpdateMenuResponse
-	(*DeleteMenuResponse)(nil),              // 42: apiserver.v1.DeleteMenuResponse
-	(*ListMenuResponse)(nil),                // 43: apiserver.v1.ListMenuResponse
-	(*ListMenuTreeResponse)(nil),            // 44: apiserver.v1.ListMenuTreeResponse
-	(*GetUserMenuTreeResponse)(nil),         // 45: apiserver.v1.GetUserMenuTreeResponse
-	(*CreatePermissionResponse)(nil),        // 46: apiserver.v1.CreatePermissionResponse
-	(*GetPermissionResponse)(nil),           // 47: apiserver.v1.GetPermissionResponse
-	(*UpdatePermissionResponse)(nil),        // 48: apiserver.v1.UpdatePermissionResponse
-	(*DeletePermissionResponse)(nil),        // 49: apiserver.v1.DeletePermissionResponse
-	(*ListPermissionResponse)(nil),          // 50: apiserver.v1.ListPermissionResponse
-	(*ListPermissionTreeResponse)(nil),      // 51: apiserver.v1.ListPermissionTreeResponse
-	(*CreateRoleResponse)(nil),              // 52: apiserver.v1.CreateRoleResponse
-	(*GetRoleResponse)(nil),                 // 53: apiserver.v1.GetRoleResponse
-	(*UpdateRoleResponse)(nil),              // 54: apiserver.v1.UpdateRoleResponse
-	(*DeleteRoleResponse)(nil),              // 55: apiserver.v1.DeleteRoleResponse
-	(*ListRoleResponse)(nil),                // 56: apiserver.v1.ListRoleResponse
-	(*AssignPermissionsToRoleResponse)(nil), // 57: apiserver.v1.AssignPermissionsToRoleResponse
-	(*GetRolePermissionsResponse)(nil),      // 58: apiserver.v1.GetRolePermissionsResponse
-	(*AssignRolesToUserResponse)(nil),       // 59: apiserver.v1.AssignRolesToUserResponse
-	(*GetUserRolesResponse)(nil),            // 60: apiserver.v1.GetUserRolesResponse
-	(*RemoveRoleFromUserResponse)(nil),      // 61: apiserver.v1.RemoveRoleFromUserResponse
+	(*emptypb.Empty)(nil),                    // 0: google.protobuf.Empty
+	(*LoginRequest)(nil),                     // 1: apiserver.v1.LoginRequest
+	(*RefreshTokenRequest)(nil),              // 2: apiserver.v1.RefreshTokenRequest
+	(*CreateUserRequest)(nil),                // 3: apiserver.v1.CreateUserRequest
+	(*GetUserRequest)(nil),                   // 4: apiserver.v1.GetUserRequest
+	(*UpdateUserRequest)(nil),                // 5: apiserver.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                // 6: apiserver.v1.DeleteUserRequest
+	(*ListUserRequest)(nil),                  // 7: apiserver.v1.ListUserRequest
+	(*CreateMenuRequest)(nil),                // 8: apiserver.v1.CreateMenuRequest
+	(*GetMenuRequest)(nil),                   // 9: apiserver.v1.GetMenuRequest
+	(*UpdateMenuRequest)(nil),                // 10: apiserver.v1.UpdateMenuRequest
+	(*DeleteMenuRequest)(nil),                // 11: apiserver.v1.DeleteMenuRequest
+	(*ListMenuRequest)(nil),                  // 12: apiserver.v1.ListMenuRequest
+	(*ListDeletedMenusRequest)(nil),          // 13: apiserver.v1.ListDeletedMenusRequest
+	(*RestoreMenuRequest)(nil),               // 14: apiserver.v1.RestoreMenuRequest
+	(*ListMenuTreeRequest)(nil),              // 15: apiserver.v1.ListMenuTreeRequest
+	(*GetUserMenuTreeRequest)(nil),           // 16: apiserver.v1.GetUserMenuTreeRequest
+	(*CreatePermissionRequest)(nil),          // 17: apiserver.v1.CreatePermissionRequest
+	(*GetPermissionRequest)(nil),             // 18: apiserver.v1.GetPermissionRequest
+	(*UpdatePermissionRequest)(nil),          // 19: apiserver.v1.UpdatePermissionRequest
+	(*DeletePermissionRequest)(nil),          // 20: apiserver.v1.DeletePermissionRequest
+	(*ListPermissionRequest)(nil),            // 21: apiserver.v1.ListPermissionRequest
+	(*ListDeletedPermissionsRequest)(nil),    // 22: apiserver.v1.ListDeletedPermissionsRequest
+	(*RestorePermissionRequest)(nil),         // 23: apiserver.v1.RestorePermissionRequest
+	(*ListPermissionTreeRequest)(nil),        // 24: apiserver.v1.ListPermissionTreeRequest
+	(*GetAPICatalogRequest)(nil),             // 25: apiserver.v1.GetAPICatalogRequest
+	(*CreateRoleRequest)(nil),                // 26: apiserver.v1.CreateRoleRequest
+	(*GetRoleRequest)(nil),                   // 27: apiserver.v1.GetRoleRequest
+	(*UpdateRoleRequest)(nil),                // 28: apiserver.v1.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),                // 29: apiserver.v1.DeleteRoleRequest
+	(*ListRoleRequest)(nil),                  // 30: apiserver.v1.ListRoleRequest
+	(*ListDeletedRolesRequest)(nil),          // 31: apiserver.v1.ListDeletedRolesRequest
+	(*RestoreRoleRequest)(nil),               // 32: apiserver.v1.RestoreRoleRequest
+	(*AssignPermissionsToRoleRequest)(nil),   // 33: apiserver.v1.AssignPermissionsToRoleRequest
+	(*GetRolePermissionsRequest)(nil),        // 34: apiserver.v1.GetRolePermissionsRequest
+	(*AssignRolesToUserRequest)(nil),         // 35: apiserver.v1.AssignRolesToUserRequest
+	(*GetUserRolesRequest)(nil),              // 36: apiserver.v1.GetUserRolesRequest
+	(*RemoveRoleFromUserRequest)(nil),        // 37: apiserver.v1.RemoveRoleFromUserRequest
+	(*CreateAccessRequestRequest)(nil),       // 38: apiserver.v1.CreateAccessRequestRequest
+	(*ListAccessRequestsRequest)(nil),        // 39: apiserver.v1.ListAccessRequestsRequest
+	(*GetAccessRequestRequest)(nil),          // 40: apiserver.v1.GetAccessRequestRequest
+	(*ApproveAccessRequestRequest)(nil),      // 41: apiserver.v1.ApproveAccessRequestRequest
+	(*RejectAccessRequestRequest)(nil),       // 42: apiserver.v1.RejectAccessRequestRequest
+	(*CreateSoDRuleRequest)(nil),             // 43: apiserver.v1.CreateSoDRuleRequest
+	(*ListSoDRulesRequest)(nil),              // 44: apiserver.v1.ListSoDRulesRequest
+	(*UpdateSoDRuleRequest)(nil),             // 45: apiserver.v1.UpdateSoDRuleRequest
+	(*DeleteSoDRuleRequest)(nil),             // 46: apiserver.v1.DeleteSoDRuleRequest
+	(*ListSoDViolationsRequest)(nil),         // 47: apiserver.v1.ListSoDViolationsRequest
+	(*ListSessionsRequest)(nil),              // 48: apiserver.v1.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),             // 49: apiserver.v1.RevokeSessionRequest
+	(*RevokeOtherSessionsRequest)(nil),       // 50: apiserver.v1.RevokeOtherSessionsRequest
+	(*TerminateUserSessionsRequest)(nil),     // 51: apiserver.v1.TerminateUserSessionsRequest
+	(*ListDeletedUsersRequest)(nil),          // 52: apiserver.v1.ListDeletedUsersRequest
+	(*RestoreUserRequest)(nil),               // 53: apiserver.v1.RestoreUserRequest
+	(*AdminUpdateUserRequest)(nil),           // 54: apiserver.v1.AdminUpdateUserRequest
+	(*UpdateUserStatusRequest)(nil),          // 55: apiserver.v1.UpdateUserStatusRequest
+	(*ResetUserPasswordRequest)(nil),         // 56: apiserver.v1.ResetUserPasswordRequest
+	(*ImpersonateUserRequest)(nil),           // 57: apiserver.v1.ImpersonateUserRequest
+	(*GetRegistrationPolicyRequest)(nil),     // 58: apiserver.v1.GetRegistrationPolicyRequest
+	(*CreateInvitationRequest)(nil),          // 59: apiserver.v1.CreateInvitationRequest
+	(*ListInvitationRequest)(nil),            // 60: apiserver.v1.ListInvitationRequest
+	(*RevokeInvitationRequest)(nil),          // 61: apiserver.v1.RevokeInvitationRequest
+	(*ListPendingRegistrationsRequest)(nil),  // 62: apiserver.v1.ListPendingRegistrationsRequest
+	(*ApproveRegistrationRequest)(nil),       // 63: apiserver.v1.ApproveRegistrationRequest
+	(*RejectRegistrationRequest)(nil),        // 64: apiserver.v1.RejectRegistrationRequest
+	(*ListOIDCProvidersRequest)(nil),         // 65: apiserver.v1.ListOIDCProvidersRequest
+	(*StartOIDCLoginRequest)(nil),            // 66: apiserver.v1.StartOIDCLoginRequest
+	(*OIDCCallbackRequest)(nil),              // 67: apiserver.v1.OIDCCallbackRequest
+	(*ListUserIdentitiesRequest)(nil),        // 68: apiserver.v1.ListUserIdentitiesRequest
+	(*LinkUserIdentityRequest)(nil),          // 69: apiserver.v1.LinkUserIdentityRequest
+	(*UnlinkUserIdentityRequest)(nil),        // 70: apiserver.v1.UnlinkUserIdentityRequest
+	(*HealthzResponse)(nil),                  // 71: apiserver.v1.HealthzResponse
+	(*LoginResponse)(nil),                    // 72: apiserver.v1.LoginResponse
+	(*RefreshTokenResponse)(nil),             // 73: apiserver.v1.RefreshTokenResponse
+	(*CreateUserResponse)(nil),               // 74: apiserver.v1.CreateUserResponse
+	(*GetUserResponse)(nil),                  // 75: apiserver.v1.GetUserResponse
+	(*UpdateUserResponse)(nil),               // 76: apiserver.v1.UpdateUserResponse
+	(*DeleteUserResponse)(nil),               // 77: apiserver.v1.DeleteUserResponse
+	(*ListUserResponse)(nil),                 // 78: apiserver.v1.ListUserResponse
+	(*CreateMenuResponse)(nil),               // 79: apiserver.v1.CreateMenuResponse
+	(*GetMenuResponse)(nil),                  // 80: apiserver.v1.GetMenuResponse
+	(*UpdateMenuResponse)(nil),               // 81: apiserver.v1.UpdateMenuResponse
+	(*DeleteMenuResponse)(nil),               // 82: apiserver.v1.DeleteMenuResponse
+	(*ListMenuResponse)(nil),                 // 83: apiserver.v1.ListMenuResponse
+	(*ListDeletedMenusResponse)(nil),         // 84: apiserver.v1.ListDeletedMenusResponse
+	(*RestoreMenuResponse)(nil),              // 85: apiserver.v1.RestoreMenuResponse
+	(*ListMenuTreeResponse)(nil),             // 86: apiserver.v1.ListMenuTreeResponse
+	(*GetUserMenuTreeResponse)(nil),          // 87: apiserver.v1.GetUserMenuTreeResponse
+	(*CreatePermissionResponse)(nil),         // 88: apiserver.v1.CreatePermissionResponse
+	(*GetPermissionResponse)(nil),            // 89: apiserver.v1.GetPermissionResponse
+	(*UpdatePermissionResponse)(nil),         // 90: apiserver.v1.UpdatePermissionResponse
+	(*DeletePermissionResponse)(nil),         // 91: apiserver.v1.DeletePermissionResponse
+	(*ListPermissionResponse)(nil),           // 92: apiserver.v1.ListPermissionResponse
+	(*ListDeletedPermissionsResponse)(nil),   // 93: apiserver.v1.ListDeletedPermissionsResponse
+	(*RestorePermissionResponse)(nil),        // 94: apiserver.v1.RestorePermissionResponse
+	(*ListPermissionTreeResponse)(nil),       // 95: apiserver.v1.ListPermissionTreeResponse
+	(*GetAPICatalogResponse)(nil),            // 96: apiserver.v1.GetAPICatalogResponse
+	(*CreateRoleResponse)(nil),               // 97: apiserver.v1.CreateRoleResponse
+	(*GetRoleResponse)(nil),                  // 98: apiserver.v1.GetRoleResponse
+	(*UpdateRoleResponse)(nil),               // 99: apiserver.v1.UpdateRoleResponse
+	(*DeleteRoleResponse)(nil),               // 100: apiserver.v1.DeleteRoleResponse
+	(*ListRoleResponse)(nil),                 // 101: apiserver.v1.ListRoleResponse
+	(*ListDeletedRolesResponse)(nil),         // 102: apiserver.v1.ListDeletedRolesResponse
+	(*RestoreRoleResponse)(nil),              // 103: apiserver.v1.RestoreRoleResponse
+	(*AssignPermissionsToRoleResponse)(nil),  // 104: apiserver.v1.AssignPermissionsToRoleResponse
+	(*GetRolePermissionsResponse)(nil),       // 105: apiserver.v1.GetRolePermissionsResponse
+	(*AssignRolesToUserResponse)(nil),        // 106: apiserver.v1.AssignRolesToUserResponse
+	(*GetUserRolesResponse)(nil),             // 107: apiserver.v1.GetUserRolesResponse
+	(*RemoveRoleFromUserResponse)(nil),       // 108: apiserver.v1.RemoveRoleFromUserResponse
+	(*CreateAccessRequestResponse)(nil),      // 109: apiserver.v1.CreateAccessRequestResponse
+	(*ListAccessRequestsResponse)(nil),       // 110: apiserver.v1.ListAccessRequestsResponse
+	(*GetAccessRequestResponse)(nil),         // 111: apiserver.v1.GetAccessRequestResponse
+	(*ApproveAccessRequestResponse)(nil),     // 112: apiserver.v1.ApproveAccessRequestResponse
+	(*RejectAccessRequestResponse)(nil),      // 113: apiserver.v1.RejectAccessRequestResponse
+	(*CreateSoDRuleResponse)(nil),            // 114: apiserver.v1.CreateSoDRuleResponse
+	(*ListSoDRulesResponse)(nil),             // 115: apiserver.v1.ListSoDRulesResponse
+	(*UpdateSoDRuleResponse)(nil),            // 116: apiserver.v1.UpdateSoDRuleResponse
+	(*DeleteSoDRuleResponse)(nil),            // 117: apiserver.v1.DeleteSoDRuleResponse
+	(*ListSoDViolationsResponse)(nil),        // 118: apiserver.v1.ListSoDViolationsResponse
+	(*ListSessionsResponse)(nil),             // 119: apiserver.v1.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),            // 120: apiserver.v1.RevokeSessionResponse
+	(*RevokeOtherSessionsResponse)(nil),      // 121: apiserver.v1.RevokeOtherSessionsResponse
+	(*TerminateUserSessionsResponse)(nil),    // 122: apiserver.v1.TerminateUserSessionsResponse
+	(*ListDeletedUsersResponse)(nil),         // 123: apiserver.v1.ListDeletedUsersResponse
+	(*RestoreUserResponse)(nil),              // 124: apiserver.v1.RestoreUserResponse
+	(*AdminUpdateUserResponse)(nil),          // 125: apiserver.v1.AdminUpdateUserResponse
+	(*UpdateUserStatusResponse)(nil),         // 126: apiserver.v1.UpdateUserStatusResponse
+	(*ResetUserPasswordResponse)(nil),        // 127: apiserver.v1.ResetUserPasswordResponse
+	(*ImpersonateUserResponse)(nil),          // 128: apiserver.v1.ImpersonateUserResponse
+	(*GetRegistrationPolicyResponse)(nil),    // 129: apiserver.v1.GetRegistrationPolicyResponse
+	(*CreateInvitationResponse)(nil),         // 130: apiserver.v1.CreateInvitationResponse
+	(*ListInvitationResponse)(nil),           // 131: apiserver.v1.ListInvitationResponse
+	(*RevokeInvitationResponse)(nil),         // 132: apiserver.v1.RevokeInvitationResponse
+	(*ListPendingRegistrationsResponse)(nil), // 133: apiserver.v1.ListPendingRegistrationsResponse
+	(*ApproveRegistrationResponse)(nil),      // 134: apiserver.v1.ApproveRegistrationResponse
+	(*RejectRegistrationResponse)(nil),       // 135: apiserver.v1.RejectRegistrationResponse
+	(*ListOIDCProvidersResponse)(nil),        // 136: apiserver.v1.ListOIDCProvidersResponse
+	(*StartOIDCLoginResponse)(nil),           // 137: apiserver.v1.StartOIDCLoginResponse
+	(*OIDCCallbackResponse)(nil),             // 138: apiserver.v1.OIDCCallbackResponse
+	(*ListUserIdentitiesResponse)(nil),       // 139: apiserver.v1.ListUserIdentitiesResponse
+	(*UnlinkUserIdentityResponse)(nil),       // 140: apiserver.v1.UnlinkUserIdentityResponse
 }
 var file_apiserver_v1_apiserver_proto_depIdxs = []int32{
-	0,  // 0: apiserver.v1.BlogService.Healthz:input_type -> google.protobuf.Empty
-	1,  // 1: apiserver.v1.BlogService.Login:input_type -> apiserver.v1.LoginRequest
-	2,  // 2: apiserver.v1.BlogService.RefreshToken:input_type -> apiserver.v1.RefreshTokenRequest
-	3,  // 3: apiserver.v1.BlogService.CreateUser:input_type -> apiserver.v1.CreateUserRequest
-	4,  // 4: apiserver.v1.BlogService.GetUser:input_type -> apiserver.v1.GetUserRequest
-	5,  // 5: apiserver.v1.BlogService.UpdateUser:input_type -> apiserver.v1.UpdateUserRequest
-	6,  // 6: apiserver.v1.BlogService.DeleteUser:input_type -> apiserver.v1.DeleteUserRequest
-	7,  // 7: apiserver.v1.BlogService.ListUsers:input_type -> apiserver.v1.ListUserRequest
-	8,  // 8: apiserver.v1.BlogService.CreateMenu:input_type -> apiserver.v1.CreateMenuRequest
-	9,  // 9: apiserver.v1.BlogService.GetMenu:input_type -> apiserver.v1.GetMenuRequest
-	10, // 10: apiserver.v1.BlogService.UpdateMenu:input_type -> apiserver.v1.UpdateMenuRequest
-	11, // 11: apiserver.v1.BlogService.DeleteMenu:input_type -> apiserver.v1.DeleteMenuRequest
-	12, // 12: apiserver.v1.BlogService.ListMenus:input_type -> apiserver.v1.ListMenuRequest
-	13, // 13: apiserver.v1.BlogService.ListMenuTree:input_type -> apiserver.v1.ListMenuTreeRequest
-	14, // 14: apiserver.v1.BlogService.GetUserMenuTree:input_type -> apiserver.v1.GetUserMenuTreeRequest
-	15, // 15: apiserver.v1.BlogService.CreatePermission:input_type -> apiserver.v1.CreatePermissionRequest
-	16, // 16: apiserver.v1.BlogService.GetPermission:input_type -> apiserver.v1.GetPermissionRequest
-	17, // 17: apiserver.v1.BlogService.UpdatePermission:input_type -> apiserver.v1.UpdatePermissionRequest
-	18, // 18: apiserver.v1.BlogService.DeletePermission:input_type -> apiserver.v1.DeletePermissionRequest
-	19, // 19: apiserver.v1.BlogService.ListPermissions:input_type -> apiserver.v1.ListPermissionRequest
-	20, // 20: apiserver.v1.BlogService.ListPermissionTree:input_type -> apiserver.v1.ListPermissionTreeRequest
-	21, // 21: apiserver.v1.BlogService.CreateRole:input_type -> apiserver.v1.CreateRoleRequest
-	22, // 22: apiserver.v1.BlogService.GetRole:input_type -> apiserver.v1.GetRoleRequest
-	23, // 23: apiserver.v1.BlogService.UpdateRole:input_type -> apiserver.v1.UpdateRoleRequest
-	24, // 24: apiserver.v1.BlogService.DeleteRole:input_type -> apiserver.v1.DeleteRoleRequest
-	25, // 25: apiserver.v1.BlogService.ListRoles:input_type -> apiserver.v1.ListRoleRequest
-	26, // 26: apiserver.v1.BlogService.AssignPermissionsToRole:input_type -> apiserver.v1.AssignPermissionsToRoleRequest
-	27, // 27: apiserver.v1.BlogService.GetRolePermissions:input_type -> apiserver.v1.GetRolePermissionsRequest
-	28, // 28: apiserver.v1.BlogService.AssignRolesToUser:input_type -> apiserver.v1.AssignRolesToUserRequest
-	29, // 29: apiserver.v1.BlogService.GetUserRoles:input_type -> apiserver.v1.GetUserRolesRequest
-	30, // 30: apiserver.v1.BlogService.RemoveRoleFromUser:input_type -> apiserver.v1.RemoveRoleFromUserRequest
-	31, // 31: apiserver.v1.BlogService.Healthz:output_type -> apiserver.v1.HealthzResponse
-	32, // 32: apiserver.v1.BlogService.Login:output_type -> apiserver.v1.LoginResponse
-	33, // 33: apiserver.v1.BlogService.RefreshToken:output_type -> apiserver.v1.RefreshTokenResponse
-	34, // 34: apiserver.v1.BlogService.CreateUser:output_type -> apiserver.v1.CreateUserResponse
-	35, // 35: apiserver.v1.BlogService.GetUser:output_type -> apiserver.v1.GetUserResponse
-	36, // 36: apiserver.v1.BlogService.UpdateUser:output_type -> apiserver.v1.UpdateUserResponse
-	37, // 37: apiserver.v1.BlogService.DeleteUser:output_type -> apiserver.v1.DeleteUserResponse
-	38, // 38: apiserver.v1.BlogService.ListUsers:output_type -> apiserver.v1.ListUserResponse
-	39, // 39: apiserver.v1.BlogService.CreateMenu:output_type -> apiserver.v1.CreateMenuResponse
-	40, // 40: apiserver.v1.BlogService.GetMenu:output_type -> apiserver.v1.GetMenuResponse
-	41, // 41: apiserver.v1.BlogService.UpdateMenu:output_type -> apiserver.v1.UpdateMenuResponse
-	42, // 42: apiserver.v1.BlogService.DeleteMenu:output_type -> apiserver.v1.DeleteMenuResponse
-	43, // 43: apiserver.v1.BlogService.ListMenus:output_type -> apiserver.v1.ListMenuResponse
-	44, // 44: apiserver.v1.BlogService.ListMenuTree:output_type -> apiserver.v1.ListMenuTreeResponse
-	45, // 45: apiserver.v1.BlogService.GetUserMenuTree:output_type -> apiserver.v1.GetUserMenuTreeResponse
-	46, // 46: apiserver.v1.BlogService.CreatePermission:output_type -> apiserver.v1.CreatePermissionResponse
-	47, // 47: apiserver.v1.BlogService.GetPermission:output_type -> apiserver.v1.GetPermissionResponse
-	48, // 48: apiserver.v1.BlogService.UpdatePermission:output_type -> apiserver.v1.UpdatePermissionResponse
-	49, // 49: apiserver.v1.BlogService.DeletePermission:output_type -> apiserver.v1.DeletePermissionResponse
-	50, // 50: apiserver.v1.BlogService.ListPermissions:output_type -> apiserver.v1.ListPermissionResponse
-	51, // 51: apiserver.v1.BlogService.ListPermissionTree:output_type -> apiserver.v1.ListPermissionTreeResponse
-	52, // 52: apiserver.v1.BlogService.CreateRole:output_type -> apiserver.v1.CreateRoleResponse
-	53, // 53: apiserver.v1.BlogService.GetRole:output_type -> apiserver.v1.GetRoleResponse
-	54, // 54: apiserver.v1.BlogService.UpdateRole:output_type -> apiserver.v1.UpdateRoleResponse
-	55, // 55: apiserver.v1.BlogService.DeleteRole:output_type -> apiserver.v1.DeleteRoleResponse
-	56, // 56: apiserver.v1.BlogService.ListRoles:output_type -> apiserver.v1.ListRoleResponse
-	57, // 57: apiserver.v1.BlogService.AssignPermissionsToRole:output_type -> apiserver.v1.AssignPermissionsToRoleResponse
-	58, // 58: apiserver.v1.BlogService.GetRolePermissions:output_type -> apiserver.v1.GetRolePermissionsResponse
-	59, // 59: apiserver.v1.BlogService.AssignRolesToUser:output_type -> apiserver.v1.AssignRolesToUserResponse
-	60, // 60: apiserver.v1.BlogService.GetUserRoles:output_type -> apiserver.v1.GetUserRolesResponse
-	61, // 61: apiserver.v1.BlogService.RemoveRoleFromUser:output_type -> apiserver.v1.RemoveRoleFromUserResponse
-	31, // [31:62] is the sub-list for method output_type
-	0,  // [0:31] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: apiserver.v1.BlogService.Healthz:input_type -> google.protobuf.Empty
+	1,   // 1: apiserver.v1.BlogService.Login:input_type -> apiserver.v1.LoginRequest
+	2,   // 2: apiserver.v1.BlogService.RefreshToken:input_type -> apiserver.v1.RefreshTokenRequest
+	3,   // 3: apiserver.v1.BlogService.CreateUser:input_type -> apiserver.v1.CreateUserRequest
+	4,   // 4: apiserver.v1.BlogService.GetUser:input_type -> apiserver.v1.GetUserRequest
+	5,   // 5: apiserver.v1.BlogService.UpdateUser:input_type -> apiserver.v1.UpdateUserRequest
+	6,   // 6: apiserver.v1.BlogService.DeleteUser:input_type -> apiserver.v1.DeleteUserRequest
+	7,   // 7: apiserver.v1.BlogService.ListUsers:input_type -> apiserver.v1.ListUserRequest
+	8,   // 8: apiserver.v1.BlogService.CreateMenu:input_type -> apiserver.v1.CreateMenuRequest
+	9,   // 9: apiserver.v1.BlogService.GetMenu:input_type -> apiserver.v1.GetMenuRequest
+	10,  // 10: apiserver.v1.BlogService.UpdateMenu:input_type -> apiserver.v1.UpdateMenuRequest
+	11,  // 11: apiserver.v1.BlogService.DeleteMenu:input_type -> apiserver.v1.DeleteMenuRequest
+	12,  // 12: apiserver.v1.BlogService.ListMenus:input_type -> apiserver.v1.ListMenuRequest
+	13,  // 13: apiserver.v1.BlogService.ListDeletedMenus:input_type -> apiserver.v1.ListDeletedMenusRequest
+	14,  // 14: apiserver.v1.BlogService.RestoreMenu:input_type -> apiserver.v1.RestoreMenuRequest
+	15,  // 15: apiserver.v1.BlogService.ListMenuTree:input_type -> apiserver.v1.ListMenuTreeRequest
+	16,  // 16: apiserver.v1.BlogService.GetUserMenuTree:input_type -> apiserver.v1.GetUserMenuTreeRequest
+	17,  // 17: apiserver.v1.BlogService.CreatePermission:input_type -> apiserver.v1.CreatePermissionRequest
+	18,  // 18: apiserver.v1.BlogService.GetPermission:input_type -> apiserver.v1.GetPermissionRequest
+	19,  // 19: apiserver.v1.BlogService.UpdatePermission:input_type -> apiserver.v1.UpdatePermissionRequest
+	20,  // 20: apiserver.v1.BlogService.DeletePermission:input_type -> apiserver.v1.DeletePermissionRequest
+	21,  // 21: apiserver.v1.BlogService.ListPermissions:input_type -> apiserver.v1.ListPermissionRequest
+	22,  // 22: apiserver.v1.BlogService.ListDeletedPermissions:input_type -> apiserver.v1.ListDeletedPermissionsRequest
+	23,  // 23: apiserver.v1.BlogService.RestorePermission:input_type -> apiserver.v1.RestorePermissionRequest
+	24,  // 24: apiserver.v1.BlogService.ListPermissionTree:input_type -> apiserver.v1.ListPermissionTreeRequest
+	25,  // 25: apiserver.v1.BlogService.GetAPICatalog:input_type -> apiserver.v1.GetAPICatalogRequest
+	26,  // 26: apiserver.v1.BlogService.CreateRole:input_type -> apiserver.v1.CreateRoleRequest
+	27,  // 27: apiserver.v1.BlogService.GetRole:input_type -> apiserver.v1.GetRoleRequest
+	28,  // 28: apiserver.v1.BlogService.UpdateRole:input_type -> apiserver.v1.UpdateRoleRequest
+	29,  // 29: apiserver.v1.BlogService.DeleteRole:input_type -> apiserver.v1.DeleteRoleRequest
+	30,  // 30: apiserver.v1.BlogService.ListRoles:input_type -> apiserver.v1.ListRoleRequest
+	31,  // 31: apiserver.v1.BlogService.ListDeletedRoles:input_type -> apiserver.v1.ListDeletedRolesRequest
+	32,  // 32: apiserver.v1.BlogService.RestoreRole:input_type -> apiserver.v1.RestoreRoleRequest
+	33,  // 33: apiserver.v1.BlogService.AssignPermissionsToRole:input_type -> apiserver.v1.AssignPermissionsToRoleRequest
+	34,  // 34: apiserver.v1.BlogService.GetRolePermissions:input_type -> apiserver.v1.GetRolePermissionsRequest
+	35,  // 35: apiserver.v1.BlogService.AssignRolesToUser:input_type -> apiserver.v1.AssignRolesToUserRequest
+	36,  // 36: apiserver.v1.BlogService.GetUserRoles:input_type -> apiserver.v1.GetUserRolesRequest
+	37,  // 37: apiserver.v1.BlogService.RemoveRoleFromUser:input_type -> apiserver.v1.RemoveRoleFromUserRequest
+	38,  // 38: apiserver.v1.BlogService.CreateAccessRequest:input_type -> apiserver.v1.CreateAccessRequestRequest
+	39,  // 39: apiserver.v1.BlogService.ListAccessRequests:input_type -> apiserver.v1.ListAccessRequestsRequest
+	40,  // 40: apiserver.v1.BlogService.GetAccessRequest:input_type -> apiserver.v1.GetAccessRequestRequest
+	41,  // 41: apiserver.v1.BlogService.ApproveAccessRequest:input_type -> apiserver.v1.ApproveAccessRequestRequest
+	42,  // 42: apiserver.v1.BlogService.RejectAccessRequest:input_type -> apiserver.v1.RejectAccessRequestRequest
+	43,  // 43: apiserver.v1.BlogService.CreateSoDRule:input_type -> apiserver.v1.CreateSoDRuleRequest
+	44,  // 44: apiserver.v1.BlogService.ListSoDRules:input_type -> apiserver.v1.ListSoDRulesRequest
+	45,  // 45: apiserver.v1.BlogService.UpdateSoDRule:input_type -> apiserver.v1.UpdateSoDRuleRequest
+	46,  // 46: apiserver.v1.BlogService.DeleteSoDRule:input_type -> apiserver.v1.DeleteSoDRuleRequest
+	47,  // 47: apiserver.v1.BlogService.ListSoDViolations:input_type -> apiserver.v1.ListSoDViolationsRequest
+	48,  // 48: apiserver.v1.BlogService.ListSessions:input_type -> apiserver.v1.ListSessionsRequest
+	49,  // 49: apiserver.v1.BlogService.RevokeSession:input_type -> apiserver.v1.RevokeSessionRequest
+	50,  // 50: apiserver.v1.BlogService.RevokeOtherSessions:input_type -> apiserver.v1.RevokeOtherSessionsRequest
+	51,  // 51: apiserver.v1.BlogService.TerminateUserSessions:input_type -> apiserver.v1.TerminateUserSessionsRequest
+	3,   // 52: apiserver.v1.BlogService.AdminCreateUser:input_type -> apiserver.v1.CreateUserRequest
+	7,   // 53: apiserver.v1.BlogService.AdminListUsers:input_type -> apiserver.v1.ListUserRequest
+	52,  // 54: apiserver.v1.BlogService.ListDeletedUsers:input_type -> apiserver.v1.ListDeletedUsersRequest
+	53,  // 55: apiserver.v1.BlogService.RestoreUser:input_type -> apiserver.v1.RestoreUserRequest
+	4,   // 56: apiserver.v1.BlogService.AdminGetUser:input_type -> apiserver.v1.GetUserRequest
+	54,  // 57: apiserver.v1.BlogService.AdminUpdateUser:input_type -> apiserver.v1.AdminUpdateUserRequest
+	55,  // 58: apiserver.v1.BlogService.UpdateUserStatus:input_type -> apiserver.v1.UpdateUserStatusRequest
+	56,  // 59: apiserver.v1.BlogService.ResetUserPassword:input_type -> apiserver.v1.ResetUserPasswordRequest
+	57,  // 60: apiserver.v1.BlogService.ImpersonateUser:input_type -> apiserver.v1.ImpersonateUserRequest
+	58,  // 61: apiserver.v1.BlogService.GetRegistrationPolicy:input_type -> apiserver.v1.GetRegistrationPolicyRequest
+	59,  // 62: apiserver.v1.BlogService.CreateInvitation:input_type -> apiserver.v1.CreateInvitationRequest
+	60,  // 63: apiserver.v1.BlogService.ListInvitation:input_type -> apiserver.v1.ListInvitationRequest
+	61,  // 64: apiserver.v1.BlogService.RevokeInvitation:input_type -> apiserver.v1.RevokeInvitationRequest
+	62,  // 65: apiserver.v1.BlogService.ListPendingRegistrations:input_type -> apiserver.v1.ListPendingRegistrationsRequest
+	63,  // 66: apiserver.v1.BlogService.ApproveRegistration:input_type -> apiserver.v1.ApproveRegistrationRequest
+	64,  // 67: apiserver.v1.BlogService.RejectRegistration:input_type -> apiserver.v1.RejectRegistrationRequest
+	65,  // 68: apiserver.v1.BlogService.ListOIDCProviders:input_type -> apiserver.v1.ListOIDCProvidersRequest
+	66,  // 69: apiserver.v1.BlogService.StartOIDCLogin:input_type -> apiserver.v1.StartOIDCLoginRequest
+	67,  // 70: apiserver.v1.BlogService.OIDCCallback:input_type -> apiserver.v1.OIDCCallbackRequest
+	68,  // 71: apiserver.v1.BlogService.ListUserIdentities:input_type -> apiserver.v1.ListUserIdentitiesRequest
+	69,  // 72: apiserver.v1.BlogService.LinkUserIdentity:input_type -> apiserver.v1.LinkUserIdentityRequest
+	70,  // 73: apiserver.v1.BlogService.UnlinkUserIdentity:input_type -> apiserver.v1.UnlinkUserIdentityRequest
+	71,  // 74: apiserver.v1.BlogService.Healthz:output_type -> apiserver.v1.HealthzResponse
+	72,  // 75: apiserver.v1.BlogService.Login:output_type -> apiserver.v1.LoginResponse
+	73,  // 76: apiserver.v1.BlogService.RefreshToken:output_type -> apiserver.v1.RefreshTokenResponse
+	74,  // 77: apiserver.v1.BlogService.CreateUser:output_type -> apiserver.v1.CreateUserResponse
+	75,  // 78: apiserver.v1.BlogService.GetUser:output_type -> apiserver.v1.GetUserResponse
+	76,  // 79: apiserver.v1.BlogService.UpdateUser:output_type -> apiserver.v1.UpdateUserResponse
+	77,  // 80: apiserver.v1.BlogService.DeleteUser:output_type -> apiserver.v1.DeleteUserResponse
+	78,  // 81: apiserver.v1.BlogService.ListUsers:output_type -> apiserver.v1.ListUserResponse
+	79,  // 82: apiserver.v1.BlogService.CreateMenu:output_type -> apiserver.v1.CreateMenuResponse
+	80,  // 83: apiserver.v1.BlogService.GetMenu:output_type -> apiserver.v1.GetMenuResponse
+	81,  // 84: apiserver.v1.BlogService.UpdateMenu:output_type -> apiserver.v1.UpdateMenuResponse
+	82,  // 85: apiserver.v1.BlogService.DeleteMenu:output_type -> apiserver.v1.DeleteMenuResponse
+	83,  // 86: apiserver.v1.BlogService.ListMenus:output_type -> apiserver.v1.ListMenuResponse
+	84,  // 87: apiserver.v1.BlogService.ListDeletedMenus:output_type -> apiserver.v1.ListDeletedMenusResponse
+	85,  // 88: apiserver.v1.BlogService.RestoreMenu:output_type -> apiserver.v1.RestoreMenuResponse
+	86,  // 89: apiserver.v1.BlogService.ListMenuTree:output_type -> apiserver.v1.ListMenuTreeResponse
+	87,  // 90: apiserver.v1.BlogService.GetUserMenuTree:output_type -> apiserver.v1.GetUserMenuTreeResponse
+	88,  // 91: apiserver.v1.BlogService.CreatePermission:output_type -> apiserver.v1.CreatePermissionResponse
+	89,  // 92: apiserver.v1.BlogService.GetPermission:output_type -> apiserver.v1.GetPermissionResponse
+	90,  // 93: apiserver.v1.BlogService.UpdatePermission:output_type -> apiserver.v1.UpdatePermissionResponse
+	91,  // 94: apiserver.v1.BlogService.DeletePermission:output_type -> apiserver.v1.DeletePermissionResponse
+	92,  // 95: apiserver.v1.BlogService.ListPermissions:output_type -> apiserver.v1.ListPermissionResponse
+	93,  // 96: apiserver.v1.BlogService.ListDeletedPermissions:output_type -> apiserver.v1.ListDeletedPermissionsResponse
+	94,  // 97: apiserver.v1.BlogService.RestorePermission:output_type -> apiserver.v1.RestorePermissionResponse
+	95,  // 98: apiserver.v1.BlogService.ListPermissionTree:output_type -> apiserver.v1.ListPermissionTreeResponse
+	96,  // 99: apiserver.v1.BlogService.GetAPICatalog:output_type -> apiserver.v1.GetAPICatalogResponse
+	97,  // 100: apiserver.v1.BlogService.CreateRole:output_type -> apiserver.v1.CreateRoleResponse
+	98,  // 101: apiserver.v1.BlogService.GetRole:output_type -> apiserver.v1.GetRoleResponse
+	99,  // 102: apiserver.v1.BlogService.UpdateRole:output_type -> apiserver.v1.UpdateRoleResponse
+	100, // 103: apiserver.v1.BlogService.DeleteRole:output_type -> apiserver.v1.DeleteRoleResponse
+	101, // 104: apiserver.v1.BlogService.ListRoles:output_type -> apiserver.v1.ListRoleResponse
+	102, // 105: apiserver.v1.BlogService.ListDeletedRoles:output_type -> apiserver.v1.ListDeletedRolesResponse
+	103, // 106: apiserver.v1.BlogService.RestoreRole:output_type -> apiserver.v1.RestoreRoleResponse
+	104, // 107: apiserver.v1.BlogService.AssignPermissionsToRole:output_type -> apiserver.v1.AssignPermissionsToRoleResponse
+	105, // 108: apiserver.v1.BlogService.GetRolePermissions:output_type -> apiserver.v1.GetRolePermissionsResponse
+	106, // 109: apiserver.v1.BlogService.AssignRolesToUser:output_type -> apiserver.v1.AssignRolesToUserResponse
+	107, // 110: apiserver.v1.BlogService.GetUserRoles:output_type -> apiserver.v1.GetUserRolesResponse
+	108, // 111: apiserver.v1.BlogService.RemoveRoleFromUser:output_type -> apiserver.v1.RemoveRoleFromUserResponse
+	109, // 112: apiserver.v1.BlogService.CreateAccessRequest:output_type -> apiserver.v1.CreateAccessRequestResponse
+	110, // 113: apiserver.v1.BlogService.ListAccessRequests:output_type -> apiserver.v1.ListAccessRequestsResponse
+	111, // 114: apiserver.v1.BlogService.GetAccessRequest:output_type -> apiserver.v1.GetAccessRequestResponse
+	112, // 115: apiserver.v1.BlogService.ApproveAccessRequest:output_type -> apiserver.v1.ApproveAccessRequestResponse
+	113, // 116: apiserver.v1.BlogService.RejectAccessRequest:output_type -> apiserver.v1.RejectAccessRequestResponse
+	114, // 117: apiserver.v1.BlogService.CreateSoDRule:output_type -> apiserver.v1.CreateSoDRuleResponse
+	115, // 118: apiserver.v1.BlogService.ListSoDRules:output_type -> apiserver.v1.ListSoDRulesResponse
+	116, // 119: apiserver.v1.BlogService.UpdateSoDRule:output_type -> apiserver.v1.UpdateSoDRuleResponse
+	117, // 120: apiserver.v1.BlogService.DeleteSoDRule:output_type -> apiserver.v1.DeleteSoDRuleResponse
+	118, // 121: apiserver.v1.BlogService.ListSoDViolations:output_type -> apiserver.v1.ListSoDViolationsResponse
+	119, // 122: apiserver.v1.BlogService.ListSessions:output_type -> apiserver.v1.ListSessionsResponse
+	120, // 123: apiserver.v1.BlogService.RevokeSession:output_type -> apiserver.v1.RevokeSessionResponse
+	121, // 124: apiserver.v1.BlogService.RevokeOtherSessions:output_type -> apiserver.v1.RevokeOtherSessionsResponse
+	122, // 125: apiserver.v1.BlogService.TerminateUserSessions:output_type -> apiserver.v1.TerminateUserSessionsResponse
+	74,  // 126: apiserver.v1.BlogService.AdminCreateUser:output_type -> apiserver.v1.CreateUserResponse
+	78,  // 127: apiserver.v1.BlogService.AdminListUsers:output_type -> apiserver.v1.ListUserResponse
+	123, // 128: apiserver.v1.BlogService.ListDeletedUsers:output_type -> apiserver.v1.ListDeletedUsersResponse
+	124, // 129: apiserver.v1.BlogService.RestoreUser:output_type -> apiserver.v1.RestoreUserResponse
+	75,  // 130: apiserver.v1.BlogService.AdminGetUser:output_type -> apiserver.v1.GetUserResponse
+	125, // 131: apiserver.v1.BlogService.AdminUpdateUser:output_type -> apiserver.v1.AdminUpdateUserResponse
+	126, // 132: apiserver.v1.BlogService.UpdateUserStatus:output_type -> apiserver.v1.UpdateUserStatusResponse
+	127, // 133: apiserver.v1.BlogService.ResetUserPassword:output_type -> apiserver.v1.ResetUserPasswordResponse
+	128, // 134: apiserver.v1.BlogService.ImpersonateUser:output_type -> apiserver.v1.ImpersonateUserResponse
+	129, // 135: apiserver.v1.BlogService.GetRegistrationPolicy:output_type -> apiserver.v1.GetRegistrationPolicyResponse
+	130, // 136: apiserver.v1.BlogService.CreateInvitation:output_type -> apiserver.v1.CreateInvitationResponse
+	131, // 137: apiserver.v1.BlogService.ListInvitation:output_type -> apiserver.v1.ListInvitationResponse
+	132, // 138: apiserver.v1.BlogService.RevokeInvitation:output_type -> apiserver.v1.RevokeInvitationResponse
+	133, // 139: apiserver.v1.BlogService.ListPendingRegistrations:output_type -> apiserver.v1.ListPendingRegistrationsResponse
+	134, // 140: apiserver.v1.BlogService.ApproveRegistration:output_type -> apiserver.v1.ApproveRegistrationResponse
+	135, // 141: apiserver.v1.BlogService.RejectRegistration:output_type -> apiserver.v1.RejectRegistrationResponse
+	136, // 142: apiserver.v1.BlogService.ListOIDCProviders:output_type -> apiserver.v1.ListOIDCProvidersResponse
+	137, // 143: apiserver.v1.BlogService.StartOIDCLogin:output_type -> apiserver.v1.StartOIDCLoginResponse
+	138, // 144: apiserver.v1.BlogService.OIDCCallback:output_type -> apiserver.v1.OIDCCallbackResponse
+	139, // 145: apiserver.v1.BlogService.ListUserIdentities:output_type -> apiserver.v1.ListUserIdentitiesResponse
+	137, // 146: apiserver.v1.BlogService.LinkUserIdentity:output_type -> apiserver.v1.StartOIDCLoginResponse
+	140, // 147: apiserver.v1.BlogService.UnlinkUserIdentity:output_type -> apiserver.v1.UnlinkUserIdentityResponse
+	74,  // [74:148] is the sub-list for method output_type
+	0,   // [0:74] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_apiserver_proto_init() }
@@ -257,6 +508,12 @@ func file_apiserver_v1_apiserver_proto_init() {
 	file_apiserver_v1_permission_proto_init()
 	file_apiserver_v1_role_proto_init()
 	file_apiserver_v1_user_role_proto_init()
+	file_apiserver_v1_session_proto_init()
+	file_apiserver_v1_admin_user_proto_init()
+	file_apiserver_v1_invitation_proto_init()
+	file_apiserver_v1_oidc_proto_init()
+	file_apiserver_v1_access_request_proto_init()
+	file_apiserver_v1_sod_rule_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -20,37 +20,80 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BlogService_Healthz_FullMethodName                 = "/apiserver.v1.BlogService/Healthz"
-	BlogService_Login_FullMethodName                   = "/apiserver.v1.BlogService/Login"
-	BlogService_RefreshToken_FullMethodName            = "/apiserver.v1.BlogService/RefreshToken"
-	BlogService_CreateUser_FullMethodName              = "/apiserver.v1.BlogService/CreateUser"
-	BlogService_GetUser_FullMethodName                 = "/apiserver.v1.BlogService/GetUser"
-	BlogService_UpdateUser_FullMethodName              = "/apiserver.v1.BlogService/UpdateUser"
-	BlogService_DeleteUser_FullMethodName              = "/apiserver.v1.BlogService/DeleteUser"
-	BlogService_ListUsers_FullMethodName               = "/apiserver.v1.BlogService/ListUsers"
-	BlogService_CreateMenu_FullMethodName              = "/apiserver.v1.BlogService/CreateMenu"
-	BlogService_GetMenu_FullMethodName                 = "/apiserver.v1.BlogService/GetMenu"
-	BlogService_UpdateMenu_FullMethodName              = "/apiserver.v1.BlogService/UpdateMenu"
-	BlogService_DeleteMenu_FullMethodName              = "/apiserver.v1.BlogService/DeleteMenu"
-	BlogService_ListMenus_FullMethodName               = "/apiserver.v1.BlogService/ListMenus"
-	BlogService_ListMenuTree_FullMethodName            = "/apiserver.v1.BlogService/ListMenuTree"
-	BlogService_GetUserMenuTree_FullMethodName         = "/apiserver.v1.BlogService/GetUserMenuTree"
-	BlogService_CreatePermission_FullMethodName        = "/apiserver.v1.BlogService/CreatePermission"
-	BlogService_GetPermission_FullMethodName           = "/apiserver.v1.BlogService/GetPermission"
-	BlogService_UpdatePermission_FullMethodName        = "/apiserver.v1.BlogService/UpdatePermission"
-	BlogService_DeletePermission_FullMethodName        = "/apiserver.v1.BlogService/DeletePermission"
-	BlogService_ListPermissions_FullMethodName         = "/apiserver.v1.BlogService/ListPermissions"
-	BlogService_ListPermissionTree_FullMethodName      = "/apiserver.v1.BlogService/ListPermissionTree"
-	BlogService_CreateRole_FullMethodName              = "/apiserver.v1.BlogService/CreateRole"
-	BlogService_GetRole_FullMethodName                 = "/apiserver.v1.BlogService/GetRole"
-	BlogService_UpdateRole_FullMethodName              = "/apiserver.v1.BlogService/UpdateRole"
-	BlogService_DeleteRole_FullMethodName              = "/apiserver.v1.BlogService/DeleteRole"
-	BlogService_ListRoles_FullMethodName               = "/apiserver.v1.BlogService/ListRoles"
-	BlogService_AssignPermissionsToRole_FullMethodName = "/apiserver.v1.BlogService/AssignPermissionsToRole"
-	BlogService_GetRolePermissions_FullMethodName      = "/apiserver.v1.BlogService/GetRolePermissions"
-	BlogService_AssignRolesToUser_FullMethodName       = "/apiserver.v1.BlogService/AssignRolesToUser"
-	BlogService_GetUserRoles_FullMethodName            = "/apiserver.v1.BlogService/GetUserRoles"
-	BlogService_RemoveRoleFromUser_FullMethodName      = "/apiserver.v1.BlogService/RemoveRoleFromUser"
+	BlogService_Healthz_FullMethodName                  = "/apiserver.v1.BlogService/Healthz"
+	BlogService_Login_FullMethodName                    = "/apiserver.v1.BlogService/Login"
+	BlogService_RefreshToken_FullMethodName             = "/apiserver.v1.BlogService/RefreshToken"
+	BlogService_CreateUser_FullMethodName               = "/apiserver.v1.BlogService/CreateUser"
+	BlogService_GetUser_FullMethodName                  = "/apiserver.v1.BlogService/GetUser"
+	BlogService_UpdateUser_FullMethodName               = "/apiserver.v1.BlogService/UpdateUser"
+	BlogService_DeleteUser_FullMethodName               = "/apiserver.v1.BlogService/DeleteUser"
+	BlogService_ListUsers_FullMethodName                = "/apiserver.v1.BlogService/ListUsers"
+	BlogService_CreateMenu_FullMethodName               = "/apiserver.v1.BlogService/CreateMenu"
+	BlogService_GetMenu_FullMethodName                  = "/apiserver.v1.BlogService/GetMenu"
+	BlogService_UpdateMenu_FullMethodName               = "/apiserver.v1.BlogService/UpdateMenu"
+	BlogService_DeleteMenu_FullMethodName               = "/apiserver.v1.BlogService/DeleteMenu"
+	BlogService_ListMenus_FullMethodName                = "/apiserver.v1.BlogService/ListMenus"
+	BlogService_ListDeletedMenus_FullMethodName         = "/apiserver.v1.BlogService/ListDeletedMenus"
+	BlogService_RestoreMenu_FullMethodName              = "/apiserver.v1.BlogService/RestoreMenu"
+	BlogService_ListMenuTree_FullMethodName             = "/apiserver.v1.BlogService/ListMenuTree"
+	BlogService_GetUserMenuTree_FullMethodName          = "/apiserver.v1.BlogService/GetUserMenuTree"
+	BlogService_CreatePermission_FullMethodName         = "/apiserver.v1.BlogService/CreatePermission"
+	BlogService_GetPermission_FullMethodName            = "/apiserver.v1.BlogService/GetPermission"
+	BlogService_UpdatePermission_FullMethodName         = "/apiserver.v1.BlogService/UpdatePermission"
+	BlogService_DeletePermission_FullMethodName         = "/apiserver.v1.BlogService/DeletePermission"
+	BlogService_ListPermissions_FullMethodName          = "/apiserver.v1.BlogService/ListPermissions"
+	BlogService_ListDeletedPermissions_FullMethodName   = "/apiserver.v1.BlogService/ListDeletedPermissions"
+	BlogService_RestorePermission_FullMethodName        = "/apiserver.v1.BlogService/RestorePermission"
+	BlogService_ListPermissionTree_FullMethodName       = "/apiserver.v1.BlogService/ListPermissionTree"
+	BlogService_GetAPICatalog_FullMethodName            = "/apiserver.v1.BlogService/GetAPICatalog"
+	BlogService_CreateRole_FullMethodName               = "/apiserver.v1.BlogService/CreateRole"
+	BlogService_GetRole_FullMethodName                  = "/apiserver.v1.BlogService/GetRole"
+	BlogService_UpdateRole_FullMethodName               = "/apiserver.v1.BlogService/UpdateRole"
+	BlogService_DeleteRole_FullMethodName               = "/apiserver.v1.BlogService/DeleteRole"
+	BlogService_ListRoles_FullMethodName                = "/apiserver.v1.BlogService/ListRoles"
+	BlogService_ListDeletedRoles_FullMethodName         = "/apiserver.v1.BlogService/ListDeletedRoles"
+	BlogService_RestoreRole_FullMethodName              = "/apiserver.v1.BlogService/RestoreRole"
+	BlogService_AssignPermissionsToRole_FullMethodName  = "/apiserver.v1.BlogService/AssignPermissionsToRole"
+	BlogService_GetRolePermissions_FullMethodName       = "/apiserver.v1.BlogService/GetRolePermissions"
+	BlogService_AssignRolesToUser_FullMethodName        = "/apiserver.v1.BlogService/AssignRolesToUser"
+	BlogService_GetUserRoles_FullMethodName             = "/apiserver.v1.BlogService/GetUserRoles"
+	BlogService_RemoveRoleFromUser_FullMethodName       = "/apiserver.v1.BlogService/RemoveRoleFromUser"
+	BlogService_CreateAccessRequest_FullMethodName      = "/apiserver.v1.BlogService/CreateAccessRequest"
+	BlogService_ListAccessRequests_FullMethodName       = "/apiserver.v1.BlogService/ListAccessRequests"
+	BlogService_GetAccessRequest_FullMethodName         = "/apiserver.v1.BlogService/GetAccessRequest"
+	BlogService_ApproveAccessRequest_FullMethodName     = "/apiserver.v1.BlogService/ApproveAccessRequest"
+	BlogService_RejectAccessRequest_FullMethodName      = "/apiserver.v1.BlogService/RejectAccessRequest"
+	BlogService_CreateSoDRule_FullMethodName            = "/apiserver.v1.BlogService/CreateSoDRule"
+	BlogService_ListSoDRules_FullMethodName             = "/apiserver.v1.BlogService/ListSoDRules"
+	BlogService_UpdateSoDRule_FullMethodName            = "/apiserver.v1.BlogService/UpdateSoDRule"
+	BlogService_DeleteSoDRule_FullMethodName            = "/apiserver.v1.BlogService/DeleteSoDRule"
+	BlogService_ListSoDViolations_FullMethodName        = "/apiserver.v1.BlogService/ListSoDViolations"
+	BlogService_ListSessions_FullMethodName             = "/apiserver.v1.BlogService/ListSessions"
+	BlogService_RevokeSession_FullMethodName            = "/apiserver.v1.BlogService/RevokeSession"
+	BlogService_RevokeOtherSessions_FullMethodName      = "/apiserver.v1.BlogService/RevokeOtherSessions"
+	BlogService_TerminateUserSessions_FullMethodName    = "/apiserver.v1.BlogService/TerminateUserSessions"
+	BlogService_AdminCreateUser_FullMethodName          = "/apiserver.v1.BlogService/AdminCreateUser"
+	BlogService_AdminListUsers_FullMethodName           = "/apiserver.v1.BlogService/AdminListUsers"
+	BlogService_ListDeletedUsers_FullMethodName         = "/apiserver.v1.BlogService/ListDeletedUsers"
+	BlogService_RestoreUser_FullMethodName              = "/apiserver.v1.BlogService/RestoreUser"
+	BlogService_AdminGetUser_FullMethodName             = "/apiserver.v1.BlogService/AdminGetUser"
+	BlogService_AdminUpdateUser_FullMethodName          = "/apiserver.v1.BlogService/AdminUpdateUser"
+	BlogService_UpdateUserStatus_FullMethodName         = "/apiserver.v1.BlogService/UpdateUserStatus"
+	BlogService_ResetUserPassword_FullMethodName        = "/apiserver.v1.BlogService/ResetUserPassword"
+	BlogService_ImpersonateUser_FullMethodName          = "/apiserver.v1.BlogService/ImpersonateUser"
+	BlogService_GetRegistrationPolicy_FullMethodName    = "/apiserver.v1.BlogService/GetRegistrationPolicy"
+	BlogService_CreateInvitation_FullMethodName         = "/apiserver.v1.BlogService/CreateInvitation"
+	BlogService_ListInvitation_FullMethodName           = "/apiserver.v1.BlogService/ListInvitation"
+	BlogService_RevokeInvitation_FullMethodName         = "/apiserver.v1.BlogService/RevokeInvitation"
+	BlogService_ListPendingRegistrations_FullMethodName = "/apiserver.v1.BlogService/ListPendingRegistrations"
+	BlogService_ApproveRegistration_FullMethodName      = "/apiserver.v1.BlogService/ApproveRegistration"
+	BlogService_RejectRegistration_FullMethodName       = "/apiserver.v1.BlogService/RejectRegistration"
+	BlogService_ListOIDCProviders_FullMethodName        = "/apiserver.v1.BlogService/ListOIDCProviders"
+	BlogService_StartOIDCLogin_FullMethodName           = "/apiserver.v1.BlogService/StartOIDCLogin"
+	BlogService_OIDCCallback_FullMethodName             = "/apiserver.v1.BlogService/OIDCCallback"
+	BlogService_ListUserIdentities_FullMethodName       = "/apiserver.v1.BlogService/ListUserIdentities"
+	BlogService_LinkUserIdentity_FullMethodName         = "/apiserver.v1.BlogService/LinkUserIdentity"
+	BlogService_UnlinkUserIdentity_FullMethodName       = "/apiserver.v1.BlogService/UnlinkUserIdentity"
 )
 
 // BlogServiceClient is the client API for BlogService service.
@@ -84,6 +127,10 @@ type BlogServiceClient interface {
 	DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*DeleteMenuResponse, error)
 	// 列表菜单
 	ListMenus(ctx context.Context, in *ListMenuRequest, opts ...grpc.CallOption) (*ListMenuResponse, error)
+	// 获取回收站菜单列表
+	ListDeletedMenus(ctx context.Context, in *ListDeletedMenusRequest, opts ...grpc.CallOption) (*ListDeletedMenusResponse, error)
+	// 恢复菜单
+	RestoreMenu(ctx context.Context, in *RestoreMenuRequest, opts ...grpc.CallOption) (*RestoreMenuResponse, error)
 	// 列表菜单树
 	ListMenuTree(ctx context.Context, in *ListMenuTreeRequest, opts ...grpc.CallOption) (*ListMenuTreeResponse, error)
 	// 获取用户菜单树
@@ -99,8 +146,14 @@ type BlogServiceClient interface {
 	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error)
 	// 列表权限
 	ListPermissions(ctx context.Context, in *ListPermissionRequest, opts ...grpc.CallOption) (*ListPermissionResponse, error)
+	// 获取回收站权限列表
+	ListDeletedPermissions(ctx context.Context, in *ListDeletedPermissionsRequest, opts ...grpc.CallOption) (*ListDeletedPermissionsResponse, error)
+	// 恢复权限
+	RestorePermission(ctx context.Context, in *RestorePermissionRequest, opts ...grpc.CallOption) (*RestorePermissionResponse, error)
 	// 列表权限树
 	ListPermissionTree(ctx context.Context, in *ListPermissionTreeRequest, opts ...grpc.CallOption) (*ListPermissionTreeResponse, error)
+	// 获取 API 路由目录
+	GetAPICatalog(ctx context.Context, in *GetAPICatalogRequest, opts ...grpc.CallOption) (*GetAPICatalogResponse, error)
 	// ========== 角色管理 ==========
 	// 创建角色
 	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
@@ -112,6 +165,10 @@ type BlogServiceClient interface {
 	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
 	// 列表角色
 	ListRoles(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error)
+	// 获取回收站角色列表
+	ListDeletedRoles(ctx context.Context, in *ListDeletedRolesRequest, opts ...grpc.CallOption) (*ListDeletedRolesResponse, error)
+	// 恢复角色
+	RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...grpc.CallOption) (*RestoreRoleResponse, error)
 	// 给角色分配权限
 	AssignPermissionsToRole(ctx context.Context, in *AssignPermissionsToRoleRequest, opts ...grpc.CallOption) (*AssignPermissionsToRoleResponse, error)
 	// 获取角色权限
@@ -123,6 +180,84 @@ type BlogServiceClient interface {
 	GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
 	// 从用户移除角色
 	RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, opts ...grpc.CallOption) (*RemoveRoleFromUserResponse, error)
+	// ========== 授权申请 ==========
+	// 创建授权申请
+	CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error)
+	// 获取授权申请列表
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	// 获取授权申请详情
+	GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error)
+	// 批准授权申请
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
+	// 拒绝授权申请
+	RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*RejectAccessRequestResponse, error)
+	// ========== 职责分离 ==========
+	// 创建职责分离规则
+	CreateSoDRule(ctx context.Context, in *CreateSoDRuleRequest, opts ...grpc.CallOption) (*CreateSoDRuleResponse, error)
+	// 获取职责分离规则列表
+	ListSoDRules(ctx context.Context, in *ListSoDRulesRequest, opts ...grpc.CallOption) (*ListSoDRulesResponse, error)
+	// 更新职责分离规则
+	UpdateSoDRule(ctx context.Context, in *UpdateSoDRuleRequest, opts ...grpc.CallOption) (*UpdateSoDRuleResponse, error)
+	// 删除职责分离规则
+	DeleteSoDRule(ctx context.Context, in *DeleteSoDRuleRequest, opts ...grpc.CallOption) (*DeleteSoDRuleResponse, error)
+	// 获取职责分离违规报告
+	ListSoDViolations(ctx context.Context, in *ListSoDViolationsRequest, opts ...grpc.CallOption) (*ListSoDViolationsResponse, error)
+	// ========== 会话管理 ==========
+	// 获取用户会话列表
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// 撤销单个会话
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// 撤销除当前会话外的其他会话
+	RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherSessionsResponse, error)
+	// 管理员终止指定用户的全部会话
+	TerminateUserSessions(ctx context.Context, in *TerminateUserSessionsRequest, opts ...grpc.CallOption) (*TerminateUserSessionsResponse, error)
+	// ========== 用户管理（管理员） ==========
+	// 管理员创建用户
+	AdminCreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	// 管理员获取用户列表
+	AdminListUsers(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
+	// 获取回收站用户列表
+	ListDeletedUsers(ctx context.Context, in *ListDeletedUsersRequest, opts ...grpc.CallOption) (*ListDeletedUsersResponse, error)
+	// 恢复用户
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	// 管理员获取用户详情
+	AdminGetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// 管理员更新用户信息
+	AdminUpdateUser(ctx context.Context, in *AdminUpdateUserRequest, opts ...grpc.CallOption) (*AdminUpdateUserResponse, error)
+	// 启用/禁用用户
+	UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UpdateUserStatusResponse, error)
+	// 重置用户密码
+	ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...grpc.CallOption) (*ResetUserPasswordResponse, error)
+	// 模拟登录用户
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error)
+	// ========== 注册管理 ==========
+	// 获取注册策略
+	GetRegistrationPolicy(ctx context.Context, in *GetRegistrationPolicyRequest, opts ...grpc.CallOption) (*GetRegistrationPolicyResponse, error)
+	// 创建邀请码
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	// 获取邀请码列表
+	ListInvitation(ctx context.Context, in *ListInvitationRequest, opts ...grpc.CallOption) (*ListInvitationResponse, error)
+	// 撤销邀请码
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error)
+	// 获取待审核注册列表
+	ListPendingRegistrations(ctx context.Context, in *ListPendingRegistrationsRequest, opts ...grpc.CallOption) (*ListPendingRegistrationsResponse, error)
+	// 审核通过注册
+	ApproveRegistration(ctx context.Context, in *ApproveRegistrationRequest, opts ...grpc.CallOption) (*ApproveRegistrationResponse, error)
+	// 拒绝注册
+	RejectRegistration(ctx context.Context, in *RejectRegistrationRequest, opts ...grpc.CallOption) (*RejectRegistrationResponse, error)
+	// ========== 单点登录 ==========
+	// 获取单点登录 Provider 列表
+	ListOIDCProviders(ctx context.Context, in *ListOIDCProvidersRequest, opts ...grpc.CallOption) (*ListOIDCProvidersResponse, error)
+	// 发起单点登录
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	// 单点登录回调
+	OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*OIDCCallbackResponse, error)
+	// 获取外部身份列表
+	ListUserIdentities(ctx context.Context, in *ListUserIdentitiesRequest, opts ...grpc.CallOption) (*ListUserIdentitiesResponse, error)
+	// 关联外部身份
+	LinkUserIdentity(ctx context.Context, in *LinkUserIdentityRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	// 解除外部身份关联
+	UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkUserIdentityResponse, error)
 }
 
 type blogServiceClient struct {
@@ -263,6 +398,26 @@ func (c *blogServiceClient) ListMenus(ctx context.Context, in *ListMenuRequest,
 	return out, nil
 }
 
+func (c *blogServiceClient) ListDeletedMenus(ctx context.Context, in *ListDeletedMenusRequest, opts ...grpc.CallOption) (*ListDeletedMenusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedMenusResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListDeletedMenus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RestoreMenu(ctx context.Context, in *RestoreMenuRequest, opts ...grpc.CallOption) (*RestoreMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreMenuResponse)
+	err := c.cc.Invoke(ctx, BlogService_RestoreMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) ListMenuTree(ctx context.Context, in *ListMenuTreeRequest, opts ...grpc.CallOption) (*ListMenuTreeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMenuTreeResponse)
@@ -333,6 +488,26 @@ func (c *blogServiceClient) ListPermissions(ctx context.Context, in *ListPermiss
 	return out, nil
 }
 
+func (c *blogServiceClient) ListDeletedPermissions(ctx context.Context, in *ListDeletedPermissionsRequest, opts ...grpc.CallOption) (*ListDeletedPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedPermissionsResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListDeletedPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RestorePermission(ctx context.Context, in *RestorePermissionRequest, opts ...grpc.CallOption) (*RestorePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePermissionResponse)
+	err := c.cc.Invoke(ctx, BlogService_RestorePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) ListPermissionTree(ctx context.Context, in *ListPermissionTreeRequest, opts ...grpc.CallOption) (*ListPermissionTreeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPermissionTreeResponse)
@@ -343,6 +518,16 @@ func (c *blogServiceClient) ListPermissionTree(ctx context.Context, in *ListPerm
 	return out, nil
 }
 
+func (c *blogServiceClient) GetAPICatalog(ctx context.Context, in *GetAPICatalogRequest, opts ...grpc.CallOption) (*GetAPICatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAPICatalogResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetAPICatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRoleResponse)
@@ -393,6 +578,26 @@ func (c *blogServiceClient) ListRoles(ctx context.Context, in *ListRoleRequest,
 	return out, nil
 }
 
+func (c *blogServiceClient) ListDeletedRoles(ctx context.Context, in *ListDeletedRolesRequest, opts ...grpc.CallOption) (*ListDeletedRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedRolesResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListDeletedRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...grpc.CallOption) (*RestoreRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRoleResponse)
+	err := c.cc.Invoke(ctx, BlogService_RestoreRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) AssignPermissionsToRole(ctx context.Context, in *AssignPermissionsToRoleRequest, opts ...grpc.CallOption) (*AssignPermissionsToRoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AssignPermissionsToRoleResponse)
@@ -443,754 +648,2109 @@ func (c *blogServiceClient) RemoveRoleFromUser(ctx context.Context, in *RemoveRo
 	return out, nil
 }
 
-// BlogServiceServer is the server API for BlogService service.
-// All implementations must embed UnimplementedBlogServiceServer
-// for forward compatibility.
-type BlogServiceServer interface {
-	// 健康检查
-	Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error)
-	// 用户登录
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// 刷新令牌
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	// 创建用户
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	// 获取用户
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	// 更新用户
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	// 删除用户
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	// 列表用户
-	ListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error)
-	// ========== 菜单管理 ==========
-	// 创建菜单
-	CreateMenu(context.Context, *CreateMenuRequest) (*CreateMenuResponse, error)
-	// 获取菜单
-	GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error)
-	// 更新菜单
-	UpdateMenu(context.Context, *UpdateMenuRequest) (*UpdateMenuResponse, error)
-	// 删除菜单
-	DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteMenuResponse, error)
-	// 列表菜单
-	ListMenus(context.Context, *ListMenuRequest) (*ListMenuResponse, error)
-	// 列表菜单树
-	ListMenuTree(context.Context, *ListMenuTreeRequest) (*ListMenuTreeResponse, error)
-	// 获取用户菜单树
-	GetUserMenuTree(context.Context, *GetUserMenuTreeRequest) (*GetUserMenuTreeResponse, error)
-	// ========== 权限管理 ==========
-	// 创建权限
-	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
-	// 获取权限
-	GetPermission(context.Context, *GetPermissionRequest) (*GetPermissionResponse, error)
-	// 更新权限
-	UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error)
-	// 删除权限
-	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
-	// 列表权限
-	ListPermissions(context.Context, *ListPermissionRequest) (*ListPermissionResponse, error)
-	// 列表权限树
-	ListPermissionTree(context.Context, *ListPermissionTreeRequest) (*ListPermissionTreeResponse, error)
-	// ========== 角色管理 ==========
-	// 创建角色
-	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
-	// 获取角色
-	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
-	// 更新角色
-	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
-	// 删除角色
-	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
-	// 列表角色
-	ListRoles(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
-	// 给角色分配权限
-	AssignPermissionsToRole(context.Context, *AssignPermissionsToRoleRequest) (*AssignPermissionsToRoleResponse, error)
-	// 获取角色权限
-	GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error)
-	// ========== 用户角色管理 ==========
-	// 给用户分配角色
-	AssignRolesToUser(context.Context, *AssignRolesToUserRequest) (*AssignRolesToUserResponse, error)
-	// 获取用户角色
-	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
-	// 从用户移除角色
-	RemoveRoleFromUser(context.Context, *RemoveRoleFromUserRequest) (*RemoveRoleFromUserResponse, error)
-	mustEmbedUnimplementedBlogServiceServer()
+func (c *blogServiceClient) CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessRequestResponse)
+	err := c.cc.Invoke(ctx, BlogService_CreateAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedBlogServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBlogServiceServer struct{}
-
-func (UnimplementedBlogServiceServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Healthz not implemented")
-}
-func (UnimplementedBlogServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+func (c *blogServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListAccessRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (c *blogServiceClient) GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccessRequestResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
+
+func (c *blogServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAccessRequestResponse)
+	err := c.cc.Invoke(ctx, BlogService_ApproveAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *blogServiceClient) RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*RejectAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectAccessRequestResponse)
+	err := c.cc.Invoke(ctx, BlogService_RejectAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *blogServiceClient) CreateSoDRule(ctx context.Context, in *CreateSoDRuleRequest, opts ...grpc.CallOption) (*CreateSoDRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSoDRuleResponse)
+	err := c.cc.Invoke(ctx, BlogService_CreateSoDRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *blogServiceClient) ListSoDRules(ctx context.Context, in *ListSoDRulesRequest, opts ...grpc.CallOption) (*ListSoDRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSoDRulesResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListSoDRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) ListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+
+func (c *blogServiceClient) UpdateSoDRule(ctx context.Context, in *UpdateSoDRuleRequest, opts ...grpc.CallOption) (*UpdateSoDRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSoDRuleResponse)
+	err := c.cc.Invoke(ctx, BlogService_UpdateSoDRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) CreateMenu(context.Context, *CreateMenuRequest) (*CreateMenuResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateMenu not implemented")
+
+func (c *blogServiceClient) DeleteSoDRule(ctx context.Context, in *DeleteSoDRuleRequest, opts ...grpc.CallOption) (*DeleteSoDRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSoDRuleResponse)
+	err := c.cc.Invoke(ctx, BlogService_DeleteSoDRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMenu not implemented")
+
+func (c *blogServiceClient) ListSoDViolations(ctx context.Context, in *ListSoDViolationsRequest, opts ...grpc.CallOption) (*ListSoDViolationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSoDViolationsResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListSoDViolations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) UpdateMenu(context.Context, *UpdateMenuRequest) (*UpdateMenuResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateMenu not implemented")
+
+func (c *blogServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteMenuResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteMenu not implemented")
+
+func (c *blogServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, BlogService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) ListMenus(context.Context, *ListMenuRequest) (*ListMenuResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListMenus not implemented")
+
+func (c *blogServiceClient) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeOtherSessionsResponse)
+	err := c.cc.Invoke(ctx, BlogService_RevokeOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) ListMenuTree(context.Context, *ListMenuTreeRequest) (*ListMenuTreeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListMenuTree not implemented")
+
+func (c *blogServiceClient) TerminateUserSessions(ctx context.Context, in *TerminateUserSessionsRequest, opts ...grpc.CallOption) (*TerminateUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TerminateUserSessionsResponse)
+	err := c.cc.Invoke(ctx, BlogService_TerminateUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetUserMenuTree(context.Context, *GetUserMenuTreeRequest) (*GetUserMenuTreeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetUserMenuTree not implemented")
+
+func (c *blogServiceClient) AdminCreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, BlogService_AdminCreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreatePermission not implemented")
+
+func (c *blogServiceClient) AdminListUsers(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserResponse)
+	err := c.cc.Invoke(ctx, BlogService_AdminListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetPermission(context.Context, *GetPermissionRequest) (*GetPermissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetPermission not implemented")
+
+func (c *blogServiceClient) ListDeletedUsers(ctx context.Context, in *ListDeletedUsersRequest, opts ...grpc.CallOption) (*ListDeletedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedUsersResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListDeletedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdatePermission not implemented")
+
+func (c *blogServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, BlogService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeletePermission not implemented")
+
+func (c *blogServiceClient) AdminGetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, BlogService_AdminGetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) ListPermissions(context.Context, *ListPermissionRequest) (*ListPermissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListPermissions not implemented")
+
+func (c *blogServiceClient) AdminUpdateUser(ctx context.Context, in *AdminUpdateUserRequest, opts ...grpc.CallOption) (*AdminUpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateUserResponse)
+	err := c.cc.Invoke(ctx, BlogService_AdminUpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) ListPermissionTree(context.Context, *ListPermissionTreeRequest) (*ListPermissionTreeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListPermissionTree not implemented")
+
+func (c *blogServiceClient) UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UpdateUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserStatusResponse)
+	err := c.cc.Invoke(ctx, BlogService_UpdateUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateRole not implemented")
+
+func (c *blogServiceClient) ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...grpc.CallOption) (*ResetUserPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetUserPasswordResponse)
+	err := c.cc.Invoke(ctx, BlogService_ResetUserPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetRole not implemented")
+
+func (c *blogServiceClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateUserResponse)
+	err := c.cc.Invoke(ctx, BlogService_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateRole not implemented")
+
+func (c *blogServiceClient) GetRegistrationPolicy(ctx context.Context, in *GetRegistrationPolicyRequest, opts ...grpc.CallOption) (*GetRegistrationPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegistrationPolicyResponse)
+	err := c.cc.Invoke(ctx, BlogService_GetRegistrationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteRole not implemented")
+
+func (c *blogServiceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, BlogService_CreateInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) ListRoles(context.Context, *ListRoleRequest) (*ListRoleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListRoles not implemented")
+
+func (c *blogServiceClient) ListInvitation(ctx context.Context, in *ListInvitationRequest, opts ...grpc.CallOption) (*ListInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) AssignPermissionsToRole(context.Context, *AssignPermissionsToRoleRequest) (*AssignPermissionsToRoleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AssignPermissionsToRole not implemented")
+
+func (c *blogServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInvitationResponse)
+	err := c.cc.Invoke(ctx, BlogService_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetRolePermissions not implemented")
+
+func (c *blogServiceClient) ListPendingRegistrations(ctx context.Context, in *ListPendingRegistrationsRequest, opts ...grpc.CallOption) (*ListPendingRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingRegistrationsResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListPendingRegistrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) AssignRolesToUser(context.Context, *AssignRolesToUserRequest) (*AssignRolesToUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AssignRolesToUser not implemented")
+
+func (c *blogServiceClient) ApproveRegistration(ctx context.Context, in *ApproveRegistrationRequest, opts ...grpc.CallOption) (*ApproveRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRegistrationResponse)
+	err := c.cc.Invoke(ctx, BlogService_ApproveRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetUserRoles not implemented")
+
+func (c *blogServiceClient) RejectRegistration(ctx context.Context, in *RejectRegistrationRequest, opts ...grpc.CallOption) (*RejectRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectRegistrationResponse)
+	err := c.cc.Invoke(ctx, BlogService_RejectRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) RemoveRoleFromUser(context.Context, *RemoveRoleFromUserRequest) (*RemoveRoleFromUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveRoleFromUser not implemented")
+
+func (c *blogServiceClient) ListOIDCProviders(ctx context.Context, in *ListOIDCProvidersRequest, opts ...grpc.CallOption) (*ListOIDCProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOIDCProvidersResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListOIDCProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBlogServiceServer) mustEmbedUnimplementedBlogServiceServer() {}
-func (UnimplementedBlogServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeBlogServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BlogServiceServer will
-// result in compilation errors.
-type UnsafeBlogServiceServer interface {
+func (c *blogServiceClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, BlogService_StartOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*OIDCCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OIDCCallbackResponse)
+	err := c.cc.Invoke(ctx, BlogService_OIDCCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListUserIdentities(ctx context.Context, in *ListUserIdentitiesRequest, opts ...grpc.CallOption) (*ListUserIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserIdentitiesResponse)
+	err := c.cc.Invoke(ctx, BlogService_ListUserIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) LinkUserIdentity(ctx context.Context, in *LinkUserIdentityRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, BlogService_LinkUserIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkUserIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkUserIdentityResponse)
+	err := c.cc.Invoke(ctx, BlogService_UnlinkUserIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlogServiceServer is the server API for BlogService service.
+// All implementations must embed UnimplementedBlogServiceServer
+// for forward compatibility.
+type BlogServiceServer interface {
+	// 健康检查
+	Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error)
+	// 用户登录
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// 刷新令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// 创建用户
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// 获取用户
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// 更新用户
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// 删除用户
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// 列表用户
+	ListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	// ========== 菜单管理 ==========
+	// 创建菜单
+	CreateMenu(context.Context, *CreateMenuRequest) (*CreateMenuResponse, error)
+	// 获取菜单
+	GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error)
+	// 更新菜单
+	UpdateMenu(context.Context, *UpdateMenuRequest) (*UpdateMenuResponse, error)
+	// 删除菜单
+	DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteMenuResponse, error)
+	// 列表菜单
+	ListMenus(context.Context, *ListMenuRequest) (*ListMenuResponse, error)
+	// 获取回收站菜单列表
+	ListDeletedMenus(context.Context, *ListDeletedMenusRequest) (*ListDeletedMenusResponse, error)
+	// 恢复菜单
+	RestoreMenu(context.Context, *RestoreMenuRequest) (*RestoreMenuResponse, error)
+	// 列表菜单树
+	ListMenuTree(context.Context, *ListMenuTreeRequest) (*ListMenuTreeResponse, error)
+	// 获取用户菜单树
+	GetUserMenuTree(context.Context, *GetUserMenuTreeRequest) (*GetUserMenuTreeResponse, error)
+	// ========== 权限管理 ==========
+	// 创建权限
+	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
+	// 获取权限
+	GetPermission(context.Context, *GetPermissionRequest) (*GetPermissionResponse, error)
+	// 更新权限
+	UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error)
+	// 删除权限
+	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
+	// 列表权限
+	ListPermissions(context.Context, *ListPermissionRequest) (*ListPermissionResponse, error)
+	// 获取回收站权限列表
+	ListDeletedPermissions(context.Context, *ListDeletedPermissionsRequest) (*ListDeletedPermissionsResponse, error)
+	// 恢复权限
+	RestorePermission(context.Context, *RestorePermissionRequest) (*RestorePermissionResponse, error)
+	// 列表权限树
+	ListPermissionTree(context.Context, *ListPermissionTreeRequest) (*ListPermissionTreeResponse, error)
+	// 获取 API 路由目录
+	GetAPICatalog(context.Context, *GetAPICatalogRequest) (*GetAPICatalogResponse, error)
+	// ========== 角色管理 ==========
+	// 创建角色
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	// 获取角色
+	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
+	// 更新角色
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	// 删除角色
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	// 列表角色
+	ListRoles(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
+	// 获取回收站角色列表
+	ListDeletedRoles(context.Context, *ListDeletedRolesRequest) (*ListDeletedRolesResponse, error)
+	// 恢复角色
+	RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error)
+	// 给角色分配权限
+	AssignPermissionsToRole(context.Context, *AssignPermissionsToRoleRequest) (*AssignPermissionsToRoleResponse, error)
+	// 获取角色权限
+	GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error)
+	// ========== 用户角色管理 ==========
+	// 给用户分配角色
+	AssignRolesToUser(context.Context, *AssignRolesToUserRequest) (*AssignRolesToUserResponse, error)
+	// 获取用户角色
+	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
+	// 从用户移除角色
+	RemoveRoleFromUser(context.Context, *RemoveRoleFromUserRequest) (*RemoveRoleFromUserResponse, error)
+	// ========== 授权申请 ==========
+	// 创建授权申请
+	CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error)
+	// 获取授权申请列表
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	// 获取授权申请详情
+	GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error)
+	// 批准授权申请
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
+	// 拒绝授权申请
+	RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error)
+	// ========== 职责分离 ==========
+	// 创建职责分离规则
+	CreateSoDRule(context.Context, *CreateSoDRuleRequest) (*CreateSoDRuleResponse, error)
+	// 获取职责分离规则列表
+	ListSoDRules(context.Context, *ListSoDRulesRequest) (*ListSoDRulesResponse, error)
+	// 更新职责分离规则
+	UpdateSoDRule(context.Context, *UpdateSoDRuleRequest) (*UpdateSoDRuleResponse, error)
+	// 删除职责分离规则
+	DeleteSoDRule(context.Context, *DeleteSoDRuleRequest) (*DeleteSoDRuleResponse, error)
+	// 获取职责分离违规报告
+	ListSoDViolations(context.Context, *ListSoDViolationsRequest) (*ListSoDViolationsResponse, error)
+	// ========== 会话管理 ==========
+	// 获取用户会话列表
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// 撤销单个会话
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// 撤销除当前会话外的其他会话
+	RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*RevokeOtherSessionsResponse, error)
+	// 管理员终止指定用户的全部会话
+	TerminateUserSessions(context.Context, *TerminateUserSessionsRequest) (*TerminateUserSessionsResponse, error)
+	// ========== 用户管理（管理员） ==========
+	// 管理员创建用户
+	AdminCreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// 管理员获取用户列表
+	AdminListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	// 获取回收站用户列表
+	ListDeletedUsers(context.Context, *ListDeletedUsersRequest) (*ListDeletedUsersResponse, error)
+	// 恢复用户
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	// 管理员获取用户详情
+	AdminGetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// 管理员更新用户信息
+	AdminUpdateUser(context.Context, *AdminUpdateUserRequest) (*AdminUpdateUserResponse, error)
+	// 启用/禁用用户
+	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UpdateUserStatusResponse, error)
+	// 重置用户密码
+	ResetUserPassword(context.Context, *ResetUserPasswordRequest) (*ResetUserPasswordResponse, error)
+	// 模拟登录用户
+	ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error)
+	// ========== 注册管理 ==========
+	// 获取注册策略
+	GetRegistrationPolicy(context.Context, *GetRegistrationPolicyRequest) (*GetRegistrationPolicyResponse, error)
+	// 创建邀请码
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	// 获取邀请码列表
+	ListInvitation(context.Context, *ListInvitationRequest) (*ListInvitationResponse, error)
+	// 撤销邀请码
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	// 获取待审核注册列表
+	ListPendingRegistrations(context.Context, *ListPendingRegistrationsRequest) (*ListPendingRegistrationsResponse, error)
+	// 审核通过注册
+	ApproveRegistration(context.Context, *ApproveRegistrationRequest) (*ApproveRegistrationResponse, error)
+	// 拒绝注册
+	RejectRegistration(context.Context, *RejectRegistrationRequest) (*RejectRegistrationResponse, error)
+	// ========== 单点登录 ==========
+	// 获取单点登录 Provider 列表
+	ListOIDCProviders(context.Context, *ListOIDCProvidersRequest) (*ListOIDCProvidersResponse, error)
+	// 发起单点登录
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error)
+	// 单点登录回调
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*OIDCCallbackResponse, error)
+	// 获取外部身份列表
+	ListUserIdentities(context.Context, *ListUserIdentitiesRequest) (*ListUserIdentitiesResponse, error)
+	// 关联外部身份
+	LinkUserIdentity(context.Context, *LinkUserIdentityRequest) (*StartOIDCLoginResponse, error)
+	// 解除外部身份关联
+	UnlinkUserIdentity(context.Context, *UnlinkUserIdentityRequest) (*UnlinkUserIdentityResponse, error)
 	mustEmbedUnimplementedBlogServiceServer()
 }
 
-func RegisterBlogServiceServer(s grpc.ServiceRegistrar, srv BlogServiceServer) {
-	// If the following call panics, it indicates UnimplementedBlogServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+// UnimplementedBlogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlogServiceServer struct{}
+
+func (UnimplementedBlogServiceServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Healthz not implemented")
+}
+func (UnimplementedBlogServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedBlogServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedBlogServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedBlogServiceServer) ListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateMenu(context.Context, *CreateMenuRequest) (*CreateMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMenu not implemented")
+}
+func (UnimplementedBlogServiceServer) GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMenu not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateMenu(context.Context, *UpdateMenuRequest) (*UpdateMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMenu not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMenu not implemented")
+}
+func (UnimplementedBlogServiceServer) ListMenus(context.Context, *ListMenuRequest) (*ListMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMenus not implemented")
+}
+func (UnimplementedBlogServiceServer) ListDeletedMenus(context.Context, *ListDeletedMenusRequest) (*ListDeletedMenusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedMenus not implemented")
+}
+func (UnimplementedBlogServiceServer) RestoreMenu(context.Context, *RestoreMenuRequest) (*RestoreMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreMenu not implemented")
+}
+func (UnimplementedBlogServiceServer) ListMenuTree(context.Context, *ListMenuTreeRequest) (*ListMenuTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMenuTree not implemented")
+}
+func (UnimplementedBlogServiceServer) GetUserMenuTree(context.Context, *GetUserMenuTreeRequest) (*GetUserMenuTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserMenuTree not implemented")
+}
+func (UnimplementedBlogServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePermission not implemented")
+}
+func (UnimplementedBlogServiceServer) GetPermission(context.Context, *GetPermissionRequest) (*GetPermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPermission not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePermission not implemented")
+}
+func (UnimplementedBlogServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePermission not implemented")
+}
+func (UnimplementedBlogServiceServer) ListPermissions(context.Context, *ListPermissionRequest) (*ListPermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedBlogServiceServer) ListDeletedPermissions(context.Context, *ListDeletedPermissionsRequest) (*ListDeletedPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedPermissions not implemented")
+}
+func (UnimplementedBlogServiceServer) RestorePermission(context.Context, *RestorePermissionRequest) (*RestorePermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestorePermission not implemented")
+}
+func (UnimplementedBlogServiceServer) ListPermissionTree(context.Context, *ListPermissionTreeRequest) (*ListPermissionTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPermissionTree not implemented")
+}
+func (UnimplementedBlogServiceServer) GetAPICatalog(context.Context, *GetAPICatalogRequest) (*GetAPICatalogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAPICatalog not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedBlogServiceServer) GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedBlogServiceServer) ListRoles(context.Context, *ListRoleRequest) (*ListRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedBlogServiceServer) ListDeletedRoles(context.Context, *ListDeletedRolesRequest) (*ListDeletedRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedRoles not implemented")
+}
+func (UnimplementedBlogServiceServer) RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreRole not implemented")
+}
+func (UnimplementedBlogServiceServer) AssignPermissionsToRole(context.Context, *AssignPermissionsToRoleRequest) (*AssignPermissionsToRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignPermissionsToRole not implemented")
+}
+func (UnimplementedBlogServiceServer) GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRolePermissions not implemented")
+}
+func (UnimplementedBlogServiceServer) AssignRolesToUser(context.Context, *AssignRolesToUserRequest) (*AssignRolesToUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignRolesToUser not implemented")
+}
+func (UnimplementedBlogServiceServer) GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserRoles not implemented")
+}
+func (UnimplementedBlogServiceServer) RemoveRoleFromUser(context.Context, *RemoveRoleFromUserRequest) (*RemoveRoleFromUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveRoleFromUser not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccessRequest not implemented")
+}
+func (UnimplementedBlogServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
+func (UnimplementedBlogServiceServer) GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccessRequest not implemented")
+}
+func (UnimplementedBlogServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedBlogServiceServer) RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectAccessRequest not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateSoDRule(context.Context, *CreateSoDRuleRequest) (*CreateSoDRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSoDRule not implemented")
+}
+func (UnimplementedBlogServiceServer) ListSoDRules(context.Context, *ListSoDRulesRequest) (*ListSoDRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSoDRules not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateSoDRule(context.Context, *UpdateSoDRuleRequest) (*UpdateSoDRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSoDRule not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteSoDRule(context.Context, *DeleteSoDRuleRequest) (*DeleteSoDRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSoDRule not implemented")
+}
+func (UnimplementedBlogServiceServer) ListSoDViolations(context.Context, *ListSoDViolationsRequest) (*ListSoDViolationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSoDViolations not implemented")
+}
+func (UnimplementedBlogServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedBlogServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedBlogServiceServer) RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*RevokeOtherSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
+func (UnimplementedBlogServiceServer) TerminateUserSessions(context.Context, *TerminateUserSessionsRequest) (*TerminateUserSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TerminateUserSessions not implemented")
+}
+func (UnimplementedBlogServiceServer) AdminCreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminCreateUser not implemented")
+}
+func (UnimplementedBlogServiceServer) AdminListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListUsers not implemented")
+}
+func (UnimplementedBlogServiceServer) ListDeletedUsers(context.Context, *ListDeletedUsersRequest) (*ListDeletedUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedUsers not implemented")
+}
+func (UnimplementedBlogServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedBlogServiceServer) AdminGetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetUser not implemented")
+}
+func (UnimplementedBlogServiceServer) AdminUpdateUser(context.Context, *AdminUpdateUserRequest) (*AdminUpdateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminUpdateUser not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UpdateUserStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserStatus not implemented")
+}
+func (UnimplementedBlogServiceServer) ResetUserPassword(context.Context, *ResetUserPasswordRequest) (*ResetUserPasswordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetUserPassword not implemented")
+}
+func (UnimplementedBlogServiceServer) ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedBlogServiceServer) GetRegistrationPolicy(context.Context, *GetRegistrationPolicyRequest) (*GetRegistrationPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRegistrationPolicy not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedBlogServiceServer) ListInvitation(context.Context, *ListInvitationRequest) (*ListInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInvitation not implemented")
+}
+func (UnimplementedBlogServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedBlogServiceServer) ListPendingRegistrations(context.Context, *ListPendingRegistrationsRequest) (*ListPendingRegistrationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingRegistrations not implemented")
+}
+func (UnimplementedBlogServiceServer) ApproveRegistration(context.Context, *ApproveRegistrationRequest) (*ApproveRegistrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveRegistration not implemented")
+}
+func (UnimplementedBlogServiceServer) RejectRegistration(context.Context, *RejectRegistrationRequest) (*RejectRegistrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectRegistration not implemented")
+}
+func (UnimplementedBlogServiceServer) ListOIDCProviders(context.Context, *ListOIDCProvidersRequest) (*ListOIDCProvidersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOIDCProviders not implemented")
+}
+func (UnimplementedBlogServiceServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedBlogServiceServer) OIDCCallback(context.Context, *OIDCCallbackRequest) (*OIDCCallbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OIDCCallback not implemented")
+}
+func (UnimplementedBlogServiceServer) ListUserIdentities(context.Context, *ListUserIdentitiesRequest) (*ListUserIdentitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserIdentities not implemented")
+}
+func (UnimplementedBlogServiceServer) LinkUserIdentity(context.Context, *LinkUserIdentityRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkUserIdentity not implemented")
+}
+func (UnimplementedBlogServiceServer) UnlinkUserIdentity(context.Context, *UnlinkUserIdentityRequest) (*UnlinkUserIdentityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkUserIdentity not implemented")
+}
+func (UnimplementedBlogServiceServer) mustEmbedUnimplementedBlogServiceServer() {}
+func (UnimplementedBlogServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeBlogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlogServiceServer will
+// result in compilation errors.
+type UnsafeBlogServiceServer interface {
+	mustEmbedUnimplementedBlogServiceServer()
+}
+
+func RegisterBlogServiceServer(s grpc.ServiceRegistrar, srv BlogServiceServer) {
+	// If the following call panics, it indicates UnimplementedBlogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlogService_ServiceDesc, srv)
+}
+
+func _BlogService_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_Healthz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).Healthz(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListUsers(ctx, req.(*ListUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreateMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateMenu(ctx, req.(*CreateMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetMenu(ctx, req.(*GetMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdateMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateMenu(ctx, req.(*UpdateMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeleteMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteMenu(ctx, req.(*DeleteMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListMenus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListMenus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListMenus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListMenus(ctx, req.(*ListMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListDeletedMenus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedMenusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListDeletedMenus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListDeletedMenus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListDeletedMenus(ctx, req.(*ListDeletedMenusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RestoreMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RestoreMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RestoreMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RestoreMenu(ctx, req.(*RestoreMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListMenuTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMenuTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListMenuTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListMenuTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListMenuTree(ctx, req.(*ListMenuTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetUserMenuTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMenuTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetUserMenuTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetUserMenuTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetUserMenuTree(ctx, req.(*GetUserMenuTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreatePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreatePermission(ctx, req.(*CreatePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetPermission(ctx, req.(*GetPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdatePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdatePermission(ctx, req.(*UpdatePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeletePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeletePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListPermissions(ctx, req.(*ListPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListDeletedPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListDeletedPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListDeletedPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListDeletedPermissions(ctx, req.(*ListDeletedPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RestorePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RestorePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RestorePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RestorePermission(ctx, req.(*RestorePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListPermissionTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListPermissionTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListPermissionTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListPermissionTree(ctx, req.(*ListPermissionTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetAPICatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPICatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetAPICatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetAPICatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetAPICatalog(ctx, req.(*GetAPICatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListRoles(ctx, req.(*ListRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListDeletedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListDeletedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListDeletedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListDeletedRoles(ctx, req.(*ListDeletedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RestoreRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RestoreRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RestoreRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RestoreRole(ctx, req.(*RestoreRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_AssignPermissionsToRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignPermissionsToRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).AssignPermissionsToRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_AssignPermissionsToRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).AssignPermissionsToRole(ctx, req.(*AssignPermissionsToRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetRolePermissions(ctx, req.(*GetRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_AssignRolesToUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRolesToUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).AssignRolesToUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_AssignRolesToUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).AssignRolesToUser(ctx, req.(*AssignRolesToUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetUserRoles(ctx, req.(*GetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RemoveRoleFromUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleFromUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RemoveRoleFromUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RemoveRoleFromUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RemoveRoleFromUser(ctx, req.(*RemoveRoleFromUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreateAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateAccessRequest(ctx, req.(*CreateAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetAccessRequest(ctx, req.(*GetAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RejectAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BlogService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RejectAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RejectAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RejectAccessRequest(ctx, req.(*RejectAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _BlogService_CreateSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSoDRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).Healthz(ctx, in)
+		return srv.(BlogServiceServer).CreateSoDRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_Healthz_FullMethodName,
+		FullMethod: BlogService_CreateSoDRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).Healthz(ctx, req.(*emptypb.Empty))
+		return srv.(BlogServiceServer).CreateSoDRule(ctx, req.(*CreateSoDRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _BlogService_ListSoDRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).Login(ctx, in)
+		return srv.(BlogServiceServer).ListSoDRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_Login_FullMethodName,
+		FullMethod: BlogService_ListSoDRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(BlogServiceServer).ListSoDRules(ctx, req.(*ListSoDRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _BlogService_UpdateSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSoDRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).RefreshToken(ctx, in)
+		return srv.(BlogServiceServer).UpdateSoDRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_RefreshToken_FullMethodName,
+		FullMethod: BlogService_UpdateSoDRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(BlogServiceServer).UpdateSoDRule(ctx, req.(*UpdateSoDRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _BlogService_DeleteSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSoDRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).CreateUser(ctx, in)
+		return srv.(BlogServiceServer).DeleteSoDRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_CreateUser_FullMethodName,
+		FullMethod: BlogService_DeleteSoDRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(BlogServiceServer).DeleteSoDRule(ctx, req.(*DeleteSoDRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _BlogService_ListSoDViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDViolationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetUser(ctx, in)
+		return srv.(BlogServiceServer).ListSoDViolations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetUser_FullMethodName,
+		FullMethod: BlogService_ListSoDViolations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(BlogServiceServer).ListSoDViolations(ctx, req.(*ListSoDViolationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _BlogService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).UpdateUser(ctx, in)
+		return srv.(BlogServiceServer).ListSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_UpdateUser_FullMethodName,
+		FullMethod: BlogService_ListSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(BlogServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _BlogService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).DeleteUser(ctx, in)
+		return srv.(BlogServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_DeleteUser_FullMethodName,
+		FullMethod: BlogService_RevokeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(BlogServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserRequest)
+func _BlogService_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).ListUsers(ctx, in)
+		return srv.(BlogServiceServer).RevokeOtherSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_ListUsers_FullMethodName,
+		FullMethod: BlogService_RevokeOtherSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).ListUsers(ctx, req.(*ListUserRequest))
+		return srv.(BlogServiceServer).RevokeOtherSessions(ctx, req.(*RevokeOtherSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_CreateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMenuRequest)
+func _BlogService_TerminateUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateUserSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).CreateMenu(ctx, in)
+		return srv.(BlogServiceServer).TerminateUserSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_CreateMenu_FullMethodName,
+		FullMethod: BlogService_TerminateUserSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).CreateMenu(ctx, req.(*CreateMenuRequest))
+		return srv.(BlogServiceServer).TerminateUserSessions(ctx, req.(*TerminateUserSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMenuRequest)
+func _BlogService_AdminCreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetMenu(ctx, in)
+		return srv.(BlogServiceServer).AdminCreateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetMenu_FullMethodName,
+		FullMethod: BlogService_AdminCreateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetMenu(ctx, req.(*GetMenuRequest))
+		return srv.(BlogServiceServer).AdminCreateUser(ctx, req.(*CreateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_UpdateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMenuRequest)
+func _BlogService_AdminListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).UpdateMenu(ctx, in)
+		return srv.(BlogServiceServer).AdminListUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_UpdateMenu_FullMethodName,
+		FullMethod: BlogService_AdminListUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).UpdateMenu(ctx, req.(*UpdateMenuRequest))
+		return srv.(BlogServiceServer).AdminListUsers(ctx, req.(*ListUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_DeleteMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMenuRequest)
+func _BlogService_ListDeletedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).DeleteMenu(ctx, in)
+		return srv.(BlogServiceServer).ListDeletedUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_DeleteMenu_FullMethodName,
+		FullMethod: BlogService_ListDeletedUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).DeleteMenu(ctx, req.(*DeleteMenuRequest))
+		return srv.(BlogServiceServer).ListDeletedUsers(ctx, req.(*ListDeletedUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_ListMenus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMenuRequest)
+func _BlogService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).ListMenus(ctx, in)
+		return srv.(BlogServiceServer).RestoreUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_ListMenus_FullMethodName,
+		FullMethod: BlogService_RestoreUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).ListMenus(ctx, req.(*ListMenuRequest))
+		return srv.(BlogServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_ListMenuTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMenuTreeRequest)
+func _BlogService_AdminGetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).ListMenuTree(ctx, in)
+		return srv.(BlogServiceServer).AdminGetUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_ListMenuTree_FullMethodName,
+		FullMethod: BlogService_AdminGetUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).ListMenuTree(ctx, req.(*ListMenuTreeRequest))
+		return srv.(BlogServiceServer).AdminGetUser(ctx, req.(*GetUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetUserMenuTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserMenuTreeRequest)
+func _BlogService_AdminUpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetUserMenuTree(ctx, in)
+		return srv.(BlogServiceServer).AdminUpdateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetUserMenuTree_FullMethodName,
+		FullMethod: BlogService_AdminUpdateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetUserMenuTree(ctx, req.(*GetUserMenuTreeRequest))
+		return srv.(BlogServiceServer).AdminUpdateUser(ctx, req.(*AdminUpdateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePermissionRequest)
+func _BlogService_UpdateUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).CreatePermission(ctx, in)
+		return srv.(BlogServiceServer).UpdateUserStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_CreatePermission_FullMethodName,
+		FullMethod: BlogService_UpdateUserStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).CreatePermission(ctx, req.(*CreatePermissionRequest))
+		return srv.(BlogServiceServer).UpdateUserStatus(ctx, req.(*UpdateUserStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPermissionRequest)
+func _BlogService_ResetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetPermission(ctx, in)
+		return srv.(BlogServiceServer).ResetUserPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetPermission_FullMethodName,
+		FullMethod: BlogService_ResetUserPassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetPermission(ctx, req.(*GetPermissionRequest))
+		return srv.(BlogServiceServer).ResetUserPassword(ctx, req.(*ResetUserPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_UpdatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePermissionRequest)
+func _BlogService_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).UpdatePermission(ctx, in)
+		return srv.(BlogServiceServer).ImpersonateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_UpdatePermission_FullMethodName,
+		FullMethod: BlogService_ImpersonateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).UpdatePermission(ctx, req.(*UpdatePermissionRequest))
+		return srv.(BlogServiceServer).ImpersonateUser(ctx, req.(*ImpersonateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePermissionRequest)
+func _BlogService_GetRegistrationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistrationPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).DeletePermission(ctx, in)
+		return srv.(BlogServiceServer).GetRegistrationPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_DeletePermission_FullMethodName,
+		FullMethod: BlogService_GetRegistrationPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
+		return srv.(BlogServiceServer).GetRegistrationPolicy(ctx, req.(*GetRegistrationPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPermissionRequest)
+func _BlogService_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).ListPermissions(ctx, in)
+		return srv.(BlogServiceServer).CreateInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_ListPermissions_FullMethodName,
+		FullMethod: BlogService_CreateInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).ListPermissions(ctx, req.(*ListPermissionRequest))
+		return srv.(BlogServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_ListPermissionTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPermissionTreeRequest)
+func _BlogService_ListInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).ListPermissionTree(ctx, in)
+		return srv.(BlogServiceServer).ListInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_ListPermissionTree_FullMethodName,
+		FullMethod: BlogService_ListInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).ListPermissionTree(ctx, req.(*ListPermissionTreeRequest))
+		return srv.(BlogServiceServer).ListInvitation(ctx, req.(*ListInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoleRequest)
+func _BlogService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).CreateRole(ctx, in)
+		return srv.(BlogServiceServer).RevokeInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_CreateRole_FullMethodName,
+		FullMethod: BlogService_RevokeInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+		return srv.(BlogServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoleRequest)
+func _BlogService_ListPendingRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingRegistrationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetRole(ctx, in)
+		return srv.(BlogServiceServer).ListPendingRegistrations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetRole_FullMethodName,
+		FullMethod: BlogService_ListPendingRegistrations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+		return srv.(BlogServiceServer).ListPendingRegistrations(ctx, req.(*ListPendingRegistrationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoleRequest)
+func _BlogService_ApproveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRegistrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).UpdateRole(ctx, in)
+		return srv.(BlogServiceServer).ApproveRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_UpdateRole_FullMethodName,
+		FullMethod: BlogService_ApproveRegistration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+		return srv.(BlogServiceServer).ApproveRegistration(ctx, req.(*ApproveRegistrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRoleRequest)
+func _BlogService_RejectRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRegistrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).DeleteRole(ctx, in)
+		return srv.(BlogServiceServer).RejectRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_DeleteRole_FullMethodName,
+		FullMethod: BlogService_RejectRegistration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+		return srv.(BlogServiceServer).RejectRegistration(ctx, req.(*RejectRegistrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRoleRequest)
+func _BlogService_ListOIDCProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOIDCProvidersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).ListRoles(ctx, in)
+		return srv.(BlogServiceServer).ListOIDCProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_ListRoles_FullMethodName,
+		FullMethod: BlogService_ListOIDCProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).ListRoles(ctx, req.(*ListRoleRequest))
+		return srv.(BlogServiceServer).ListOIDCProviders(ctx, req.(*ListOIDCProvidersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_AssignPermissionsToRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignPermissionsToRoleRequest)
+func _BlogService_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).AssignPermissionsToRole(ctx, in)
+		return srv.(BlogServiceServer).StartOIDCLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_AssignPermissionsToRole_FullMethodName,
+		FullMethod: BlogService_StartOIDCLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).AssignPermissionsToRole(ctx, req.(*AssignPermissionsToRoleRequest))
+		return srv.(BlogServiceServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRolePermissionsRequest)
+func _BlogService_OIDCCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCCallbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetRolePermissions(ctx, in)
+		return srv.(BlogServiceServer).OIDCCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetRolePermissions_FullMethodName,
+		FullMethod: BlogService_OIDCCallback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetRolePermissions(ctx, req.(*GetRolePermissionsRequest))
+		return srv.(BlogServiceServer).OIDCCallback(ctx, req.(*OIDCCallbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_AssignRolesToUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignRolesToUserRequest)
+func _BlogService_ListUserIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserIdentitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).AssignRolesToUser(ctx, in)
+		return srv.(BlogServiceServer).ListUserIdentities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_AssignRolesToUser_FullMethodName,
+		FullMethod: BlogService_ListUserIdentities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).AssignRolesToUser(ctx, req.(*AssignRolesToUserRequest))
+		return srv.(BlogServiceServer).ListUserIdentities(ctx, req.(*ListUserIdentitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRolesRequest)
+func _BlogService_LinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkUserIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).GetUserRoles(ctx, in)
+		return srv.(BlogServiceServer).LinkUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_GetUserRoles_FullMethodName,
+		FullMethod: BlogService_LinkUserIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).GetUserRoles(ctx, req.(*GetUserRolesRequest))
+		return srv.(BlogServiceServer).LinkUserIdentity(ctx, req.(*LinkUserIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BlogService_RemoveRoleFromUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRoleFromUserRequest)
+func _BlogService_UnlinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BlogServiceServer).RemoveRoleFromUser(ctx, in)
+		return srv.(BlogServiceServer).UnlinkUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BlogService_RemoveRoleFromUser_FullMethodName,
+		FullMethod: BlogService_UnlinkUserIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BlogServiceServer).RemoveRoleFromUser(ctx, req.(*RemoveRoleFromUserRequest))
+		return srv.(BlogServiceServer).UnlinkUserIdentity(ctx, req.(*UnlinkUserIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1254,6 +2814,14 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMenus",
 			Handler:    _BlogService_ListMenus_Handler,
 		},
+		{
+			MethodName: "ListDeletedMenus",
+			Handler:    _BlogService_ListDeletedMenus_Handler,
+		},
+		{
+			MethodName: "RestoreMenu",
+			Handler:    _BlogService_RestoreMenu_Handler,
+		},
 		{
 			MethodName: "ListMenuTree",
 			Handler:    _BlogService_ListMenuTree_Handler,
@@ -1282,10 +2850,22 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPermissions",
 			Handler:    _BlogService_ListPermissions_Handler,
 		},
+		{
+			MethodName: "ListDeletedPermissions",
+			Handler:    _BlogService_ListDeletedPermissions_Handler,
+		},
+		{
+			MethodName: "RestorePermission",
+			Handler:    _BlogService_RestorePermission_Handler,
+		},
 		{
 			MethodName: "ListPermissionTree",
 			Handler:    _BlogService_ListPermissionTree_Handler,
 		},
+		{
+			MethodName: "GetAPICatalog",
+			Handler:    _BlogService_GetAPICatalog_Handler,
+		},
 		{
 			MethodName: "CreateRole",
 			Handler:    _BlogService_CreateRole_Handler,
@@ -1306,6 +2886,14 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRoles",
 			Handler:    _BlogService_ListRoles_Handler,
 		},
+		{
+			MethodName: "ListDeletedRoles",
+			Handler:    _BlogService_ListDeletedRoles_Handler,
+		},
+		{
+			MethodName: "RestoreRole",
+			Handler:    _BlogService_RestoreRole_Handler,
+		},
 		{
 			MethodName: "AssignPermissionsToRole",
 			Handler:    _BlogService_AssignPermissionsToRole_Handler,
@@ -1326,6 +2914,150 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRoleFromUser",
 			Handler:    _BlogService_RemoveRoleFromUser_Handler,
 		},
+		{
+			MethodName: "CreateAccessRequest",
+			Handler:    _BlogService_CreateAccessRequest_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _BlogService_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "GetAccessRequest",
+			Handler:    _BlogService_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _BlogService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "RejectAccessRequest",
+			Handler:    _BlogService_RejectAccessRequest_Handler,
+		},
+		{
+			MethodName: "CreateSoDRule",
+			Handler:    _BlogService_CreateSoDRule_Handler,
+		},
+		{
+			MethodName: "ListSoDRules",
+			Handler:    _BlogService_ListSoDRules_Handler,
+		},
+		{
+			MethodName: "UpdateSoDRule",
+			Handler:    _BlogService_UpdateSoDRule_Handler,
+		},
+		{
+			MethodName: "DeleteSoDRule",
+			Handler:    _BlogService_DeleteSoDRule_Handler,
+		},
+		{
+			MethodName: "ListSoDViolations",
+			Handler:    _BlogService_ListSoDViolations_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _BlogService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _BlogService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _BlogService_RevokeOtherSessions_Handler,
+		},
+		{
+			MethodName: "TerminateUserSessions",
+			Handler:    _BlogService_TerminateUserSessions_Handler,
+		},
+		{
+			MethodName: "AdminCreateUser",
+			Handler:    _BlogService_AdminCreateUser_Handler,
+		},
+		{
+			MethodName: "AdminListUsers",
+			Handler:    _BlogService_AdminListUsers_Handler,
+		},
+		{
+			MethodName: "ListDeletedUsers",
+			Handler:    _BlogService_ListDeletedUsers_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _BlogService_RestoreUser_Handler,
+		},
+		{
+			MethodName: "AdminGetUser",
+			Handler:    _BlogService_AdminGetUser_Handler,
+		},
+		{
+			MethodName: "AdminUpdateUser",
+			Handler:    _BlogService_AdminUpdateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUserStatus",
+			Handler:    _BlogService_UpdateUserStatus_Handler,
+		},
+		{
+			MethodName: "ResetUserPassword",
+			Handler:    _BlogService_ResetUserPassword_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _BlogService_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "GetRegistrationPolicy",
+			Handler:    _BlogService_GetRegistrationPolicy_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _BlogService_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitation",
+			Handler:    _BlogService_ListInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _BlogService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "ListPendingRegistrations",
+			Handler:    _BlogService_ListPendingRegistrations_Handler,
+		},
+		{
+			MethodName: "ApproveRegistration",
+			Handler:    _BlogService_ApproveRegistration_Handler,
+		},
+		{
+			MethodName: "RejectRegistration",
+			Handler:    _BlogService_RejectRegistration_Handler,
+		},
+		{
+			MethodName: "ListOIDCProviders",
+			Handler:    _BlogService_ListOIDCProviders_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _BlogService_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "OIDCCallback",
+			Handler:    _BlogService_OIDCCallback_Handler,
+		},
+		{
+			MethodName: "ListUserIdentities",
+			Handler:    _BlogService_ListUserIdentities_Handler,
+		},
+		{
+			MethodName: "LinkUserIdentity",
+			Handler:    _BlogService_LinkUserIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkUserIdentity",
+			Handler:    _BlogService_UnlinkUserIdentity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apiserver/v1/apiserver.proto",
@@ -0,0 +1,60 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+)
+
+func (x *Invitation) Default() {
+}
+
+func (x *CreateInvitationRequest) Default() {
+}
+
+func (x *CreateInvitationResponse) Default() {
+}
+
+func (x *ListInvitationRequest) Default() {
+}
+
+func (x *ListInvitationResponse) Default() {
+}
+
+func (x *RevokeInvitationRequest) Default() {
+}
+
+func (x *RevokeInvitationResponse) Default() {
+}
+
+func (x *GetRegistrationPolicyRequest) Default() {
+}
+
+func (x *GetRegistrationPolicyResponse) Default() {
+}
+
+func (x *ListPendingRegistrationsRequest) Default() {
+}
+
+func (x *ListPendingRegistrationsResponse) Default() {
+}
+
+func (x *ApproveRegistrationRequest) Default() {
+}
+
+func (x *ApproveRegistrationResponse) Default() {
+}
+
+func (x *RejectRegistrationRequest) Default() {
+}
+
+func (x *RejectRegistrationResponse) Default() {
+}
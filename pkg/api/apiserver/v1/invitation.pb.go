@@ -0,0 +1,959 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.30.0
+// source: apiserver/v1/invitation.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Invitation 表示注册邀请码
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code 表示邀请码
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// roleIDs 表示使用该邀请码注册后预分配的角色 ID 列表
+	RoleIDs []string `protobuf:"bytes,2,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// maxUses 表示最大使用次数
+	MaxUses int32 `protobuf:"varint,3,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	// usedCount 表示已使用次数
+	UsedCount int32 `protobuf:"varint,4,opt,name=usedCount,proto3" json:"usedCount,omitempty"`
+	// expiresAt 表示过期时间，0 表示永不过期
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// revoked 表示邀请码是否已被撤销
+	Revoked bool `protobuf:"varint,6,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// remark 表示备注
+	Remark string `protobuf:"bytes,7,opt,name=remark,proto3" json:"remark,omitempty"`
+	// createdBy 表示创建人用户 ID
+	CreatedBy string `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	// createdAt 表示创建时间
+	CreatedAt     int64 `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invitation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invitation) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *Invitation) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invitation) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *Invitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *Invitation) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// CreateInvitationRequest 表示创建邀请码请求
+type CreateInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// roleIDs 表示使用该邀请码注册后预分配的角色 ID 列表，为空时只授予普通用户角色
+	RoleIDs []string `protobuf:"bytes,1,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// maxUses 表示最大使用次数，默认为 1
+	MaxUses int32 `protobuf:"varint,2,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	// expiresAt 表示过期时间（Unix 时间戳），0 表示永不过期
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// remark 表示备注
+	Remark        string `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateInvitationRequest) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *CreateInvitationRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInvitationRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateInvitationRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// CreateInvitationResponse 表示创建邀请码响应
+type CreateInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// invitation 表示新创建的邀请码
+	Invitation    *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationResponse) Reset() {
+	*x = CreateInvitationResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationResponse) ProtoMessage() {}
+
+func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// ListInvitationRequest 表示邀请码列表请求
+type ListInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationRequest) Reset() {
+	*x = ListInvitationRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationRequest) ProtoMessage() {}
+
+func (x *ListInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListInvitationRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListInvitationRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListInvitationResponse 表示邀请码列表响应
+type ListInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示邀请码总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// invitations 表示邀请码列表
+	Invitations []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	// pageToken 表示下一页的分页游标，为空表示没有更多数据
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationResponse) Reset() {
+	*x = ListInvitationResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationResponse) ProtoMessage() {}
+
+func (x *ListInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListInvitationResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListInvitationResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// RevokeInvitationRequest 表示撤销邀请码请求
+type RevokeInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code 表示邀请码
+	// @gotags: uri:"code"
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty" uri:"code"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeInvitationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// RevokeInvitationResponse 表示撤销邀请码响应
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{6}
+}
+
+// GetRegistrationPolicyRequest 表示获取注册策略请求
+type GetRegistrationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationPolicyRequest) Reset() {
+	*x = GetRegistrationPolicyRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationPolicyRequest) ProtoMessage() {}
+
+func (x *GetRegistrationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetRegistrationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{7}
+}
+
+// GetRegistrationPolicyResponse 表示获取注册策略响应
+type GetRegistrationPolicyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mode 表示注册模式（open、closed、invite、approval）
+	Mode          string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationPolicyResponse) Reset() {
+	*x = GetRegistrationPolicyResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationPolicyResponse) ProtoMessage() {}
+
+func (x *GetRegistrationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetRegistrationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetRegistrationPolicyResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// ListPendingRegistrationsRequest 表示待审核注册列表请求
+type ListPendingRegistrationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingRegistrationsRequest) Reset() {
+	*x = ListPendingRegistrationsRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingRegistrationsRequest) ProtoMessage() {}
+
+func (x *ListPendingRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPendingRegistrationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPendingRegistrationsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListPendingRegistrationsResponse 表示待审核注册列表响应
+type ListPendingRegistrationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示待审核用户总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// users 表示待审核用户列表
+	Users []*User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	// pageToken 表示下一页的分页游标，为空表示没有更多数据
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingRegistrationsResponse) Reset() {
+	*x = ListPendingRegistrationsResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingRegistrationsResponse) ProtoMessage() {}
+
+func (x *ListPendingRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPendingRegistrationsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPendingRegistrationsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListPendingRegistrationsResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ApproveRegistrationRequest 表示审核通过注册请求
+type ApproveRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示待审核的用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRegistrationRequest) Reset() {
+	*x = ApproveRegistrationRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRegistrationRequest) ProtoMessage() {}
+
+func (x *ApproveRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ApproveRegistrationRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// ApproveRegistrationResponse 表示审核通过注册响应
+type ApproveRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRegistrationResponse) Reset() {
+	*x = ApproveRegistrationResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRegistrationResponse) ProtoMessage() {}
+
+func (x *ApproveRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{12}
+}
+
+// RejectRegistrationRequest 表示拒绝注册请求
+type RejectRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示待审核的用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRegistrationRequest) Reset() {
+	*x = RejectRegistrationRequest{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRegistrationRequest) ProtoMessage() {}
+
+func (x *RejectRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*RejectRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RejectRegistrationRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// RejectRegistrationResponse 表示拒绝注册响应
+type RejectRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRegistrationResponse) Reset() {
+	*x = RejectRegistrationResponse{}
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRegistrationResponse) ProtoMessage() {}
+
+func (x *RejectRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_invitation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*RejectRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_invitation_proto_rawDescGZIP(), []int{14}
+}
+
+var File_apiserver_v1_invitation_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_invitation_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapiserver/v1/invitation.proto\x12\fapiserver.v1\x1a\x17apiserver/v1/user.proto\"\xfe\x01\n" +
+	"\n" +
+	"Invitation\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\aroleIDs\x18\x02 \x03(\tR\aroleIDs\x12\x18\n" +
+	"\amaxUses\x18\x03 \x01(\x05R\amaxUses\x12\x1c\n" +
+	"\tusedCount\x18\x04 \x01(\x05R\tusedCount\x12\x1c\n" +
+	"\texpiresAt\x18\x05 \x01(\x03R\texpiresAt\x12\x18\n" +
+	"\arevoked\x18\x06 \x01(\bR\arevoked\x12\x16\n" +
+	"\x06remark\x18\a \x01(\tR\x06remark\x12\x1c\n" +
+	"\tcreatedBy\x18\b \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\x03R\tcreatedAt\"\x83\x01\n" +
+	"\x17CreateInvitationRequest\x12\x18\n" +
+	"\aroleIDs\x18\x01 \x03(\tR\aroleIDs\x12\x18\n" +
+	"\amaxUses\x18\x02 \x01(\x05R\amaxUses\x12\x1c\n" +
+	"\texpiresAt\x18\x03 \x01(\x03R\texpiresAt\x12\x16\n" +
+	"\x06remark\x18\x04 \x01(\tR\x06remark\"T\n" +
+	"\x18CreateInvitationResponse\x128\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x18.apiserver.v1.InvitationR\n" +
+	"invitation\"Q\n" +
+	"\x15ListInvitationRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x92\x01\n" +
+	"\x16ListInvitationResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12:\n" +
+	"\vinvitations\x18\x02 \x03(\v2\x18.apiserver.v1.InvitationR\vinvitations\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\"-\n" +
+	"\x17RevokeInvitationRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x1a\n" +
+	"\x18RevokeInvitationResponse\"\x1e\n" +
+	"\x1cGetRegistrationPolicyRequest\"3\n" +
+	"\x1dGetRegistrationPolicyResponse\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"[\n" +
+	"\x1fListPendingRegistrationsRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x8a\x01\n" +
+	" ListPendingRegistrationsResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12(\n" +
+	"\x05users\x18\x02 \x03(\v2\x12.apiserver.v1.UserR\x05users\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\"4\n" +
+	"\x1aApproveRegistrationRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x1d\n" +
+	"\x1bApproveRegistrationResponse\"3\n" +
+	"\x19RejectRegistrationRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x1c\n" +
+	"\x1aRejectRegistrationResponseBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+
+var (
+	file_apiserver_v1_invitation_proto_rawDescOnce sync.Once
+	file_apiserver_v1_invitation_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_invitation_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_invitation_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_invitation_proto_rawDesc), len(file_apiserver_v1_invitation_proto_rawDesc)))
+	})
+	return file_apiserver_v1_invitation_proto_rawDescData
+}
+
+var file_apiserver_v1_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_apiserver_v1_invitation_proto_goTypes = []any{
+	(*Invitation)(nil),                       // 0: apiserver.v1.Invitation
+	(*CreateInvitationRequest)(nil),          // 1: apiserver.v1.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),         // 2: apiserver.v1.CreateInvitationResponse
+	(*ListInvitationRequest)(nil),            // 3: apiserver.v1.ListInvitationRequest
+	(*ListInvitationResponse)(nil),           // 4: apiserver.v1.ListInvitationResponse
+	(*RevokeInvitationRequest)(nil),          // 5: apiserver.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),         // 6: apiserver.v1.RevokeInvitationResponse
+	(*GetRegistrationPolicyRequest)(nil),     // 7: apiserver.v1.GetRegistrationPolicyRequest
+	(*GetRegistrationPolicyResponse)(nil),    // 8: apiserver.v1.GetRegistrationPolicyResponse
+	(*ListPendingRegistrationsRequest)(nil),  // 9: apiserver.v1.ListPendingRegistrationsRequest
+	(*ListPendingRegistrationsResponse)(nil), // 10: apiserver.v1.ListPendingRegistrationsResponse
+	(*ApproveRegistrationRequest)(nil),       // 11: apiserver.v1.ApproveRegistrationRequest
+	(*ApproveRegistrationResponse)(nil),      // 12: apiserver.v1.ApproveRegistrationResponse
+	(*RejectRegistrationRequest)(nil),        // 13: apiserver.v1.RejectRegistrationRequest
+	(*RejectRegistrationResponse)(nil),       // 14: apiserver.v1.RejectRegistrationResponse
+	(*User)(nil),                             // 15: apiserver.v1.User
+}
+var file_apiserver_v1_invitation_proto_depIdxs = []int32{
+	0,  // 0: apiserver.v1.CreateInvitationResponse.invitation:type_name -> apiserver.v1.Invitation
+	0,  // 1: apiserver.v1.ListInvitationResponse.invitations:type_name -> apiserver.v1.Invitation
+	15, // 2: apiserver.v1.ListPendingRegistrationsResponse.users:type_name -> apiserver.v1.User
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_invitation_proto_init() }
+func file_apiserver_v1_invitation_proto_init() {
+	if File_apiserver_v1_invitation_proto != nil {
+		return
+	}
+	file_apiserver_v1_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_invitation_proto_rawDesc), len(file_apiserver_v1_invitation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_invitation_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_invitation_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_invitation_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_invitation_proto = out.File
+	file_apiserver_v1_invitation_proto_goTypes = nil
+	file_apiserver_v1_invitation_proto_depIdxs = nil
+}
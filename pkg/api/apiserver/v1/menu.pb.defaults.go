@@ -28,10 +28,6 @@ func (x *CreateMenuResponse) Default() {
 }
 
 func (x *UpdateMenuRequest) Default() {
-	if x.Visible == nil {
-		v := int32(1)
-		x.Visible = &v
-	}
 }
 
 func (x *UpdateMenuResponse) Default() {
@@ -69,3 +65,15 @@ func (x *GetUserMenuTreeRequest) Default() {
 
 func (x *GetUserMenuTreeResponse) Default() {
 }
+
+func (x *ListDeletedMenusRequest) Default() {
+}
+
+func (x *ListDeletedMenusResponse) Default() {
+}
+
+func (x *RestoreMenuRequest) Default() {
+}
+
+func (x *RestoreMenuResponse) Default() {
+}
@@ -52,7 +52,11 @@ type Menu struct {
 	// createdAt 表示创建时间
 	CreatedAt int64 `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示更新时间
-	UpdatedAt     int64 `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// deletedAt 表示删除时间（Unix 时间戳，秒），仅回收站中的菜单有值
+	DeletedAt int64 `protobuf:"varint,15,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// version 表示资源版本号，每次修改后递增，可以作为更新请求的前置条件
+	Version       int64 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -185,6 +189,20 @@ func (x *Menu) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Menu) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Menu) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateMenuRequest 表示创建菜单请求
 type CreateMenuRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -370,7 +388,12 @@ type UpdateMenuRequest struct {
 	// visible 表示可选的是否可见（0=隐藏,1=显示）
 	Visible *int32 `protobuf:"varint,8,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
 	// status 表示可选的菜单状态（0=启用,1=禁用）
-	Status        *int32 `protobuf:"varint,9,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Status *int32 `protobuf:"varint,9,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// version 表示可选的菜单版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version *int64 `protobuf:"varint,10,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示需要修改的字段名列表（如 menuName、visible），为空时修改所有已设置的字段；
+	// 设置后只修改列出的字段，列出但未传值的字段会被清空
+	UpdateMask    []string `protobuf:"bytes,11,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -468,9 +491,25 @@ func (x *UpdateMenuRequest) GetStatus() int32 {
 	return 0
 }
 
+func (x *UpdateMenuRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateMenuRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // UpdateMenuResponse 表示更新菜单响应
 type UpdateMenuResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的菜单版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -505,6 +544,13 @@ func (*UpdateMenuResponse) Descriptor() ([]byte, []int) {
 	return file_apiserver_v1_menu_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *UpdateMenuResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // DeleteMenuRequest 表示删除菜单请求
 type DeleteMenuRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1064,11 +1110,216 @@ func (x *GetUserMenuTreeResponse) GetMenus() []*MenuTreeNode {
 	return nil
 }
 
+// ListDeletedMenusRequest 表示回收站菜单列表请求
+type ListDeletedMenusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedMenusRequest) Reset() {
+	*x = ListDeletedMenusRequest{}
+	mi := &file_apiserver_v1_menu_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedMenusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedMenusRequest) ProtoMessage() {}
+
+func (x *ListDeletedMenusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_menu_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedMenusRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedMenusRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_menu_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDeletedMenusRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeletedMenusRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListDeletedMenusResponse 表示回收站菜单列表响应
+type ListDeletedMenusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示回收站中的菜单总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// menus 表示已删除的菜单列表
+	Menus []*Menu `protobuf:"bytes,2,rep,name=menus,proto3" json:"menus,omitempty"`
+	// pageToken 表示下一页游标
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedMenusResponse) Reset() {
+	*x = ListDeletedMenusResponse{}
+	mi := &file_apiserver_v1_menu_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedMenusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedMenusResponse) ProtoMessage() {}
+
+func (x *ListDeletedMenusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_menu_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedMenusResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedMenusResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_menu_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDeletedMenusResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDeletedMenusResponse) GetMenus() []*Menu {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+func (x *ListDeletedMenusResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// RestoreMenuRequest 表示从回收站恢复菜单请求
+type RestoreMenuRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// menuID 表示菜单 ID
+	// @gotags: uri:"menuID"
+	MenuID        string `protobuf:"bytes,1,opt,name=menuID,proto3" json:"menuID,omitempty" uri:"menuID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMenuRequest) Reset() {
+	*x = RestoreMenuRequest{}
+	mi := &file_apiserver_v1_menu_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMenuRequest) ProtoMessage() {}
+
+func (x *RestoreMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_menu_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMenuRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_menu_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreMenuRequest) GetMenuID() string {
+	if x != nil {
+		return x.MenuID
+	}
+	return ""
+}
+
+// RestoreMenuResponse 表示从回收站恢复菜单响应
+type RestoreMenuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMenuResponse) Reset() {
+	*x = RestoreMenuResponse{}
+	mi := &file_apiserver_v1_menu_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMenuResponse) ProtoMessage() {}
+
+func (x *RestoreMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_menu_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMenuResponse.ProtoReflect.Descriptor instead.
+func (*RestoreMenuResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_menu_proto_rawDescGZIP(), []int{19}
+}
+
 var File_apiserver_v1_menu_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_menu_proto_rawDesc = "" +
 	"\n" +
-	"\x17apiserver/v1/menu.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\x84\x03\n" +
+	"\x17apiserver/v1/menu.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\xbc\x03\n" +
 	"\x04Menu\x12\x16\n" +
 	"\x06menuID\x18\x01 \x01(\tR\x06menuID\x12\x1a\n" +
 	"\bparentID\x18\x02 \x01(\tR\bparentID\x12\x1a\n" +
@@ -1084,7 +1335,9 @@ const file_apiserver_v1_menu_proto_rawDesc = "" +
 	"\avisible\x18\v \x01(\x05R\avisible\x12\x16\n" +
 	"\x06status\x18\f \x01(\x05R\x06status\x12\x1c\n" +
 	"\tcreatedAt\x18\r \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\x0e \x01(\x03R\tupdatedAt\"\xfc\x02\n" +
+	"\tupdatedAt\x18\x0e \x01(\x03R\tupdatedAt\x12\x1c\n" +
+	"\tdeletedAt\x18\x0f \x01(\x03R\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\"\xfc\x02\n" +
 	"\x11CreateMenuRequest\x12\x1f\n" +
 	"\bparentID\x18\x01 \x01(\tH\x00R\bparentID\x88\x01\x01\x12\x1a\n" +
 	"\bmenuName\x18\x02 \x01(\tR\bmenuName\x12\x1a\n" +
@@ -1104,7 +1357,7 @@ const file_apiserver_v1_menu_proto_rawDesc = "" +
 	"\n" +
 	"_sortOrder\",\n" +
 	"\x12CreateMenuResponse\x12\x16\n" +
-	"\x06menuID\x18\x01 \x01(\tR\x06menuID\"\x87\x03\n" +
+	"\x06menuID\x18\x01 \x01(\tR\x06menuID\"\xcb\x03\n" +
 	"\x11UpdateMenuRequest\x12\x16\n" +
 	"\x06menuID\x18\x01 \x01(\tR\x06menuID\x12\x1f\n" +
 	"\bparentID\x18\x02 \x01(\tH\x00R\bparentID\x88\x01\x01\x12\x1f\n" +
@@ -1112,9 +1365,14 @@ const file_apiserver_v1_menu_proto_rawDesc = "" +
 	"\x04icon\x18\x04 \x01(\tH\x02R\x04icon\x88\x01\x01\x12\x17\n" +
 	"\x04path\x18\x05 \x01(\tH\x03R\x04path\x88\x01\x01\x12!\n" +
 	"\tcomponent\x18\x06 \x01(\tH\x04R\tcomponent\x88\x01\x01\x12!\n" +
-	"\tsortOrder\x18\a \x01(\x05H\x05R\tsortOrder\x88\x01\x01\x12$\n" +
-	"\avisible\x18\b \x01(\x05B\x05\x9aI\x02\x18\x01H\x06R\avisible\x88\x01\x01\x12\x1b\n" +
-	"\x06status\x18\t \x01(\x05H\aR\x06status\x88\x01\x01B\v\n" +
+	"\tsortOrder\x18\a \x01(\x05H\x05R\tsortOrder\x88\x01\x01\x12\x1d\n" +
+	"\avisible\x18\b \x01(\x05H\x06R\avisible\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\t \x01(\x05H\aR\x06status\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03H\bR\aversion\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\v \x03(\tR\n" +
+	"updateMaskB\v\n" +
 	"\t_parentIDB\v\n" +
 	"\t_menuNameB\a\n" +
 	"\x05_iconB\a\n" +
@@ -1125,8 +1383,11 @@ const file_apiserver_v1_menu_proto_rawDesc = "" +
 	"_sortOrderB\n" +
 	"\n" +
 	"\b_visibleB\t\n" +
-	"\a_status\"\x14\n" +
-	"\x12UpdateMenuResponse\"+\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_version\".\n" +
+	"\x12UpdateMenuResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"+\n" +
 	"\x11DeleteMenuRequest\x12\x16\n" +
 	"\x06menuID\x18\x01 \x01(\tR\x06menuID\"\x14\n" +
 	"\x12DeleteMenuResponse\"(\n" +
@@ -1159,7 +1420,19 @@ const file_apiserver_v1_menu_proto_rawDesc = "" +
 	"\bchildren\x18\x02 \x03(\v2\x1a.apiserver.v1.MenuTreeNodeR\bchildren\"\x18\n" +
 	"\x16GetUserMenuTreeRequest\"K\n" +
 	"\x17GetUserMenuTreeResponse\x120\n" +
-	"\x05menus\x18\x01 \x03(\v2\x1a.apiserver.v1.MenuTreeNodeR\x05menusBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+	"\x05menus\x18\x01 \x03(\v2\x1a.apiserver.v1.MenuTreeNodeR\x05menus\"S\n" +
+	"\x17ListDeletedMenusRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x82\x01\n" +
+	"\x18ListDeletedMenusResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12(\n" +
+	"\x05menus\x18\x02 \x03(\v2\x12.apiserver.v1.MenuR\x05menus\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\",\n" +
+	"\x12RestoreMenuRequest\x12\x16\n" +
+	"\x06menuID\x18\x01 \x01(\tR\x06menuID\"\x15\n" +
+	"\x13RestoreMenuResponseBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
 
 var (
 	file_apiserver_v1_menu_proto_rawDescOnce sync.Once
@@ -1173,24 +1446,28 @@ func file_apiserver_v1_menu_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_menu_proto_rawDescData
 }
 
-var file_apiserver_v1_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_apiserver_v1_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_apiserver_v1_menu_proto_goTypes = []any{
-	(*Menu)(nil),                    // 0: apiserver.v1.Menu
-	(*CreateMenuRequest)(nil),       // 1: apiserver.v1.CreateMenuRequest
-	(*CreateMenuResponse)(nil),      // 2: apiserver.v1.CreateMenuResponse
-	(*UpdateMenuRequest)(nil),       // 3: apiserver.v1.UpdateMenuRequest
-	(*UpdateMenuResponse)(nil),      // 4: apiserver.v1.UpdateMenuResponse
-	(*DeleteMenuRequest)(nil),       // 5: apiserver.v1.DeleteMenuRequest
-	(*DeleteMenuResponse)(nil),      // 6: apiserver.v1.DeleteMenuResponse
-	(*GetMenuRequest)(nil),          // 7: apiserver.v1.GetMenuRequest
-	(*GetMenuResponse)(nil),         // 8: apiserver.v1.GetMenuResponse
-	(*ListMenuRequest)(nil),         // 9: apiserver.v1.ListMenuRequest
-	(*ListMenuResponse)(nil),        // 10: apiserver.v1.ListMenuResponse
-	(*ListMenuTreeRequest)(nil),     // 11: apiserver.v1.ListMenuTreeRequest
-	(*ListMenuTreeResponse)(nil),    // 12: apiserver.v1.ListMenuTreeResponse
-	(*MenuTreeNode)(nil),            // 13: apiserver.v1.MenuTreeNode
-	(*GetUserMenuTreeRequest)(nil),  // 14: apiserver.v1.GetUserMenuTreeRequest
-	(*GetUserMenuTreeResponse)(nil), // 15: apiserver.v1.GetUserMenuTreeResponse
+	(*Menu)(nil),                     // 0: apiserver.v1.Menu
+	(*CreateMenuRequest)(nil),        // 1: apiserver.v1.CreateMenuRequest
+	(*CreateMenuResponse)(nil),       // 2: apiserver.v1.CreateMenuResponse
+	(*UpdateMenuRequest)(nil),        // 3: apiserver.v1.UpdateMenuRequest
+	(*UpdateMenuResponse)(nil),       // 4: apiserver.v1.UpdateMenuResponse
+	(*DeleteMenuRequest)(nil),        // 5: apiserver.v1.DeleteMenuRequest
+	(*DeleteMenuResponse)(nil),       // 6: apiserver.v1.DeleteMenuResponse
+	(*GetMenuRequest)(nil),           // 7: apiserver.v1.GetMenuRequest
+	(*GetMenuResponse)(nil),          // 8: apiserver.v1.GetMenuResponse
+	(*ListMenuRequest)(nil),          // 9: apiserver.v1.ListMenuRequest
+	(*ListMenuResponse)(nil),         // 10: apiserver.v1.ListMenuResponse
+	(*ListMenuTreeRequest)(nil),      // 11: apiserver.v1.ListMenuTreeRequest
+	(*ListMenuTreeResponse)(nil),     // 12: apiserver.v1.ListMenuTreeResponse
+	(*MenuTreeNode)(nil),             // 13: apiserver.v1.MenuTreeNode
+	(*GetUserMenuTreeRequest)(nil),   // 14: apiserver.v1.GetUserMenuTreeRequest
+	(*GetUserMenuTreeResponse)(nil),  // 15: apiserver.v1.GetUserMenuTreeResponse
+	(*ListDeletedMenusRequest)(nil),  // 16: apiserver.v1.ListDeletedMenusRequest
+	(*ListDeletedMenusResponse)(nil), // 17: apiserver.v1.ListDeletedMenusResponse
+	(*RestoreMenuRequest)(nil),       // 18: apiserver.v1.RestoreMenuRequest
+	(*RestoreMenuResponse)(nil),      // 19: apiserver.v1.RestoreMenuResponse
 }
 var file_apiserver_v1_menu_proto_depIdxs = []int32{
 	0,  // 0: apiserver.v1.GetMenuResponse.menu:type_name -> apiserver.v1.Menu
@@ -1199,11 +1476,12 @@ var file_apiserver_v1_menu_proto_depIdxs = []int32{
 	0,  // 3: apiserver.v1.MenuTreeNode.menu:type_name -> apiserver.v1.Menu
 	13, // 4: apiserver.v1.MenuTreeNode.children:type_name -> apiserver.v1.MenuTreeNode
 	13, // 5: apiserver.v1.GetUserMenuTreeResponse.menus:type_name -> apiserver.v1.MenuTreeNode
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 6: apiserver.v1.ListDeletedMenusResponse.menus:type_name -> apiserver.v1.Menu
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_menu_proto_init() }
@@ -1221,7 +1499,7 @@ func file_apiserver_v1_menu_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_menu_proto_rawDesc), len(file_apiserver_v1_menu_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
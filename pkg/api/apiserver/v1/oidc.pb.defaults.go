@@ -0,0 +1,54 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+)
+
+func (x *OIDCProvider) Default() {
+}
+
+func (x *ListOIDCProvidersRequest) Default() {
+}
+
+func (x *ListOIDCProvidersResponse) Default() {
+}
+
+func (x *StartOIDCLoginRequest) Default() {
+}
+
+func (x *StartOIDCLoginResponse) Default() {
+}
+
+func (x *OIDCCallbackRequest) Default() {
+}
+
+func (x *OIDCCallbackResponse) Default() {
+}
+
+func (x *UserIdentity) Default() {
+}
+
+func (x *ListUserIdentitiesRequest) Default() {
+}
+
+func (x *ListUserIdentitiesResponse) Default() {
+}
+
+func (x *LinkUserIdentityRequest) Default() {
+}
+
+func (x *UnlinkUserIdentityRequest) Default() {
+}
+
+func (x *UnlinkUserIdentityResponse) Default() {
+}
@@ -0,0 +1,865 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.30.0
+// source: apiserver/v1/oidc.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OIDCProvider 表示一个可用于单点登录的 OIDC Provider
+type OIDCProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name 表示 Provider 名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// displayName 表示展示给用户的名称
+	DisplayName   string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCProvider) Reset() {
+	*x = OIDCProvider{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCProvider) ProtoMessage() {}
+
+func (x *OIDCProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCProvider.ProtoReflect.Descriptor instead.
+func (*OIDCProvider) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OIDCProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// ListOIDCProvidersRequest 表示获取可用 OIDC Provider 列表请求
+type ListOIDCProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOIDCProvidersRequest) Reset() {
+	*x = ListOIDCProvidersRequest{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOIDCProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOIDCProvidersRequest) ProtoMessage() {}
+
+func (x *ListOIDCProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOIDCProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListOIDCProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{1}
+}
+
+// ListOIDCProvidersResponse 表示获取可用 OIDC Provider 列表响应
+type ListOIDCProvidersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// providers 表示 Provider 列表
+	Providers     []*OIDCProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOIDCProvidersResponse) Reset() {
+	*x = ListOIDCProvidersResponse{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOIDCProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOIDCProvidersResponse) ProtoMessage() {}
+
+func (x *ListOIDCProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOIDCProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListOIDCProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListOIDCProvidersResponse) GetProviders() []*OIDCProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// StartOIDCLoginRequest 表示发起 OIDC 登录请求
+type StartOIDCLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider 表示 Provider 名称
+	// @gotags: uri:"provider"
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty" uri:"provider"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOIDCLoginRequest) Reset() {
+	*x = StartOIDCLoginRequest{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOIDCLoginRequest) ProtoMessage() {}
+
+func (x *StartOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// StartOIDCLoginResponse 表示发起 OIDC 登录（或关联身份）响应
+type StartOIDCLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// authorizationURL 表示 IdP 的授权地址，客户端需要将用户重定向到该地址
+	AuthorizationURL string `protobuf:"bytes,1,opt,name=authorizationURL,proto3" json:"authorizationURL,omitempty"`
+	// state 表示本次登录流程的 state，回调时原样返回
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// expiresAt 表示本次登录流程的过期时间
+	ExpiresAt     int64 `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOIDCLoginResponse) Reset() {
+	*x = StartOIDCLoginResponse{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOIDCLoginResponse) ProtoMessage() {}
+
+func (x *StartOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartOIDCLoginResponse) GetAuthorizationURL() string {
+	if x != nil {
+		return x.AuthorizationURL
+	}
+	return ""
+}
+
+func (x *StartOIDCLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StartOIDCLoginResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// OIDCCallbackRequest 表示 OIDC 授权回调请求
+type OIDCCallbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code 表示 IdP 返回的授权码
+	// @gotags: form:"code"
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty" form:"code"`
+	// state 表示发起登录时返回的 state
+	// @gotags: form:"state"
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty" form:"state"`
+	// error 表示 IdP 返回的错误码（用户拒绝授权等）
+	// @gotags: form:"error"
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty" form:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCCallbackRequest) Reset() {
+	*x = OIDCCallbackRequest{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCallbackRequest) ProtoMessage() {}
+
+func (x *OIDCCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OIDCCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OIDCCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OIDCCallbackRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// OIDCCallbackResponse 表示 OIDC 授权回调响应
+type OIDCCallbackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accessToken 表示访问令牌
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// refreshToken 表示刷新令牌
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// expireAt 表示访问令牌的过期时间
+	ExpireAt string `protobuf:"bytes,3,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	// userID 表示登录的用户 ID
+	UserID string `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	// created 表示是否在本次登录中自动创建了用户
+	Created bool `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	// linked 表示是否在本次登录中将外部身份关联到了用户
+	Linked        bool `protobuf:"varint,6,opt,name=linked,proto3" json:"linked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCCallbackResponse) Reset() {
+	*x = OIDCCallbackResponse{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCallbackResponse) ProtoMessage() {}
+
+func (x *OIDCCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCallbackResponse.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OIDCCallbackResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *OIDCCallbackResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+// UserIdentity 表示用户关联的外部身份
+type UserIdentity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider 表示 Provider 名称
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// subject 表示 IdP 中的用户唯一标识
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// email 表示 IdP 返回的邮箱
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// createdAt 表示关联时间
+	CreatedAt int64 `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// lastLoginAt 表示最近一次通过该身份登录的时间
+	LastLoginAt   int64 `protobuf:"varint,5,opt,name=lastLoginAt,proto3" json:"lastLoginAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIdentity) Reset() {
+	*x = UserIdentity{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdentity) ProtoMessage() {}
+
+func (x *UserIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdentity.ProtoReflect.Descriptor instead.
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UserIdentity) GetLastLoginAt() int64 {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return 0
+}
+
+// ListUserIdentitiesRequest 表示获取用户外部身份列表请求
+type ListUserIdentitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserIdentitiesRequest) Reset() {
+	*x = ListUserIdentitiesRequest{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListUserIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListUserIdentitiesRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// ListUserIdentitiesResponse 表示获取用户外部身份列表响应
+type ListUserIdentitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identities 表示外部身份列表
+	Identities    []*UserIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserIdentitiesResponse) Reset() {
+	*x = ListUserIdentitiesResponse{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListUserIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListUserIdentitiesResponse) GetIdentities() []*UserIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// LinkUserIdentityRequest 表示为当前用户关联外部身份请求
+type LinkUserIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// provider 表示 Provider 名称
+	// @gotags: uri:"provider"
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" uri:"provider"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkUserIdentityRequest) Reset() {
+	*x = LinkUserIdentityRequest{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkUserIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkUserIdentityRequest) ProtoMessage() {}
+
+func (x *LinkUserIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkUserIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkUserIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LinkUserIdentityRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *LinkUserIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// UnlinkUserIdentityRequest 表示解除外部身份关联请求
+type UnlinkUserIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// provider 表示 Provider 名称
+	// @gotags: uri:"provider"
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" uri:"provider"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkUserIdentityRequest) Reset() {
+	*x = UnlinkUserIdentityRequest{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkUserIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkUserIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkUserIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkUserIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkUserIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnlinkUserIdentityRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UnlinkUserIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// UnlinkUserIdentityResponse 表示解除外部身份关联响应
+type UnlinkUserIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkUserIdentityResponse) Reset() {
+	*x = UnlinkUserIdentityResponse{}
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkUserIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkUserIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkUserIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_oidc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkUserIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkUserIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_oidc_proto_rawDescGZIP(), []int{12}
+}
+
+var File_apiserver_v1_oidc_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_oidc_proto_rawDesc = "" +
+	"\n" +
+	"\x17apiserver/v1/oidc.proto\x12\fapiserver.v1\"D\n" +
+	"\fOIDCProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdisplayName\x18\x02 \x01(\tR\vdisplayName\"\x1a\n" +
+	"\x18ListOIDCProvidersRequest\"U\n" +
+	"\x19ListOIDCProvidersResponse\x128\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1a.apiserver.v1.OIDCProviderR\tproviders\"3\n" +
+	"\x15StartOIDCLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"x\n" +
+	"\x16StartOIDCLoginResponse\x12*\n" +
+	"\x10authorizationURL\x18\x01 \x01(\tR\x10authorizationURL\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1c\n" +
+	"\texpiresAt\x18\x03 \x01(\x03R\texpiresAt\"U\n" +
+	"\x13OIDCCallbackRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xc2\x01\n" +
+	"\x14OIDCCallbackResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x12\x1a\n" +
+	"\bexpireAt\x18\x03 \x01(\tR\bexpireAt\x12\x16\n" +
+	"\x06userID\x18\x04 \x01(\tR\x06userID\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\bR\acreated\x12\x16\n" +
+	"\x06linked\x18\x06 \x01(\bR\x06linked\"\x9a\x01\n" +
+	"\fUserIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\x03R\tcreatedAt\x12 \n" +
+	"\vlastLoginAt\x18\x05 \x01(\x03R\vlastLoginAt\"3\n" +
+	"\x19ListUserIdentitiesRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"X\n" +
+	"\x1aListUserIdentitiesResponse\x12:\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x1a.apiserver.v1.UserIdentityR\n" +
+	"identities\"M\n" +
+	"\x17LinkUserIdentityRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"O\n" +
+	"\x19UnlinkUserIdentityRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"\x1c\n" +
+	"\x1aUnlinkUserIdentityResponseBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+
+var (
+	file_apiserver_v1_oidc_proto_rawDescOnce sync.Once
+	file_apiserver_v1_oidc_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_oidc_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_oidc_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_oidc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_oidc_proto_rawDesc), len(file_apiserver_v1_oidc_proto_rawDesc)))
+	})
+	return file_apiserver_v1_oidc_proto_rawDescData
+}
+
+var file_apiserver_v1_oidc_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_apiserver_v1_oidc_proto_goTypes = []any{
+	(*OIDCProvider)(nil),               // 0: apiserver.v1.OIDCProvider
+	(*ListOIDCProvidersRequest)(nil),   // 1: apiserver.v1.ListOIDCProvidersRequest
+	(*ListOIDCProvidersResponse)(nil),  // 2: apiserver.v1.ListOIDCProvidersResponse
+	(*StartOIDCLoginRequest)(nil),      // 3: apiserver.v1.StartOIDCLoginRequest
+	(*StartOIDCLoginResponse)(nil),     // 4: apiserver.v1.StartOIDCLoginResponse
+	(*OIDCCallbackRequest)(nil),        // 5: apiserver.v1.OIDCCallbackRequest
+	(*OIDCCallbackResponse)(nil),       // 6: apiserver.v1.OIDCCallbackResponse
+	(*UserIdentity)(nil),               // 7: apiserver.v1.UserIdentity
+	(*ListUserIdentitiesRequest)(nil),  // 8: apiserver.v1.ListUserIdentitiesRequest
+	(*ListUserIdentitiesResponse)(nil), // 9: apiserver.v1.ListUserIdentitiesResponse
+	(*LinkUserIdentityRequest)(nil),    // 10: apiserver.v1.LinkUserIdentityRequest
+	(*UnlinkUserIdentityRequest)(nil),  // 11: apiserver.v1.UnlinkUserIdentityRequest
+	(*UnlinkUserIdentityResponse)(nil), // 12: apiserver.v1.UnlinkUserIdentityResponse
+}
+var file_apiserver_v1_oidc_proto_depIdxs = []int32{
+	0, // 0: apiserver.v1.ListOIDCProvidersResponse.providers:type_name -> apiserver.v1.OIDCProvider
+	7, // 1: apiserver.v1.ListUserIdentitiesResponse.identities:type_name -> apiserver.v1.UserIdentity
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_oidc_proto_init() }
+func file_apiserver_v1_oidc_proto_init() {
+	if File_apiserver_v1_oidc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_oidc_proto_rawDesc), len(file_apiserver_v1_oidc_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_oidc_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_oidc_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_oidc_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_oidc_proto = out.File
+	file_apiserver_v1_oidc_proto_goTypes = nil
+	file_apiserver_v1_oidc_proto_depIdxs = nil
+}
@@ -17,6 +17,9 @@ var (
 func (x *Permission) Default() {
 }
 
+func (x *PermissionCondition) Default() {
+}
+
 func (x *CreatePermissionRequest) Default() {
 }
 
@@ -59,3 +62,27 @@ func (x *ListPermissionTreeResponse) Default() {
 
 func (x *PermissionTreeNode) Default() {
 }
+
+func (x *GetAPICatalogRequest) Default() {
+}
+
+func (x *GetAPICatalogResponse) Default() {
+}
+
+func (x *APIModule) Default() {
+}
+
+func (x *APIRoute) Default() {
+}
+
+func (x *ListDeletedPermissionsRequest) Default() {
+}
+
+func (x *ListDeletedPermissionsResponse) Default() {
+}
+
+func (x *RestorePermissionRequest) Default() {
+}
+
+func (x *RestorePermissionResponse) Default() {
+}
@@ -31,7 +31,7 @@ type Permission struct {
 	PermissionName string `protobuf:"bytes,2,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
 	// permissionCode 表示权限编码
 	PermissionCode string `protobuf:"bytes,3,opt,name=permissionCode,proto3" json:"permissionCode,omitempty"`
-	// resourceType 表示资源类型（menu=菜单, button=按钮）
+	// resourceType 表示资源类型（menu=菜单, button=按钮, api=接口）
 	ResourceType string `protobuf:"bytes,4,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
 	// resourcePath 表示资源路径
 	ResourcePath string `protobuf:"bytes,5,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
@@ -48,7 +48,15 @@ type Permission struct {
 	// createdAt 表示创建时间
 	CreatedAt int64 `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示更新时间
-	UpdatedAt     int64 `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// conditions 表示权限的 ABAC 生效条件，为空表示无条件生效
+	Conditions *PermissionCondition `protobuf:"bytes,13,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	// stale 表示自动发现的 API 权限对应的路由是否已不存在
+	Stale bool `protobuf:"varint,14,opt,name=stale,proto3" json:"stale,omitempty"`
+	// deletedAt 表示删除时间（Unix 时间戳，秒），仅回收站中的权限有值
+	DeletedAt int64 `protobuf:"varint,15,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// version 表示资源版本号，每次修改后递增，可以作为更新请求的前置条件
+	Version       int64 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -167,6 +175,125 @@ func (x *Permission) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Permission) GetConditions() *PermissionCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Permission) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *Permission) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Permission) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// PermissionCondition 表示权限的 ABAC 生效条件，所有已设置的条件同时满足时权限才生效
+type PermissionCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cidrs 表示允许访问的客户端 IP 段（如 10.0.0.0/8），支持单个 IP
+	Cidrs []string `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	// weekdays 表示允许访问的星期（0=周日 ... 6=周六）
+	Weekdays []int32 `protobuf:"varint,2,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// startHour 表示允许访问的起始小时（包含，0-23）
+	StartHour *int32 `protobuf:"varint,3,opt,name=startHour,proto3,oneof" json:"startHour,omitempty"`
+	// endHour 表示允许访问的结束小时（不包含，1-24），小于 startHour 时表示跨零点
+	EndHour *int32 `protobuf:"varint,4,opt,name=endHour,proto3,oneof" json:"endHour,omitempty"`
+	// timezone 表示计算星期和小时使用的时区（IANA 名称），默认 UTC
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// attributes 表示要求请求满足的属性（user.id、route、param.<name> 等服务端属性，名称不区分大小写）及其取值
+	Attributes    map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionCondition) Reset() {
+	*x = PermissionCondition{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCondition) ProtoMessage() {}
+
+func (x *PermissionCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCondition.ProtoReflect.Descriptor instead.
+func (*PermissionCondition) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PermissionCondition) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *PermissionCondition) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *PermissionCondition) GetStartHour() int32 {
+	if x != nil && x.StartHour != nil {
+		return *x.StartHour
+	}
+	return 0
+}
+
+func (x *PermissionCondition) GetEndHour() int32 {
+	if x != nil && x.EndHour != nil {
+		return *x.EndHour
+	}
+	return 0
+}
+
+func (x *PermissionCondition) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PermissionCondition) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // CreatePermissionRequest 表示创建权限请求
 type CreatePermissionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -174,7 +301,7 @@ type CreatePermissionRequest struct {
 	PermissionName string `protobuf:"bytes,1,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
 	// permissionCode 表示权限编码
 	PermissionCode string `protobuf:"bytes,2,opt,name=permissionCode,proto3" json:"permissionCode,omitempty"`
-	// resourceType 表示资源类型（menu=菜单, button=按钮）
+	// resourceType 表示资源类型（menu=菜单, button=按钮, api=接口）
 	ResourceType string `protobuf:"bytes,3,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
 	// resourcePath 表示资源路径
 	ResourcePath *string `protobuf:"bytes,4,opt,name=resourcePath,proto3,oneof" json:"resourcePath,omitempty"`
@@ -183,14 +310,16 @@ type CreatePermissionRequest struct {
 	// description 表示权限描述
 	Description *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	// parentID 表示父权限 ID
-	ParentID      *string `protobuf:"bytes,7,opt,name=parentID,proto3,oneof" json:"parentID,omitempty"`
+	ParentID *string `protobuf:"bytes,7,opt,name=parentID,proto3,oneof" json:"parentID,omitempty"`
+	// conditions 表示可选的 ABAC 生效条件
+	Conditions    *PermissionCondition `protobuf:"bytes,8,opt,name=conditions,proto3,oneof" json:"conditions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePermissionRequest) Reset() {
 	*x = CreatePermissionRequest{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[1]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +331,7 @@ func (x *CreatePermissionRequest) String() string {
 func (*CreatePermissionRequest) ProtoMessage() {}
 
 func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[1]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +344,7 @@ func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{1}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePermissionRequest) GetPermissionName() string {
@@ -267,6 +396,13 @@ func (x *CreatePermissionRequest) GetParentID() string {
 	return ""
 }
 
+func (x *CreatePermissionRequest) GetConditions() *PermissionCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 // CreatePermissionResponse 表示创建权限响应
 type CreatePermissionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -278,7 +414,7 @@ type CreatePermissionResponse struct {
 
 func (x *CreatePermissionResponse) Reset() {
 	*x = CreatePermissionResponse{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[2]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -290,7 +426,7 @@ func (x *CreatePermissionResponse) String() string {
 func (*CreatePermissionResponse) ProtoMessage() {}
 
 func (x *CreatePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[2]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +439,7 @@ func (x *CreatePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePermissionResponse.ProtoReflect.Descriptor instead.
 func (*CreatePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{2}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePermissionResponse) GetPermissionID() string {
@@ -324,14 +460,21 @@ type UpdatePermissionRequest struct {
 	// description 表示可选的权限描述
 	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	// status 表示可选的权限状态（0=启用,1=禁用）
-	Status        *int32 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Status *int32 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// conditions 表示可选的 ABAC 生效条件，传入空对象表示清除条件
+	Conditions *PermissionCondition `protobuf:"bytes,5,opt,name=conditions,proto3,oneof" json:"conditions,omitempty"`
+	// version 表示可选的权限版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version *int64 `protobuf:"varint,6,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示需要修改的字段名列表（如 permissionName、conditions），为空时修改所有已设置的字段；
+	// 设置后只修改列出的字段，列出但未传值的字段会被清空
+	UpdateMask    []string `protobuf:"bytes,7,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePermissionRequest) Reset() {
 	*x = UpdatePermissionRequest{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[3]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +486,7 @@ func (x *UpdatePermissionRequest) String() string {
 func (*UpdatePermissionRequest) ProtoMessage() {}
 
 func (x *UpdatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[3]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +499,7 @@ func (x *UpdatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{3}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdatePermissionRequest) GetPermissionID() string {
@@ -387,16 +530,39 @@ func (x *UpdatePermissionRequest) GetStatus() int32 {
 	return 0
 }
 
+func (x *UpdatePermissionRequest) GetConditions() *PermissionCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *UpdatePermissionRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdatePermissionRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // UpdatePermissionResponse 表示更新权限响应
 type UpdatePermissionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的权限版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePermissionResponse) Reset() {
 	*x = UpdatePermissionResponse{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[4]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +574,7 @@ func (x *UpdatePermissionResponse) String() string {
 func (*UpdatePermissionResponse) ProtoMessage() {}
 
 func (x *UpdatePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[4]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +587,14 @@ func (x *UpdatePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{4}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePermissionResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
 // DeletePermissionRequest 表示删除权限请求
@@ -436,7 +609,7 @@ type DeletePermissionRequest struct {
 
 func (x *DeletePermissionRequest) Reset() {
 	*x = DeletePermissionRequest{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[5]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +621,7 @@ func (x *DeletePermissionRequest) String() string {
 func (*DeletePermissionRequest) ProtoMessage() {}
 
 func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[5]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +634,7 @@ func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePermissionRequest.ProtoReflect.Descriptor instead.
 func (*DeletePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{5}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeletePermissionRequest) GetPermissionID() string {
@@ -480,7 +653,7 @@ type DeletePermissionResponse struct {
 
 func (x *DeletePermissionResponse) Reset() {
 	*x = DeletePermissionResponse{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[6]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +665,7 @@ func (x *DeletePermissionResponse) String() string {
 func (*DeletePermissionResponse) ProtoMessage() {}
 
 func (x *DeletePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[6]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +678,7 @@ func (x *DeletePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePermissionResponse.ProtoReflect.Descriptor instead.
 func (*DeletePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{6}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{7}
 }
 
 // GetPermissionRequest 表示获取权限请求
@@ -520,7 +693,7 @@ type GetPermissionRequest struct {
 
 func (x *GetPermissionRequest) Reset() {
 	*x = GetPermissionRequest{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[7]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +705,7 @@ func (x *GetPermissionRequest) String() string {
 func (*GetPermissionRequest) ProtoMessage() {}
 
 func (x *GetPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[7]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +718,7 @@ func (x *GetPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{7}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPermissionRequest) GetPermissionID() string {
@@ -566,7 +739,7 @@ type GetPermissionResponse struct {
 
 func (x *GetPermissionResponse) Reset() {
 	*x = GetPermissionResponse{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[8]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +751,7 @@ func (x *GetPermissionResponse) String() string {
 func (*GetPermissionResponse) ProtoMessage() {}
 
 func (x *GetPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[8]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +764,7 @@ func (x *GetPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionResponse.ProtoReflect.Descriptor instead.
 func (*GetPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{8}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPermissionResponse) GetPermission() *Permission {
@@ -610,7 +783,7 @@ type ListPermissionRequest struct {
 	// pageSize 表示每页数量
 	// @gotags: form:"page_size"
 	PageSize int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
-	// resourceType 表示资源类型过滤（menu=菜单, button=按钮，留空表示全部）
+	// resourceType 表示资源类型过滤（menu=菜单, button=按钮, api=接口，留空表示全部）
 	// @gotags: form:"resource_type"
 	ResourceType *string `protobuf:"bytes,3,opt,name=resourceType,proto3,oneof" json:"resourceType,omitempty" form:"resource_type"`
 	// status 表示状态过滤（0=启用,1=禁用，留空表示全部）
@@ -625,7 +798,7 @@ type ListPermissionRequest struct {
 
 func (x *ListPermissionRequest) Reset() {
 	*x = ListPermissionRequest{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[9]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +810,7 @@ func (x *ListPermissionRequest) String() string {
 func (*ListPermissionRequest) ProtoMessage() {}
 
 func (x *ListPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[9]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +823,7 @@ func (x *ListPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{9}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListPermissionRequest) GetPageToken() string {
@@ -703,7 +876,7 @@ type ListPermissionResponse struct {
 
 func (x *ListPermissionResponse) Reset() {
 	*x = ListPermissionResponse{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[10]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +888,7 @@ func (x *ListPermissionResponse) String() string {
 func (*ListPermissionResponse) ProtoMessage() {}
 
 func (x *ListPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[10]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +901,7 @@ func (x *ListPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{10}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListPermissionResponse) GetTotalCount() int64 {
@@ -770,7 +943,7 @@ type ListPermissionTreeRequest struct {
 
 func (x *ListPermissionTreeRequest) Reset() {
 	*x = ListPermissionTreeRequest{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[11]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -782,7 +955,7 @@ func (x *ListPermissionTreeRequest) String() string {
 func (*ListPermissionTreeRequest) ProtoMessage() {}
 
 func (x *ListPermissionTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[11]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,7 +968,7 @@ func (x *ListPermissionTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionTreeRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionTreeRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{11}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListPermissionTreeRequest) GetLevel() int32 {
@@ -830,7 +1003,7 @@ type ListPermissionTreeResponse struct {
 
 func (x *ListPermissionTreeResponse) Reset() {
 	*x = ListPermissionTreeResponse{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[12]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +1015,7 @@ func (x *ListPermissionTreeResponse) String() string {
 func (*ListPermissionTreeResponse) ProtoMessage() {}
 
 func (x *ListPermissionTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[12]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1028,7 @@ func (x *ListPermissionTreeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionTreeResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionTreeResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{12}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListPermissionTreeResponse) GetPermissions() []*PermissionTreeNode {
@@ -878,7 +1051,7 @@ type PermissionTreeNode struct {
 
 func (x *PermissionTreeNode) Reset() {
 	*x = PermissionTreeNode{}
-	mi := &file_apiserver_v1_permission_proto_msgTypes[13]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1063,7 @@ func (x *PermissionTreeNode) String() string {
 func (*PermissionTreeNode) ProtoMessage() {}
 
 func (x *PermissionTreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_permission_proto_msgTypes[13]
+	mi := &file_apiserver_v1_permission_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1076,7 @@ func (x *PermissionTreeNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionTreeNode.ProtoReflect.Descriptor instead.
 func (*PermissionTreeNode) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{13}
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PermissionTreeNode) GetPermission() *Permission {
@@ -920,11 +1093,473 @@ func (x *PermissionTreeNode) GetChildren() []*PermissionTreeNode {
 	return nil
 }
 
+// GetAPICatalogRequest 表示获取 API 路由目录请求
+type GetAPICatalogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// includeStale 表示是否包含已不存在的路由
+	// @gotags: form:"include_stale"
+	IncludeStale  bool `protobuf:"varint,1,opt,name=includeStale,proto3" json:"includeStale,omitempty" form:"include_stale"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPICatalogRequest) Reset() {
+	*x = GetAPICatalogRequest{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPICatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPICatalogRequest) ProtoMessage() {}
+
+func (x *GetAPICatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPICatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetAPICatalogRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAPICatalogRequest) GetIncludeStale() bool {
+	if x != nil {
+		return x.IncludeStale
+	}
+	return false
+}
+
+// GetAPICatalogResponse 表示获取 API 路由目录响应
+type GetAPICatalogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// modules 表示按模块分组的 API 路由
+	Modules       []*APIModule `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPICatalogResponse) Reset() {
+	*x = GetAPICatalogResponse{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPICatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPICatalogResponse) ProtoMessage() {}
+
+func (x *GetAPICatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPICatalogResponse.ProtoReflect.Descriptor instead.
+func (*GetAPICatalogResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAPICatalogResponse) GetModules() []*APIModule {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+// APIModule 表示一个 API 模块及其路由
+type APIModule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// permissionID 表示模块父权限 ID
+	PermissionID string `protobuf:"bytes,1,opt,name=permissionID,proto3" json:"permissionID,omitempty"`
+	// module 表示模块标识（/v1 之后的第一段路径）
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// name 表示模块名称
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// routes 表示模块下的路由
+	Routes        []*APIRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIModule) Reset() {
+	*x = APIModule{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIModule) ProtoMessage() {}
+
+func (x *APIModule) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIModule.ProtoReflect.Descriptor instead.
+func (*APIModule) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *APIModule) GetPermissionID() string {
+	if x != nil {
+		return x.PermissionID
+	}
+	return ""
+}
+
+func (x *APIModule) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *APIModule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIModule) GetRoutes() []*APIRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+// APIRoute 表示一个已注册的 API 路由
+type APIRoute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// permissionID 表示路由对应的权限 ID
+	PermissionID string `protobuf:"bytes,1,opt,name=permissionID,proto3" json:"permissionID,omitempty"`
+	// name 表示路由名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// method 表示 HTTP 方法
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// route 表示 Gin 路由路径（如 /v1/users/:userID）
+	Route string `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	// resourcePath 表示用于授权匹配的资源路径（如 /v1/users/*）
+	ResourcePath string `protobuf:"bytes,5,opt,name=resourcePath,proto3" json:"resourcePath,omitempty"`
+	// stale 表示路由是否已不存在
+	Stale         bool `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIRoute) Reset() {
+	*x = APIRoute{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIRoute) ProtoMessage() {}
+
+func (x *APIRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIRoute.ProtoReflect.Descriptor instead.
+func (*APIRoute) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *APIRoute) GetPermissionID() string {
+	if x != nil {
+		return x.PermissionID
+	}
+	return ""
+}
+
+func (x *APIRoute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIRoute) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *APIRoute) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *APIRoute) GetResourcePath() string {
+	if x != nil {
+		return x.ResourcePath
+	}
+	return ""
+}
+
+func (x *APIRoute) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+// ListDeletedPermissionsRequest 表示回收站权限列表请求
+type ListDeletedPermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPermissionsRequest) Reset() {
+	*x = ListDeletedPermissionsRequest{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPermissionsRequest) ProtoMessage() {}
+
+func (x *ListDeletedPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDeletedPermissionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeletedPermissionsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListDeletedPermissionsResponse 表示回收站权限列表响应
+type ListDeletedPermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示回收站中的权限总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// permissions 表示已删除的权限列表
+	Permissions []*Permission `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// pageToken 表示下一页游标
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPermissionsResponse) Reset() {
+	*x = ListDeletedPermissionsResponse{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPermissionsResponse) ProtoMessage() {}
+
+func (x *ListDeletedPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDeletedPermissionsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDeletedPermissionsResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ListDeletedPermissionsResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// RestorePermissionRequest 表示从回收站恢复权限请求
+type RestorePermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// permissionID 表示权限 ID
+	// @gotags: uri:"permissionID"
+	PermissionID  string `protobuf:"bytes,1,opt,name=permissionID,proto3" json:"permissionID,omitempty" uri:"permissionID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePermissionRequest) Reset() {
+	*x = RestorePermissionRequest{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePermissionRequest) ProtoMessage() {}
+
+func (x *RestorePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePermissionRequest.ProtoReflect.Descriptor instead.
+func (*RestorePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestorePermissionRequest) GetPermissionID() string {
+	if x != nil {
+		return x.PermissionID
+	}
+	return ""
+}
+
+// RestorePermissionResponse 表示从回收站恢复权限响应
+type RestorePermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePermissionResponse) Reset() {
+	*x = RestorePermissionResponse{}
+	mi := &file_apiserver_v1_permission_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePermissionResponse) ProtoMessage() {}
+
+func (x *RestorePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_permission_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePermissionResponse.ProtoReflect.Descriptor instead.
+func (*RestorePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_permission_proto_rawDescGZIP(), []int{22}
+}
+
 var File_apiserver_v1_permission_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapiserver/v1/permission.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\x86\x03\n" +
+	"\x1dapiserver/v1/permission.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\x97\x04\n" +
 	"\n" +
 	"Permission\x12\"\n" +
 	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\x12&\n" +
@@ -939,7 +1574,29 @@ const file_apiserver_v1_permission_proto_rawDesc = "" +
 	"\x06status\x18\n" +
 	" \x01(\x05R\x06status\x12\x1c\n" +
 	"\tcreatedAt\x18\v \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\f \x01(\x03R\tupdatedAt\"\xc4\x02\n" +
+	"\tupdatedAt\x18\f \x01(\x03R\tupdatedAt\x12A\n" +
+	"\n" +
+	"conditions\x18\r \x01(\v2!.apiserver.v1.PermissionConditionR\n" +
+	"conditions\x12\x14\n" +
+	"\x05stale\x18\x0e \x01(\bR\x05stale\x12\x1c\n" +
+	"\tdeletedAt\x18\x0f \x01(\x03R\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\"\xd1\x02\n" +
+	"\x13PermissionCondition\x12\x14\n" +
+	"\x05cidrs\x18\x01 \x03(\tR\x05cidrs\x12\x1a\n" +
+	"\bweekdays\x18\x02 \x03(\x05R\bweekdays\x12!\n" +
+	"\tstartHour\x18\x03 \x01(\x05H\x00R\tstartHour\x88\x01\x01\x12\x1d\n" +
+	"\aendHour\x18\x04 \x01(\x05H\x01R\aendHour\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12Q\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v21.apiserver.v1.PermissionCondition.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_startHourB\n" +
+	"\n" +
+	"\b_endHour\"\x9b\x03\n" +
 	"\x17CreatePermissionRequest\x12&\n" +
 	"\x0epermissionName\x18\x01 \x01(\tR\x0epermissionName\x12&\n" +
 	"\x0epermissionCode\x18\x02 \x01(\tR\x0epermissionCode\x12\"\n" +
@@ -947,21 +1604,36 @@ const file_apiserver_v1_permission_proto_rawDesc = "" +
 	"\fresourcePath\x18\x04 \x01(\tH\x00R\fresourcePath\x88\x01\x01\x12\x16\n" +
 	"\x06action\x18\x05 \x01(\tR\x06action\x12%\n" +
 	"\vdescription\x18\x06 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
-	"\bparentID\x18\a \x01(\tH\x02R\bparentID\x88\x01\x01B\x0f\n" +
+	"\bparentID\x18\a \x01(\tH\x02R\bparentID\x88\x01\x01\x12F\n" +
+	"\n" +
+	"conditions\x18\b \x01(\v2!.apiserver.v1.PermissionConditionH\x03R\n" +
+	"conditions\x88\x01\x01B\x0f\n" +
 	"\r_resourcePathB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_parentID\">\n" +
+	"\t_parentIDB\r\n" +
+	"\v_conditions\">\n" +
 	"\x18CreatePermissionResponse\x12\"\n" +
-	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\"\xdc\x01\n" +
+	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\"\xfe\x02\n" +
 	"\x17UpdatePermissionRequest\x12\"\n" +
 	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\x12+\n" +
 	"\x0epermissionName\x18\x02 \x01(\tH\x00R\x0epermissionName\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
-	"\x06status\x18\x04 \x01(\x05H\x02R\x06status\x88\x01\x01B\x11\n" +
+	"\x06status\x18\x04 \x01(\x05H\x02R\x06status\x88\x01\x01\x12F\n" +
+	"\n" +
+	"conditions\x18\x05 \x01(\v2!.apiserver.v1.PermissionConditionH\x03R\n" +
+	"conditions\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x06 \x01(\x03H\x04R\aversion\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\a \x03(\tR\n" +
+	"updateMaskB\x11\n" +
 	"\x0f_permissionNameB\x0e\n" +
 	"\f_descriptionB\t\n" +
-	"\a_status\"\x1a\n" +
-	"\x18UpdatePermissionResponse\"=\n" +
+	"\a_statusB\r\n" +
+	"\v_conditionsB\n" +
+	"\n" +
+	"\b_version\"4\n" +
+	"\x18UpdatePermissionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"=\n" +
 	"\x17DeletePermissionRequest\x12\"\n" +
 	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\"\x1a\n" +
 	"\x18DeletePermissionResponse\":\n" +
@@ -999,7 +1671,35 @@ const file_apiserver_v1_permission_proto_rawDesc = "" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2\x18.apiserver.v1.PermissionR\n" +
 	"permission\x12<\n" +
-	"\bchildren\x18\x02 \x03(\v2 .apiserver.v1.PermissionTreeNodeR\bchildrenBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+	"\bchildren\x18\x02 \x03(\v2 .apiserver.v1.PermissionTreeNodeR\bchildren\":\n" +
+	"\x14GetAPICatalogRequest\x12\"\n" +
+	"\fincludeStale\x18\x01 \x01(\bR\fincludeStale\"J\n" +
+	"\x15GetAPICatalogResponse\x121\n" +
+	"\amodules\x18\x01 \x03(\v2\x17.apiserver.v1.APIModuleR\amodules\"\x8b\x01\n" +
+	"\tAPIModule\x12\"\n" +
+	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\x12\x16\n" +
+	"\x06module\x18\x02 \x01(\tR\x06module\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\x06routes\x18\x04 \x03(\v2\x16.apiserver.v1.APIRouteR\x06routes\"\xaa\x01\n" +
+	"\bAPIRoute\x12\"\n" +
+	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x14\n" +
+	"\x05route\x18\x04 \x01(\tR\x05route\x12\"\n" +
+	"\fresourcePath\x18\x05 \x01(\tR\fresourcePath\x12\x14\n" +
+	"\x05stale\x18\x06 \x01(\bR\x05stale\"Y\n" +
+	"\x1dListDeletedPermissionsRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x9a\x01\n" +
+	"\x1eListDeletedPermissionsResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12:\n" +
+	"\vpermissions\x18\x02 \x03(\v2\x18.apiserver.v1.PermissionR\vpermissions\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\">\n" +
+	"\x18RestorePermissionRequest\x12\"\n" +
+	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\"\x1b\n" +
+	"\x19RestorePermissionResponseBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
 
 var (
 	file_apiserver_v1_permission_proto_rawDescOnce sync.Once
@@ -1013,34 +1713,51 @@ func file_apiserver_v1_permission_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_permission_proto_rawDescData
 }
 
-var file_apiserver_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_apiserver_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_apiserver_v1_permission_proto_goTypes = []any{
-	(*Permission)(nil),                 // 0: apiserver.v1.Permission
-	(*CreatePermissionRequest)(nil),    // 1: apiserver.v1.CreatePermissionRequest
-	(*CreatePermissionResponse)(nil),   // 2: apiserver.v1.CreatePermissionResponse
-	(*UpdatePermissionRequest)(nil),    // 3: apiserver.v1.UpdatePermissionRequest
-	(*UpdatePermissionResponse)(nil),   // 4: apiserver.v1.UpdatePermissionResponse
-	(*DeletePermissionRequest)(nil),    // 5: apiserver.v1.DeletePermissionRequest
-	(*DeletePermissionResponse)(nil),   // 6: apiserver.v1.DeletePermissionResponse
-	(*GetPermissionRequest)(nil),       // 7: apiserver.v1.GetPermissionRequest
-	(*GetPermissionResponse)(nil),      // 8: apiserver.v1.GetPermissionResponse
-	(*ListPermissionRequest)(nil),      // 9: apiserver.v1.ListPermissionRequest
-	(*ListPermissionResponse)(nil),     // 10: apiserver.v1.ListPermissionResponse
-	(*ListPermissionTreeRequest)(nil),  // 11: apiserver.v1.ListPermissionTreeRequest
-	(*ListPermissionTreeResponse)(nil), // 12: apiserver.v1.ListPermissionTreeResponse
-	(*PermissionTreeNode)(nil),         // 13: apiserver.v1.PermissionTreeNode
+	(*Permission)(nil),                     // 0: apiserver.v1.Permission
+	(*PermissionCondition)(nil),            // 1: apiserver.v1.PermissionCondition
+	(*CreatePermissionRequest)(nil),        // 2: apiserver.v1.CreatePermissionRequest
+	(*CreatePermissionResponse)(nil),       // 3: apiserver.v1.CreatePermissionResponse
+	(*UpdatePermissionRequest)(nil),        // 4: apiserver.v1.UpdatePermissionRequest
+	(*UpdatePermissionResponse)(nil),       // 5: apiserver.v1.UpdatePermissionResponse
+	(*DeletePermissionRequest)(nil),        // 6: apiserver.v1.DeletePermissionRequest
+	(*DeletePermissionResponse)(nil),       // 7: apiserver.v1.DeletePermissionResponse
+	(*GetPermissionRequest)(nil),           // 8: apiserver.v1.GetPermissionRequest
+	(*GetPermissionResponse)(nil),          // 9: apiserver.v1.GetPermissionResponse
+	(*ListPermissionRequest)(nil),          // 10: apiserver.v1.ListPermissionRequest
+	(*ListPermissionResponse)(nil),         // 11: apiserver.v1.ListPermissionResponse
+	(*ListPermissionTreeRequest)(nil),      // 12: apiserver.v1.ListPermissionTreeRequest
+	(*ListPermissionTreeResponse)(nil),     // 13: apiserver.v1.ListPermissionTreeResponse
+	(*PermissionTreeNode)(nil),             // 14: apiserver.v1.PermissionTreeNode
+	(*GetAPICatalogRequest)(nil),           // 15: apiserver.v1.GetAPICatalogRequest
+	(*GetAPICatalogResponse)(nil),          // 16: apiserver.v1.GetAPICatalogResponse
+	(*APIModule)(nil),                      // 17: apiserver.v1.APIModule
+	(*APIRoute)(nil),                       // 18: apiserver.v1.APIRoute
+	(*ListDeletedPermissionsRequest)(nil),  // 19: apiserver.v1.ListDeletedPermissionsRequest
+	(*ListDeletedPermissionsResponse)(nil), // 20: apiserver.v1.ListDeletedPermissionsResponse
+	(*RestorePermissionRequest)(nil),       // 21: apiserver.v1.RestorePermissionRequest
+	(*RestorePermissionResponse)(nil),      // 22: apiserver.v1.RestorePermissionResponse
+	nil,                                    // 23: apiserver.v1.PermissionCondition.AttributesEntry
 }
 var file_apiserver_v1_permission_proto_depIdxs = []int32{
-	0,  // 0: apiserver.v1.GetPermissionResponse.permission:type_name -> apiserver.v1.Permission
-	0,  // 1: apiserver.v1.ListPermissionResponse.permissions:type_name -> apiserver.v1.Permission
-	13, // 2: apiserver.v1.ListPermissionTreeResponse.permissions:type_name -> apiserver.v1.PermissionTreeNode
-	0,  // 3: apiserver.v1.PermissionTreeNode.permission:type_name -> apiserver.v1.Permission
-	13, // 4: apiserver.v1.PermissionTreeNode.children:type_name -> apiserver.v1.PermissionTreeNode
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	1,  // 0: apiserver.v1.Permission.conditions:type_name -> apiserver.v1.PermissionCondition
+	23, // 1: apiserver.v1.PermissionCondition.attributes:type_name -> apiserver.v1.PermissionCondition.AttributesEntry
+	1,  // 2: apiserver.v1.CreatePermissionRequest.conditions:type_name -> apiserver.v1.PermissionCondition
+	1,  // 3: apiserver.v1.UpdatePermissionRequest.conditions:type_name -> apiserver.v1.PermissionCondition
+	0,  // 4: apiserver.v1.GetPermissionResponse.permission:type_name -> apiserver.v1.Permission
+	0,  // 5: apiserver.v1.ListPermissionResponse.permissions:type_name -> apiserver.v1.Permission
+	14, // 6: apiserver.v1.ListPermissionTreeResponse.permissions:type_name -> apiserver.v1.PermissionTreeNode
+	0,  // 7: apiserver.v1.PermissionTreeNode.permission:type_name -> apiserver.v1.Permission
+	14, // 8: apiserver.v1.PermissionTreeNode.children:type_name -> apiserver.v1.PermissionTreeNode
+	17, // 9: apiserver.v1.GetAPICatalogResponse.modules:type_name -> apiserver.v1.APIModule
+	18, // 10: apiserver.v1.APIModule.routes:type_name -> apiserver.v1.APIRoute
+	0,  // 11: apiserver.v1.ListDeletedPermissionsResponse.permissions:type_name -> apiserver.v1.Permission
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_permission_proto_init() }
@@ -1049,16 +1766,17 @@ func file_apiserver_v1_permission_proto_init() {
 		return
 	}
 	file_apiserver_v1_permission_proto_msgTypes[1].OneofWrappers = []any{}
-	file_apiserver_v1_permission_proto_msgTypes[3].OneofWrappers = []any{}
-	file_apiserver_v1_permission_proto_msgTypes[9].OneofWrappers = []any{}
-	file_apiserver_v1_permission_proto_msgTypes[11].OneofWrappers = []any{}
+	file_apiserver_v1_permission_proto_msgTypes[2].OneofWrappers = []any{}
+	file_apiserver_v1_permission_proto_msgTypes[4].OneofWrappers = []any{}
+	file_apiserver_v1_permission_proto_msgTypes[10].OneofWrappers = []any{}
+	file_apiserver_v1_permission_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_permission_proto_rawDesc), len(file_apiserver_v1_permission_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
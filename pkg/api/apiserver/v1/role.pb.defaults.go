@@ -58,6 +58,9 @@ func (x *AssignPermissionsToRoleRequest) Default() {
 	}
 }
 
+func (x *PermissionAssignment) Default() {
+}
+
 func (x *AssignPermissionsToRoleResponse) Default() {
 }
 
@@ -69,3 +72,15 @@ func (x *GetRolePermissionsResponse) Default() {
 
 func (x *PermissionTree) Default() {
 }
+
+func (x *ListDeletedRolesRequest) Default() {
+}
+
+func (x *ListDeletedRolesResponse) Default() {
+}
+
+func (x *RestoreRoleRequest) Default() {
+}
+
+func (x *RestoreRoleResponse) Default() {
+}
@@ -40,7 +40,15 @@ type Role struct {
 	// createdAt 表示创建时间
 	CreatedAt int64 `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示更新时间
-	UpdatedAt     int64 `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// sensitive 表示是否为敏感角色，授予敏感角色需要经过审批
+	Sensitive bool `protobuf:"varint,9,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// approverRoleIDs 表示可审批该角色授权申请的角色 ID 列表，为空时仅 super_admin 可审批
+	ApproverRoleIDs []string `protobuf:"bytes,10,rep,name=approverRoleIDs,proto3" json:"approverRoleIDs,omitempty"`
+	// deletedAt 表示删除时间（Unix 时间戳，秒），仅回收站中的角色有值
+	DeletedAt int64 `protobuf:"varint,11,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// version 表示资源版本号，每次修改后递增，可以作为更新请求的前置条件
+	Version       int64 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +139,34 @@ func (x *Role) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Role) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Role) GetApproverRoleIDs() []string {
+	if x != nil {
+		return x.ApproverRoleIDs
+	}
+	return nil
+}
+
+func (x *Role) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Role) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateRoleRequest 表示创建角色请求
 type CreateRoleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -141,9 +177,13 @@ type CreateRoleRequest struct {
 	// description 表示角色描述
 	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	// sortOrder 表示排序序号
-	SortOrder     *int32 `protobuf:"varint,4,opt,name=sortOrder,proto3,oneof" json:"sortOrder,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SortOrder *int32 `protobuf:"varint,4,opt,name=sortOrder,proto3,oneof" json:"sortOrder,omitempty"`
+	// sensitive 表示是否为敏感角色
+	Sensitive *bool `protobuf:"varint,5,opt,name=sensitive,proto3,oneof" json:"sensitive,omitempty"`
+	// approverRoleIDs 表示可审批该角色授权申请的角色 ID 列表
+	ApproverRoleIDs []string `protobuf:"bytes,6,rep,name=approverRoleIDs,proto3" json:"approverRoleIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateRoleRequest) Reset() {
@@ -204,6 +244,20 @@ func (x *CreateRoleRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *CreateRoleRequest) GetSensitive() bool {
+	if x != nil && x.Sensitive != nil {
+		return *x.Sensitive
+	}
+	return false
+}
+
+func (x *CreateRoleRequest) GetApproverRoleIDs() []string {
+	if x != nil {
+		return x.ApproverRoleIDs
+	}
+	return nil
+}
+
 // CreateRoleResponse 表示创建角色响应
 type CreateRoleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -263,7 +317,16 @@ type UpdateRoleRequest struct {
 	// status 表示可选的角色状态（0=启用,1=禁用）
 	Status *int32 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	// sortOrder 表示可选的排序序号
-	SortOrder     *int32 `protobuf:"varint,5,opt,name=sortOrder,proto3,oneof" json:"sortOrder,omitempty"`
+	SortOrder *int32 `protobuf:"varint,5,opt,name=sortOrder,proto3,oneof" json:"sortOrder,omitempty"`
+	// sensitive 表示可选的敏感角色标记
+	Sensitive *bool `protobuf:"varint,6,opt,name=sensitive,proto3,oneof" json:"sensitive,omitempty"`
+	// approverRoleIDs 表示可审批该角色授权申请的角色 ID 列表，未设置 updateMask 时为空表示不修改
+	ApproverRoleIDs []string `protobuf:"bytes,7,rep,name=approverRoleIDs,proto3" json:"approverRoleIDs,omitempty"`
+	// version 表示可选的角色版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version *int64 `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示需要修改的字段名列表（如 roleName、description），为空时修改所有已设置的字段；
+	// 设置后只修改列出的字段，列出但未传值的字段会被清空
+	UpdateMask    []string `protobuf:"bytes,9,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -333,9 +396,39 @@ func (x *UpdateRoleRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *UpdateRoleRequest) GetSensitive() bool {
+	if x != nil && x.Sensitive != nil {
+		return *x.Sensitive
+	}
+	return false
+}
+
+func (x *UpdateRoleRequest) GetApproverRoleIDs() []string {
+	if x != nil {
+		return x.ApproverRoleIDs
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateRoleRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // UpdateRoleResponse 表示更新角色响应
 type UpdateRoleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的角色版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -370,6 +463,13 @@ func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
 	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *UpdateRoleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // DeleteRoleRequest 表示删除角色请求
 type DeleteRoleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -698,7 +798,9 @@ type AssignPermissionsToRoleRequest struct {
 	PermissionIDs []string `protobuf:"bytes,2,rep,name=permissionIDs,proto3" json:"permissionIDs,omitempty"`
 	// mode 表示分配模式（override=覆盖, append=追加）
 	// @gotags: form:"mode"
-	Mode          *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty" form:"mode"`
+	Mode *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty" form:"mode"`
+	// assignments 表示带有效期的权限分配，到达生效时间后自动授予、过期后自动回收
+	Assignments   []*PermissionAssignment `protobuf:"bytes,4,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -754,6 +856,77 @@ func (x *AssignPermissionsToRoleRequest) GetMode() string {
 	return ""
 }
 
+func (x *AssignPermissionsToRoleRequest) GetAssignments() []*PermissionAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// PermissionAssignment 表示带有效期的权限分配
+type PermissionAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// permissionID 表示权限 ID
+	PermissionID string `protobuf:"bytes,1,opt,name=permissionID,proto3" json:"permissionID,omitempty"`
+	// startsAt 表示生效时间（Unix 时间戳，秒），不填表示立即生效
+	StartsAt *int64 `protobuf:"varint,2,opt,name=startsAt,proto3,oneof" json:"startsAt,omitempty"`
+	// expiresAt 表示过期时间（Unix 时间戳，秒），不填表示永久有效
+	ExpiresAt     *int64 `protobuf:"varint,3,opt,name=expiresAt,proto3,oneof" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionAssignment) Reset() {
+	*x = PermissionAssignment{}
+	mi := &file_apiserver_v1_role_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionAssignment) ProtoMessage() {}
+
+func (x *PermissionAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_role_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionAssignment.ProtoReflect.Descriptor instead.
+func (*PermissionAssignment) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PermissionAssignment) GetPermissionID() string {
+	if x != nil {
+		return x.PermissionID
+	}
+	return ""
+}
+
+func (x *PermissionAssignment) GetStartsAt() int64 {
+	if x != nil && x.StartsAt != nil {
+		return *x.StartsAt
+	}
+	return 0
+}
+
+func (x *PermissionAssignment) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
 // AssignPermissionsToRoleResponse 表示给角色分配权限响应
 type AssignPermissionsToRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -763,7 +936,7 @@ type AssignPermissionsToRoleResponse struct {
 
 func (x *AssignPermissionsToRoleResponse) Reset() {
 	*x = AssignPermissionsToRoleResponse{}
-	mi := &file_apiserver_v1_role_proto_msgTypes[12]
+	mi := &file_apiserver_v1_role_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +948,7 @@ func (x *AssignPermissionsToRoleResponse) String() string {
 func (*AssignPermissionsToRoleResponse) ProtoMessage() {}
 
 func (x *AssignPermissionsToRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_role_proto_msgTypes[12]
+	mi := &file_apiserver_v1_role_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +961,7 @@ func (x *AssignPermissionsToRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignPermissionsToRoleResponse.ProtoReflect.Descriptor instead.
 func (*AssignPermissionsToRoleResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{12}
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{13}
 }
 
 // GetRolePermissionsRequest 表示获取角色权限请求
@@ -803,7 +976,7 @@ type GetRolePermissionsRequest struct {
 
 func (x *GetRolePermissionsRequest) Reset() {
 	*x = GetRolePermissionsRequest{}
-	mi := &file_apiserver_v1_role_proto_msgTypes[13]
+	mi := &file_apiserver_v1_role_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -815,7 +988,7 @@ func (x *GetRolePermissionsRequest) String() string {
 func (*GetRolePermissionsRequest) ProtoMessage() {}
 
 func (x *GetRolePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_role_proto_msgTypes[13]
+	mi := &file_apiserver_v1_role_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,7 +1001,7 @@ func (x *GetRolePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetRolePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{13}
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetRolePermissionsRequest) GetRoleID() string {
@@ -849,7 +1022,7 @@ type GetRolePermissionsResponse struct {
 
 func (x *GetRolePermissionsResponse) Reset() {
 	*x = GetRolePermissionsResponse{}
-	mi := &file_apiserver_v1_role_proto_msgTypes[14]
+	mi := &file_apiserver_v1_role_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -861,7 +1034,7 @@ func (x *GetRolePermissionsResponse) String() string {
 func (*GetRolePermissionsResponse) ProtoMessage() {}
 
 func (x *GetRolePermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_role_proto_msgTypes[14]
+	mi := &file_apiserver_v1_role_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1047,7 @@ func (x *GetRolePermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolePermissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetRolePermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{14}
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetRolePermissionsResponse) GetPermissions() []*PermissionTree {
@@ -909,7 +1082,7 @@ type PermissionTree struct {
 
 func (x *PermissionTree) Reset() {
 	*x = PermissionTree{}
-	mi := &file_apiserver_v1_role_proto_msgTypes[15]
+	mi := &file_apiserver_v1_role_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1094,7 @@ func (x *PermissionTree) String() string {
 func (*PermissionTree) ProtoMessage() {}
 
 func (x *PermissionTree) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_role_proto_msgTypes[15]
+	mi := &file_apiserver_v1_role_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1107,7 @@ func (x *PermissionTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionTree.ProtoReflect.Descriptor instead.
 func (*PermissionTree) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{15}
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PermissionTree) GetPermissionID() string {
@@ -993,11 +1166,216 @@ func (x *PermissionTree) GetAssigned() bool {
 	return false
 }
 
+// ListDeletedRolesRequest 表示回收站角色列表请求
+type ListDeletedRolesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedRolesRequest) Reset() {
+	*x = ListDeletedRolesRequest{}
+	mi := &file_apiserver_v1_role_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRolesRequest) ProtoMessage() {}
+
+func (x *ListDeletedRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_role_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedRolesRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDeletedRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeletedRolesRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListDeletedRolesResponse 表示回收站角色列表响应
+type ListDeletedRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示回收站中的角色总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// roles 表示已删除的角色列表
+	Roles []*Role `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// pageToken 表示下一页游标
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedRolesResponse) Reset() {
+	*x = ListDeletedRolesResponse{}
+	mi := &file_apiserver_v1_role_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRolesResponse) ProtoMessage() {}
+
+func (x *ListDeletedRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_role_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedRolesResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDeletedRolesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDeletedRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListDeletedRolesResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// RestoreRoleRequest 表示从回收站恢复角色请求
+type RestoreRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// roleID 表示角色 ID
+	// @gotags: uri:"roleID"
+	RoleID        string `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID,omitempty" uri:"roleID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRoleRequest) Reset() {
+	*x = RestoreRoleRequest{}
+	mi := &file_apiserver_v1_role_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoleRequest) ProtoMessage() {}
+
+func (x *RestoreRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_role_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoleRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestoreRoleRequest) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+// RestoreRoleResponse 表示从回收站恢复角色响应
+type RestoreRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRoleResponse) Reset() {
+	*x = RestoreRoleResponse{}
+	mi := &file_apiserver_v1_role_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoleResponse) ProtoMessage() {}
+
+func (x *RestoreRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_role_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoleResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRoleResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_role_proto_rawDescGZIP(), []int{20}
+}
+
 var File_apiserver_v1_role_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_role_proto_rawDesc = "" +
 	"\n" +
-	"\x17apiserver/v1/role.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\xea\x01\n" +
+	"\x17apiserver/v1/role.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\xea\x02\n" +
 	"\x04Role\x12\x16\n" +
 	"\x06roleID\x18\x01 \x01(\tR\x06roleID\x12\x1a\n" +
 	"\broleName\x18\x02 \x01(\tR\broleName\x12\x1a\n" +
@@ -1006,29 +1384,49 @@ const file_apiserver_v1_role_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1c\n" +
 	"\tsortOrder\x18\x06 \x01(\x05R\tsortOrder\x12\x1c\n" +
 	"\tcreatedAt\x18\a \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\b \x01(\x03R\tupdatedAt\"\xba\x01\n" +
+	"\tupdatedAt\x18\b \x01(\x03R\tupdatedAt\x12\x1c\n" +
+	"\tsensitive\x18\t \x01(\bR\tsensitive\x12(\n" +
+	"\x0fapproverRoleIDs\x18\n" +
+	" \x03(\tR\x0fapproverRoleIDs\x12\x1c\n" +
+	"\tdeletedAt\x18\v \x01(\x03R\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\"\x95\x02\n" +
 	"\x11CreateRoleRequest\x12\x1a\n" +
 	"\broleName\x18\x01 \x01(\tR\broleName\x12\x1a\n" +
 	"\broleCode\x18\x02 \x01(\tR\broleCode\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12(\n" +
-	"\tsortOrder\x18\x04 \x01(\x05B\x05\x9aI\x02\x18\x00H\x01R\tsortOrder\x88\x01\x01B\x0e\n" +
+	"\tsortOrder\x18\x04 \x01(\x05B\x05\x9aI\x02\x18\x00H\x01R\tsortOrder\x88\x01\x01\x12!\n" +
+	"\tsensitive\x18\x05 \x01(\bH\x02R\tsensitive\x88\x01\x01\x12(\n" +
+	"\x0fapproverRoleIDs\x18\x06 \x03(\tR\x0fapproverRoleIDsB\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
-	"_sortOrder\",\n" +
+	"_sortOrderB\f\n" +
+	"\n" +
+	"_sensitive\",\n" +
 	"\x12CreateRoleResponse\x12\x16\n" +
-	"\x06roleID\x18\x01 \x01(\tR\x06roleID\"\xe9\x01\n" +
+	"\x06roleID\x18\x01 \x01(\tR\x06roleID\"\x8f\x03\n" +
 	"\x11UpdateRoleRequest\x12\x16\n" +
 	"\x06roleID\x18\x01 \x01(\tR\x06roleID\x12\x1f\n" +
 	"\broleName\x18\x02 \x01(\tH\x00R\broleName\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
 	"\x06status\x18\x04 \x01(\x05H\x02R\x06status\x88\x01\x01\x12!\n" +
-	"\tsortOrder\x18\x05 \x01(\x05H\x03R\tsortOrder\x88\x01\x01B\v\n" +
+	"\tsortOrder\x18\x05 \x01(\x05H\x03R\tsortOrder\x88\x01\x01\x12!\n" +
+	"\tsensitive\x18\x06 \x01(\bH\x04R\tsensitive\x88\x01\x01\x12(\n" +
+	"\x0fapproverRoleIDs\x18\a \x03(\tR\x0fapproverRoleIDs\x12\x1d\n" +
+	"\aversion\x18\b \x01(\x03H\x05R\aversion\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\t \x03(\tR\n" +
+	"updateMaskB\v\n" +
 	"\t_roleNameB\x0e\n" +
 	"\f_descriptionB\t\n" +
 	"\a_statusB\f\n" +
 	"\n" +
-	"_sortOrder\"\x14\n" +
-	"\x12UpdateRoleResponse\"+\n" +
+	"_sortOrderB\f\n" +
+	"\n" +
+	"_sensitiveB\n" +
+	"\n" +
+	"\b_version\".\n" +
+	"\x12UpdateRoleResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"+\n" +
 	"\x11DeleteRoleRequest\x12\x16\n" +
 	"\x06roleID\x18\x01 \x01(\tR\x06roleID\"\x14\n" +
 	"\x12DeleteRoleResponse\"(\n" +
@@ -1049,13 +1447,21 @@ const file_apiserver_v1_role_proto_rawDesc = "" +
 	"totalCount\x18\x01 \x01(\x03R\n" +
 	"totalCount\x12(\n" +
 	"\x05roles\x18\x02 \x03(\v2\x12.apiserver.v1.RoleR\x05roles\x12\x1c\n" +
-	"\tpageToken\x18\x03 \x01(\tR\tpageToken\"\x8f\x01\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\"\xd5\x01\n" +
 	"\x1eAssignPermissionsToRoleRequest\x12\x16\n" +
 	"\x06roleID\x18\x01 \x01(\tR\x06roleID\x12$\n" +
 	"\rpermissionIDs\x18\x02 \x03(\tR\rpermissionIDs\x12&\n" +
 	"\x04mode\x18\x03 \x01(\tB\r\x9aI\n" +
-	"r\boverrideH\x00R\x04mode\x88\x01\x01B\a\n" +
-	"\x05_mode\"!\n" +
+	"r\boverrideH\x00R\x04mode\x88\x01\x01\x12D\n" +
+	"\vassignments\x18\x04 \x03(\v2\".apiserver.v1.PermissionAssignmentR\vassignmentsB\a\n" +
+	"\x05_mode\"\x99\x01\n" +
+	"\x14PermissionAssignment\x12\"\n" +
+	"\fpermissionID\x18\x01 \x01(\tR\fpermissionID\x12\x1f\n" +
+	"\bstartsAt\x18\x02 \x01(\x03H\x00R\bstartsAt\x88\x01\x01\x12!\n" +
+	"\texpiresAt\x18\x03 \x01(\x03H\x01R\texpiresAt\x88\x01\x01B\v\n" +
+	"\t_startsAtB\f\n" +
+	"\n" +
+	"_expiresAt\"!\n" +
 	"\x1fAssignPermissionsToRoleResponse\"3\n" +
 	"\x19GetRolePermissionsRequest\x12\x16\n" +
 	"\x06roleID\x18\x01 \x01(\tR\x06roleID\"\\\n" +
@@ -1069,7 +1475,19 @@ const file_apiserver_v1_role_proto_rawDesc = "" +
 	"\fresourcePath\x18\x05 \x01(\tR\fresourcePath\x12\x16\n" +
 	"\x06action\x18\x06 \x01(\tR\x06action\x128\n" +
 	"\bchildren\x18\a \x03(\v2\x1c.apiserver.v1.PermissionTreeR\bchildren\x12\x1a\n" +
-	"\bassigned\x18\b \x01(\bR\bassignedBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+	"\bassigned\x18\b \x01(\bR\bassigned\"S\n" +
+	"\x17ListDeletedRolesRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x82\x01\n" +
+	"\x18ListDeletedRolesResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12(\n" +
+	"\x05roles\x18\x02 \x03(\v2\x12.apiserver.v1.RoleR\x05roles\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\",\n" +
+	"\x12RestoreRoleRequest\x12\x16\n" +
+	"\x06roleID\x18\x01 \x01(\tR\x06roleID\"\x15\n" +
+	"\x13RestoreRoleResponseBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
 
 var (
 	file_apiserver_v1_role_proto_rawDescOnce sync.Once
@@ -1083,7 +1501,7 @@ func file_apiserver_v1_role_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_role_proto_rawDescData
 }
 
-var file_apiserver_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_apiserver_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_apiserver_v1_role_proto_goTypes = []any{
 	(*Role)(nil),                            // 0: apiserver.v1.Role
 	(*CreateRoleRequest)(nil),               // 1: apiserver.v1.CreateRoleRequest
@@ -1097,21 +1515,28 @@ var file_apiserver_v1_role_proto_goTypes = []any{
 	(*ListRoleRequest)(nil),                 // 9: apiserver.v1.ListRoleRequest
 	(*ListRoleResponse)(nil),                // 10: apiserver.v1.ListRoleResponse
 	(*AssignPermissionsToRoleRequest)(nil),  // 11: apiserver.v1.AssignPermissionsToRoleRequest
-	(*AssignPermissionsToRoleResponse)(nil), // 12: apiserver.v1.AssignPermissionsToRoleResponse
-	(*GetRolePermissionsRequest)(nil),       // 13: apiserver.v1.GetRolePermissionsRequest
-	(*GetRolePermissionsResponse)(nil),      // 14: apiserver.v1.GetRolePermissionsResponse
-	(*PermissionTree)(nil),                  // 15: apiserver.v1.PermissionTree
+	(*PermissionAssignment)(nil),            // 12: apiserver.v1.PermissionAssignment
+	(*AssignPermissionsToRoleResponse)(nil), // 13: apiserver.v1.AssignPermissionsToRoleResponse
+	(*GetRolePermissionsRequest)(nil),       // 14: apiserver.v1.GetRolePermissionsRequest
+	(*GetRolePermissionsResponse)(nil),      // 15: apiserver.v1.GetRolePermissionsResponse
+	(*PermissionTree)(nil),                  // 16: apiserver.v1.PermissionTree
+	(*ListDeletedRolesRequest)(nil),         // 17: apiserver.v1.ListDeletedRolesRequest
+	(*ListDeletedRolesResponse)(nil),        // 18: apiserver.v1.ListDeletedRolesResponse
+	(*RestoreRoleRequest)(nil),              // 19: apiserver.v1.RestoreRoleRequest
+	(*RestoreRoleResponse)(nil),             // 20: apiserver.v1.RestoreRoleResponse
 }
 var file_apiserver_v1_role_proto_depIdxs = []int32{
 	0,  // 0: apiserver.v1.GetRoleResponse.role:type_name -> apiserver.v1.Role
 	0,  // 1: apiserver.v1.ListRoleResponse.roles:type_name -> apiserver.v1.Role
-	15, // 2: apiserver.v1.GetRolePermissionsResponse.permissions:type_name -> apiserver.v1.PermissionTree
-	15, // 3: apiserver.v1.PermissionTree.children:type_name -> apiserver.v1.PermissionTree
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	12, // 2: apiserver.v1.AssignPermissionsToRoleRequest.assignments:type_name -> apiserver.v1.PermissionAssignment
+	16, // 3: apiserver.v1.GetRolePermissionsResponse.permissions:type_name -> apiserver.v1.PermissionTree
+	16, // 4: apiserver.v1.PermissionTree.children:type_name -> apiserver.v1.PermissionTree
+	0,  // 5: apiserver.v1.ListDeletedRolesResponse.roles:type_name -> apiserver.v1.Role
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_role_proto_init() }
@@ -1123,13 +1548,14 @@ func file_apiserver_v1_role_proto_init() {
 	file_apiserver_v1_role_proto_msgTypes[3].OneofWrappers = []any{}
 	file_apiserver_v1_role_proto_msgTypes[9].OneofWrappers = []any{}
 	file_apiserver_v1_role_proto_msgTypes[11].OneofWrappers = []any{}
+	file_apiserver_v1_role_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_role_proto_rawDesc), len(file_apiserver_v1_role_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,42 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+)
+
+func (x *Session) Default() {
+}
+
+func (x *ListSessionsRequest) Default() {
+}
+
+func (x *ListSessionsResponse) Default() {
+}
+
+func (x *RevokeSessionRequest) Default() {
+}
+
+func (x *RevokeSessionResponse) Default() {
+}
+
+func (x *RevokeOtherSessionsRequest) Default() {
+}
+
+func (x *RevokeOtherSessionsResponse) Default() {
+}
+
+func (x *TerminateUserSessionsRequest) Default() {
+}
+
+func (x *TerminateUserSessionsResponse) Default() {
+}
@@ -0,0 +1,618 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.30.0
+// source: apiserver/v1/session.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Session 表示用户的一个登录会话（一次登录及其刷新令牌族）
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sessionID 表示会话 ID
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	// userID 表示会话所属的用户 ID
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// deviceName 表示登录设备名称
+	DeviceName string `protobuf:"bytes,3,opt,name=deviceName,proto3" json:"deviceName,omitempty"`
+	// ipAddress 表示登录时的客户端 IP
+	IpAddress string `protobuf:"bytes,4,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	// userAgent 表示登录时的客户端 User-Agent
+	UserAgent string `protobuf:"bytes,5,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	// createdAt 表示会话创建时间（登录时间）
+	CreatedAt int64 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// lastSeenAt 表示会话最近活跃时间
+	LastSeenAt int64 `protobuf:"varint,7,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"`
+	// expiresAt 表示会话过期时间
+	ExpiresAt int64 `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// current 表示是否为发起本次请求的会话
+	Current       bool `protobuf:"varint,9,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Session) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *Session) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Session) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Session) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// ListSessionsRequest 表示获取用户会话列表请求
+type ListSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSessionsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// ListSessionsResponse 表示获取用户会话列表响应
+type ListSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示有效会话总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// sessions 表示会话列表
+	Sessions      []*Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSessionsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// RevokeSessionRequest 表示撤销单个会话请求
+type RevokeSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	// sessionID 表示会话 ID
+	// @gotags: uri:"sessionID"
+	SessionID     string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty" uri:"sessionID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RevokeSessionRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeSessionRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+// RevokeSessionResponse 表示撤销单个会话响应
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{4}
+}
+
+// RevokeOtherSessionsRequest 表示撤销除当前会话外的其他会话请求
+type RevokeOtherSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeOtherSessionsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// RevokeOtherSessionsResponse 表示撤销除当前会话外的其他会话响应
+type RevokeOtherSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revokedCount 表示被撤销的会话数量
+	RevokedCount  int64 `protobuf:"varint,1,opt,name=revokedCount,proto3" json:"revokedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsResponse) Reset() {
+	*x = RevokeOtherSessionsResponse{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeOtherSessionsResponse) GetRevokedCount() int64 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// TerminateUserSessionsRequest 表示管理员终止指定用户全部会话请求
+type TerminateUserSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	// @gotags: uri:"userID"
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateUserSessionsRequest) Reset() {
+	*x = TerminateUserSessionsRequest{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateUserSessionsRequest) ProtoMessage() {}
+
+func (x *TerminateUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*TerminateUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TerminateUserSessionsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// TerminateUserSessionsResponse 表示管理员终止指定用户全部会话响应
+type TerminateUserSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revokedCount 表示被撤销的会话数量
+	RevokedCount  int64 `protobuf:"varint,1,opt,name=revokedCount,proto3" json:"revokedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateUserSessionsResponse) Reset() {
+	*x = TerminateUserSessionsResponse{}
+	mi := &file_apiserver_v1_session_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateUserSessionsResponse) ProtoMessage() {}
+
+func (x *TerminateUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_session_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*TerminateUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_session_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TerminateUserSessionsResponse) GetRevokedCount() int64 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+var File_apiserver_v1_session_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_session_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapiserver/v1/session.proto\x12\fapiserver.v1\"\x91\x02\n" +
+	"\aSession\x12\x1c\n" +
+	"\tsessionID\x18\x01 \x01(\tR\tsessionID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"deviceName\x18\x03 \x01(\tR\n" +
+	"deviceName\x12\x1c\n" +
+	"\tipAddress\x18\x04 \x01(\tR\tipAddress\x12\x1c\n" +
+	"\tuserAgent\x18\x05 \x01(\tR\tuserAgent\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\x03R\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"lastSeenAt\x18\a \x01(\x03R\n" +
+	"lastSeenAt\x12\x1c\n" +
+	"\texpiresAt\x18\b \x01(\x03R\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\t \x01(\bR\acurrent\"-\n" +
+	"\x13ListSessionsRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"i\n" +
+	"\x14ListSessionsResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x121\n" +
+	"\bsessions\x18\x02 \x03(\v2\x15.apiserver.v1.SessionR\bsessions\"L\n" +
+	"\x14RevokeSessionRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tsessionID\x18\x02 \x01(\tR\tsessionID\"\x17\n" +
+	"\x15RevokeSessionResponse\"4\n" +
+	"\x1aRevokeOtherSessionsRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"A\n" +
+	"\x1bRevokeOtherSessionsResponse\x12\"\n" +
+	"\frevokedCount\x18\x01 \x01(\x03R\frevokedCount\"6\n" +
+	"\x1cTerminateUserSessionsRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"C\n" +
+	"\x1dTerminateUserSessionsResponse\x12\"\n" +
+	"\frevokedCount\x18\x01 \x01(\x03R\frevokedCountBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+
+var (
+	file_apiserver_v1_session_proto_rawDescOnce sync.Once
+	file_apiserver_v1_session_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_session_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_session_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_session_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_session_proto_rawDesc), len(file_apiserver_v1_session_proto_rawDesc)))
+	})
+	return file_apiserver_v1_session_proto_rawDescData
+}
+
+var file_apiserver_v1_session_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_apiserver_v1_session_proto_goTypes = []any{
+	(*Session)(nil),                       // 0: apiserver.v1.Session
+	(*ListSessionsRequest)(nil),           // 1: apiserver.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),          // 2: apiserver.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),          // 3: apiserver.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),         // 4: apiserver.v1.RevokeSessionResponse
+	(*RevokeOtherSessionsRequest)(nil),    // 5: apiserver.v1.RevokeOtherSessionsRequest
+	(*RevokeOtherSessionsResponse)(nil),   // 6: apiserver.v1.RevokeOtherSessionsResponse
+	(*TerminateUserSessionsRequest)(nil),  // 7: apiserver.v1.TerminateUserSessionsRequest
+	(*TerminateUserSessionsResponse)(nil), // 8: apiserver.v1.TerminateUserSessionsResponse
+}
+var file_apiserver_v1_session_proto_depIdxs = []int32{
+	0, // 0: apiserver.v1.ListSessionsResponse.sessions:type_name -> apiserver.v1.Session
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_session_proto_init() }
+func file_apiserver_v1_session_proto_init() {
+	if File_apiserver_v1_session_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_session_proto_rawDesc), len(file_apiserver_v1_session_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_session_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_session_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_session_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_session_proto = out.File
+	file_apiserver_v1_session_proto_goTypes = nil
+	file_apiserver_v1_session_proto_depIdxs = nil
+}
@@ -0,0 +1,55 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+)
+
+func (x *SoDRule) Default() {
+}
+
+func (x *CreateSoDRuleRequest) Default() {
+	if x.MaxRoles == nil {
+		v := int32(1)
+		x.MaxRoles = &v
+	}
+}
+
+func (x *CreateSoDRuleResponse) Default() {
+}
+
+func (x *UpdateSoDRuleRequest) Default() {
+}
+
+func (x *UpdateSoDRuleResponse) Default() {
+}
+
+func (x *DeleteSoDRuleRequest) Default() {
+}
+
+func (x *DeleteSoDRuleResponse) Default() {
+}
+
+func (x *ListSoDRulesRequest) Default() {
+}
+
+func (x *ListSoDRulesResponse) Default() {
+}
+
+func (x *SoDViolation) Default() {
+}
+
+func (x *ListSoDViolationsRequest) Default() {
+}
+
+func (x *ListSoDViolationsResponse) Default() {
+}
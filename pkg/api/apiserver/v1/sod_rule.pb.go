@@ -0,0 +1,929 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.30.0
+// source: apiserver/v1/sod_rule.proto
+
+package v1
+
+import (
+	_ "github.com/onexstack/protoc-gen-defaults/defaults"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SoDRule 表示静态职责分离规则，同一用户最多只能同时持有 roleIDs 中的 maxRoles 个角色
+type SoDRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ruleID 表示规则 ID
+	RuleID string `protobuf:"bytes,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+	// name 表示规则名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description 表示规则描述
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// roleIDs 表示互斥的角色 ID 集合
+	RoleIDs []string `protobuf:"bytes,4,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// maxRoles 表示同一用户最多可同时持有集合中的角色数量
+	MaxRoles int32 `protobuf:"varint,5,opt,name=maxRoles,proto3" json:"maxRoles,omitempty"`
+	// createdAt 表示创建时间
+	CreatedAt int64 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// updatedAt 表示更新时间
+	UpdatedAt int64 `protobuf:"varint,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// version 表示资源版本号，每次修改后递增，可以作为更新请求的前置条件
+	Version       int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoDRule) Reset() {
+	*x = SoDRule{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoDRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDRule) ProtoMessage() {}
+
+func (x *SoDRule) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDRule.ProtoReflect.Descriptor instead.
+func (*SoDRule) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SoDRule) GetRuleID() string {
+	if x != nil {
+		return x.RuleID
+	}
+	return ""
+}
+
+func (x *SoDRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SoDRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SoDRule) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *SoDRule) GetMaxRoles() int32 {
+	if x != nil {
+		return x.MaxRoles
+	}
+	return 0
+}
+
+func (x *SoDRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SoDRule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *SoDRule) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// CreateSoDRuleRequest 表示创建职责分离规则请求
+type CreateSoDRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name 表示规则名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description 表示规则描述
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// roleIDs 表示互斥的角色 ID 集合，至少包含两个角色
+	RoleIDs []string `protobuf:"bytes,3,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// maxRoles 表示同一用户最多可同时持有集合中的角色数量，默认为 1
+	MaxRoles      *int32 `protobuf:"varint,4,opt,name=maxRoles,proto3,oneof" json:"maxRoles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSoDRuleRequest) Reset() {
+	*x = CreateSoDRuleRequest{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSoDRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSoDRuleRequest) ProtoMessage() {}
+
+func (x *CreateSoDRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSoDRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSoDRuleRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSoDRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSoDRuleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateSoDRuleRequest) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *CreateSoDRuleRequest) GetMaxRoles() int32 {
+	if x != nil && x.MaxRoles != nil {
+		return *x.MaxRoles
+	}
+	return 0
+}
+
+// CreateSoDRuleResponse 表示创建职责分离规则响应
+type CreateSoDRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule 表示新创建的规则
+	Rule          *SoDRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSoDRuleResponse) Reset() {
+	*x = CreateSoDRuleResponse{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSoDRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSoDRuleResponse) ProtoMessage() {}
+
+func (x *CreateSoDRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSoDRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateSoDRuleResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSoDRuleResponse) GetRule() *SoDRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// UpdateSoDRuleRequest 表示更新职责分离规则请求
+type UpdateSoDRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ruleID 表示规则 ID
+	// @gotags: uri:"ruleID"
+	RuleID string `protobuf:"bytes,1,opt,name=ruleID,proto3" json:"ruleID,omitempty" uri:"ruleID"`
+	// name 表示可选的规则名称
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// description 表示可选的规则描述
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// roleIDs 表示互斥的角色 ID 集合，未设置 updateMask 时为空表示不修改
+	RoleIDs []string `protobuf:"bytes,4,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// maxRoles 表示可选的同时持有角色数量上限
+	MaxRoles *int32 `protobuf:"varint,5,opt,name=maxRoles,proto3,oneof" json:"maxRoles,omitempty"`
+	// version 表示可选的规则版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version *int64 `protobuf:"varint,6,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示需要修改的字段名列表（如 name、roleIDs），为空时修改所有已设置的字段；
+	// 设置后只修改列出的字段，列出但未传值的字段会被清空
+	UpdateMask    []string `protobuf:"bytes,7,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSoDRuleRequest) Reset() {
+	*x = UpdateSoDRuleRequest{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSoDRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSoDRuleRequest) ProtoMessage() {}
+
+func (x *UpdateSoDRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSoDRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSoDRuleRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateSoDRuleRequest) GetRuleID() string {
+	if x != nil {
+		return x.RuleID
+	}
+	return ""
+}
+
+func (x *UpdateSoDRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSoDRuleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSoDRuleRequest) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *UpdateSoDRuleRequest) GetMaxRoles() int32 {
+	if x != nil && x.MaxRoles != nil {
+		return *x.MaxRoles
+	}
+	return 0
+}
+
+func (x *UpdateSoDRuleRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateSoDRuleRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateSoDRuleResponse 表示更新职责分离规则响应
+type UpdateSoDRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的规则版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSoDRuleResponse) Reset() {
+	*x = UpdateSoDRuleResponse{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSoDRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSoDRuleResponse) ProtoMessage() {}
+
+func (x *UpdateSoDRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSoDRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSoDRuleResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSoDRuleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DeleteSoDRuleRequest 表示删除职责分离规则请求
+type DeleteSoDRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ruleID 表示规则 ID
+	// @gotags: uri:"ruleID"
+	RuleID        string `protobuf:"bytes,1,opt,name=ruleID,proto3" json:"ruleID,omitempty" uri:"ruleID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSoDRuleRequest) Reset() {
+	*x = DeleteSoDRuleRequest{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSoDRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSoDRuleRequest) ProtoMessage() {}
+
+func (x *DeleteSoDRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSoDRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSoDRuleRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteSoDRuleRequest) GetRuleID() string {
+	if x != nil {
+		return x.RuleID
+	}
+	return ""
+}
+
+// DeleteSoDRuleResponse 表示删除职责分离规则响应
+type DeleteSoDRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSoDRuleResponse) Reset() {
+	*x = DeleteSoDRuleResponse{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSoDRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSoDRuleResponse) ProtoMessage() {}
+
+func (x *DeleteSoDRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSoDRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSoDRuleResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{6}
+}
+
+// ListSoDRulesRequest 表示职责分离规则列表请求
+type ListSoDRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pageToken 表示分页游标
+	// @gotags: form:"page_token"
+	PageToken string `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty" form:"page_token"`
+	// pageSize 表示每页数量
+	// @gotags: form:"page_size"
+	PageSize      int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty" form:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoDRulesRequest) Reset() {
+	*x = ListSoDRulesRequest{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoDRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoDRulesRequest) ProtoMessage() {}
+
+func (x *ListSoDRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoDRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSoDRulesRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSoDRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSoDRulesRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListSoDRulesResponse 表示职责分离规则列表响应
+type ListSoDRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示规则总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// rules 表示规则列表
+	Rules []*SoDRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// pageToken 表示下一页的分页游标，为空表示没有更多数据
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoDRulesResponse) Reset() {
+	*x = ListSoDRulesResponse{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoDRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoDRulesResponse) ProtoMessage() {}
+
+func (x *ListSoDRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoDRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSoDRulesResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSoDRulesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListSoDRulesResponse) GetRules() []*SoDRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListSoDRulesResponse) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SoDViolation 表示一个用户违反了某条职责分离规则
+type SoDViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// username 表示用户名
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// ruleID 表示违反的规则 ID
+	RuleID string `protobuf:"bytes,3,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+	// ruleName 表示违反的规则名称
+	RuleName string `protobuf:"bytes,4,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	// maxRoles 表示规则允许同时持有的角色数量
+	MaxRoles int32 `protobuf:"varint,5,opt,name=maxRoles,proto3" json:"maxRoles,omitempty"`
+	// roleIDs 表示用户同时持有的、属于该规则的角色 ID
+	RoleIDs []string `protobuf:"bytes,6,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// roleCodes 表示用户同时持有的、属于该规则的角色编码
+	RoleCodes     []string `protobuf:"bytes,7,rep,name=roleCodes,proto3" json:"roleCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoDViolation) Reset() {
+	*x = SoDViolation{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoDViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDViolation) ProtoMessage() {}
+
+func (x *SoDViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDViolation.ProtoReflect.Descriptor instead.
+func (*SoDViolation) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SoDViolation) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SoDViolation) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SoDViolation) GetRuleID() string {
+	if x != nil {
+		return x.RuleID
+	}
+	return ""
+}
+
+func (x *SoDViolation) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *SoDViolation) GetMaxRoles() int32 {
+	if x != nil {
+		return x.MaxRoles
+	}
+	return 0
+}
+
+func (x *SoDViolation) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *SoDViolation) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
+	}
+	return nil
+}
+
+// ListSoDViolationsRequest 表示职责分离违规报告请求
+type ListSoDViolationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoDViolationsRequest) Reset() {
+	*x = ListSoDViolationsRequest{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoDViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoDViolationsRequest) ProtoMessage() {}
+
+func (x *ListSoDViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoDViolationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSoDViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{10}
+}
+
+// ListSoDViolationsResponse 表示职责分离违规报告响应
+type ListSoDViolationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示违规总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// violations 表示现有角色分配中违反规则的用户列表
+	Violations    []*SoDViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoDViolationsResponse) Reset() {
+	*x = ListSoDViolationsResponse{}
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoDViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoDViolationsResponse) ProtoMessage() {}
+
+func (x *ListSoDViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_sod_rule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoDViolationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSoDViolationsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_sod_rule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSoDViolationsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListSoDViolationsResponse) GetViolations() []*SoDViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+var File_apiserver_v1_sod_rule_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_sod_rule_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapiserver/v1/sod_rule.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\xe3\x01\n" +
+	"\aSoDRule\x12\x16\n" +
+	"\x06ruleID\x18\x01 \x01(\tR\x06ruleID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aroleIDs\x18\x04 \x03(\tR\aroleIDs\x12\x1a\n" +
+	"\bmaxRoles\x18\x05 \x01(\x05R\bmaxRoles\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\a \x01(\x03R\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\"\xb0\x01\n" +
+	"\x14CreateSoDRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x18\n" +
+	"\aroleIDs\x18\x03 \x03(\tR\aroleIDs\x12&\n" +
+	"\bmaxRoles\x18\x04 \x01(\x05B\x05\x9aI\x02\x18\x01H\x01R\bmaxRoles\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_maxRoles\"B\n" +
+	"\x15CreateSoDRuleResponse\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.apiserver.v1.SoDRuleR\x04rule\"\x9a\x02\n" +
+	"\x14UpdateSoDRuleRequest\x12\x16\n" +
+	"\x06ruleID\x18\x01 \x01(\tR\x06ruleID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x18\n" +
+	"\aroleIDs\x18\x04 \x03(\tR\aroleIDs\x12\x1f\n" +
+	"\bmaxRoles\x18\x05 \x01(\x05H\x02R\bmaxRoles\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x06 \x01(\x03H\x03R\aversion\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\a \x03(\tR\n" +
+	"updateMaskB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_maxRolesB\n" +
+	"\n" +
+	"\b_version\"1\n" +
+	"\x15UpdateSoDRuleResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\".\n" +
+	"\x14DeleteSoDRuleRequest\x12\x16\n" +
+	"\x06ruleID\x18\x01 \x01(\tR\x06ruleID\"\x17\n" +
+	"\x15DeleteSoDRuleResponse\"O\n" +
+	"\x13ListSoDRulesRequest\x12\x1c\n" +
+	"\tpageToken\x18\x01 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\"\x81\x01\n" +
+	"\x14ListSoDRulesResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12+\n" +
+	"\x05rules\x18\x02 \x03(\v2\x15.apiserver.v1.SoDRuleR\x05rules\x12\x1c\n" +
+	"\tpageToken\x18\x03 \x01(\tR\tpageToken\"\xca\x01\n" +
+	"\fSoDViolation\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06ruleID\x18\x03 \x01(\tR\x06ruleID\x12\x1a\n" +
+	"\bruleName\x18\x04 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bmaxRoles\x18\x05 \x01(\x05R\bmaxRoles\x12\x18\n" +
+	"\aroleIDs\x18\x06 \x03(\tR\aroleIDs\x12\x1c\n" +
+	"\troleCodes\x18\a \x03(\tR\troleCodes\"\x1a\n" +
+	"\x18ListSoDViolationsRequest\"w\n" +
+	"\x19ListSoDViolationsResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12:\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1a.apiserver.v1.SoDViolationR\n" +
+	"violationsBDZBgithub.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1;v1b\x06proto3"
+
+var (
+	file_apiserver_v1_sod_rule_proto_rawDescOnce sync.Once
+	file_apiserver_v1_sod_rule_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_sod_rule_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_sod_rule_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_sod_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_sod_rule_proto_rawDesc), len(file_apiserver_v1_sod_rule_proto_rawDesc)))
+	})
+	return file_apiserver_v1_sod_rule_proto_rawDescData
+}
+
+var file_apiserver_v1_sod_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_apiserver_v1_sod_rule_proto_goTypes = []any{
+	(*SoDRule)(nil),                   // 0: apiserver.v1.SoDRule
+	(*CreateSoDRuleRequest)(nil),      // 1: apiserver.v1.CreateSoDRuleRequest
+	(*CreateSoDRuleResponse)(nil),     // 2: apiserver.v1.CreateSoDRuleResponse
+	(*UpdateSoDRuleRequest)(nil),      // 3: apiserver.v1.UpdateSoDRuleRequest
+	(*UpdateSoDRuleResponse)(nil),     // 4: apiserver.v1.UpdateSoDRuleResponse
+	(*DeleteSoDRuleRequest)(nil),      // 5: apiserver.v1.DeleteSoDRuleRequest
+	(*DeleteSoDRuleResponse)(nil),     // 6: apiserver.v1.DeleteSoDRuleResponse
+	(*ListSoDRulesRequest)(nil),       // 7: apiserver.v1.ListSoDRulesRequest
+	(*ListSoDRulesResponse)(nil),      // 8: apiserver.v1.ListSoDRulesResponse
+	(*SoDViolation)(nil),              // 9: apiserver.v1.SoDViolation
+	(*ListSoDViolationsRequest)(nil),  // 10: apiserver.v1.ListSoDViolationsRequest
+	(*ListSoDViolationsResponse)(nil), // 11: apiserver.v1.ListSoDViolationsResponse
+}
+var file_apiserver_v1_sod_rule_proto_depIdxs = []int32{
+	0, // 0: apiserver.v1.CreateSoDRuleResponse.rule:type_name -> apiserver.v1.SoDRule
+	0, // 1: apiserver.v1.ListSoDRulesResponse.rules:type_name -> apiserver.v1.SoDRule
+	9, // 2: apiserver.v1.ListSoDViolationsResponse.violations:type_name -> apiserver.v1.SoDViolation
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_sod_rule_proto_init() }
+func file_apiserver_v1_sod_rule_proto_init() {
+	if File_apiserver_v1_sod_rule_proto != nil {
+		return
+	}
+	file_apiserver_v1_sod_rule_proto_msgTypes[1].OneofWrappers = []any{}
+	file_apiserver_v1_sod_rule_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_sod_rule_proto_rawDesc), len(file_apiserver_v1_sod_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_sod_rule_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_sod_rule_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_sod_rule_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_sod_rule_proto = out.File
+	file_apiserver_v1_sod_rule_proto_goTypes = nil
+	file_apiserver_v1_sod_rule_proto_depIdxs = nil
+}
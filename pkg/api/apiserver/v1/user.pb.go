@@ -40,7 +40,21 @@ type User struct {
 	// createdAt 表示用户注册时间
 	CreatedAt int64 `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示用户最后更新时间
-	UpdatedAt     int64 `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// avatar 表示用户头像 URL
+	Avatar string `protobuf:"bytes,9,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// gender 表示用户性别（0=未知,1=男,2=女）
+	Gender int32 `protobuf:"varint,10,opt,name=gender,proto3" json:"gender,omitempty"`
+	// status 表示用户状态（0=活跃,1=禁用）
+	Status int32 `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`
+	// description 表示用户描述/简介
+	Description string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	// lastLoginAt 表示用户最后登录时间
+	LastLoginAt int64 `protobuf:"varint,13,opt,name=lastLoginAt,proto3" json:"lastLoginAt,omitempty"`
+	// deletedAt 表示删除时间（Unix 时间戳，秒），仅回收站中的用户有值
+	DeletedAt int64 `protobuf:"varint,14,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// version 表示资源版本号，每次修改后递增，可以作为更新请求的前置条件
+	Version       int64 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,13 +145,64 @@ func (x *User) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *User) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *User) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *User) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *User) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *User) GetLastLoginAt() int64 {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return 0
+}
+
+func (x *User) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *User) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // LoginRequest 表示登录请求
 type LoginRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// username 表示用户名称
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	// password 表示用户密码
-	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// deviceName 表示可选的登录设备名称，用于会话管理中区分不同设备
+	DeviceName    *string `protobuf:"bytes,3,opt,name=deviceName,proto3,oneof" json:"deviceName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +251,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDeviceName() string {
+	if x != nil && x.DeviceName != nil {
+		return *x.DeviceName
+	}
+	return ""
+}
+
 // LoginResponse 表示登录响应
 type LoginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -473,9 +545,11 @@ type CreateUserRequest struct {
 	// email 表示用户电子邮箱
 	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	// phone 表示用户手机号
-	Phone         string `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Phone string `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	// invitationCode 表示可选的邀请码，invite 注册模式下必填
+	InvitationCode *string `protobuf:"bytes,6,opt,name=invitationCode,proto3,oneof" json:"invitationCode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
@@ -543,13 +617,22 @@ func (x *CreateUserRequest) GetPhone() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetInvitationCode() string {
+	if x != nil && x.InvitationCode != nil {
+		return *x.InvitationCode
+	}
+	return ""
+}
+
 // CreateUserResponse 表示创建用户响应
 type CreateUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// userID 表示新创建的用户 ID
-	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// pendingApproval 表示该用户是否需要等待管理员审核后才能登录
+	PendingApproval bool `protobuf:"varint,2,opt,name=pendingApproval,proto3" json:"pendingApproval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateUserResponse) Reset() {
@@ -589,6 +672,13 @@ func (x *CreateUserResponse) GetUserID() string {
 	return ""
 }
 
+func (x *CreateUserResponse) GetPendingApproval() bool {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return false
+}
+
 // UpdateUserRequest 表示更新用户请求
 type UpdateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -601,7 +691,18 @@ type UpdateUserRequest struct {
 	// email 表示可选的用户电子邮箱
 	Email *string `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
 	// phone 表示可选的用户手机号
-	Phone         *string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Phone *string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// avatar 表示可选的用户头像 URL
+	Avatar *string `protobuf:"bytes,6,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	// gender 表示可选的用户性别（0=未知,1=男,2=女）
+	Gender *int32 `protobuf:"varint,7,opt,name=gender,proto3,oneof" json:"gender,omitempty"`
+	// description 表示可选的用户描述/简介
+	Description *string `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// version 表示可选的用户版本号，设置时只有与服务端当前版本号一致才会更新，否则返回 409 冲突
+	Version *int64 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示需要修改的字段名列表（如 nickname、avatar），为空时修改所有已设置的字段；
+	// 设置后只修改列出的字段，列出但未传值的字段会被清空
+	UpdateMask    []string `protobuf:"bytes,10,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -671,9 +772,46 @@ func (x *UpdateUserRequest) GetPhone() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetGender() int32 {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // UpdateUserResponse 表示更新用户响应
 type UpdateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version 表示更新后的用户版本号
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -708,6 +846,13 @@ func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
 	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
+func (x *UpdateUserResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // DeleteUserRequest 表示删除用户请求
 type DeleteUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1011,7 +1156,7 @@ var File_apiserver_v1_user_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x17apiserver/v1/user.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\xdc\x01\n" +
+	"\x17apiserver/v1/user.proto\x12\fapiserver.v1\x1a,github.com/onexstack/defaults/defaults.proto\"\xa0\x03\n" +
 	"\x04User\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -1020,10 +1165,22 @@ const file_apiserver_v1_user_proto_rawDesc = "" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x1c\n" +
 	"\tpostCount\x18\x06 \x01(\x03R\tpostCount\x12\x1c\n" +
 	"\tcreatedAt\x18\a \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\b \x01(\x03R\tupdatedAt\"F\n" +
+	"\tupdatedAt\x18\b \x01(\x03R\tupdatedAt\x12\x16\n" +
+	"\x06avatar\x18\t \x01(\tR\x06avatar\x12\x16\n" +
+	"\x06gender\x18\n" +
+	" \x01(\x05R\x06gender\x12\x16\n" +
+	"\x06status\x18\v \x01(\x05R\x06status\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12 \n" +
+	"\vlastLoginAt\x18\r \x01(\x03R\vlastLoginAt\x12\x1c\n" +
+	"\tdeletedAt\x18\x0e \x01(\x03R\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversion\"z\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"q\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12#\n" +
+	"\n" +
+	"deviceName\x18\x03 \x01(\tH\x00R\n" +
+	"deviceName\x88\x01\x01B\r\n" +
+	"\v_deviceName\"q\n" +
 	"\rLoginResponse\x12 \n" +
 	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\"\n" +
 	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x12\x1a\n" +
@@ -1038,27 +1195,44 @@ const file_apiserver_v1_user_proto_rawDesc = "" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12 \n" +
 	"\voldPassword\x18\x02 \x01(\tR\voldPassword\x12 \n" +
 	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"\x18\n" +
-	"\x16ChangePasswordResponse\"\xb8\x01\n" +
+	"\x16ChangePasswordResponse\"\xf8\x01\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x122\n" +
 	"\bnickname\x18\x03 \x01(\tB\x11\x9aI\x0er\f你好世界H\x00R\bnickname\x88\x01\x01\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
-	"\x05phone\x18\x05 \x01(\tR\x05phoneB\v\n" +
-	"\t_nickname\",\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12+\n" +
+	"\x0einvitationCode\x18\x06 \x01(\tH\x01R\x0einvitationCode\x88\x01\x01B\v\n" +
+	"\t_nicknameB\x11\n" +
+	"\x0f_invitationCode\"V\n" +
 	"\x12CreateUserResponse\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"\xd1\x01\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12(\n" +
+	"\x0fpendingApproval\x18\x02 \x01(\bR\x0fpendingApproval\"\xa3\x03\n" +
 	"\x11UpdateUserRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1f\n" +
 	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x1f\n" +
 	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12\x19\n" +
 	"\x05email\x18\x04 \x01(\tH\x02R\x05email\x88\x01\x01\x12\x19\n" +
-	"\x05phone\x18\x05 \x01(\tH\x03R\x05phone\x88\x01\x01B\v\n" +
+	"\x05phone\x18\x05 \x01(\tH\x03R\x05phone\x88\x01\x01\x12\x1b\n" +
+	"\x06avatar\x18\x06 \x01(\tH\x04R\x06avatar\x88\x01\x01\x12\x1b\n" +
+	"\x06gender\x18\a \x01(\x05H\x05R\x06gender\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\b \x01(\tH\x06R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x03H\aR\aversion\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"updateMask\x18\n" +
+	" \x03(\tR\n" +
+	"updateMaskB\v\n" +
 	"\t_usernameB\v\n" +
 	"\t_nicknameB\b\n" +
 	"\x06_emailB\b\n" +
-	"\x06_phone\"\x14\n" +
-	"\x12UpdateUserResponse\"+\n" +
+	"\x06_phoneB\t\n" +
+	"\a_avatarB\t\n" +
+	"\a_genderB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_version\".\n" +
+	"\x12UpdateUserResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"+\n" +
 	"\x11DeleteUserRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x14\n" +
 	"\x12DeleteUserResponse\"(\n" +
@@ -1125,6 +1299,7 @@ func file_apiserver_v1_user_proto_init() {
 	if File_apiserver_v1_user_proto != nil {
 		return
 	}
+	file_apiserver_v1_user_proto_msgTypes[1].OneofWrappers = []any{}
 	file_apiserver_v1_user_proto_msgTypes[7].OneofWrappers = []any{}
 	file_apiserver_v1_user_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
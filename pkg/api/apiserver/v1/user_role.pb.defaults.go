@@ -17,6 +17,9 @@ var (
 func (x *AssignRolesToUserRequest) Default() {
 }
 
+func (x *RoleAssignment) Default() {
+}
+
 func (x *AssignRolesToUserResponse) Default() {
 }
 
@@ -26,6 +29,9 @@ func (x *GetUserRolesRequest) Default() {
 func (x *GetUserRolesResponse) Default() {
 }
 
+func (x *UserRoleAssignment) Default() {
+}
+
 func (x *RemoveRoleFromUserRequest) Default() {
 }
 
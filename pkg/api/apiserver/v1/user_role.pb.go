@@ -28,8 +28,10 @@ type AssignRolesToUserRequest struct {
 	// userID 表示用户 ID
 	// @gotags: uri:"userID"
 	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" uri:"userID"`
-	// roleIDs 表示角色 ID 列表
-	RoleIDs       []string `protobuf:"bytes,2,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// roleIDs 表示永久有效的角色 ID 列表
+	RoleIDs []string `protobuf:"bytes,2,rep,name=roleIDs,proto3" json:"roleIDs,omitempty"`
+	// assignments 表示带有效期的角色分配，到达生效时间后自动授予、过期后自动回收
+	Assignments   []*RoleAssignment `protobuf:"bytes,3,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -78,16 +80,89 @@ func (x *AssignRolesToUserRequest) GetRoleIDs() []string {
 	return nil
 }
 
-// AssignRolesToUserResponse 表示给用户分配角色响应
-type AssignRolesToUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *AssignRolesToUserRequest) GetAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// RoleAssignment 表示带有效期的角色分配
+type RoleAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// roleID 表示角色 ID
+	RoleID string `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	// startsAt 表示生效时间（Unix 时间戳，秒），不填表示立即生效
+	StartsAt *int64 `protobuf:"varint,2,opt,name=startsAt,proto3,oneof" json:"startsAt,omitempty"`
+	// expiresAt 表示过期时间（Unix 时间戳，秒），不填表示永久有效
+	ExpiresAt     *int64 `protobuf:"varint,3,opt,name=expiresAt,proto3,oneof" json:"expiresAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RoleAssignment) Reset() {
+	*x = RoleAssignment{}
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAssignment) ProtoMessage() {}
+
+func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoleAssignment) GetRoleID() string {
+	if x != nil {
+		return x.RoleID
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetStartsAt() int64 {
+	if x != nil && x.StartsAt != nil {
+		return *x.StartsAt
+	}
+	return 0
+}
+
+func (x *RoleAssignment) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+// AssignRolesToUserResponse 表示给用户分配角色响应
+type AssignRolesToUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accessRequests 表示因授予敏感角色而创建的待审批申请，审批通过前这些角色不会生效
+	AccessRequests []*AccessRequest `protobuf:"bytes,1,rep,name=accessRequests,proto3" json:"accessRequests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *AssignRolesToUserResponse) Reset() {
 	*x = AssignRolesToUserResponse{}
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[1]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -99,7 +174,7 @@ func (x *AssignRolesToUserResponse) String() string {
 func (*AssignRolesToUserResponse) ProtoMessage() {}
 
 func (x *AssignRolesToUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[1]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,7 +187,14 @@ func (x *AssignRolesToUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRolesToUserResponse.ProtoReflect.Descriptor instead.
 func (*AssignRolesToUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{1}
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AssignRolesToUserResponse) GetAccessRequests() []*AccessRequest {
+	if x != nil {
+		return x.AccessRequests
+	}
+	return nil
 }
 
 // GetUserRolesRequest 表示获取用户角色请求
@@ -127,7 +209,7 @@ type GetUserRolesRequest struct {
 
 func (x *GetUserRolesRequest) Reset() {
 	*x = GetUserRolesRequest{}
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[2]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +221,7 @@ func (x *GetUserRolesRequest) String() string {
 func (*GetUserRolesRequest) ProtoMessage() {}
 
 func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[2]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +234,7 @@ func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{2}
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetUserRolesRequest) GetUserID() string {
@@ -165,17 +247,19 @@ func (x *GetUserRolesRequest) GetUserID() string {
 // GetUserRolesResponse 表示获取用户角色响应
 type GetUserRolesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// roles 表示角色列表
+	// roles 表示当前生效的角色列表
 	Roles []*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	// permissions 表示扁平化权限列表
+	// permissions 表示当前生效的扁平化权限列表
 	PermissionCodes []string `protobuf:"bytes,2,rep,name=permissionCodes,proto3" json:"permissionCodes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// assignments 表示全部角色分配及其有效期，包括尚未生效的定时分配
+	Assignments   []*UserRoleAssignment `protobuf:"bytes,3,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserRolesResponse) Reset() {
 	*x = GetUserRolesResponse{}
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[3]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +271,7 @@ func (x *GetUserRolesResponse) String() string {
 func (*GetUserRolesResponse) ProtoMessage() {}
 
 func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[3]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +284,7 @@ func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{3}
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUserRolesResponse) GetRoles() []*Role {
@@ -217,6 +301,104 @@ func (x *GetUserRolesResponse) GetPermissionCodes() []string {
 	return nil
 }
 
+func (x *GetUserRolesResponse) GetAssignments() []*UserRoleAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// UserRoleAssignment 表示用户的一条角色分配
+type UserRoleAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role 表示角色
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// assignedAt 表示分配时间（Unix 时间戳，秒）
+	AssignedAt int64 `protobuf:"varint,2,opt,name=assignedAt,proto3" json:"assignedAt,omitempty"`
+	// startsAt 表示生效时间（Unix 时间戳，秒），为空表示分配后立即生效
+	StartsAt *int64 `protobuf:"varint,3,opt,name=startsAt,proto3,oneof" json:"startsAt,omitempty"`
+	// expiresAt 表示过期时间（Unix 时间戳，秒），为空表示永久有效
+	ExpiresAt *int64 `protobuf:"varint,4,opt,name=expiresAt,proto3,oneof" json:"expiresAt,omitempty"`
+	// active 表示该分配当前是否生效
+	Active bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// remainingSeconds 表示距离过期的剩余秒数，永久有效时为空
+	RemainingSeconds *int64 `protobuf:"varint,6,opt,name=remainingSeconds,proto3,oneof" json:"remainingSeconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserRoleAssignment) Reset() {
+	*x = UserRoleAssignment{}
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRoleAssignment) ProtoMessage() {}
+
+func (x *UserRoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRoleAssignment.ProtoReflect.Descriptor instead.
+func (*UserRoleAssignment) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserRoleAssignment) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *UserRoleAssignment) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
+func (x *UserRoleAssignment) GetStartsAt() int64 {
+	if x != nil && x.StartsAt != nil {
+		return *x.StartsAt
+	}
+	return 0
+}
+
+func (x *UserRoleAssignment) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *UserRoleAssignment) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *UserRoleAssignment) GetRemainingSeconds() int64 {
+	if x != nil && x.RemainingSeconds != nil {
+		return *x.RemainingSeconds
+	}
+	return 0
+}
+
 // RemoveRoleFromUserRequest 表示从用户移除角色请求
 type RemoveRoleFromUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -232,7 +414,7 @@ type RemoveRoleFromUserRequest struct {
 
 func (x *RemoveRoleFromUserRequest) Reset() {
 	*x = RemoveRoleFromUserRequest{}
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[4]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +426,7 @@ func (x *RemoveRoleFromUserRequest) String() string {
 func (*RemoveRoleFromUserRequest) ProtoMessage() {}
 
 func (x *RemoveRoleFromUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[4]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +439,7 @@ func (x *RemoveRoleFromUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRoleFromUserRequest.ProtoReflect.Descriptor instead.
 func (*RemoveRoleFromUserRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{4}
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RemoveRoleFromUserRequest) GetUserID() string {
@@ -283,7 +465,7 @@ type RemoveRoleFromUserResponse struct {
 
 func (x *RemoveRoleFromUserResponse) Reset() {
 	*x = RemoveRoleFromUserResponse{}
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[5]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -295,7 +477,7 @@ func (x *RemoveRoleFromUserResponse) String() string {
 func (*RemoveRoleFromUserResponse) ProtoMessage() {}
 
 func (x *RemoveRoleFromUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_role_proto_msgTypes[5]
+	mi := &file_apiserver_v1_user_role_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,23 +490,46 @@ func (x *RemoveRoleFromUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRoleFromUserResponse.ProtoReflect.Descriptor instead.
 func (*RemoveRoleFromUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{5}
+	return file_apiserver_v1_user_role_proto_rawDescGZIP(), []int{7}
 }
 
 var File_apiserver_v1_user_role_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_user_role_proto_rawDesc = "" +
 	"\n" +
-	"\x1capiserver/v1/user_role.proto\x12\fapiserver.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17apiserver/v1/role.proto\"L\n" +
+	"\x1capiserver/v1/user_role.proto\x12\fapiserver.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17apiserver/v1/role.proto\x1a!apiserver/v1/access_request.proto\"\x8c\x01\n" +
 	"\x18AssignRolesToUserRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
-	"\aroleIDs\x18\x02 \x03(\tR\aroleIDs\"\x1b\n" +
-	"\x19AssignRolesToUserResponse\"-\n" +
+	"\aroleIDs\x18\x02 \x03(\tR\aroleIDs\x12>\n" +
+	"\vassignments\x18\x03 \x03(\v2\x1c.apiserver.v1.RoleAssignmentR\vassignments\"\x87\x01\n" +
+	"\x0eRoleAssignment\x12\x16\n" +
+	"\x06roleID\x18\x01 \x01(\tR\x06roleID\x12\x1f\n" +
+	"\bstartsAt\x18\x02 \x01(\x03H\x00R\bstartsAt\x88\x01\x01\x12!\n" +
+	"\texpiresAt\x18\x03 \x01(\x03H\x01R\texpiresAt\x88\x01\x01B\v\n" +
+	"\t_startsAtB\f\n" +
+	"\n" +
+	"_expiresAt\"`\n" +
+	"\x19AssignRolesToUserResponse\x12C\n" +
+	"\x0eaccessRequests\x18\x01 \x03(\v2\x1b.apiserver.v1.AccessRequestR\x0eaccessRequests\"-\n" +
 	"\x13GetUserRolesRequest\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"j\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\xae\x01\n" +
 	"\x14GetUserRolesResponse\x12(\n" +
 	"\x05roles\x18\x01 \x03(\v2\x12.apiserver.v1.RoleR\x05roles\x12(\n" +
-	"\x0fpermissionCodes\x18\x02 \x03(\tR\x0fpermissionCodes\"K\n" +
+	"\x0fpermissionCodes\x18\x02 \x03(\tR\x0fpermissionCodes\x12B\n" +
+	"\vassignments\x18\x03 \x03(\v2 .apiserver.v1.UserRoleAssignmentR\vassignments\"\x99\x02\n" +
+	"\x12UserRoleAssignment\x12&\n" +
+	"\x04role\x18\x01 \x01(\v2\x12.apiserver.v1.RoleR\x04role\x12\x1e\n" +
+	"\n" +
+	"assignedAt\x18\x02 \x01(\x03R\n" +
+	"assignedAt\x12\x1f\n" +
+	"\bstartsAt\x18\x03 \x01(\x03H\x00R\bstartsAt\x88\x01\x01\x12!\n" +
+	"\texpiresAt\x18\x04 \x01(\x03H\x01R\texpiresAt\x88\x01\x01\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12/\n" +
+	"\x10remainingSeconds\x18\x06 \x01(\x03H\x02R\x10remainingSeconds\x88\x01\x01B\v\n" +
+	"\t_startsAtB\f\n" +
+	"\n" +
+	"_expiresAtB\x13\n" +
+	"\x11_remainingSeconds\"K\n" +
 	"\x19RemoveRoleFromUserRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
 	"\x06roleID\x18\x02 \x01(\tR\x06roleID\"\x1c\n" +
@@ -342,23 +547,30 @@ func file_apiserver_v1_user_role_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_user_role_proto_rawDescData
 }
 
-var file_apiserver_v1_user_role_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_apiserver_v1_user_role_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_apiserver_v1_user_role_proto_goTypes = []any{
 	(*AssignRolesToUserRequest)(nil),   // 0: apiserver.v1.AssignRolesToUserRequest
-	(*AssignRolesToUserResponse)(nil),  // 1: apiserver.v1.AssignRolesToUserResponse
-	(*GetUserRolesRequest)(nil),        // 2: apiserver.v1.GetUserRolesRequest
-	(*GetUserRolesResponse)(nil),       // 3: apiserver.v1.GetUserRolesResponse
-	(*RemoveRoleFromUserRequest)(nil),  // 4: apiserver.v1.RemoveRoleFromUserRequest
-	(*RemoveRoleFromUserResponse)(nil), // 5: apiserver.v1.RemoveRoleFromUserResponse
-	(*Role)(nil),                       // 6: apiserver.v1.Role
+	(*RoleAssignment)(nil),             // 1: apiserver.v1.RoleAssignment
+	(*AssignRolesToUserResponse)(nil),  // 2: apiserver.v1.AssignRolesToUserResponse
+	(*GetUserRolesRequest)(nil),        // 3: apiserver.v1.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),       // 4: apiserver.v1.GetUserRolesResponse
+	(*UserRoleAssignment)(nil),         // 5: apiserver.v1.UserRoleAssignment
+	(*RemoveRoleFromUserRequest)(nil),  // 6: apiserver.v1.RemoveRoleFromUserRequest
+	(*RemoveRoleFromUserResponse)(nil), // 7: apiserver.v1.RemoveRoleFromUserResponse
+	(*AccessRequest)(nil),              // 8: apiserver.v1.AccessRequest
+	(*Role)(nil),                       // 9: apiserver.v1.Role
 }
 var file_apiserver_v1_user_role_proto_depIdxs = []int32{
-	6, // 0: apiserver.v1.GetUserRolesResponse.roles:type_name -> apiserver.v1.Role
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: apiserver.v1.AssignRolesToUserRequest.assignments:type_name -> apiserver.v1.RoleAssignment
+	8, // 1: apiserver.v1.AssignRolesToUserResponse.accessRequests:type_name -> apiserver.v1.AccessRequest
+	9, // 2: apiserver.v1.GetUserRolesResponse.roles:type_name -> apiserver.v1.Role
+	5, // 3: apiserver.v1.GetUserRolesResponse.assignments:type_name -> apiserver.v1.UserRoleAssignment
+	9, // 4: apiserver.v1.UserRoleAssignment.role:type_name -> apiserver.v1.Role
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_user_role_proto_init() }
@@ -367,13 +579,16 @@ func file_apiserver_v1_user_role_proto_init() {
 		return
 	}
 	file_apiserver_v1_role_proto_init()
+	file_apiserver_v1_access_request_proto_init()
+	file_apiserver_v1_user_role_proto_msgTypes[1].OneofWrappers = []any{}
+	file_apiserver_v1_user_role_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_user_role_proto_rawDesc), len(file_apiserver_v1_user_role_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/golang-jwt/jwt/v4"
-	"golang.org/x/crypto/bcrypt"
 )
 
 // IToken 定义了实现通用令牌的方法。
@@ -34,13 +33,13 @@ type Authenticator interface {
 	Release() error
 }
 
-// Encrypt 使用 bcrypt 对明文进行加密。
+// Encrypt 使用默认的密码哈希算法（见 SetDefaultHasher）对明文进行加密。
 func Encrypt(source string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(source), bcrypt.DefaultCost)
-	return string(hashedBytes), err
+	return DefaultHasher().Hash(source)
 }
 
 // Compare 比较加密后的文本与明文是否相同。
+// 算法和参数从加密后的文本中识别，因此 bcrypt 和 argon2id 生成的哈希值都可以校验。
 func Compare(hashedPassword, password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	return verify(hashedPassword, password)
 }
@@ -0,0 +1,354 @@
+package authn
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 支持的密码哈希算法标识，对应 PHC 字符串中的 $<id>$ 部分。
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+var (
+	// ErrPasswordMismatch 表示明文密码与哈希值不匹配。
+	ErrPasswordMismatch = errors.New("authn: hashed password does not match the given password")
+	// ErrUnknownHashFormat 表示无法识别哈希值所使用的算法。
+	ErrUnknownHashFormat = errors.New("authn: unknown password hash format")
+	// ErrInvalidHash 表示哈希值格式不正确。
+	ErrInvalidHash = errors.New("authn: invalid password hash")
+)
+
+// PasswordHasher 定义了密码哈希算法需要实现的方法。
+// 哈希结果统一使用 PHC 字符串格式（bcrypt 沿用其 $2a$ / $2b$ / $2y$ 模块化格式），
+// 因此不同算法生成的哈希值可以在同一张表中共存。
+type PasswordHasher interface {
+	// Algorithm 返回算法标识。
+	Algorithm() string
+	// Hash 对明文密码进行哈希。
+	Hash(password string) (string, error)
+	// Verify 校验明文密码与本算法生成的哈希值是否匹配，哈希参数从哈希值中解析。
+	Verify(encoded, password string) error
+	// NeedsRehash 判断本算法生成的哈希值是否使用了与当前配置不同的参数。
+	NeedsRehash(encoded string) bool
+}
+
+var (
+	hasherMu sync.RWMutex
+	// defaultHasher 是 Encrypt 使用的哈希算法，默认为 bcrypt.DefaultCost，与历史行为保持一致。
+	defaultHasher PasswordHasher = NewBcryptHasher(bcrypt.DefaultCost)
+)
+
+// HashLimits 是校验已有哈希值时允许的最大参数.
+// 哈希参数从哈希值中解析，超过上限的哈希值视为无效，避免参数过大的哈希值在每次登录校验时耗尽服务端的内存和 CPU。
+type HashLimits struct {
+	// BcryptCost 是 bcrypt cost 的上限。
+	BcryptCost int
+	// Argon2Memory 是 argon2id 内存大小的上限，单位为 KiB。
+	Argon2Memory uint32
+	// Argon2Iterations 是 argon2id 迭代次数的上限。
+	Argon2Iterations uint32
+	// Argon2Parallelism 是 argon2id 并行度的上限。
+	Argon2Parallelism uint8
+}
+
+// DefaultHashLimits 返回默认的哈希参数上限，足以覆盖 DefaultArgon2Params 和 bcrypt.DefaultCost 之上的常见配置。
+func DefaultHashLimits() HashLimits {
+	return HashLimits{
+		BcryptCost:        14,
+		Argon2Memory:      64 * 1024,
+		Argon2Iterations:  10,
+		Argon2Parallelism: 8,
+	}
+}
+
+// limits 是当前的哈希参数上限，由 hasherMu 保护。
+var limits = DefaultHashLimits()
+
+// SetHashLimits 设置校验已有哈希值时允许的最大参数。
+func SetHashLimits(l HashLimits) {
+	hasherMu.Lock()
+	defer hasherMu.Unlock()
+	limits = l
+}
+
+// hashLimits 返回当前的哈希参数上限。
+func hashLimits() HashLimits {
+	hasherMu.RLock()
+	defer hasherMu.RUnlock()
+	return limits
+}
+
+// SetDefaultHasher 设置 Encrypt 使用的密码哈希算法以及 NeedsRehash 的比较基准。
+func SetDefaultHasher(hasher PasswordHasher) {
+	if hasher == nil {
+		return
+	}
+
+	hasherMu.Lock()
+	defer hasherMu.Unlock()
+	defaultHasher = hasher
+}
+
+// DefaultHasher 返回当前使用的密码哈希算法。
+func DefaultHasher() PasswordHasher {
+	hasherMu.RLock()
+	defer hasherMu.RUnlock()
+	return defaultHasher
+}
+
+// Identify 根据哈希值的前缀识别其使用的算法，无法识别时返回空字符串。
+func Identify(encoded string) string {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return AlgorithmArgon2id
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		return AlgorithmBcrypt
+	default:
+		return ""
+	}
+}
+
+// IsHashed 判断给定的字符串是否已经是受支持算法生成的哈希值，用于防止重复哈希。
+// 只有能被完整解析且参数不超过 HashLimits 的哈希值才返回 true，仅前缀相同的明文密码（例如 "$2a$..."）仍会被哈希。
+func IsHashed(s string) bool {
+	switch Identify(s) {
+	case AlgorithmBcrypt:
+		return isBcryptHash(s)
+	case AlgorithmArgon2id:
+		version, params, salt, _, err := decodeArgon2id(s)
+		return err == nil && version == argon2.Version && params.Memory > 0 && len(salt) > 0
+	default:
+		return false
+	}
+}
+
+// bcryptHashLen 是 bcrypt 哈希值的长度：$2a$<cost>$ 共 7 个字符，加上 22 个字符的盐和 31 个字符的哈希。
+const bcryptHashLen = 60
+
+// bcryptAlphabet 是 bcrypt 编码盐和哈希使用的 base64 字符集。
+const bcryptAlphabet = "./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// isBcryptHash 判断字符串是否为格式完整的 bcrypt 哈希值。
+func isBcryptHash(s string) bool {
+	if len(s) != bcryptHashLen {
+		return false
+	}
+	if checkBcryptCost(s) != nil {
+		return false
+	}
+	// bcrypt.Cost 只解析版本和 cost，盐和哈希部分需要单独校验
+	for _, c := range s[7:] {
+		if !strings.ContainsRune(bcryptAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkBcryptCost 校验 bcrypt 哈希值的 cost 不超过 HashLimits.
+func checkBcryptCost(encoded string) error {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidHash, err)
+	}
+	if limit := hashLimits().BcryptCost; cost > limit {
+		return fmt.Errorf("%w: bcrypt cost %d exceeds the maximum %d", ErrInvalidHash, cost, limit)
+	}
+	return nil
+}
+
+// NeedsRehash 判断哈希值是否需要使用当前的默认算法和参数重新生成。
+// 算法不同或参数过时都会返回 true，调用方应在拿到明文密码（例如登录成功）时重新哈希。
+func NeedsRehash(encoded string) bool {
+	hasher := DefaultHasher()
+	if Identify(encoded) != hasher.Algorithm() {
+		return true
+	}
+	return hasher.NeedsRehash(encoded)
+}
+
+// verify 根据哈希值识别算法并校验明文密码，校验时使用哈希值中记录的参数而不是当前配置。
+func verify(encoded, password string) error {
+	switch Identify(encoded) {
+	case AlgorithmBcrypt:
+		return (&bcryptHasher{}).Verify(encoded, password)
+	case AlgorithmArgon2id:
+		return (&argon2idHasher{}).Verify(encoded, password)
+	default:
+		return ErrUnknownHashFormat
+	}
+}
+
+// bcryptHasher 是基于 bcrypt 的 PasswordHasher 实现。
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher 创建一个使用指定 cost 的 bcrypt 哈希器。
+func NewBcryptHasher(cost int) PasswordHasher {
+	return &bcryptHasher{cost: cost}
+}
+
+// Algorithm 返回算法标识。
+func (h *bcryptHasher) Algorithm() string {
+	return AlgorithmBcrypt
+}
+
+// Hash 对明文密码进行哈希。
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	return string(hashedBytes), err
+}
+
+// Verify 校验明文密码与哈希值是否匹配。
+func (h *bcryptHasher) Verify(encoded, password string) error {
+	if err := checkBcryptCost(encoded); err != nil {
+		return err
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrPasswordMismatch
+	}
+	return err
+}
+
+// NeedsRehash 判断哈希值的 cost 是否与当前配置不同。
+func (h *bcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost != h.cost
+}
+
+// Argon2Params 是 argon2id 的哈希参数。
+type Argon2Params struct {
+	// Memory 是使用的内存大小，单位为 KiB。
+	Memory uint32
+	// Iterations 是迭代次数。
+	Iterations uint32
+	// Parallelism 是并行度。
+	Parallelism uint8
+	// SaltLength 是随机盐的字节数。
+	SaltLength uint32
+	// KeyLength 是生成的哈希字节数。
+	KeyLength uint32
+}
+
+// DefaultArgon2Params 返回 OWASP 推荐的 argon2id 参数（19 MiB 内存、2 次迭代、并行度 1）。
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Memory:      19 * 1024,
+		Iterations:  2,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// argon2idHasher 是基于 argon2id 的 PasswordHasher 实现。
+type argon2idHasher struct {
+	params Argon2Params
+}
+
+// NewArgon2idHasher 创建一个使用指定参数的 argon2id 哈希器。
+func NewArgon2idHasher(params Argon2Params) PasswordHasher {
+	return &argon2idHasher{params: params}
+}
+
+// Algorithm 返回算法标识。
+func (h *argon2idHasher) Algorithm() string {
+	return AlgorithmArgon2id
+}
+
+// Hash 对明文密码进行哈希，返回 $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash> 格式的字符串。
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify 校验明文密码与哈希值是否匹配。
+func (h *argon2idHasher) Verify(encoded, password string) error {
+	version, params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return err
+	}
+	if version != argon2.Version {
+		return fmt.Errorf("%w: unsupported argon2 version %d", ErrInvalidHash, version)
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+// NeedsRehash 判断哈希值的参数是否与当前配置不同。
+func (h *argon2idHasher) NeedsRehash(encoded string) bool {
+	version, params, salt, _, err := decodeArgon2id(encoded)
+	if err != nil || version != argon2.Version {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		uint32(len(salt)) != h.params.SaltLength
+}
+
+// decodeArgon2id 解析 argon2id 的 PHC 字符串，参数超过 HashLimits 时返回 ErrInvalidHash。
+func decodeArgon2id(encoded string) (version int, params Argon2Params, salt, key []byte, err error) {
+	// 格式：["", "argon2id", "v=19", "m=..,t=..,p=..", "<salt>", "<hash>"]
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return 0, params, nil, nil, ErrInvalidHash
+	}
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return 0, params, nil, nil, fmt.Errorf("%w: %v", ErrInvalidHash, err)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return 0, params, nil, nil, fmt.Errorf("%w: %v", ErrInvalidHash, err)
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return 0, params, nil, nil, fmt.Errorf("%w: %v", ErrInvalidHash, err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return 0, params, nil, nil, fmt.Errorf("%w: %v", ErrInvalidHash, err)
+	}
+	if len(key) == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		return 0, params, nil, nil, ErrInvalidHash
+	}
+	if l := hashLimits(); params.Memory > l.Argon2Memory || params.Iterations > l.Argon2Iterations || params.Parallelism > l.Argon2Parallelism {
+		return 0, params, nil, nil, fmt.Errorf("%w: argon2id parameters m=%d,t=%d,p=%d exceed the maximum m=%d,t=%d,p=%d", ErrInvalidHash,
+			params.Memory, params.Iterations, params.Parallelism, l.Argon2Memory, l.Argon2Iterations, l.Argon2Parallelism)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return version, params, salt, key, nil
+}
@@ -0,0 +1,159 @@
+package authn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params 使用较小的参数以加快测试速度
+var testArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+// TestArgon2idHasher 测试 argon2id 哈希与校验
+func TestArgon2idHasher(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2Params)
+
+	hashed, err := hasher.Hash("password123")
+	if err != nil {
+		t.Fatalf("Hash() 失败: %v", err)
+	}
+
+	if !strings.HasPrefix(hashed, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("Hash() 返回的不是 PHC 格式: %s", hashed)
+	}
+
+	if err := hasher.Verify(hashed, "password123"); err != nil {
+		t.Errorf("Verify() 正确密码校验失败: %v", err)
+	}
+
+	if err := hasher.Verify(hashed, "wrongpassword"); !errors.Is(err, ErrPasswordMismatch) {
+		t.Errorf("Verify() 错误密码应返回 ErrPasswordMismatch, got %v", err)
+	}
+}
+
+// TestCompareMixedAlgorithms 测试 bcrypt 和 argon2id 哈希值可以共存
+func TestCompareMixedAlgorithms(t *testing.T) {
+	bcryptHash, _ := NewBcryptHasher(bcrypt.MinCost).Hash("password123")
+	argon2Hash, _ := NewArgon2idHasher(testArgon2Params).Hash("password123")
+
+	tests := []compareTestCase{
+		{name: "bcrypt 密码匹配", hashedPassword: bcryptHash, password: "password123", wantErr: false},
+		{name: "bcrypt 密码不匹配", hashedPassword: bcryptHash, password: "wrong", wantErr: true},
+		{name: "argon2id 密码匹配", hashedPassword: argon2Hash, password: "password123", wantErr: false},
+		{name: "argon2id 密码不匹配", hashedPassword: argon2Hash, password: "wrong", wantErr: true},
+		{name: "argon2id 格式损坏", hashedPassword: "$argon2id$v=19$m=1024$abc", password: "password123", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Compare(tt.hashedPassword, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Compare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestNeedsRehash 测试算法或参数变化时需要重新哈希
+func TestNeedsRehash(t *testing.T) {
+	previous := DefaultHasher()
+	defer SetDefaultHasher(previous)
+
+	bcryptHash, _ := NewBcryptHasher(bcrypt.MinCost).Hash("password123")
+	argon2Hash, _ := NewArgon2idHasher(testArgon2Params).Hash("password123")
+
+	stronger := testArgon2Params
+	stronger.Iterations = 2
+
+	tests := []struct {
+		name    string
+		hasher  PasswordHasher
+		encoded string
+		want    bool
+	}{
+		{name: "bcrypt 参数一致", hasher: NewBcryptHasher(bcrypt.MinCost), encoded: bcryptHash, want: false},
+		{name: "bcrypt cost 变化", hasher: NewBcryptHasher(bcrypt.MinCost + 1), encoded: bcryptHash, want: true},
+		{name: "bcrypt 迁移到 argon2id", hasher: NewArgon2idHasher(testArgon2Params), encoded: bcryptHash, want: true},
+		{name: "argon2id 参数一致", hasher: NewArgon2idHasher(testArgon2Params), encoded: argon2Hash, want: false},
+		{name: "argon2id 参数变化", hasher: NewArgon2idHasher(stronger), encoded: argon2Hash, want: true},
+		{name: "argon2id 回退到 bcrypt", hasher: NewBcryptHasher(bcrypt.MinCost), encoded: argon2Hash, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaultHasher(tt.hasher)
+			if got := NeedsRehash(tt.encoded); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsHashed 测试识别已哈希的值，防止重复哈希
+func TestIsHashed(t *testing.T) {
+	bcryptHash, _ := NewBcryptHasher(bcrypt.MinCost).Hash("password123")
+	argon2Hash, _ := NewArgon2idHasher(testArgon2Params).Hash("password123")
+
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{name: "bcrypt 哈希", value: bcryptHash, want: true},
+		{name: "argon2id 哈希", value: argon2Hash, want: true},
+		{name: "明文密码", value: "password123", want: false},
+		{name: "空字符串", value: "", want: false},
+		{name: "bcrypt 前缀的明文密码", value: "$2a$MyPassw0rd!", want: false},
+		{name: "cost 不合法的 bcrypt 哈希", value: "$2a$99$" + bcryptHash[7:], want: false},
+		{name: "截断的 bcrypt 哈希", value: bcryptHash[:59], want: false},
+		{name: "含非法字符的 bcrypt 哈希", value: bcryptHash[:59] + "!", want: false},
+		{name: "argon2id 前缀的明文密码", value: "$argon2id$MyPassw0rd!", want: false},
+		{name: "参数不合法的 argon2id 哈希", value: strings.Replace(argon2Hash, "t=1", "t=0", 1), want: false},
+		{name: "缺少盐的 argon2id 哈希", value: "$argon2id$v=19$m=19456,t=2,p=1$$" + argon2Hash[strings.LastIndex(argon2Hash, "$")+1:], want: false},
+		{name: "版本不支持的 argon2id 哈希", value: strings.Replace(argon2Hash, "v=19", "v=16", 1), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsHashed(tt.value); got != tt.want {
+				t.Errorf("IsHashed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHashLimits 测试参数超过上限的哈希值既不被视为已哈希，也不会被用于校验密码
+func TestHashLimits(t *testing.T) {
+	previous := hashLimits()
+	defer SetHashLimits(previous)
+	SetHashLimits(HashLimits{BcryptCost: bcrypt.MinCost, Argon2Memory: 1024, Argon2Iterations: 1, Argon2Parallelism: 1})
+
+	bcryptHash, _ := NewBcryptHasher(bcrypt.MinCost).Hash("password123")
+	argon2Hash, _ := NewArgon2idHasher(testArgon2Params).Hash("password123")
+	if !IsHashed(bcryptHash) || !IsHashed(argon2Hash) {
+		t.Fatal("IsHashed() 应接受上限以内的哈希值")
+	}
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "bcrypt cost 超过上限", value: "$2a$31$" + bcryptHash[7:]},
+		{name: "argon2id 内存超过上限", value: strings.Replace(argon2Hash, "m=1024", "m=4194304", 1)},
+		{name: "argon2id 迭代次数超过上限", value: strings.Replace(argon2Hash, "t=1", "t=1000", 1)},
+		{name: "argon2id 并行度超过上限", value: strings.Replace(argon2Hash, "p=1", "p=255", 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if IsHashed(tt.value) {
+				t.Error("IsHashed() 应拒绝参数超过上限的哈希值")
+			}
+			if err := Compare(tt.value, "password123"); !errors.Is(err, ErrInvalidHash) {
+				t.Errorf("Compare() 应返回 ErrInvalidHash, got %v", err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	casbin "github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/util"
 	adapter "github.com/casbin/gorm-adapter/v3"
 	"github.com/google/wire"
 	"gorm.io/gorm"
@@ -13,25 +14,36 @@ import (
 
 const (
 	// 默认的 Casbin 访问控制模型.
+	// p 为普通策略；p2 为带 ABAC 条件的授权策略，cond 字段保存 JSON 编码的 Condition，
+	// 通过 r2/e2/m2 在请求上下文上评估。资源路径使用 keyMatch2 匹配，:param 只匹配一段路径，/* 匹配任意后缀。
 	defaultAclModel = `[request_definition]
 r = sub, obj, act
+r2 = sub, obj, act, ctx
 
 [policy_definition]
 p = sub, obj, act, eft
+p2 = sub, obj, act, eft, cond
 
 [role_definition]
 g = _, _
 
 [policy_effect]
 e = !some(where (p.eft == deny))
+e2 = some(where (p.eft == allow))
 
 [matchers]
-m = g(r.sub, p.sub) && keyMatch(r.obj, p.obj) && r.act == p.act`
+m = g(r.sub, p.sub) && keyMatch2(r.obj, p.obj) && r.act == p.act
+m2 = g(r2.sub, p2.sub) && keyMatch2(r2.obj, p2.obj) && r2.act == p2.act && conditionMatch(r2.ctx, p2.cond)`
+
+	// conditionalPolicyType 是带条件策略的策略类型.
+	conditionalPolicyType = "p2"
 )
 
 // Authz 定义了一个授权器，提供授权功能。
 type Authz struct {
 	*casbin.SyncedEnforcer // 使用 Casbin 的同步授权器
+
+	watcher *Watcher
 }
 
 // Option 定义了一个函数选项类型，用于自定义 NewAuthz 的行为。
@@ -41,6 +53,8 @@ type Option func(*authzConfig)
 type authzConfig struct {
 	aclModel           string        // Casbin 的模型字符串
 	autoLoadPolicyTime time.Duration // 自动加载策略的时间间隔
+	watcher            *Watcher      // 策略变更广播器，为空时仅依赖定时加载
+	disableAutoMigrate bool          // 为 true 时不由适配器自动创建和变更 casbin_rule 表
 }
 
 // ProviderSet 是一个 Wire 的 Provider 集合，用于声明依赖注入的规则。
@@ -81,6 +95,20 @@ func WithAutoLoadPolicyTime(interval time.Duration) Option {
 	}
 }
 
+// WithoutAutoMigrate 禁止适配器自动迁移 casbin_rule 表，用于表结构由迁移文件维护的场景.
+func WithoutAutoMigrate() Option {
+	return func(cfg *authzConfig) {
+		cfg.disableAutoMigrate = true
+	}
+}
+
+// WithWatcher 设置策略变更广播器，各实例增量同步策略变更，定时加载仅作为兜底。
+func WithWatcher(watcher *Watcher) Option {
+	return func(cfg *authzConfig) {
+		cfg.watcher = watcher
+	}
+}
+
 // NewAuthz 创建一个使用 Casbin 完成授权的授权器，通过函数选项模式支持自定义配置。
 func NewAuthz(db *gorm.DB, opts ...Option) (*Authz, error) {
 	// 初始化默认配置
@@ -92,6 +120,11 @@ func NewAuthz(db *gorm.DB, opts ...Option) (*Authz, error) {
 	}
 
 	// 初始化 Gorm 适配器并用于 Casbin 授权器
+	if cfg.disableAutoMigrate {
+		// TurnOffAutoMigrate 会修改传入的 db，使用新的会话避免影响调用方
+		db = db.Session(&gorm.Session{})
+		adapter.TurnOffAutoMigrate(db)
+	}
 	adp, err := adapter.NewAdapterByDB(db)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create adapter: %w", err)
@@ -109,20 +142,131 @@ func NewAuthz(db *gorm.DB, opts ...Option) (*Authz, error) {
 		return nil, fmt.Errorf("failed to create enforcer: %w", err)
 	}
 
+	// 注册 ABAC 条件匹配函数，需在加载策略前完成
+	enforcer.AddFunction(ConditionFuncName, conditionMatch)
+
 	// 从数据库加载策略
 	if err := enforcer.LoadPolicy(); err != nil {
 		return nil, fmt.Errorf("failed to load policy: %w", err)
 	}
 
+	a := &Authz{SyncedEnforcer: enforcer}
+	if cfg.watcher != nil {
+		if err := a.setWatcher(cfg.watcher); err != nil {
+			return nil, fmt.Errorf("failed to set watcher: %w", err)
+		}
+	}
+
 	// 启动自动加载策略，使用配置的时间间隔
 	enforcer.StartAutoLoadPolicy(cfg.autoLoadPolicyTime)
 
 	// 返回新的授权器实例
-	return &Authz{enforcer}, nil
+	return a, nil
 }
 
-// Authorize 用于进行授权。
+// setWatcher 设置策略变更广播器，收到其他实例的变更时在本地增量应用.
+// casbin 为 WatcherEx 设置的默认回调是全量加载，需要在 SetWatcher 之后覆盖.
+func (a *Authz) setWatcher(watcher *Watcher) error {
+	if err := a.SetWatcher(watcher); err != nil {
+		return err
+	}
+	a.watcher = watcher
+
+	return watcher.SetUpdateCallback(func(payload string) {
+		a.applyUpdate(watcher.id, payload)
+	})
+}
+
+// Close 停止自动加载策略并关闭策略变更广播器。
+func (a *Authz) Close() {
+	a.StopAutoLoadPolicy()
+	if a.watcher != nil {
+		a.watcher.Close()
+	}
+}
+
+// Authorize 用于进行授权，等价于不携带请求上下文的 AuthorizeRequest。
 func (a *Authz) Authorize(sub, obj, act string) (bool, error) {
-	// 调用 Enforce 方法进行授权检查
-	return a.Enforce(sub, obj, act)
+	return a.AuthorizeRequest(sub, obj, act, nil)
+}
+
+// AuthorizeRequest 结合请求上下文进行授权。
+// 先按普通策略检查（deny 优先），若 sub 在 obj/act 上存在带条件的授权策略，
+// 则要求至少一条条件满足，或者 sub 另外拥有无条件的 allow 策略。
+func (a *Authz) AuthorizeRequest(sub, obj, act string, rc *RequestContext) (bool, error) {
+	ok, err := a.Enforce(sub, obj, act)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if !a.supportsConditions() {
+		return true, nil
+	}
+
+	policies, err := a.GetNamedImplicitPermissionsForUser(conditionalPolicyType, "g", sub)
+	if err != nil {
+		return false, err
+	}
+	if !matchAny(policies, obj, act) {
+		return true, nil
+	}
+
+	ok, err = a.Enforce(casbin.NewEnforceContext("2"), sub, obj, act, rc)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	return a.unconditionallyAllowed(sub, obj, act)
+}
+
+// AddConditionalPolicy 为 sub 添加一条带条件的授权策略。
+func (a *Authz) AddConditionalPolicy(sub, obj, act string, cond *Condition) (bool, error) {
+	return a.AddNamedPolicy(conditionalPolicyType, sub, obj, act, "allow", cond.String())
+}
+
+// RemoveConditionalPolicies 删除 sub 的所有带条件的授权策略。
+func (a *Authz) RemoveConditionalPolicies(sub string) (bool, error) {
+	return a.RemoveFilteredNamedPolicy(conditionalPolicyType, 0, sub)
+}
+
+// ExplicitlyAllowed 判断 sub 是否被显式授予了 obj 上的 act 权限。
+// 默认模型在没有任何匹配策略时放行，敏感操作（如模拟登录）需要使用本方法，
+// 要求在 sub 的直接或继承策略中存在一条匹配的 allow 策略，且没有 deny 策略。
+// 带条件的授权策略不视为显式授权。
+func (a *Authz) ExplicitlyAllowed(sub, obj, act string) (bool, error) {
+	ok, err := a.Enforce(sub, obj, act)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return a.unconditionallyAllowed(sub, obj, act)
+}
+
+// unconditionallyAllowed 判断 sub 的直接或继承策略中是否存在匹配的无条件 allow 策略.
+func (a *Authz) unconditionallyAllowed(sub, obj, act string) (bool, error) {
+	policies, err := a.GetImplicitPermissionsForUser(sub)
+	if err != nil {
+		return false, err
+	}
+
+	return matchAny(policies, obj, act), nil
+}
+
+// supportsConditions 判断当前模型是否定义了带条件的策略（自定义模型可能未定义）.
+func (a *Authz) supportsConditions() bool {
+	_, ok := a.GetModel()["p"][conditionalPolicyType]
+	return ok
+}
+
+// matchAny 判断策略列表中是否存在匹配 obj/act 的 allow 策略.
+func matchAny(policies [][]string, obj, act string) bool {
+	for _, p := range policies {
+		if len(p) < 4 || p[3] != "allow" || p[2] != act {
+			continue
+		}
+		if util.KeyMatch2(obj, p[1]) {
+			return true
+		}
+	}
+	return false
 }
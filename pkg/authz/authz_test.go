@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	casbin "github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -207,6 +209,19 @@ func TestWithAutoLoadPolicyTime(t *testing.T) {
 	}
 }
 
+// TestWithoutAutoMigrate 测试禁用自动迁移选项。
+func TestWithoutAutoMigrate(t *testing.T) {
+	cfg := defaultAuthzConfig()
+	if cfg.disableAutoMigrate {
+		t.Error("默认配置不应禁用自动迁移")
+	}
+
+	WithoutAutoMigrate()(cfg)
+	if !cfg.disableAutoMigrate {
+		t.Error("WithoutAutoMigrate() 未禁用自动迁移")
+	}
+}
+
 // TestDefaultOptions 测试默认选项。
 func TestDefaultOptions(t *testing.T) {
 	opts := DefaultOptions()
@@ -251,3 +266,105 @@ func BenchmarkAuthz_Authorize_Allow(b *testing.B) {
 func BenchmarkAuthz_Authorize_Deny(b *testing.B) {
 	benchmarkAuthorize(b, "bob", "data1", "read")
 }
+
+// TestAuthz_ExplicitlyAllowed 测试显式授权检查。
+func TestAuthz_ExplicitlyAllowed(t *testing.T) {
+	m, err := model.NewModelFromString(defaultAclModel)
+	if err != nil {
+		t.Fatalf("无法加载模型: %v", err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatalf("无法创建授权器: %v", err)
+	}
+	authz := &Authz{SyncedEnforcer: enforcer}
+
+	_, _ = authz.AddPolicy("role::admin", "/v1/admin/users/*/impersonate", "POST", "allow")
+	_, _ = authz.AddPolicy("role::auditor", "/v1/admin/users/*/impersonate", "POST", "deny")
+	_, _ = authz.AddGroupingPolicy("alice", "role::admin")
+	_, _ = authz.AddGroupingPolicy("bob", "role::admin")
+	_, _ = authz.AddGroupingPolicy("bob", "role::auditor")
+
+	tests := []struct {
+		name string
+		sub  string
+		obj  string
+		act  string
+		want bool
+	}{
+		{name: "继承角色的显式授权", sub: "alice", obj: "/v1/admin/users/u1/impersonate", act: "POST", want: true},
+		{name: "动作不匹配", sub: "alice", obj: "/v1/admin/users/u1/impersonate", act: "GET", want: false},
+		{name: "无策略时默认放行但不视为显式授权", sub: "carol", obj: "/v1/admin/users/u1/impersonate", act: "POST", want: false},
+		{name: "存在拒绝策略", sub: "bob", obj: "/v1/admin/users/u1/impersonate", act: "POST", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := authz.ExplicitlyAllowed(tt.sub, tt.obj, tt.act)
+			if err != nil {
+				t.Fatalf("ExplicitlyAllowed() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ExplicitlyAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAuthz_AuthorizeRequest 测试带条件策略的授权。
+func TestAuthz_AuthorizeRequest(t *testing.T) {
+	m, err := model.NewModelFromString(defaultAclModel)
+	if err != nil {
+		t.Fatalf("无法加载模型: %v", err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatalf("无法创建授权器: %v", err)
+	}
+	enforcer.AddFunction(ConditionFuncName, conditionMatch)
+	authz := &Authz{SyncedEnforcer: enforcer}
+
+	office := &Condition{CIDRs: []string{"10.0.0.0/8"}}
+	_, _ = authz.AddConditionalPolicy("role::ops", "/v1/admin/*", "POST", office)
+	_, _ = authz.AddPolicy("role::admin", "/v1/admin/*", "POST", "allow")
+	_, _ = authz.AddPolicy("role::banned", "/v1/admin/*", "POST", "deny")
+	_, _ = authz.AddGroupingPolicy("alice", "role::ops")
+	_, _ = authz.AddGroupingPolicy("bob", "role::ops")
+	_, _ = authz.AddGroupingPolicy("bob", "role::admin")
+	_, _ = authz.AddGroupingPolicy("carol", "role::ops")
+	_, _ = authz.AddGroupingPolicy("carol", "role::banned")
+
+	inside := &RequestContext{IP: "10.1.2.3"}
+	outside := &RequestContext{IP: "192.168.1.1"}
+
+	tests := []struct {
+		name string
+		sub  string
+		obj  string
+		rc   *RequestContext
+		want bool
+	}{
+		{name: "条件满足", sub: "alice", obj: "/v1/admin/users", rc: inside, want: true},
+		{name: "条件不满足", sub: "alice", obj: "/v1/admin/users", rc: outside, want: false},
+		{name: "缺少请求上下文", sub: "alice", obj: "/v1/admin/users", rc: nil, want: false},
+		{name: "其他角色无条件授权", sub: "bob", obj: "/v1/admin/users", rc: outside, want: true},
+		{name: "拒绝策略优先", sub: "carol", obj: "/v1/admin/users", rc: inside, want: false},
+		{name: "无带条件策略时默认放行", sub: "alice", obj: "/v1/users", rc: outside, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := authz.AuthorizeRequest(tt.sub, tt.obj, "POST", tt.rc)
+			if err != nil {
+				t.Fatalf("AuthorizeRequest() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AuthorizeRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if ok, _ := authz.ExplicitlyAllowed("alice", "/v1/admin/users", "POST"); ok {
+		t.Error("ExplicitlyAllowed() 不应将带条件的策略视为显式授权")
+	}
+}
@@ -0,0 +1,235 @@
+package authz
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConditionFuncName 是注册到 Casbin 匹配器中的条件匹配函数名称。
+const ConditionFuncName = "conditionMatch"
+
+// RequestContext 描述一次请求的上下文属性，用于评估策略上的 ABAC 条件。
+type RequestContext struct {
+	// IP 为客户端 IP 地址
+	IP string
+	// Time 为请求时间，零值表示当前时间
+	Time time.Time
+	// Attributes 为请求属性（如当前用户、路由参数），键不区分大小写
+	Attributes map[string]string
+}
+
+// Attribute 返回指定名称的请求属性，名称不区分大小写。
+func (rc *RequestContext) Attribute(name string) (string, bool) {
+	if rc == nil {
+		return "", false
+	}
+	if v, ok := rc.Attributes[name]; ok {
+		return v, true
+	}
+	for k, v := range rc.Attributes {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// Condition 定义策略的生效条件，所有已设置的条件同时满足时策略才生效。
+type Condition struct {
+	// CIDRs 允许访问的客户端 IP 段，支持单个 IP
+	CIDRs []string `json:"cidrs,omitempty"`
+	// Weekdays 允许访问的星期，0 表示周日，6 表示周六
+	Weekdays []int `json:"weekdays,omitempty"`
+	// StartHour 允许访问的起始小时（包含），取值 0-23
+	StartHour *int `json:"startHour,omitempty"`
+	// EndHour 允许访问的结束小时（不包含），取值 1-24，小于 StartHour 时表示跨零点
+	EndHour *int `json:"endHour,omitempty"`
+	// Timezone 计算星期和小时所使用的时区（IANA 名称），默认 UTC
+	Timezone string `json:"timezone,omitempty"`
+	// Attributes 要求请求携带的属性及其取值
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// conditionCache 缓存已解析的条件，避免每次授权都重复解析 JSON。
+var conditionCache sync.Map
+
+// ParseCondition 解析并校验 JSON 格式的条件。
+func ParseCondition(s string) (*Condition, error) {
+	if v, ok := conditionCache.Load(s); ok {
+		return v.(*Condition), nil
+	}
+
+	var cond Condition
+	if err := json.Unmarshal([]byte(s), &cond); err != nil {
+		return nil, fmt.Errorf("invalid condition: %w", err)
+	}
+	if err := cond.Validate(); err != nil {
+		return nil, err
+	}
+
+	conditionCache.Store(s, &cond)
+	return &cond, nil
+}
+
+// String 返回条件的 JSON 编码，用作 Casbin 策略中的 cond 字段。
+func (c *Condition) String() string {
+	data, _ := json.Marshal(c)
+	return string(data)
+}
+
+// IsEmpty 判断条件是否未设置任何约束。
+func (c *Condition) IsEmpty() bool {
+	return c == nil || (len(c.CIDRs) == 0 && len(c.Weekdays) == 0 &&
+		c.StartHour == nil && c.EndHour == nil && len(c.Attributes) == 0)
+}
+
+// Validate 校验条件的合法性。
+func (c *Condition) Validate() error {
+	for _, cidr := range c.CIDRs {
+		if _, err := parseCIDR(cidr); err != nil {
+			return err
+		}
+	}
+	for _, d := range c.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("invalid weekday %d: must be between 0 and 6", d)
+		}
+	}
+	if (c.StartHour == nil) != (c.EndHour == nil) {
+		return errors.New("startHour and endHour must be set together")
+	}
+	if c.StartHour != nil {
+		if *c.StartHour < 0 || *c.StartHour > 23 {
+			return fmt.Errorf("invalid startHour %d: must be between 0 and 23", *c.StartHour)
+		}
+		if *c.EndHour < 1 || *c.EndHour > 24 || *c.EndHour == *c.StartHour {
+			return fmt.Errorf("invalid endHour %d: must be between 1 and 24 and differ from startHour", *c.EndHour)
+		}
+	}
+	if c.Timezone != "" {
+		if _, err := time.LoadLocation(c.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+		}
+	}
+	for k := range c.Attributes {
+		if k == "" {
+			return errors.New("attribute name must not be empty")
+		}
+	}
+	return nil
+}
+
+// Match 判断请求上下文是否满足条件，rc 为 nil 时仅空条件可以匹配。
+func (c *Condition) Match(rc *RequestContext) bool {
+	if c.IsEmpty() {
+		return true
+	}
+	if rc == nil {
+		return false
+	}
+
+	if len(c.CIDRs) > 0 && !c.matchIP(rc.IP) {
+		return false
+	}
+
+	now := rc.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+	loc := time.UTC
+	if c.Timezone != "" {
+		if l, err := time.LoadLocation(c.Timezone); err == nil {
+			loc = l
+		}
+	}
+	now = now.In(loc)
+
+	if len(c.Weekdays) > 0 && !containsInt(c.Weekdays, int(now.Weekday())) {
+		return false
+	}
+	if c.StartHour != nil && c.EndHour != nil && !inHourRange(now.Hour(), *c.StartHour, *c.EndHour) {
+		return false
+	}
+
+	for k, want := range c.Attributes {
+		if got, ok := rc.Attribute(k); !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// matchIP 判断 IP 是否落在任一允许的网段中.
+func (c *Condition) matchIP(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range c.CIDRs {
+		n, err := parseCIDR(cidr)
+		if err == nil && n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCIDR 解析网段，单个 IP 视为 /32 或 /128 网段.
+func parseCIDR(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid CIDR %q", s)
+		}
+		bits := 128
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 32
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q: %w", s, err)
+	}
+	return n, nil
+}
+
+// inHourRange 判断小时是否落在 [start, end) 内，end 小于 start 时表示跨零点.
+func inHourRange(hour, start, end int) bool {
+	if start < end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+func containsInt(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionMatch 是注册到 Casbin 的匹配函数，签名为 conditionMatch(r.ctx, p.cond).
+func conditionMatch(args ...any) (any, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf("%s expects 2 arguments, got %d", ConditionFuncName, len(args))
+	}
+	raw, _ := args[1].(string)
+	if raw == "" {
+		return true, nil
+	}
+	cond, err := ParseCondition(raw)
+	if err != nil {
+		// 无法解析的条件视为不满足，避免错误配置导致越权
+		return false, nil
+	}
+	rc, _ := args[0].(*RequestContext)
+	return cond.Match(rc), nil
+}
@@ -0,0 +1,86 @@
+package authz
+
+import (
+	"testing"
+	"time"
+)
+
+func intPtr(v int) *int { return &v }
+
+// TestCondition_Match 测试条件匹配。
+func TestCondition_Match(t *testing.T) {
+	// 2026-10-19 为周一
+	monday10 := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	monday23 := time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		cond *Condition
+		rc   *RequestContext
+		want bool
+	}{
+		{name: "空条件", cond: &Condition{}, rc: nil, want: true},
+		{name: "IP 在网段内", cond: &Condition{CIDRs: []string{"10.0.0.0/8"}}, rc: &RequestContext{IP: "10.0.0.1"}, want: true},
+		{name: "IP 不在网段内", cond: &Condition{CIDRs: []string{"10.0.0.0/8"}}, rc: &RequestContext{IP: "11.0.0.1"}, want: false},
+		{name: "单个 IP", cond: &Condition{CIDRs: []string{"127.0.0.1"}}, rc: &RequestContext{IP: "127.0.0.1"}, want: true},
+		{name: "工作日", cond: &Condition{Weekdays: []int{1, 2, 3, 4, 5}}, rc: &RequestContext{Time: monday10}, want: true},
+		{name: "非允许星期", cond: &Condition{Weekdays: []int{0, 6}}, rc: &RequestContext{Time: monday10}, want: false},
+		{name: "小时窗口内", cond: &Condition{StartHour: intPtr(9), EndHour: intPtr(18)}, rc: &RequestContext{Time: monday10}, want: true},
+		{name: "小时窗口外", cond: &Condition{StartHour: intPtr(9), EndHour: intPtr(18)}, rc: &RequestContext{Time: monday23}, want: false},
+		{name: "跨零点窗口", cond: &Condition{StartHour: intPtr(22), EndHour: intPtr(6)}, rc: &RequestContext{Time: monday23}, want: true},
+		{
+			name: "时区换算",
+			cond: &Condition{StartHour: intPtr(18), EndHour: intPtr(19), Timezone: "Asia/Shanghai"},
+			rc:   &RequestContext{Time: monday10},
+			want: true,
+		},
+		{
+			name: "请求属性匹配（不区分大小写）",
+			cond: &Condition{Attributes: map[string]string{"X-Device-Trusted": "true"}},
+			rc:   &RequestContext{Attributes: map[string]string{"x-device-trusted": "true"}},
+			want: true,
+		},
+		{
+			name: "缺少请求属性",
+			cond: &Condition{Attributes: map[string]string{"x-device-trusted": "true"}},
+			rc:   &RequestContext{},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cond.Match(tt.rc); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseCondition 测试条件解析与校验。
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr bool
+	}{
+		{name: "合法条件", raw: `{"cidrs":["10.0.0.0/8"],"weekdays":[1,5],"startHour":9,"endHour":18,"timezone":"Asia/Shanghai"}`},
+		{name: "非法 JSON", raw: `{`, wantErr: true},
+		{name: "非法网段", raw: `{"cidrs":["10.0.0.0/33"]}`, wantErr: true},
+		{name: "非法星期", raw: `{"weekdays":[7]}`, wantErr: true},
+		{name: "小时只设置一端", raw: `{"startHour":9}`, wantErr: true},
+		{name: "非法时区", raw: `{"timezone":"Mars/Base"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, err := ParseCondition(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && cond.IsEmpty() {
+				t.Error("ParseCondition() 返回空条件")
+			}
+		})
+	}
+}
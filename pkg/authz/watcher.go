@@ -0,0 +1,262 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultWatcherChannel 是默认的策略变更广播频道.
+const DefaultWatcherChannel = "casbin:policy"
+
+// 定义策略变更消息的类型.
+const (
+	updateMethodReload         = "Reload"
+	updateMethodAddPolicies    = "AddPolicies"
+	updateMethodRemovePolicies = "RemovePolicies"
+	updateMethodRemoveFiltered = "RemoveFilteredPolicy"
+	updateMethodUpdatePolicies = "UpdatePolicies"
+)
+
+// 确保 Watcher 实现了 casbin 的增量 Watcher 接口.
+var (
+	_ persist.WatcherEx        = (*Watcher)(nil)
+	_ persist.UpdatableWatcher = (*Watcher)(nil)
+)
+
+// policyUpdate 是通过 Redis 广播的策略变更消息.
+type policyUpdate struct {
+	// ID 是发送方 Watcher 的实例 ID，用于忽略自己发出的消息.
+	ID          string     `json:"id"`
+	Method      string     `json:"method"`
+	Sec         string     `json:"sec,omitempty"`
+	Ptype       string     `json:"ptype,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+	NewRules    [][]string `json:"newRules,omitempty"`
+	FieldIndex  int        `json:"fieldIndex,omitempty"`
+	FieldValues []string   `json:"fieldValues,omitempty"`
+}
+
+// Watcher 基于 Redis 发布订阅实现 casbin Watcher，在实例之间广播增量策略变更.
+type Watcher struct {
+	client  redis.UniversalClient
+	channel string
+	id      string
+	pubsub  *redis.PubSub
+	done    chan struct{}
+
+	mu       sync.RWMutex
+	callback func(string)
+}
+
+// NewWatcher 订阅 channel 并返回一个 Watcher，channel 为空时使用 DefaultWatcherChannel.
+// Watcher 不持有 client，Close 时不会关闭 client.
+func NewWatcher(ctx context.Context, client redis.UniversalClient, channel string) (*Watcher, error) {
+	if channel == "" {
+		channel = DefaultWatcherChannel
+	}
+
+	pubsub := client.Subscribe(ctx, channel)
+	// 等待订阅确认，确保返回后不会丢失消息
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe policy channel: %w", err)
+	}
+
+	w := &Watcher{
+		client:  client,
+		channel: channel,
+		id:      uuid.NewString(),
+		pubsub:  pubsub,
+		done:    make(chan struct{}),
+	}
+	go w.receive()
+
+	return w, nil
+}
+
+// receive 持续接收其他实例广播的策略变更并交给回调处理.
+func (w *Watcher) receive() {
+	defer close(w.done)
+
+	for msg := range w.pubsub.Channel() {
+		w.mu.RLock()
+		callback := w.callback
+		w.mu.RUnlock()
+
+		if callback != nil {
+			callback(msg.Payload)
+		}
+	}
+}
+
+// SetUpdateCallback 设置收到策略变更时调用的回调函数.
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.callback = callback
+	return nil
+}
+
+// Update 通知其他实例全量重新加载策略.
+func (w *Watcher) Update() error {
+	return w.publish(&policyUpdate{Method: updateMethodReload})
+}
+
+// UpdateForAddPolicy 广播新增的策略.
+func (w *Watcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForAddPolicies(sec, ptype, params)
+}
+
+// UpdateForRemovePolicy 广播删除的策略.
+func (w *Watcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForRemovePolicies(sec, ptype, params)
+}
+
+// UpdateForRemoveFilteredPolicy 广播按字段过滤删除的策略.
+func (w *Watcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.publish(&policyUpdate{
+		Method:      updateMethodRemoveFiltered,
+		Sec:         sec,
+		Ptype:       ptype,
+		FieldIndex:  fieldIndex,
+		FieldValues: fieldValues,
+	})
+}
+
+// UpdateForSavePolicy 在全量保存策略后通知其他实例全量重新加载策略.
+func (w *Watcher) UpdateForSavePolicy(model.Model) error {
+	return w.Update()
+}
+
+// UpdateForAddPolicies 广播批量新增的策略.
+func (w *Watcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&policyUpdate{Method: updateMethodAddPolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+// UpdateForRemovePolicies 广播批量删除的策略.
+func (w *Watcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&policyUpdate{Method: updateMethodRemovePolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+// UpdateForUpdatePolicy 广播修改的策略.
+func (w *Watcher) UpdateForUpdatePolicy(sec string, ptype string, oldRule, newRule []string) error {
+	return w.UpdateForUpdatePolicies(sec, ptype, [][]string{oldRule}, [][]string{newRule})
+}
+
+// UpdateForUpdatePolicies 广播批量修改的策略.
+func (w *Watcher) UpdateForUpdatePolicies(sec string, ptype string, oldRules, newRules [][]string) error {
+	return w.publish(&policyUpdate{
+		Method:   updateMethodUpdatePolicies,
+		Sec:      sec,
+		Ptype:    ptype,
+		Rules:    oldRules,
+		NewRules: newRules,
+	})
+}
+
+// Close 取消订阅并等待接收协程退出，之后不会再调用回调函数.
+func (w *Watcher) Close() {
+	_ = w.pubsub.Close()
+	<-w.done
+}
+
+// publish 将策略变更消息发布到频道.
+func (w *Watcher) publish(update *policyUpdate) error {
+	update.ID = w.id
+
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	return w.client.Publish(context.Background(), w.channel, data).Err()
+}
+
+// applyUpdate 在本地策略上应用其他实例广播的变更，无法增量应用时回退为全量加载.
+func (a *Authz) applyUpdate(id string, payload string) {
+	var update policyUpdate
+	if err := json.Unmarshal([]byte(payload), &update); err != nil {
+		slog.Warn("Failed to decode policy update, reloading policy", "error", err)
+		a.reloadPolicy()
+		return
+	}
+
+	// 本实例的变更已经在本地生效
+	if update.ID == id {
+		return
+	}
+
+	if update.Method == updateMethodReload {
+		a.reloadPolicy()
+		return
+	}
+
+	if err := a.applyIncremental(&update); err != nil {
+		slog.Warn("Failed to apply policy update, reloading policy", "method", update.Method, "error", err)
+		a.reloadPolicy()
+	}
+}
+
+// applyIncremental 持有授权器写锁，直接修改内存中的策略，不写回数据库也不再次广播.
+func (a *Authz) applyIncremental(update *policyUpdate) error {
+	lock := a.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	e := a.SyncedEnforcer.Enforcer
+	m := e.GetModel()
+
+	var (
+		op       model.PolicyOp
+		affected [][]string
+		err      error
+	)
+	switch update.Method {
+	case updateMethodAddPolicies:
+		op = model.PolicyAdd
+		affected, err = m.AddPoliciesWithAffected(update.Sec, update.Ptype, update.Rules)
+	case updateMethodRemovePolicies:
+		op = model.PolicyRemove
+		affected, err = m.RemovePoliciesWithAffected(update.Sec, update.Ptype, update.Rules)
+	case updateMethodRemoveFiltered:
+		op = model.PolicyRemove
+		_, affected, err = m.RemoveFilteredPolicy(update.Sec, update.Ptype, update.FieldIndex, update.FieldValues...)
+	case updateMethodUpdatePolicies:
+		if _, err = m.UpdatePolicies(update.Sec, update.Ptype, update.Rules, update.NewRules); err != nil {
+			return err
+		}
+		if update.Sec != "g" {
+			return nil
+		}
+		if err = e.BuildIncrementalRoleLinks(model.PolicyRemove, update.Ptype, update.Rules); err != nil {
+			return err
+		}
+		return e.BuildIncrementalRoleLinks(model.PolicyAdd, update.Ptype, update.NewRules)
+	default:
+		return fmt.Errorf("unknown policy update method %q", update.Method)
+	}
+	if err != nil {
+		return err
+	}
+
+	if update.Sec == "g" && len(affected) > 0 {
+		return e.BuildIncrementalRoleLinks(op, update.Ptype, affected)
+	}
+	return nil
+}
+
+// reloadPolicy 从数据库全量加载策略.
+func (a *Authz) reloadPolicy() {
+	if err := a.LoadPolicy(); err != nil {
+		slog.Error("Failed to reload policy", "error", err)
+	}
+}
@@ -0,0 +1,80 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	casbin "github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWatchedAuthz 创建一个不依赖数据库、通过 Redis 同步策略的授权器.
+func newWatchedAuthz(t *testing.T, addr string) *Authz {
+	t.Helper()
+
+	m, err := model.NewModelFromString(defaultAclModel)
+	require.NoError(t, err)
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	require.NoError(t, err)
+	enforcer.AddFunction(ConditionFuncName, conditionMatch)
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { _ = client.Close() })
+
+	watcher, err := NewWatcher(context.Background(), client, "")
+	require.NoError(t, err)
+
+	a := &Authz{SyncedEnforcer: enforcer}
+	require.NoError(t, a.setWatcher(watcher))
+	t.Cleanup(a.Close)
+
+	return a
+}
+
+// TestWatcher_IncrementalUpdates 测试策略变更在实例之间增量同步。
+func TestWatcher_IncrementalUpdates(t *testing.T) {
+	mr := miniredis.RunT(t)
+	a := newWatchedAuthz(t, mr.Addr())
+	b := newWatchedAuthz(t, mr.Addr())
+
+	explicitlyAllowed := func(sub string) func() bool {
+		return func() bool {
+			ok, err := b.ExplicitlyAllowed(sub, "/v1/users", "GET")
+			return err == nil && ok
+		}
+	}
+
+	_, err := a.AddPolicy("role::viewer", "/v1/users", "GET", "allow")
+	require.NoError(t, err)
+	_, err = a.AddGroupingPolicy("alice", "role::viewer")
+	require.NoError(t, err)
+	assert.Eventually(t, explicitlyAllowed("alice"), time.Second, 10*time.Millisecond, "新增策略未同步")
+
+	_, err = a.AddGroupingPolicies([][]string{{"bob", "role::viewer"}, {"carol", "role::viewer"}})
+	require.NoError(t, err)
+	assert.Eventually(t, explicitlyAllowed("carol"), time.Second, 10*time.Millisecond, "批量新增策略未同步")
+
+	_, err = a.RemoveGroupingPolicy("alice", "role::viewer")
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return !explicitlyAllowed("alice")() }, time.Second, 10*time.Millisecond, "删除策略未同步")
+
+	_, err = a.AddConditionalPolicy("role::ops", "/v1/admin/*", "POST", &Condition{CIDRs: []string{"10.0.0.0/8"}})
+	require.NoError(t, err)
+	_, err = a.RemoveFilteredPolicy(0, "role::viewer")
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return !explicitlyAllowed("bob")() }, time.Second, 10*time.Millisecond, "过滤删除策略未同步")
+	assert.Eventually(t, func() bool {
+		policies, err := b.GetNamedPolicy(conditionalPolicyType)
+		return err == nil && len(policies) == 1
+	}, time.Second, 10*time.Millisecond, "带条件策略未同步")
+
+	// 本实例的变更不会被重复应用
+	policies, err := a.GetGroupingPolicy()
+	require.NoError(t, err)
+	assert.Len(t, policies, 2)
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// 支持的数据库驱动名称，与 gorm Dialector 的 Name() 一致.
+const (
+	DriverPostgres = "postgres"
+	DriverMySQL    = "mysql"
+	DriverSQLite   = "sqlite"
+)
+
+// Dialect 描述不同数据库在 SQL 语法和能力上的差异，供 store 层拼接不可移植的 SQL 片段.
+type Dialect interface {
+	// Name 返回驱动名称
+	Name() string
+	// NullsLast 返回按 column 升序排列并将 NULL 排在最后的排序表达式
+	NullsLast(column string) string
+	// SupportsReturning 返回是否支持 INSERT/UPDATE/DELETE ... RETURNING
+	SupportsReturning() bool
+	// SingleWriter 返回数据库是否同一时间只允许一个连接写入
+	SingleWriter() bool
+}
+
+// DialectOf 返回 db 使用的数据库方言. 未知的驱动按标准 SQL 处理.
+func DialectOf(db *gorm.DB) Dialect {
+	switch name := db.Dialector.Name(); name {
+	case DriverPostgres:
+		return postgresDialect{}
+	case DriverSQLite:
+		return sqliteDialect{}
+	default:
+		return standardDialect{name: name}
+	}
+}
+
+// postgresDialect 是 PostgreSQL 方言.
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string { return DriverPostgres }
+
+func (postgresDialect) NullsLast(column string) string { return column + " ASC NULLS LAST" }
+
+func (postgresDialect) SupportsReturning() bool { return true }
+
+func (postgresDialect) SingleWriter() bool { return false }
+
+// sqliteDialect 是 SQLite 方言，3.30 起支持 NULLS LAST，3.35 起支持 RETURNING.
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string { return DriverSQLite }
+
+func (sqliteDialect) NullsLast(column string) string { return column + " ASC NULLS LAST" }
+
+func (sqliteDialect) SupportsReturning() bool { return true }
+
+// SingleWriter 数据库级别的写锁使得一个连接开启写事务后，其他连接的写入只能等待其提交.
+func (sqliteDialect) SingleWriter() bool { return true }
+
+// standardDialect 用于 MySQL 等不支持 NULLS LAST 和 RETURNING 的数据库.
+type standardDialect struct {
+	name string
+}
+
+func (d standardDialect) Name() string { return d.name }
+
+// NullsLast 先按是否为 NULL 排序，非 NULL 值（表达式为 0）排在前面.
+func (standardDialect) NullsLast(column string) string {
+	return fmt.Sprintf("%[1]s IS NULL, %[1]s ASC", column)
+}
+
+func (standardDialect) SupportsReturning() bool { return false }
+
+func (standardDialect) SingleWriter() bool { return false }
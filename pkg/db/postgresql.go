@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -19,13 +20,27 @@ type PostgreSQLOptions struct {
 	MaxIdleConnections    int
 	MaxOpenConnections    int
 	MaxConnectionLifeTime time.Duration
+	// Replicas 是只读副本地址，用户名、密码、数据库名和连接池配置与主库相同
+	// +optional
+	Replicas []string
+	// ReplicaMaxLag 是副本允许的最大复制延迟，超过后暂时不再接收读请求
+	// +optional
+	ReplicaMaxLag time.Duration
+	// ReplicaHealthCheckInterval 是副本健康检查的间隔
+	// +optional
+	ReplicaHealthCheckInterval time.Duration
 	// +optional
 	Logger logger.Interface
 }
 
 // DSN 从 PostgreSQLOptions 返回数据源名称(DSN).
 func (o *PostgreSQLOptions) DSN() string {
-	splited := strings.Split(o.Addr, ":")
+	return o.dsn(o.Addr)
+}
+
+// dsn 返回连接 addr 的数据源名称.
+func (o *PostgreSQLOptions) dsn(addr string) string {
+	splited := strings.Split(addr, ":")
 	host, port := splited[0], "5432"
 	if len(splited) > 1 {
 		port = splited[1]
@@ -45,7 +60,38 @@ func NewPostgreSQL(opts *PostgreSQLOptions) (*gorm.DB, error) {
 	// 设置默认值以确保 opts 中的所有字段都可用.
 	setPostgreSQLDefaults(opts)
 
-	db, err := gorm.Open(postgres.Open(opts.DSN()), &gorm.Config{
+	db, err := openPostgreSQL(opts, opts.Addr)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Replicas) == 0 {
+		return db, nil
+	}
+
+	// 连接只读副本，读请求由 ReplicaSet 路由到健康的副本
+	dbs := make([]*sql.DB, 0, len(opts.Replicas))
+	for _, addr := range opts.Replicas {
+		replica, err := openPostgreSQL(opts, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open replica %s: %w", addr, err)
+		}
+		sqlDB, err := replica.DB()
+		if err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, sqlDB)
+	}
+
+	replicas := newReplicaSet(opts.Replicas, dbs, opts.ReplicaMaxLag, opts.ReplicaHealthCheckInterval)
+	if err := db.Use(replicas); err != nil {
+		return nil, fmt.Errorf("failed to use replicas: %w", err)
+	}
+	return db, nil
+}
+
+// openPostgreSQL 连接 addr 上的 PostgreSQL 并设置连接池.
+func openPostgreSQL(opts *PostgreSQLOptions, addr string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(opts.dsn(addr)), &gorm.Config{
 		// PrepareStmt 在缓存语句中执行给定的查询.
 		// 这可以提高性能.
 		PrepareStmt: true,
@@ -86,6 +132,12 @@ func setPostgreSQLDefaults(opts *PostgreSQLOptions) {
 	if opts.MaxConnectionLifeTime == 0 {
 		opts.MaxConnectionLifeTime = time.Duration(10) * time.Second
 	}
+	if opts.ReplicaMaxLag == 0 {
+		opts.ReplicaMaxLag = 5 * time.Second
+	}
+	if opts.ReplicaHealthCheckInterval == 0 {
+		opts.ReplicaHealthCheckInterval = 5 * time.Second
+	}
 	if opts.Logger == nil {
 		opts.Logger = logger.Default
 	}
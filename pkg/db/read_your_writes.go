@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// ReadYourWritesName 是 ReadYourWrites 插件的名称.
+const ReadYourWritesName = "db:read_your_writes"
+
+// ReadYourWrites 是一个 gorm 插件，调用方写入后的 window 时间内，其读请求也路由到主库，
+// 避免因副本复制延迟读不到刚写入的数据. 需要在 ReplicaSet 之后注册.
+type ReadYourWrites struct {
+	window time.Duration
+	// key 从请求上下文中识别调用方，返回空字符串时不做粘滞
+	key func(ctx context.Context) string
+
+	mu     sync.Mutex
+	writes map[string]time.Time
+}
+
+var _ gorm.Plugin = (*ReadYourWrites)(nil)
+
+// NewReadYourWrites 创建 ReadYourWrites 插件，window 应不小于副本允许的最大复制延迟.
+func NewReadYourWrites(window time.Duration, key func(ctx context.Context) string) *ReadYourWrites {
+	return &ReadYourWrites{
+		window: window,
+		key:    key,
+		writes: make(map[string]time.Time),
+	}
+}
+
+// Name 返回插件名称.
+func (p *ReadYourWrites) Name() string {
+	return ReadYourWritesName
+}
+
+// Initialize 注册记录写入和将读请求切换到主库的回调.
+func (p *ReadYourWrites) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	if err := cb.Create().After("gorm:create").Register(ReadYourWritesName, p.markWrite); err != nil {
+		return err
+	}
+	if err := cb.Update().After("gorm:update").Register(ReadYourWritesName, p.markWrite); err != nil {
+		return err
+	}
+	if err := cb.Delete().After("gorm:delete").Register(ReadYourWritesName, p.markWrite); err != nil {
+		return err
+	}
+	if err := cb.Raw().After("gorm:raw").Register(ReadYourWritesName, p.markWrite); err != nil {
+		return err
+	}
+
+	// 在 dbresolver 选择副本之后、执行查询之前改为使用主库
+	if err := cb.Query().After("gorm:db_resolver").Before("gorm:query").Register(ReadYourWritesName, p.stick); err != nil {
+		return err
+	}
+	return cb.Row().After("gorm:db_resolver").Before("gorm:row").Register(ReadYourWritesName, p.stick)
+}
+
+// Sticky 返回 ctx 对应的调用方是否仍处于写入后的粘滞窗口内.
+func (p *ReadYourWrites) Sticky(ctx context.Context) bool {
+	key := p.key(ctx)
+	if key == "" {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	at, ok := p.writes[key]
+	if ok && time.Since(at) >= p.window {
+		delete(p.writes, key)
+		return false
+	}
+	return ok
+}
+
+// markWrite 记录调用方的写入时间.
+func (p *ReadYourWrites) markWrite(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Context == nil {
+		return
+	}
+	key := p.key(db.Statement.Context)
+	if key == "" {
+		return
+	}
+
+	now := time.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// 顺带清理过期的记录，避免调用方很多时占用过多内存
+	if len(p.writes) >= 1024 {
+		for k, at := range p.writes {
+			if now.Sub(at) >= p.window {
+				delete(p.writes, k)
+			}
+		}
+	}
+	p.writes[key] = now
+}
+
+// stick 在粘滞窗口内将读请求切换到主库. 事务内的查询已经在主库上执行，不需要处理.
+func (p *ReadYourWrites) stick(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Context == nil {
+		return
+	}
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return
+	}
+	if p.Sticky(db.Statement.Context) {
+		dbresolver.Write.ModifyStatement(db.Statement)
+	}
+}
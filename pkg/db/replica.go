@@ -0,0 +1,181 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// replicaLagQuery 查询 PostgreSQL 副本的复制延迟（秒）.
+// 已回放完收到的全部 WAL 时认为没有延迟，避免主库空闲时 pg_last_xact_replay_timestamp 变旧导致副本被误剔除.
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// ReplicaSet 是一个 gorm 插件，将读请求路由到健康的 PostgreSQL 只读副本，写请求和事务使用主库.
+// 复制延迟超过 MaxLag 或无法连接的副本会被暂时剔除，直到健康检查通过后重新加入；
+// 没有健康的副本时读请求回退到主库.
+type ReplicaSet struct {
+	replicas []*replica
+	// byPool 通过连接池找到对应的副本，构建后只读
+	byPool   map[gorm.ConnPool]*replica
+	primary  gorm.ConnPool
+	maxLag   time.Duration
+	interval time.Duration
+	next     atomic.Uint64
+}
+
+// replica 是一个只读副本及其健康状态.
+type replica struct {
+	addr    string
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// 确保 ReplicaSet 同时实现了 gorm 插件和 dbresolver 的路由策略.
+var (
+	_ gorm.Plugin       = (*ReplicaSet)(nil)
+	_ dbresolver.Policy = (*ReplicaSet)(nil)
+)
+
+// ReplicaSetName 是 ReplicaSet 插件的名称.
+const ReplicaSetName = "db:replica_set"
+
+// newReplicaSet 使用已连接的副本创建 ReplicaSet，addrs 和 dbs 一一对应.
+func newReplicaSet(addrs []string, dbs []*sql.DB, maxLag, interval time.Duration) *ReplicaSet {
+	s := &ReplicaSet{
+		byPool:   make(map[gorm.ConnPool]*replica, len(dbs)),
+		maxLag:   maxLag,
+		interval: interval,
+	}
+	for i, db := range dbs {
+		r := &replica{addr: addrs[i], db: db}
+		// 初始视为健康，首次检查失败时会记录剔除日志
+		r.healthy.Store(true)
+		s.replicas = append(s.replicas, r)
+		s.byPool[db] = r
+	}
+	return s
+}
+
+// Name 返回插件名称.
+func (s *ReplicaSet) Name() string {
+	return ReplicaSetName
+}
+
+// Initialize 注册 dbresolver 插件，并在返回前完成一次健康检查，使启动时不可用的副本不会接收读请求.
+func (s *ReplicaSet) Initialize(db *gorm.DB) error {
+	primary, err := db.DB()
+	if err != nil {
+		return err
+	}
+	s.primary = primary
+
+	dialectors := make([]gorm.Dialector, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		// 复用已建立的连接池，Resolve 才能通过连接池找到对应的副本
+		dialectors = append(dialectors, postgres.New(postgres.Config{Conn: r.db}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.interval)
+	defer cancel()
+	s.check(ctx)
+
+	return db.Use(dbresolver.Register(dbresolver.Config{
+		Replicas: dialectors,
+		Policy:   s,
+	}))
+}
+
+// Resolve 在健康的副本之间轮询选择连接池，没有健康的副本时返回主库.
+func (s *ReplicaSet) Resolve(pools []gorm.ConnPool) gorm.ConnPool {
+	healthy := make([]gorm.ConnPool, 0, len(pools))
+	for _, pool := range pools {
+		if r, ok := s.byPool[pool]; !ok || r.healthy.Load() {
+			healthy = append(healthy, pool)
+		}
+	}
+	if len(healthy) == 0 {
+		return s.primary
+	}
+	return healthy[s.next.Add(1)%uint64(len(healthy))]
+}
+
+// Run 按 interval 周期性检查副本的健康状态，直到 ctx 被取消.
+func (s *ReplicaSet) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, s.interval)
+			s.check(checkCtx)
+			cancel()
+		}
+	}
+}
+
+// Healthy 返回当前健康的副本地址.
+func (s *ReplicaSet) Healthy() []string {
+	var addrs []string
+	for _, r := range s.replicas {
+		if r.healthy.Load() {
+			addrs = append(addrs, r.addr)
+		}
+	}
+	return addrs
+}
+
+// check 检查所有副本的连接和复制延迟，状态变化时记录日志.
+func (s *ReplicaSet) check(ctx context.Context) {
+	for _, r := range s.replicas {
+		lag, err := r.lag(ctx)
+		healthy := err == nil && lag <= s.maxLag
+		if r.healthy.Swap(healthy) == healthy {
+			continue
+		}
+
+		if healthy {
+			slog.InfoContext(ctx, "Replica is healthy, routing reads to it", "addr", r.addr, "lag", lag)
+			continue
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "Replica is unreachable, ejecting it", "addr", r.addr, "error", err)
+		} else {
+			slog.WarnContext(ctx, "Replica is lagging behind, ejecting it", "addr", r.addr, "lag", lag, "maxLag", s.maxLag)
+		}
+	}
+}
+
+// lag 返回副本的复制延迟.
+func (r *replica) lag(ctx context.Context) (time.Duration, error) {
+	var seconds float64
+	if err := r.db.QueryRowContext(ctx, replicaLagQuery).Scan(&seconds); err != nil {
+		return 0, fmt.Errorf("failed to query replication lag: %w", err)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// ReplicasOf 返回 db 上注册的 ReplicaSet，未配置只读副本时返回 nil.
+func ReplicasOf(db *gorm.DB) *ReplicaSet {
+	if plugin, ok := db.Config.Plugins[ReplicaSetName]; ok {
+		return plugin.(*ReplicaSet)
+	}
+	return nil
+}
+
+// Primary 返回强制使用主库的会话，用于不能容忍复制延迟的读取，例如加载授权策略.
+func Primary(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Write).Session(&gorm.Session{})
+}
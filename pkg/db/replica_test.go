@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+)
+
+type callerKey struct{}
+
+type item struct {
+	ID   uint
+	Name string
+}
+
+// withCaller 返回携带调用方标识的 context.
+func withCaller(caller string) context.Context {
+	return context.WithValue(context.Background(), callerKey{}, caller)
+}
+
+func caller(ctx context.Context) string {
+	s, _ := ctx.Value(callerKey{}).(string)
+	return s
+}
+
+// openSQLite 打开一个包含 item 表的 SQLite 数据库.
+func openSQLite(t *testing.T, path string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{PrepareStmt: true, Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&item{}))
+	return db
+}
+
+func TestReplicaSetResolve(t *testing.T) {
+	dbs := make([]*sql.DB, 2)
+	for i := range dbs {
+		db, err := sql.Open("sqlite", ":memory:")
+		require.NoError(t, err)
+		dbs[i] = db
+	}
+	primary, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	s := newReplicaSet([]string{"replica-0", "replica-1"}, dbs, time.Second, time.Second)
+	s.primary = primary
+	pools := []gorm.ConnPool{dbs[0], dbs[1]}
+
+	seen := map[gorm.ConnPool]bool{}
+	for range 4 {
+		seen[s.Resolve(pools)] = true
+	}
+	assert.Len(t, seen, 2, "reads should be spread over all healthy replicas")
+
+	s.replicas[0].healthy.Store(false)
+	for range 4 {
+		assert.Equal(t, gorm.ConnPool(dbs[1]), s.Resolve(pools))
+	}
+	assert.Equal(t, []string{"replica-1"}, s.Healthy())
+
+	s.replicas[1].healthy.Store(false)
+	assert.Equal(t, gorm.ConnPool(primary), s.Resolve(pools), "reads should fall back to the primary")
+}
+
+func TestReadYourWrites(t *testing.T) {
+	dir := t.TempDir()
+	db := openSQLite(t, filepath.Join(dir, "primary.db"))
+	// 副本中有同名的表但没有数据，查询结果可以区分读的是主库还是副本
+	openSQLite(t, filepath.Join(dir, "replica.db"))
+
+	require.NoError(t, db.Use(dbresolver.Register(dbresolver.Config{
+		Replicas: []gorm.Dialector{sqlite.Open(filepath.Join(dir, "replica.db"))},
+	})))
+	rw := NewReadYourWrites(200*time.Millisecond, caller)
+	require.NoError(t, db.Use(rw))
+
+	alice, bob := withCaller("alice"), withCaller("bob")
+	assert.False(t, rw.Sticky(alice))
+
+	require.NoError(t, db.WithContext(alice).Create(&item{Name: "a"}).Error)
+	assert.True(t, rw.Sticky(alice))
+	assert.False(t, rw.Sticky(bob))
+
+	count := func(ctx context.Context) int64 {
+		var n int64
+		require.NoError(t, db.WithContext(ctx).Model(&item{}).Count(&n).Error)
+		return n
+	}
+	assert.Equal(t, int64(1), count(alice), "the writer should read from the primary")
+	assert.Equal(t, int64(0), count(bob), "other callers should read from the replica")
+	assert.Equal(t, int64(0), count(withCaller("")), "anonymous callers should not be sticky")
+
+	// 事务内的查询始终使用主库
+	require.NoError(t, db.WithContext(bob).Transaction(func(tx *gorm.DB) error {
+		var n int64
+		require.NoError(t, tx.Model(&item{}).Count(&n).Error)
+		assert.Equal(t, int64(1), n)
+		return nil
+	}))
+
+	// Primary 强制读主库
+	var n int64
+	require.NoError(t, Primary(db).WithContext(bob).Model(&item{}).Count(&n).Error)
+	assert.Equal(t, int64(1), n)
+
+	time.Sleep(250 * time.Millisecond)
+	assert.False(t, rw.Sticky(alice), "stickiness should expire after the window")
+	assert.Equal(t, int64(0), count(alice))
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SQLiteOptions 定义 SQLite 数据库的配置选项.
+type SQLiteOptions struct {
+	// Path 是数据库文件路径，":memory:" 表示使用内存数据库
+	Path string
+	// +optional
+	Logger logger.Interface
+}
+
+// DSN 从 SQLiteOptions 返回数据源名称(DSN).
+// 开启外键约束，并设置忙等待超时以减少并发写入时的 "database is locked" 错误.
+func (o *SQLiteOptions) DSN() string {
+	pragmas := []string{"_pragma=foreign_keys(1)", "_pragma=busy_timeout(5000)"}
+	if o.Path != ":memory:" {
+		pragmas = append(pragmas, "_pragma=journal_mode(WAL)")
+	}
+	return fmt.Sprintf("%s?%s", o.Path, strings.Join(pragmas, "&"))
+}
+
+// NewSQLite 使用给定的选项创建一个新的 gorm 数据库实例.
+// 使用纯 Go 实现的驱动，不依赖 cgo.
+func NewSQLite(opts *SQLiteOptions) (*gorm.DB, error) {
+	// 设置默认值以确保 opts 中的所有字段都可用.
+	setSQLiteDefaults(opts)
+
+	if opts.Path != ":memory:" {
+		if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create sqlite directory: %w", err)
+		}
+	}
+
+	db, err := gorm.Open(sqlite.Open(opts.DSN()), &gorm.Config{
+		PrepareStmt: true,
+		Logger:      opts.Logger,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+
+	// 内存数据库的每个连接都是相互独立的库，因此只使用一个连接.
+	if opts.Path == ":memory:" {
+		sqlDB.SetMaxOpenConns(1)
+	}
+
+	return db, nil
+}
+
+// setSQLiteDefaults 为某些字段设置可用的默认值.
+func setSQLiteDefaults(opts *SQLiteOptions) {
+	if opts.Path == "" {
+		opts.Path = ":memory:"
+	}
+	if opts.Logger == nil {
+		opts.Logger = logger.Default
+	}
+}
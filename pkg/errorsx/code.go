@@ -28,6 +28,18 @@ var (
 	// ErrPermissionDenied 表示请求没有权限
 	ErrPermissionDenied = NewBizError(CodeUserPermissionDenied, "PermissionDenied", "Permission denied. Access to the requested resource is forbidden.")
 
+	// ErrConflict 表示资源在读取之后已被其他请求修改
+	ErrConflict = NewBizError(CodeConflict, "Conflict", "The resource has been modified by another request.")
+
+	// ErrAlreadyExists 表示资源已存在（违反唯一约束）
+	ErrAlreadyExists = NewBizError(CodeAlreadyExists, "AlreadyExists", "Resource already exists.")
+
+	// ErrFailedPrecondition 表示操作的前置条件不成立，例如引用的资源不存在或资源仍被引用
+	ErrFailedPrecondition = NewBizError(CodeFailedPrecondition, "FailedPrecondition", "The operation was rejected because the system is not in a state required for its execution.")
+
+	// ErrAborted 表示事务因并发冲突被数据库中止，可以重试
+	ErrAborted = NewBizError(CodeAborted, "Aborted", "The operation was aborted due to a concurrency conflict. Please retry.")
+
 	// ErrOperationFailed 表示操作失败
 	ErrOperationFailed = NewBizError(CodeInternalServer, "OperationFailed", "The requested operation has failed. Please try again later.")
 )
@@ -46,6 +46,10 @@ const (
 	CodeUserInvalidUsername     BizCode = 20105 // 用户名无效
 	CodeUserInvalidPassword     BizCode = 20106 // 密码无效
 	CodeUserPermissionDenied    BizCode = 20107 // 用户权限不足
+	CodeConflict                BizCode = 20108 // 资源已被其他请求修改（并发修改冲突）
+	CodeFailedPrecondition      BizCode = 20109 // 操作的前置条件不成立（如引用的记录不存在或仍被引用）
+	CodeAborted                 BizCode = 20110 // 事务因并发冲突被数据库中止，可以重试
+	CodeAlreadyExists           BizCode = 20111 // 资源已存在（违反唯一约束）
 
 	CodePostNotFound         BizCode = 30101 // 文章不存在 (Level=3, Module=01, Error=01)
 	CodePostAlreadyPublished BizCode = 30102 // 文章已发布
@@ -100,6 +104,8 @@ type APIResponse struct {
 	Message string      `json:"message"`
 	Data    interface{} `json:"data,omitempty"`
 	Reason  string      `json:"reason,omitempty"`
+	// Metadata 是错误的元数据，例如唯一约束冲突的字段
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // ResponseDetail 响应详情（用于替代 ErrorDetail）
@@ -139,6 +145,10 @@ func GetHTTPCode(code BizCode) int {
 	if code == CodeOK {
 		return http.StatusOK
 	}
+	// 并发修改冲突和被中止的事务返回 409，客户端可据此重新读取资源后重试
+	if code == CodeConflict || code == CodeAborted {
+		return http.StatusConflict
+	}
 
 	level := GetErrorLevel(code)
 	switch level {
@@ -316,6 +326,7 @@ func (resp *APIResponse) WithReason(reason string) *APIResponse {
 func FromBizError(err *BizError) *APIResponse {
 	resp := Failure(int(err.Code), err.Message)
 	resp.Reason = err.Reason
+	resp.Metadata = err.Metadata
 	return resp
 }
 
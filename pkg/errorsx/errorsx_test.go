@@ -120,6 +120,8 @@ func TestGetHTTPCode(t *testing.T) {
 		{CodeOK, http.StatusOK},
 		{CodeUserNotFound, http.StatusOK},
 		{CodePostPermissionDenied, http.StatusOK},
+		{CodeConflict, http.StatusConflict},
+		{CodeAborted, http.StatusConflict},
 		{CodeInternalServer, http.StatusInternalServerError},
 		{CodeDatabaseConnectFailed, http.StatusInternalServerError},
 	}
@@ -158,6 +160,7 @@ func TestFromBizError(t *testing.T) {
 	assert.Equal(t, "用户不存在", resp.Message)
 	assert.Nil(t, resp.Data)
 	assert.Equal(t, "User.NotFound", resp.Reason)
+	assert.Equal(t, map[string]interface{}{"user_id": 12345}, resp.Metadata)
 }
 
 func TestFromError(t *testing.T) {
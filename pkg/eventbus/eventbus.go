@@ -0,0 +1,326 @@
+// Package eventbus 提供进程内的类型化事件总线.
+//
+// 订阅者按事件的 Go 类型订阅，发布者在业务变更提交后发布事件. 同步订阅者在 Publish 中依次执行，
+// 其错误会返回给发布者；异步订阅者各自拥有一个队列和一个工作协程，按发布顺序处理事件，错误只记录日志.
+// 使用 WithRetry 的订阅者失败后会在处理同一事件时重试.
+// 订阅者的 panic 会被恢复并作为错误处理，不会影响发布者和其他订阅者.
+//
+// 使用 SubscribeAll 可以订阅全部事件，用于将事件桥接到 Kafka 等外部传输.
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultQueueSize 是异步订阅者默认的队列长度.
+const DefaultQueueSize = 1024
+
+// 订阅者处理事件的结果，用于指标.
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+	resultPanic   = "panic"
+	resultDropped = "dropped"
+)
+
+// ErrClosed 表示事件总线已关闭.
+var ErrClosed = errors.New("eventbus: bus is closed")
+
+// Event 是在事件总线上发布的事件.
+type Event interface {
+	// EventType 返回事件类型，用于日志和指标.
+	EventType() string
+}
+
+// Handler 处理类型为 E 的事件.
+type Handler[E Event] func(ctx context.Context, event E) error
+
+// Subscriber 在事件总线上注册一组处理函数，便于通过依赖注入集中注册订阅者.
+type Subscriber interface {
+	Subscribe(bus *Bus)
+}
+
+// SubscribeOption 定义订阅选项.
+type SubscribeOption func(*subscriber)
+
+// Async 将订阅者设置为异步执行. Publish 只将事件放入订阅者的队列，
+// 队列已满时阻塞，直到有空位或发布者的 ctx 被取消.
+func Async() SubscribeOption {
+	return func(s *subscriber) {
+		s.async = true
+	}
+}
+
+// WithQueueSize 设置异步订阅者的队列长度，默认为 DefaultQueueSize.
+func WithQueueSize(size int) SubscribeOption {
+	return func(s *subscriber) {
+		if size > 0 {
+			s.queueSize = size
+		}
+	}
+}
+
+// WithRetry 设置订阅者处理失败后的重试次数和重试间隔，订阅者需要能够重复处理同一事件.
+// 同步订阅者的重试在 Publish 中执行，发布者的 ctx 被取消时停止重试.
+func WithRetry(retries int, interval time.Duration) SubscribeOption {
+	return func(s *subscriber) {
+		s.retries = retries
+		s.retryInterval = interval
+	}
+}
+
+// subscriber 是一个已注册的订阅者.
+type subscriber struct {
+	name          string
+	handle        func(ctx context.Context, event Event) error
+	async         bool
+	queueSize     int
+	queue         chan delivery
+	retries       int
+	retryInterval time.Duration
+}
+
+// delivery 是异步订阅者队列中的一个事件.
+type delivery struct {
+	ctx   context.Context
+	event Event
+}
+
+// Bus 是进程内的事件总线，可以被多个协程并发使用.
+type Bus struct {
+	mu sync.RWMutex
+	// typed 是按事件类型注册的订阅者
+	typed map[reflect.Type][]*subscriber
+	// all 是订阅全部事件的订阅者
+	all    []*subscriber
+	closed bool
+	wg     sync.WaitGroup
+
+	published metric.Int64Counter
+	handled   metric.Int64Counter
+	duration  metric.Float64Histogram
+}
+
+// New 创建事件总线.
+func New() (*Bus, error) {
+	meter := otel.Meter("github.com/clin211/gin-enterprise-template/pkg/eventbus")
+	published, err := meter.Int64Counter(
+		"eventbus_events_published_total",
+		metric.WithDescription("Total number of events published by event type"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	handled, err := meter.Int64Counter(
+		"eventbus_events_handled_total",
+		metric.WithDescription("Total number of events handled by event type, subscriber and result"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram(
+		"eventbus_handler_duration_seconds",
+		metric.WithDescription("Duration of handling an event by event type and subscriber"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bus{
+		typed:     make(map[reflect.Type][]*subscriber),
+		published: published,
+		handled:   handled,
+		duration:  duration,
+	}, nil
+}
+
+// Register 注册订阅者.
+func (b *Bus) Register(subscribers ...Subscriber) {
+	for _, s := range subscribers {
+		s.Subscribe(b)
+	}
+}
+
+// Subscribe 订阅类型为 E 的事件. 事件按 Go 类型精确匹配，发布和订阅时应使用相同的类型（通常为指针类型）.
+// name 用于日志和指标，同一订阅者订阅多种事件时可以使用相同的名称.
+func Subscribe[E Event](b *Bus, name string, handler Handler[E], opts ...SubscribeOption) {
+	s := newSubscriber(name, func(ctx context.Context, event Event) error {
+		return handler(ctx, event.(E))
+	}, opts...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	typ := reflect.TypeFor[E]()
+	b.typed[typ] = append(b.typed[typ], s)
+	b.start(s)
+}
+
+// SubscribeAll 订阅全部事件，通常用于将事件桥接到外部传输.
+func SubscribeAll(b *Bus, name string, handler Handler[Event], opts ...SubscribeOption) {
+	s := newSubscriber(name, handler, opts...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, s)
+	b.start(s)
+}
+
+func newSubscriber(name string, handle func(ctx context.Context, event Event) error, opts ...SubscribeOption) *subscriber {
+	s := &subscriber{name: name, handle: handle, queueSize: DefaultQueueSize}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// start 为异步订阅者启动工作协程，调用方需持有写锁.
+func (b *Bus) start(s *subscriber) {
+	if !s.async {
+		return
+	}
+
+	s.queue = make(chan delivery, s.queueSize)
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		for d := range s.queue {
+			if err := b.deliver(d.ctx, s, d.event); err != nil {
+				slog.ErrorContext(d.ctx, "Failed to handle event", "subscriber", s.name, "event", d.event.EventType(), "error", err)
+			}
+		}
+	}()
+}
+
+// Publish 按顺序将事件分发给订阅者，返回同步订阅者的错误. 某个订阅者失败时，其他订阅者仍会收到事件.
+// 异步订阅者使用不会随 ctx 取消的上下文处理事件，ctx 中的值（例如当前用户）仍然可用.
+// b 为 nil 时不做任何事情.
+func (b *Bus) Publish(ctx context.Context, events ...Event) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+
+	var errs []error
+	for _, event := range events {
+		b.published.Add(ctx, 1, metric.WithAttributes(attribute.String("event", event.EventType())))
+
+		subscribers := slices.Concat(b.typed[reflect.TypeOf(event)], b.all)
+		for _, s := range subscribers {
+			if s.async {
+				b.enqueue(ctx, s, event)
+				continue
+			}
+			if err := b.deliver(ctx, s, event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// enqueue 将事件放入异步订阅者的队列，ctx 被取消时丢弃事件.
+func (b *Bus) enqueue(ctx context.Context, s *subscriber, event Event) {
+	select {
+	case s.queue <- delivery{ctx: context.WithoutCancel(ctx), event: event}:
+	case <-ctx.Done():
+		b.record(ctx, s, event, resultDropped, 0)
+		slog.ErrorContext(ctx, "Dropped event for full subscriber queue", "subscriber", s.name, "event", event.EventType(), "error", ctx.Err())
+	}
+}
+
+// deliver 调用订阅者处理事件，失败时按订阅者的设置重试，返回最后一次处理的错误.
+func (b *Bus) deliver(ctx context.Context, s *subscriber, event Event) error {
+	err := b.dispatch(ctx, s, event)
+	for attempt := 1; err != nil && attempt <= s.retries; attempt++ {
+		slog.WarnContext(ctx, "Retrying event", "subscriber", s.name, "event", event.EventType(), "attempt", attempt, "error", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(s.retryInterval):
+		}
+		err = b.dispatch(ctx, s, event)
+	}
+	return err
+}
+
+// dispatch 调用订阅者处理事件，并将 panic 转换为错误.
+func (b *Bus) dispatch(ctx context.Context, s *subscriber, event Event) (err error) {
+	start := time.Now()
+	defer func() {
+		result := resultSuccess
+		if r := recover(); r != nil {
+			result = resultPanic
+			err = fmt.Errorf("subscriber %s panicked handling %s: %v", s.name, event.EventType(), r)
+			slog.ErrorContext(ctx, "Subscriber panicked", "subscriber", s.name, "event", event.EventType(),
+				"panic", r, "stack", string(debug.Stack()))
+		} else if err != nil {
+			result = resultError
+		}
+		b.record(ctx, s, event, result, time.Since(start))
+	}()
+
+	return s.handle(ctx, event)
+}
+
+// record 记录订阅者处理事件的指标.
+func (b *Bus) record(ctx context.Context, s *subscriber, event Event, result string, elapsed time.Duration) {
+	attrs := []attribute.KeyValue{
+		attribute.String("event", event.EventType()),
+		attribute.String("subscriber", s.name),
+	}
+	b.handled.Add(ctx, 1, metric.WithAttributes(append(attrs, attribute.String("result", result))...))
+	if result != resultDropped {
+		b.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+	}
+}
+
+// Close 关闭事件总线，等待异步订阅者处理完队列中的事件或 ctx 被取消. 关闭后 Publish 返回 ErrClosed.
+func (b *Bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, subscribers := range b.typed {
+			for _, s := range subscribers {
+				if s.async {
+					close(s.queue)
+				}
+			}
+		}
+		for _, s := range b.all {
+			if s.async {
+				close(s.queue)
+			}
+		}
+	}
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,168 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type created struct{ ID string }
+
+func (*created) EventType() string { return "test.created" }
+
+type deleted struct{ ID string }
+
+func (*deleted) EventType() string { return "test.deleted" }
+
+func newBus(t *testing.T) *Bus {
+	t.Helper()
+
+	bus, err := New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = bus.Close(context.Background()) })
+	return bus
+}
+
+func TestPublish_Typed(t *testing.T) {
+	bus := newBus(t)
+
+	var got []string
+	Subscribe(bus, "created", func(_ context.Context, e *created) error {
+		got = append(got, "created:"+e.ID)
+		return nil
+	})
+	Subscribe(bus, "deleted", func(_ context.Context, e *deleted) error {
+		got = append(got, "deleted:"+e.ID)
+		return nil
+	})
+	SubscribeAll(bus, "all", func(_ context.Context, e Event) error {
+		got = append(got, "all:"+e.EventType())
+		return nil
+	})
+
+	require.NoError(t, bus.Publish(context.Background(), &created{ID: "1"}, &deleted{ID: "2"}))
+	assert.Equal(t, []string{"created:1", "all:test.created", "deleted:2", "all:test.deleted"}, got)
+}
+
+func TestPublish_Errors(t *testing.T) {
+	bus := newBus(t)
+
+	errFailed := errors.New("failed")
+	var called bool
+	Subscribe(bus, "failing", func(context.Context, *created) error { return errFailed })
+	Subscribe(bus, "panicking", func(context.Context, *created) error { panic("boom") })
+	Subscribe(bus, "succeeding", func(context.Context, *created) error {
+		called = true
+		return nil
+	})
+
+	// 失败或 panic 的订阅者不影响其他订阅者
+	err := bus.Publish(context.Background(), &created{ID: "1"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errFailed)
+	assert.Contains(t, err.Error(), "subscriber panicking panicked handling test.created: boom")
+	assert.True(t, called)
+}
+
+func TestPublish_Retry(t *testing.T) {
+	bus := newBus(t)
+
+	errFailed := errors.New("failed")
+	var attempts int
+	Subscribe(bus, "flaky", func(context.Context, *created) error {
+		attempts++
+		if attempts < 3 {
+			return errFailed
+		}
+		return nil
+	}, WithRetry(2, time.Millisecond))
+
+	require.NoError(t, bus.Publish(context.Background(), &created{ID: "1"}))
+	assert.Equal(t, 3, attempts)
+
+	// 超过重试次数后返回最后一次的错误
+	attempts = -10
+	assert.ErrorIs(t, bus.Publish(context.Background(), &created{ID: "2"}), errFailed)
+	assert.Equal(t, -7, attempts)
+}
+
+type ctxKey struct{}
+
+func TestPublish_Async(t *testing.T) {
+	bus := newBus(t)
+
+	var (
+		mu  sync.Mutex
+		got []string
+	)
+	Subscribe(bus, "async", func(ctx context.Context, e *created) error {
+		mu.Lock()
+		defer mu.Unlock()
+		// 发布者的 ctx 已被取消，但其中的值仍然可用
+		assert.NoError(t, ctx.Err())
+		got = append(got, ctx.Value(ctxKey{}).(string)+":"+e.ID)
+		if e.ID == "2" {
+			return errors.New("ignored")
+		}
+		return nil
+	}, Async(), WithQueueSize(1))
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "user"))
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, bus.Publish(ctx, &created{ID: id}))
+	}
+	cancel()
+
+	// 关闭时等待队列中的事件处理完成
+	require.NoError(t, bus.Close(context.Background()))
+	assert.Equal(t, []string{"user:1", "user:2", "user:3"}, got)
+	assert.ErrorIs(t, bus.Publish(context.Background(), &created{ID: "4"}), ErrClosed)
+}
+
+func TestPublish_AsyncQueueFull(t *testing.T) {
+	bus := newBus(t)
+
+	release := make(chan struct{})
+	Subscribe(bus, "blocked", func(context.Context, *created) error {
+		<-release
+		return nil
+	}, Async(), WithQueueSize(1))
+
+	// 第一个事件被工作协程取走并阻塞，第二个事件占满队列
+	require.NoError(t, bus.Publish(context.Background(), &created{ID: "1"}, &created{ID: "2"}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	require.NoError(t, bus.Publish(ctx, &created{ID: "3"}))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	close(release)
+}
+
+type subscriberFunc func(bus *Bus)
+
+func (f subscriberFunc) Subscribe(bus *Bus) { f(bus) }
+
+func TestRegister(t *testing.T) {
+	bus := newBus(t)
+
+	var called bool
+	bus.Register(subscriberFunc(func(bus *Bus) {
+		Subscribe(bus, "registered", func(context.Context, *created) error {
+			called = true
+			return nil
+		})
+	}))
+
+	require.NoError(t, bus.Publish(context.Background(), &created{ID: "1"}))
+	assert.True(t, called)
+
+	var nilBus *Bus
+	assert.NoError(t, nilBus.Publish(context.Background(), &created{ID: "1"}))
+}
@@ -0,0 +1,194 @@
+// Package fieldmask 实现更新请求的 updateMask 语义：根据请求消息确定需要修改的字段，
+// 并将这些字段复制到数据库模型的同名字段上，供 store 只更新对应的列.
+//
+// 字段名使用请求消息中的字段名（例如 roleName）. 未指定 updateMask 时修改请求中所有已设置的字段
+// （optional 字段已设置、repeated 字段非空），与只支持部分更新的旧客户端兼容；
+// 指定 updateMask 后只修改列出的字段，列出但未设置的字段会被清空为零值.
+package fieldmask
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Mask 表示一次更新请求需要修改的字段集合.
+type Mask struct {
+	paths map[string]protoreflect.FieldDescriptor
+}
+
+// New 根据请求消息 msg 和客户端传入的 paths 创建 Mask.
+// exclude 是不能修改的字段，例如资源 ID、version 和 updateMask 本身，paths 中包含这些字段或未知字段时返回错误.
+func New(msg proto.Message, paths []string, exclude ...string) (*Mask, error) {
+	m := &Mask{paths: make(map[string]protoreflect.FieldDescriptor)}
+	rm := msg.ProtoReflect()
+	fields := rm.Descriptor().Fields()
+
+	if len(paths) == 0 {
+		for i := range fields.Len() {
+			fd := fields.Get(i)
+			if !slices.Contains(exclude, string(fd.Name())) && rm.Has(fd) {
+				m.paths[string(fd.Name())] = fd
+			}
+		}
+		return m, nil
+	}
+
+	for _, path := range paths {
+		fd := fields.ByName(protoreflect.Name(path))
+		if fd == nil {
+			fd = fields.ByJSONName(path)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %q in update mask", path)
+		}
+		if slices.Contains(exclude, string(fd.Name())) {
+			return nil, fmt.Errorf("field %q cannot be updated", path)
+		}
+		m.paths[string(fd.Name())] = fd
+	}
+	return m, nil
+}
+
+// Has 判断字段是否需要修改.
+func (m *Mask) Has(path string) bool {
+	_, ok := m.paths[path]
+	return ok
+}
+
+// Len 返回需要修改的字段数量.
+func (m *Mask) Len() int {
+	return len(m.paths)
+}
+
+// Paths 返回按字段名排序的需要修改的字段.
+func (m *Mask) Paths() []string {
+	paths := make([]string, 0, len(m.paths))
+	for path := range m.paths {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// Require 检查 paths 中需要修改的字段在 msg 中是否已设置，用于禁止清空名称等必填字段.
+func (m *Mask) Require(msg proto.Message, paths ...string) error {
+	rm := msg.ProtoReflect()
+	for _, path := range paths {
+		if fd, ok := m.paths[path]; ok && !rm.Has(fd) {
+			return fmt.Errorf("field %q in update mask cannot be cleared", path)
+		}
+	}
+	return nil
+}
+
+// GoNames 返回需要修改的字段在 msg 对应的 Go 结构体中的字段名，可用于按字段名执行校验.
+func (m *Mask) GoNames(msg proto.Message) []string {
+	typ := reflect.TypeOf(msg).Elem()
+	names := make([]string, 0, len(m.paths))
+	for _, path := range m.Paths() {
+		if field, ok := goField(typ, path); ok {
+			names = append(names, field.Name)
+		}
+	}
+	return names
+}
+
+// Apply 将 msg 中需要修改的字段复制到 dst 指向的结构体中 Go 字段名相同的字段，返回已复制的字段名，
+// 返回值可以直接作为 store 的 UpdateFields 参数. skip 中的字段由调用方自行处理.
+// 可选字段会被解引用，数值类型之间会进行转换；dst 的字段是指针时，零值会被写为 nil.
+func (m *Mask) Apply(dst any, msg proto.Message, skip ...string) ([]string, error) {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a pointer to struct, got %T", dst)
+	}
+	dv = dv.Elem()
+	sv := reflect.ValueOf(msg).Elem()
+
+	var applied []string
+	for _, path := range m.Paths() {
+		if slices.Contains(skip, path) {
+			continue
+		}
+
+		field, ok := goField(sv.Type(), path)
+		if !ok {
+			return nil, fmt.Errorf("field %q not found in %T", path, msg)
+		}
+		target := dv.FieldByName(field.Name)
+		if !target.IsValid() || !target.CanSet() {
+			return nil, fmt.Errorf("field %q cannot be applied to %T", path, dst)
+		}
+		if err := assign(target, sv.FieldByIndex(field.Index)); err != nil {
+			return nil, fmt.Errorf("field %q: %w", path, err)
+		}
+		applied = append(applied, field.Name)
+	}
+	return applied, nil
+}
+
+// assign 将 src 赋值给 dst，src 是未设置的可选字段时赋值为零值.
+func assign(dst, src reflect.Value) error {
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			src = reflect.Zero(src.Type().Elem())
+		} else {
+			src = src.Elem()
+		}
+	}
+
+	if dst.Kind() != reflect.Pointer {
+		return convert(dst, src)
+	}
+	if src.IsZero() {
+		dst.SetZero()
+		return nil
+	}
+	ptr := reflect.New(dst.Type().Elem())
+	if err := convert(ptr.Elem(), src); err != nil {
+		return err
+	}
+	dst.Set(ptr)
+	return nil
+}
+
+// convert 在类型兼容时将 src 赋值给 dst，只允许相同类型或数值类型之间的转换.
+func convert(dst, src reflect.Value) error {
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case isNumber(src.Kind()) && isNumber(dst.Kind()):
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("cannot assign %s to %s", src.Type(), dst.Type())
+	}
+	return nil
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// goField 根据 protobuf 标签中的字段名查找生成的 Go 结构体字段.
+func goField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		for _, opt := range strings.Split(field.Tag.Get("protobuf"), ",") {
+			if opt == "name="+name {
+				return field, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
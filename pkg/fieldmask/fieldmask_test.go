@@ -0,0 +1,74 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/clin211/gin-enterprise-template/pkg/api/apiserver/v1"
+	"github.com/clin211/gin-enterprise-template/pkg/ptr"
+)
+
+type role struct {
+	RoleID      string
+	RoleName    string
+	Description *string
+	Status      int16
+	SortOrder   int32
+	Sensitive   bool
+}
+
+var exclude = []string{"roleID", "version", "updateMask"}
+
+func TestNew(t *testing.T) {
+	// 未指定 updateMask 时使用已设置的字段
+	rq := &v1.UpdateRoleRequest{RoleID: "r1", RoleName: ptr.To("admin"), ApproverRoleIDs: []string{"r2"}, Version: ptr.To[int64](3)}
+	m, err := New(rq, nil, exclude...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"approverRoleIDs", "roleName"}, m.Paths())
+	assert.Equal(t, []string{"ApproverRoleIDs", "RoleName"}, m.GoNames(rq))
+
+	m, err = New(rq, []string{"description", "sortOrder"}, exclude...)
+	require.NoError(t, err)
+	assert.True(t, m.Has("description"))
+	assert.False(t, m.Has("roleName"))
+	assert.Equal(t, 2, m.Len())
+
+	_, err = New(rq, []string{"unknown"}, exclude...)
+	require.Error(t, err)
+	_, err = New(rq, []string{"version"}, exclude...)
+	require.Error(t, err)
+}
+
+func TestMask_Require(t *testing.T) {
+	rq := &v1.UpdateRoleRequest{Description: ptr.To("desc")}
+	m, err := New(rq, []string{"roleName", "description"}, exclude...)
+	require.NoError(t, err)
+	require.Error(t, m.Require(rq, "roleName"))
+	require.NoError(t, m.Require(rq, "description", "sortOrder"))
+}
+
+func TestMask_Apply(t *testing.T) {
+	dst := &role{RoleID: "r1", RoleName: "admin", Description: ptr.To("old"), Status: 1, SortOrder: 5, Sensitive: true}
+	rq := &v1.UpdateRoleRequest{RoleID: "r2", RoleName: ptr.To("ops"), Status: ptr.To[int32](0), ApproverRoleIDs: []string{"r3"}}
+
+	// description 和 sortOrder 在 updateMask 中但未设置，会被清空
+	m, err := New(rq, []string{"roleName", "description", "status", "sortOrder", "approverRoleIDs"}, exclude...)
+	require.NoError(t, err)
+	fields, err := m.Apply(dst, rq, "approverRoleIDs")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Description", "RoleName", "SortOrder", "Status"}, fields)
+	assert.Equal(t, &role{RoleID: "r1", RoleName: "ops", Sensitive: true}, dst)
+
+	// 目标结构体中没有对应字段
+	_, err = m.Apply(dst, rq)
+	require.Error(t, err)
+
+	// 类型不兼容
+	type mismatch struct{ RoleName int }
+	m, err = New(rq, []string{"roleName"}, exclude...)
+	require.NoError(t, err)
+	_, err = m.Apply(&mismatch{}, rq)
+	require.Error(t, err)
+}
@@ -0,0 +1,256 @@
+// Package ldap 封装了基于 LDAP / Active Directory 的用户名密码认证：
+// 使用服务账号按过滤条件搜索用户，再以用户 DN 和密码绑定验证凭证，并读取用户属性和所属组.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+var (
+	// ErrUserNotFound 表示目录中不存在匹配的用户.
+	ErrUserNotFound = errors.New("ldap: user not found")
+	// ErrInvalidCredentials 表示用户密码错误.
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+	// ErrMultipleEntries 表示过滤条件匹配到了多个用户，通常说明 UserFilter 配置不当.
+	ErrMultipleEntries = errors.New("ldap: user filter matched multiple entries")
+)
+
+// Config 是 LDAP 认证的配置.
+type Config struct {
+	// URL 是目录服务地址，例如 ldap://ldap.example.com:389 或 ldaps://ldap.example.com:636.
+	URL string
+	// StartTLS 表示在 ldap:// 连接上使用 StartTLS 升级为加密连接.
+	StartTLS bool
+	// TLSConfig 是 ldaps:// 和 StartTLS 使用的 TLS 配置.
+	TLSConfig *tls.Config
+	// BindDN 和 BindPassword 是用于搜索用户的服务账号，为空时匿名搜索.
+	BindDN       string
+	BindPassword string
+	// BaseDN 是搜索用户的起始 DN.
+	BaseDN string
+	// UserFilter 是搜索用户的过滤条件，%s 会被替换为转义后的用户名，例如 (uid=%s) 或 (sAMAccountName=%s).
+	UserFilter string
+	// UsernameAttribute、EmailAttribute、DisplayNameAttribute 是用户属性名称.
+	UsernameAttribute    string
+	EmailAttribute       string
+	DisplayNameAttribute string
+	// GroupAttribute 是用户条目上表示所属组的属性，例如 Active Directory 的 memberOf.
+	GroupAttribute string
+	// GroupBaseDN 和 GroupFilter 用于在不支持 memberOf 的目录中搜索用户所属的组，
+	// GroupFilter 中的 %s 会被替换为转义后的用户 DN，例如 (&(objectClass=groupOfNames)(member=%s)).
+	GroupBaseDN string
+	GroupFilter string
+	// Timeout 是建立连接和单次请求的超时时间.
+	Timeout time.Duration
+}
+
+// Entry 是认证成功的用户在目录中的信息.
+type Entry struct {
+	DN          string
+	Username    string
+	Email       string
+	DisplayName string
+	// Groups 是用户所属组的 DN 列表.
+	Groups []string
+}
+
+// Client 是 LDAP 认证客户端. 每次认证使用独立的连接，因此可以被并发使用.
+type Client struct {
+	cfg Config
+}
+
+// New 创建 LDAP 认证客户端.
+func New(cfg Config) *Client {
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = "(uid=%s)"
+	}
+	if cfg.UsernameAttribute == "" {
+		cfg.UsernameAttribute = "uid"
+	}
+	if cfg.EmailAttribute == "" {
+		cfg.EmailAttribute = "mail"
+	}
+	if cfg.DisplayNameAttribute == "" {
+		cfg.DisplayNameAttribute = "cn"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Client{cfg: cfg}
+}
+
+// Authenticate 验证用户名和密码，成功时返回用户在目录中的信息.
+// 空密码会被直接拒绝，因为很多目录服务把空密码的简单绑定视为匿名绑定并返回成功.
+func (c *Client) Authenticate(ctx context.Context, username, password string) (*Entry, error) {
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if c.cfg.BindDN != "" {
+		if err := conn.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap: service account bind failed: %w", err)
+		}
+	}
+
+	entry, err := c.findUser(conn, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap: user bind failed: %w", err)
+	}
+
+	if c.cfg.GroupFilter != "" {
+		// 以服务账号身份搜索组，避免普通用户没有读取组的权限
+		if c.cfg.BindDN != "" {
+			if err := conn.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+				return nil, fmt.Errorf("ldap: service account bind failed: %w", err)
+			}
+		}
+		groups, err := c.findGroups(conn, entry.DN)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			if !slices.Contains(entry.Groups, group) {
+				entry.Groups = append(entry.Groups, group)
+			}
+		}
+	}
+
+	return entry, nil
+}
+
+// dial 建立到目录服务的连接，必要时执行 StartTLS.
+func (c *Client) dial(ctx context.Context) (*goldap.Conn, error) {
+	dialer := &net.Dialer{Timeout: c.cfg.Timeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+
+	opts := []goldap.DialOpt{goldap.DialWithDialer(dialer)}
+	if c.cfg.TLSConfig != nil {
+		opts = append(opts, goldap.DialWithTLSConfig(c.cfg.TLSConfig))
+	}
+
+	conn, err := goldap.DialURL(c.cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: failed to connect to %s: %w", c.cfg.URL, err)
+	}
+	conn.SetTimeout(c.cfg.Timeout)
+
+	if c.cfg.StartTLS {
+		tlsConfig := c.cfg.TLSConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig = tlsConfig.Clone()
+			tlsConfig.ServerName = hostname(c.cfg.URL)
+		}
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: StartTLS failed: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+// findUser 按 UserFilter 搜索用户，要求恰好匹配一个条目.
+func (c *Client) findUser(conn *goldap.Conn, username string) (*Entry, error) {
+	attributes := []string{c.cfg.UsernameAttribute, c.cfg.EmailAttribute, c.cfg.DisplayNameAttribute}
+	if c.cfg.GroupAttribute != "" {
+		attributes = append(attributes, c.cfg.GroupAttribute)
+	}
+
+	result, err := conn.Search(goldap.NewSearchRequest(
+		c.cfg.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, int(c.cfg.Timeout.Seconds()), false,
+		fmt.Sprintf(c.cfg.UserFilter, goldap.EscapeFilter(username)),
+		attributes,
+		nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("ldap: user search failed: %w", err)
+	}
+	if result == nil || len(result.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	if len(result.Entries) > 1 {
+		return nil, ErrMultipleEntries
+	}
+
+	entry := result.Entries[0]
+	e := &Entry{
+		DN:          entry.DN,
+		Username:    entry.GetAttributeValue(c.cfg.UsernameAttribute),
+		Email:       entry.GetAttributeValue(c.cfg.EmailAttribute),
+		DisplayName: entry.GetAttributeValue(c.cfg.DisplayNameAttribute),
+	}
+	if c.cfg.GroupAttribute != "" {
+		e.Groups = entry.GetAttributeValues(c.cfg.GroupAttribute)
+	}
+	if e.Username == "" {
+		e.Username = username
+	}
+
+	return e, nil
+}
+
+// findGroups 按 GroupFilter 搜索用户所属的组，返回组的 DN 列表.
+func (c *Client) findGroups(conn *goldap.Conn, userDN string) ([]string, error) {
+	baseDN := c.cfg.GroupBaseDN
+	if baseDN == "" {
+		baseDN = c.cfg.BaseDN
+	}
+
+	result, err := conn.Search(goldap.NewSearchRequest(
+		baseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, int(c.cfg.Timeout.Seconds()), false,
+		fmt.Sprintf(c.cfg.GroupFilter, goldap.EscapeFilter(userDN)),
+		[]string{"dn"},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("ldap: group search failed: %w", err)
+	}
+
+	groups := make([]string, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		groups = append(groups, entry.DN)
+	}
+	return groups, nil
+}
+
+// hostname 从 URL 中解析主机名，用于 StartTLS 的证书校验.
+func hostname(rawURL string) string {
+	host := rawURL
+	if _, rest, ok := strings.Cut(rawURL, "://"); ok {
+		host = rest
+	}
+	host, _, _ = strings.Cut(host, "/")
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
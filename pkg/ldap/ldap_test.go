@@ -0,0 +1,152 @@
+package ldap_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/ldap"
+	"github.com/clin211/gin-enterprise-template/pkg/ldap/ldaptest"
+)
+
+const (
+	baseDN    = "dc=example,dc=com"
+	serviceDN = "cn=svc,ou=services,dc=example,dc=com"
+	aliceDN   = "uid=alice,ou=people,dc=example,dc=com"
+	adminsDN  = "cn=admins,ou=groups,dc=example,dc=com"
+	devsDN    = "cn=devs,ou=groups,dc=example,dc=com"
+)
+
+func entries() []ldaptest.Entry {
+	return []ldaptest.Entry{
+		{DN: serviceDN, Password: "svc-secret", Attributes: map[string][]string{"cn": {"svc"}}},
+		{
+			DN:       aliceDN,
+			Password: "alice-secret",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"alice"},
+				"mail":        {"alice@example.com"},
+				"cn":          {"Alice Liddell"},
+				"memberOf":    {adminsDN},
+			},
+		},
+		{
+			DN: devsDN,
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"member":      {aliceDN},
+			},
+		},
+		{DN: "uid=dup,ou=a,dc=example,dc=com", Password: "x", Attributes: map[string][]string{"uid": {"dup"}}},
+		{DN: "uid=dup,ou=b,dc=example,dc=com", Password: "x", Attributes: map[string][]string{"uid": {"dup"}}},
+	}
+}
+
+func newClient(server *ldaptest.Server, mutate func(*ldap.Config)) *ldap.Client {
+	cfg := ldap.Config{
+		URL:            server.URL(),
+		BindDN:         serviceDN,
+		BindPassword:   "svc-secret",
+		BaseDN:         baseDN,
+		UserFilter:     "(&(objectClass=inetOrgPerson)(uid=%s))",
+		GroupAttribute: "memberOf",
+	}
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	return ldap.New(cfg)
+}
+
+func TestAuthenticate(t *testing.T) {
+	server := ldaptest.NewServer(entries()...)
+	t.Cleanup(server.Close)
+
+	client := newClient(server, nil)
+	entry, err := client.Authenticate(context.Background(), "alice", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, aliceDN, entry.DN)
+	assert.Equal(t, "alice", entry.Username)
+	assert.Equal(t, "alice@example.com", entry.Email)
+	assert.Equal(t, "Alice Liddell", entry.DisplayName)
+	assert.Equal(t, []string{adminsDN}, entry.Groups)
+}
+
+func TestAuthenticateGroupSearch(t *testing.T) {
+	server := ldaptest.NewServer(entries()...)
+	t.Cleanup(server.Close)
+
+	client := newClient(server, func(cfg *ldap.Config) {
+		cfg.GroupFilter = "(&(objectClass=groupOfNames)(member=%s))"
+	})
+	entry, err := client.Authenticate(context.Background(), "alice", "alice-secret")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{adminsDN, devsDN}, entry.Groups)
+}
+
+func TestAuthenticateErrors(t *testing.T) {
+	server := ldaptest.NewServer(entries()...)
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		mutate   func(*ldap.Config)
+		want     error
+	}{
+		{name: "wrong password", username: "alice", password: "nope", want: ldap.ErrInvalidCredentials},
+		{name: "empty password", username: "alice", password: "", want: ldap.ErrInvalidCredentials},
+		{name: "unknown user", username: "bob", password: "x", want: ldap.ErrUserNotFound},
+		{name: "filter injection", username: "*", password: "x", want: ldap.ErrUserNotFound},
+		{
+			name: "multiple entries", username: "dup", password: "x", want: ldap.ErrMultipleEntries,
+			mutate: func(cfg *ldap.Config) { cfg.UserFilter = "(uid=%s)" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newClient(server, tt.mutate).Authenticate(context.Background(), tt.username, tt.password)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	_, err := newClient(server, func(cfg *ldap.Config) { cfg.BindPassword = "wrong" }).
+		Authenticate(context.Background(), "alice", "alice-secret")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ldap.ErrInvalidCredentials)
+}
+
+func TestAuthenticateTLS(t *testing.T) {
+	t.Run("ldaps", func(t *testing.T) {
+		server := ldaptest.NewTLSServer(entries()...)
+		t.Cleanup(server.Close)
+
+		client := newClient(server, func(cfg *ldap.Config) { cfg.TLSConfig = server.ClientTLSConfig() })
+		_, err := client.Authenticate(context.Background(), "alice", "alice-secret")
+		require.NoError(t, err)
+	})
+
+	t.Run("starttls", func(t *testing.T) {
+		server := ldaptest.NewServer(entries()...)
+		t.Cleanup(server.Close)
+
+		client := newClient(server, func(cfg *ldap.Config) {
+			cfg.StartTLS = true
+			cfg.TLSConfig = server.ClientTLSConfig()
+		})
+		_, err := client.Authenticate(context.Background(), "alice", "alice-secret")
+		require.NoError(t, err)
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		server := ldaptest.NewServer(entries()...)
+		t.Cleanup(server.Close)
+
+		client := newClient(server, func(cfg *ldap.Config) { cfg.StartTLS = true })
+		_, err := client.Authenticate(context.Background(), "alice", "alice-secret")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,425 @@
+// Package ldaptest 提供一个进程内的 LDAP 服务器，用于测试 LDAP 认证流程.
+// 服务器只实现了认证所需的最小协议子集：简单绑定、搜索（and/or/not/等值/存在过滤）、StartTLS 和解绑.
+package ldaptest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// LDAP 协议操作的 Application 标签.
+const (
+	appBindRequest      = 0
+	appBindResponse     = 1
+	appUnbindRequest    = 2
+	appSearchRequest    = 3
+	appSearchEntry      = 4
+	appSearchDone       = 5
+	appExtendedRequest  = 23
+	appExtendedResponse = 24
+)
+
+// LDAP 结果码.
+const (
+	resultSuccess            = 0
+	resultOperationsError    = 1
+	resultProtocolError      = 2
+	resultSizeLimitExceeded  = 4
+	resultInvalidCredentials = 49
+	resultUnwillingToPerform = 53
+)
+
+// 搜索过滤条件的 Context 标签.
+const (
+	filterAnd      = 0
+	filterOr       = 1
+	filterNot      = 2
+	filterEquality = 3
+	filterPresent  = 7
+)
+
+// startTLSOID 是 StartTLS 扩展操作的 OID.
+const startTLSOID = "1.3.6.1.4.1.1466.20037"
+
+// Entry 是目录中的一个条目.
+type Entry struct {
+	DN string
+	// Password 是条目的绑定密码，为空时该条目不能绑定.
+	Password string
+	// Attributes 是条目的属性，属性名大小写不敏感.
+	Attributes map[string][]string
+}
+
+// Server 是进程内的 LDAP 服务器.
+type Server struct {
+	// Addr 是服务器监听的地址，格式为 host:port.
+	Addr string
+
+	listener  net.Listener
+	tlsConfig *tls.Config
+	certPool  *x509.CertPool
+	ldaps     bool
+
+	mu      sync.RWMutex
+	entries []Entry
+	binds   int
+
+	wg sync.WaitGroup
+}
+
+// NewServer 启动一个明文 LDAP 服务器（支持 StartTLS）.
+func NewServer(entries ...Entry) *Server {
+	return newServer(false, entries)
+}
+
+// NewTLSServer 启动一个 LDAPS 服务器.
+func NewTLSServer(entries ...Entry) *Server {
+	return newServer(true, entries)
+}
+
+func newServer(ldaps bool, entries []Entry) *Server {
+	cert, pool := selfSignedCert()
+	s := &Server{
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12},
+		certPool:  pool,
+		ldaps:     ldaps,
+		entries:   entries,
+	}
+
+	var err error
+	if ldaps {
+		s.listener, err = tls.Listen("tcp", "127.0.0.1:0", s.tlsConfig)
+	} else {
+		s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		panic("ldaptest: failed to listen: " + err.Error())
+	}
+	s.Addr = s.listener.Addr().String()
+
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// URL 返回服务器的 LDAP URL.
+func (s *Server) URL() string {
+	if s.ldaps {
+		return "ldaps://" + s.Addr
+	}
+	return "ldap://" + s.Addr
+}
+
+// CertPool 返回信任服务器证书的证书池.
+func (s *Server) CertPool() *x509.CertPool {
+	return s.certPool
+}
+
+// ClientTLSConfig 返回连接该服务器时使用的 TLS 配置.
+func (s *Server) ClientTLSConfig() *tls.Config {
+	return &tls.Config{RootCAs: s.certPool, ServerName: "127.0.0.1", MinVersion: tls.VersionTLS12}
+}
+
+// AddEntry 向目录中添加条目.
+func (s *Server) AddEntry(entry Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+}
+
+// Binds 返回服务器收到的成功绑定次数.
+func (s *Server) Binds() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.binds
+}
+
+// Close 关闭服务器并等待所有连接处理结束.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+// handle 顺序处理一个连接上的请求.
+func (s *Server) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	for {
+		_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		if len(packet.Children) < 2 {
+			return
+		}
+
+		messageID := packet.Children[0].Value
+		op := packet.Children[1]
+		if op.ClassType != ber.ClassApplication {
+			return
+		}
+
+		switch op.Tag {
+		case appBindRequest:
+			code := s.bind(op)
+			if !s.write(conn, messageID, result(appBindResponse, code)) {
+				return
+			}
+		case appSearchRequest:
+			if !s.search(conn, messageID, op) {
+				return
+			}
+		case appExtendedRequest:
+			if len(op.Children) == 0 || op.Children[0].Data.String() != startTLSOID || s.ldaps {
+				if !s.write(conn, messageID, result(appExtendedResponse, resultUnwillingToPerform)) {
+					return
+				}
+				continue
+			}
+			if !s.write(conn, messageID, result(appExtendedResponse, resultSuccess)) {
+				return
+			}
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+		case appUnbindRequest:
+			return
+		default:
+			if !s.write(conn, messageID, result(appExtendedResponse, resultProtocolError)) {
+				return
+			}
+		}
+	}
+}
+
+// bind 处理简单绑定请求，空 DN 视为匿名绑定.
+func (s *Server) bind(op *ber.Packet) int {
+	if len(op.Children) < 3 {
+		return resultProtocolError
+	}
+	dn := stringValue(op.Children[1])
+	password := op.Children[2].Data.String()
+	if dn == "" && password == "" {
+		return resultSuccess
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, entry := range s.entries {
+		if strings.EqualFold(entry.DN, dn) && entry.Password != "" && entry.Password == password {
+			s.binds++
+			return resultSuccess
+		}
+	}
+	return resultInvalidCredentials
+}
+
+// search 处理搜索请求，返回匹配的条目，超过 sizeLimit 时返回 sizeLimitExceeded.
+func (s *Server) search(conn net.Conn, messageID any, op *ber.Packet) bool {
+	if len(op.Children) < 8 {
+		return s.write(conn, messageID, result(appSearchDone, resultProtocolError))
+	}
+	baseDN := strings.ToLower(stringValue(op.Children[0]))
+	sizeLimit, _ := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+
+	s.mu.RLock()
+	var matched []Entry
+	for _, entry := range s.entries {
+		dn := strings.ToLower(entry.DN)
+		if baseDN != "" && dn != baseDN && !strings.HasSuffix(dn, ","+baseDN) {
+			continue
+		}
+		ok, err := match(filter, entry)
+		if err != nil {
+			s.mu.RUnlock()
+			return s.write(conn, messageID, result(appSearchDone, resultOperationsError))
+		}
+		if ok {
+			matched = append(matched, entry)
+		}
+	}
+	s.mu.RUnlock()
+
+	code := resultSuccess
+	if sizeLimit > 0 && int64(len(matched)) > sizeLimit {
+		matched = matched[:sizeLimit]
+		code = resultSizeLimitExceeded
+	}
+
+	for _, entry := range matched {
+		resp := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchEntry, nil, "SearchResultEntry")
+		resp.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "objectName"))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+		for name, values := range entry.Attributes {
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+			vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+			for _, v := range values {
+				vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+			}
+			attr.AppendChild(vals)
+			attrs.AppendChild(attr)
+		}
+		resp.AppendChild(attrs)
+		if !s.write(conn, messageID, resp) {
+			return false
+		}
+	}
+
+	return s.write(conn, messageID, result(appSearchDone, code))
+}
+
+// match 判断条目是否满足过滤条件.
+func match(filter *ber.Packet, entry Entry) (bool, error) {
+	if filter.ClassType != ber.ClassContext {
+		return false, errors.New("unexpected filter class")
+	}
+
+	switch filter.Tag {
+	case filterAnd:
+		for _, child := range filter.Children {
+			ok, err := match(child, entry)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case filterOr:
+		for _, child := range filter.Children {
+			ok, err := match(child, entry)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	case filterNot:
+		if len(filter.Children) != 1 {
+			return false, errors.New("invalid not filter")
+		}
+		ok, err := match(filter.Children[0], entry)
+		return !ok, err
+	case filterEquality:
+		if len(filter.Children) != 2 {
+			return false, errors.New("invalid equality filter")
+		}
+		values := attribute(entry, stringValue(filter.Children[0]))
+		want := stringValue(filter.Children[1])
+		for _, v := range values {
+			if strings.EqualFold(v, want) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case filterPresent:
+		name := filter.Data.String()
+		return strings.EqualFold(name, "objectClass") || len(attribute(entry, name)) > 0, nil
+	default:
+		return false, errors.New("unsupported filter")
+	}
+}
+
+// attribute 按大小写不敏感的属性名返回条目的属性值.
+func attribute(entry Entry, name string) []string {
+	for k, v := range entry.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// write 发送一条 LDAP 消息，失败时返回 false.
+func (s *Server) write(w io.Writer, messageID any, op *ber.Packet) bool {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAPMessage")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "messageID"))
+	envelope.AppendChild(op)
+	_, err := w.Write(envelope.Bytes())
+	return err == nil
+}
+
+// result 构造一个 LDAPResult 形式的响应.
+func result(tag ber.Tag, code int) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "LDAPResult")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return p
+}
+
+// stringValue 读取 OCTET STRING 的值.
+func stringValue(p *ber.Packet) string {
+	if v, ok := p.Value.(string); ok {
+		return v
+	}
+	if p.Data != nil {
+		return p.Data.String()
+	}
+	return ""
+}
+
+// selfSignedCert 生成 127.0.0.1 和 localhost 的自签名证书.
+func selfSignedCert() (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic("ldaptest: failed to generate key: " + err.Error())
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldaptest"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic("ldaptest: failed to create certificate: " + err.Error())
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic("ldaptest: failed to parse certificate: " + err.Error())
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, pool
+}
@@ -0,0 +1,346 @@
+// Package mtls 提供服务端双向 TLS（mTLS）支持：按需请求并校验客户端证书，
+// 支持 CRL 吊销检查、证书主体白名单，以及在不重启服务的情况下热加载服务端证书、客户端 CA 和 CRL.
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// 证书主体的来源.
+const (
+	// SubjectSourceCN 使用证书 Subject 的 CommonName 作为主体.
+	SubjectSourceCN = "cn"
+	// SubjectSourceURI 使用证书的第一个 URI SAN 作为主体，例如 SPIFFE ID spiffe://example.com/ns/default/sa/billing.
+	SubjectSourceURI = "uri"
+)
+
+var (
+	// ErrCertificateRevoked 表示客户端证书已被吊销.
+	ErrCertificateRevoked = errors.New("mtls: client certificate has been revoked")
+	// ErrSubjectNotAllowed 表示客户端证书的主体不在白名单中.
+	ErrSubjectNotAllowed = errors.New("mtls: client certificate subject is not allowed")
+)
+
+// Config 是服务端 TLS 的配置.
+type Config struct {
+	// CertFile 和 KeyFile 是服务端证书和私钥文件.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile 是签发客户端证书的 CA 证书文件，为空表示不请求客户端证书.
+	ClientCAFile string
+	// RequireClientCert 表示是否要求客户端必须提供证书. 为 false 时客户端证书是可选的，
+	// 未提供证书的客户端仍可以使用其他方式（例如 JWT）认证.
+	RequireClientCert bool
+	// CRLFile 是证书吊销列表文件，支持 PEM 和 DER 格式，PEM 文件可以包含多个 CRL.
+	CRLFile string
+	// SubjectSource 是证书主体的来源，取值为 SubjectSourceCN 或 SubjectSourceURI.
+	SubjectSource string
+	// AllowedSubjects 是允许的证书主体白名单，为空表示允许所有由 ClientCA 签发的证书.
+	AllowedSubjects []string
+}
+
+// snapshot 是某一时刻加载的证书、CA 和 CRL.
+type snapshot struct {
+	config  *tls.Config
+	crls    []*x509.RevocationList
+	modTime map[string]time.Time
+}
+
+// Reloader 加载服务端 TLS 配置，并在文件变化时重新加载.
+// 通过 TLSConfig 返回的配置在每次握手时使用最新加载的证书，因此证书轮换不需要重启服务.
+type Reloader struct {
+	cfg     Config
+	current atomic.Pointer[snapshot]
+	mu      sync.Mutex
+}
+
+// New 创建 Reloader 并立即加载一次配置.
+func New(cfg Config) (*Reloader, error) {
+	if cfg.SubjectSource == "" {
+		cfg.SubjectSource = SubjectSourceCN
+	}
+	if cfg.SubjectSource != SubjectSourceCN && cfg.SubjectSource != SubjectSourceURI {
+		return nil, fmt.Errorf("mtls: unsupported subject source %q", cfg.SubjectSource)
+	}
+
+	r := &Reloader{cfg: cfg}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// TLSConfig 返回用于 http.Server 或 gRPC 的 TLS 配置.
+// http.Server 和 gRPC 会在返回的配置上设置 ALPN 协议，每次握手时会将其复制到最新加载的配置中.
+func (r *Reloader) TLSConfig() *tls.Config {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		current := r.current.Load().config.Clone()
+		current.NextProtos = config.NextProtos
+		return current, nil
+	}
+	return config
+}
+
+// Reload 在任意文件发生变化时重新加载配置，返回是否重新加载.
+// 加载失败时继续使用上一次成功加载的配置.
+func (r *Reloader) Reload() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTime, err := r.modTimes()
+	if err != nil {
+		return false, err
+	}
+	if old := r.current.Load(); old != nil && maps.EqualFunc(old.modTime, modTime, time.Time.Equal) {
+		return false, nil
+	}
+
+	snap, err := r.load()
+	if err != nil {
+		return false, err
+	}
+	snap.modTime = modTime
+	r.current.Store(snap)
+	return true, nil
+}
+
+// Run 按 interval 检查文件是否变化，直到 ctx 被取消.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				slog.Error("Failed to reload TLS certificates, keep using the previous ones", "error", err)
+				continue
+			}
+			if reloaded {
+				slog.Info("Reloaded TLS certificates", "cert", r.cfg.CertFile, "clientCA", r.cfg.ClientCAFile, "crl", r.cfg.CRLFile)
+			}
+		}
+	}
+}
+
+// files 返回需要监视的文件.
+func (r *Reloader) files() []string {
+	var files []string
+	for _, file := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.ClientCAFile, r.cfg.CRLFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// modTimes 返回所有监视文件的修改时间.
+func (r *Reloader) modTimes() (map[string]time.Time, error) {
+	modTime := make(map[string]time.Time)
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("mtls: %w", err)
+		}
+		modTime[file] = info.ModTime()
+	}
+	return modTime, nil
+}
+
+// load 从文件加载证书、客户端 CA 和 CRL.
+func (r *Reloader) load() (*snapshot, error) {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("mtls: failed to load server certificate: %w", err)
+	}
+
+	snap := &snapshot{}
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.NoClientCert,
+	}
+
+	if r.cfg.ClientCAFile != "" {
+		cas, err := loadCertificates(r.cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		for _, ca := range cas {
+			pool.AddCert(ca)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+		if r.cfg.RequireClientCert {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+
+		if r.cfg.CRLFile != "" {
+			snap.crls, err = loadCRLs(r.cfg.CRLFile, cas)
+			if err != nil {
+				return nil, err
+			}
+		}
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			return r.verify(snap, cs)
+		}
+	}
+
+	snap.config = config
+	return snap, nil
+}
+
+// verify 在证书链校验通过后检查客户端证书是否被吊销以及主体是否在白名单中.
+func (r *Reloader) verify(snap *snapshot, cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return nil
+	}
+
+	leaf := cs.PeerCertificates[0]
+	for _, crl := range snap.crls {
+		if !slices.Equal(crl.RawIssuer, leaf.RawIssuer) {
+			continue
+		}
+		for _, revoked := range crl.RevokedCertificateEntries {
+			if revoked.SerialNumber.Cmp(leaf.SerialNumber) == 0 {
+				return ErrCertificateRevoked
+			}
+		}
+	}
+
+	if len(r.cfg.AllowedSubjects) > 0 && !slices.Contains(r.cfg.AllowedSubjects, Subject(leaf, r.cfg.SubjectSource)) {
+		return ErrSubjectNotAllowed
+	}
+
+	return nil
+}
+
+// Subject 按 source 返回证书的主体.
+func Subject(cert *x509.Certificate, source string) string {
+	if source == SubjectSourceURI {
+		if len(cert.URIs) == 0 {
+			return ""
+		}
+		return cert.URIs[0].String()
+	}
+	return cert.Subject.CommonName
+}
+
+// PeerCertificate 返回已通过校验的客户端证书，未提供证书时返回 nil.
+// state 通常来自 http.Request.TLS.
+func PeerCertificate(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+// PeerCertificateFromContext 从 gRPC 请求的上下文中返回已通过校验的客户端证书，未提供证书时返回 nil.
+func PeerCertificateFromContext(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	return PeerCertificate(&info.State)
+}
+
+// loadCertificates 从 PEM 文件加载全部证书.
+func loadCertificates(file string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("mtls: %w", err)
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("mtls: failed to parse certificate in %s: %w", file, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("mtls: no certificates found in %s", file)
+	}
+	return certs, nil
+}
+
+// loadCRLs 加载 CRL 文件，并校验每个 CRL 都由 cas 中的某个 CA 签发.
+func loadCRLs(file string, cas []*x509.Certificate) ([]*x509.RevocationList, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("mtls: %w", err)
+	}
+
+	var ders [][]byte
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "X509 CRL" {
+			ders = append(ders, block.Bytes)
+		}
+	}
+	if len(ders) == 0 {
+		// 不是 PEM 格式时按 DER 格式解析
+		ders = append(ders, data)
+	}
+
+	crls := make([]*x509.RevocationList, 0, len(ders))
+	for _, der := range ders {
+		crl, err := x509.ParseRevocationList(der)
+		if err != nil {
+			return nil, fmt.Errorf("mtls: failed to parse CRL in %s: %w", file, err)
+		}
+
+		signed := false
+		for _, ca := range cas {
+			if slices.Equal(ca.RawSubject, crl.RawIssuer) && crl.CheckSignatureFrom(ca) == nil {
+				signed = true
+				break
+			}
+		}
+		if !signed {
+			return nil, fmt.Errorf("mtls: CRL in %s is not signed by any client CA", file)
+		}
+		crls = append(crls, crl)
+	}
+	return crls, nil
+}
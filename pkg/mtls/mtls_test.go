@@ -0,0 +1,292 @@
+package mtls_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/mtls"
+)
+
+// authority 是测试用的 CA.
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T, name string) *authority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue 签发一个证书，返回 PEM 格式的证书和私钥.
+func (a *authority) issue(t *testing.T, serial int64, cn string, uri string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if uri != "" {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+		template.URIs = []*url.URL{u}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// crl 签发一个吊销了 serials 的 CRL.
+func (a *authority) crl(t *testing.T, serials ...int64) []byte {
+	t.Helper()
+
+	var entries []x509.RevocationListEntry
+	for _, serial := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now().Add(-time.Minute),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: entries,
+	}, a.cert, a.key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+// fixture 包含服务端证书、客户端 CA 和 CRL 文件.
+type fixture struct {
+	dir      string
+	serverCA *authority
+	clientCA *authority
+	cfg      mtls.Config
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	f := &fixture{dir: t.TempDir(), serverCA: newAuthority(t, "server-ca"), clientCA: newAuthority(t, "client-ca")}
+	cert, key := f.serverCA.issue(t, 100, "server", "", x509.ExtKeyUsageServerAuth)
+	f.cfg = mtls.Config{
+		CertFile:     writeFile(t, f.dir, "server.crt", cert),
+		KeyFile:      writeFile(t, f.dir, "server.key", key),
+		ClientCAFile: writeFile(t, f.dir, "client-ca.crt", f.clientCA.pem),
+	}
+	return f
+}
+
+// serve 使用 Reloader 启动一个返回客户端证书主体的 HTTPS 服务器.
+func serve(t *testing.T, reloader *mtls.Reloader, source string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cert := mtls.PeerCertificate(r.TLS); cert != nil {
+			_, _ = io.WriteString(w, mtls.Subject(cert, source))
+		}
+	}))
+	server.TLS = reloader.TLSConfig()
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// get 使用客户端证书（可以为空）请求服务器，返回响应内容和服务端证书序列号.
+func (f *fixture) get(t *testing.T, server *httptest.Server, cert, key []byte) (string, int64, error) {
+	t.Helper()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(f.serverCA.cert)
+	config := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	if cert != nil {
+		pair, err := tls.X509KeyPair(cert, key)
+		require.NoError(t, err)
+		// 总是发送证书，即使它不是由服务端要求的 CA 签发的
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return &pair, nil
+		}
+	}
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config, DisableKeepAlives: true}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, err
+	}
+	return string(body), resp.TLS.PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestClientCertificate(t *testing.T) {
+	f := newFixture(t)
+	reloader, err := mtls.New(f.cfg)
+	require.NoError(t, err)
+	server := serve(t, reloader, mtls.SubjectSourceCN)
+
+	cert, key := f.clientCA.issue(t, 1, "billing", "spiffe://example.com/billing", x509.ExtKeyUsageClientAuth)
+	subject, _, err := f.get(t, server, cert, key)
+	require.NoError(t, err)
+	assert.Equal(t, "billing", subject)
+
+	// 客户端证书是可选的
+	subject, _, err = f.get(t, server, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, subject)
+
+	// 不是由客户端 CA 签发的证书会被拒绝
+	cert, key = f.serverCA.issue(t, 2, "billing", "", x509.ExtKeyUsageClientAuth)
+	_, _, err = f.get(t, server, cert, key)
+	assert.Error(t, err)
+}
+
+func TestRequireClientCertificate(t *testing.T) {
+	f := newFixture(t)
+	f.cfg.RequireClientCert = true
+	reloader, err := mtls.New(f.cfg)
+	require.NoError(t, err)
+	server := serve(t, reloader, mtls.SubjectSourceCN)
+
+	_, _, err = f.get(t, server, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestSubjectAllowlist(t *testing.T) {
+	f := newFixture(t)
+	f.cfg.SubjectSource = mtls.SubjectSourceURI
+	f.cfg.AllowedSubjects = []string{"spiffe://example.com/billing"}
+	reloader, err := mtls.New(f.cfg)
+	require.NoError(t, err)
+	server := serve(t, reloader, mtls.SubjectSourceURI)
+
+	cert, key := f.clientCA.issue(t, 1, "billing", "spiffe://example.com/billing", x509.ExtKeyUsageClientAuth)
+	subject, _, err := f.get(t, server, cert, key)
+	require.NoError(t, err)
+	assert.Equal(t, "spiffe://example.com/billing", subject)
+
+	cert, key = f.clientCA.issue(t, 2, "orders", "spiffe://example.com/orders", x509.ExtKeyUsageClientAuth)
+	_, _, err = f.get(t, server, cert, key)
+	assert.Error(t, err)
+}
+
+func TestRevocation(t *testing.T) {
+	f := newFixture(t)
+	f.cfg.CRLFile = writeFile(t, f.dir, "client.crl", f.clientCA.crl(t, 2))
+	reloader, err := mtls.New(f.cfg)
+	require.NoError(t, err)
+	server := serve(t, reloader, mtls.SubjectSourceCN)
+
+	cert, key := f.clientCA.issue(t, 1, "billing", "", x509.ExtKeyUsageClientAuth)
+	_, _, err = f.get(t, server, cert, key)
+	require.NoError(t, err)
+
+	cert, key = f.clientCA.issue(t, 2, "orders", "", x509.ExtKeyUsageClientAuth)
+	_, _, err = f.get(t, server, cert, key)
+	assert.Error(t, err)
+
+	// 不是由客户端 CA 签发的 CRL 不能被加载
+	f.cfg.CRLFile = writeFile(t, f.dir, "other.crl", f.serverCA.crl(t, 1))
+	_, err = mtls.New(f.cfg)
+	assert.Error(t, err)
+}
+
+func TestReload(t *testing.T) {
+	f := newFixture(t)
+	reloader, err := mtls.New(f.cfg)
+	require.NoError(t, err)
+	server := serve(t, reloader, mtls.SubjectSourceCN)
+
+	cert, key := f.clientCA.issue(t, 1, "billing", "", x509.ExtKeyUsageClientAuth)
+	_, serial, err := f.get(t, server, cert, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), serial)
+
+	reloaded, err := reloader.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	// 轮换服务端证书
+	serverCert, serverKey := f.serverCA.issue(t, 101, "server", "", x509.ExtKeyUsageServerAuth)
+	writeFile(t, f.dir, "server.crt", serverCert)
+	writeFile(t, f.dir, "server.key", serverKey)
+	future := time.Now().Add(time.Minute)
+	for _, name := range []string{"server.crt", "server.key"} {
+		require.NoError(t, os.Chtimes(filepath.Join(f.dir, name), future, future))
+	}
+
+	reloaded, err = reloader.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+
+	_, serial, err = f.get(t, server, cert, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), serial)
+
+	// 加载失败时继续使用上一次成功加载的证书
+	writeFile(t, f.dir, "server.key", []byte("invalid"))
+	require.NoError(t, os.Chtimes(filepath.Join(f.dir, "server.key"), future.Add(time.Minute), future.Add(time.Minute)))
+	_, err = reloader.Reload()
+	require.Error(t, err)
+
+	_, serial, err = f.get(t, server, cert, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), serial)
+}
@@ -0,0 +1,121 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// supportedAlgorithms 是允许的 ID Token 签名算法. 不允许 none 和 HMAC 算法，
+// 避免攻击者使用公开的 client_secret 或空签名伪造令牌.
+var supportedAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// IDToken 是校验通过的 ID Token 中的用户信息.
+type IDToken struct {
+	Issuer            string
+	Subject           string
+	Audience          []string
+	Expiry            time.Time
+	IssuedAt          time.Time
+	Nonce             string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Picture           string
+	// Groups 是从 Config.GroupsClaim 指定的声明中解析出的用户组.
+	Groups []string
+	// Claims 是 ID Token 中的全部声明.
+	Claims jwt.MapClaims
+}
+
+// Verify 校验 ID Token 的签名、issuer、audience、有效期和 nonce，并返回其中的用户信息.
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (*IDToken, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods(supportedAlgorithms))
+
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.keys.get(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if !claims.VerifyIssuer(p.doc.Issuer, true) {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidIDToken)
+	}
+	if !claims.VerifyAudience(p.cfg.ClientID, true) {
+		return nil, fmt.Errorf("%w: audience does not contain client id", ErrInvalidIDToken)
+	}
+	// 包含多个 audience 时，OIDC 规范要求 azp 必须是本客户端
+	audience := stringsClaim(claims, "aud")
+	if azp, ok := claims["azp"].(string); len(audience) > 1 && (!ok || azp != p.cfg.ClientID) {
+		return nil, fmt.Errorf("%w: authorized party does not match client id", ErrInvalidIDToken)
+	}
+	// jwt 包在 exp 缺失时视为通过，ID Token 必须包含 exp
+	if _, ok := claims["exp"]; !ok {
+		return nil, fmt.Errorf("%w: missing exp claim", ErrInvalidIDToken)
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	idToken := &IDToken{
+		Issuer:            stringClaim(claims, "iss"),
+		Subject:           stringClaim(claims, "sub"),
+		Audience:          audience,
+		Expiry:            timeClaim(claims, "exp"),
+		IssuedAt:          timeClaim(claims, "iat"),
+		Nonce:             stringClaim(claims, "nonce"),
+		Email:             stringClaim(claims, "email"),
+		Name:              stringClaim(claims, "name"),
+		PreferredUsername: stringClaim(claims, "preferred_username"),
+		Picture:           stringClaim(claims, "picture"),
+		Groups:            stringsClaim(claims, p.cfg.GroupsClaim),
+		Claims:            claims,
+	}
+	if verified, ok := claims["email_verified"].(bool); ok {
+		idToken.EmailVerified = verified
+	}
+	if idToken.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub claim", ErrInvalidIDToken)
+	}
+
+	return idToken, nil
+}
+
+// stringClaim 获取字符串类型的声明.
+func stringClaim(claims jwt.MapClaims, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// stringsClaim 获取字符串或字符串数组类型的声明.
+func stringsClaim(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if s, ok := v.(string); ok && !slices.Contains(values, s) {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// timeClaim 获取 NumericDate 类型的声明.
+func timeClaim(claims jwt.MapClaims, name string) time.Time {
+	if value, ok := claims[name].(float64); ok {
+		return time.Unix(int64(value), 0)
+	}
+	return time.Time{}
+}
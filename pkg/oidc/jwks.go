@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval 是两次刷新 JWKS 之间的最小间隔，防止伪造 kid 的令牌导致频繁请求 Provider.
+const minRefreshInterval = time.Minute
+
+// jsonWebKey 是 JWKS 中单个密钥的表示，只支持 RSA 和 EC 签名密钥.
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA 公钥参数
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC 公钥参数
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet 缓存 Provider 的签名公钥，遇到未知 kid 时按需刷新.
+type keySet struct {
+	client *http.Client
+	uri    string
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	lastRefresh time.Time
+}
+
+// newKeySet 创建 keySet 实例.
+func newKeySet(client *http.Client, uri string) *keySet {
+	return &keySet{client: client, uri: uri, keys: make(map[string]crypto.PublicKey)}
+}
+
+// get 根据 kid 获取公钥. 未命中缓存时会刷新 JWKS（受 minRefreshInterval 限制）.
+// kid 为空时只有在 JWKS 中恰好有一个密钥时才能确定使用哪个密钥.
+func (s *keySet) get(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	if !s.lastRefresh.IsZero() && time.Since(s.lastRefresh) < minRefreshInterval {
+		return nil, fmt.Errorf("oidc: signing key %q not found", kid)
+	}
+	if err := s.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("oidc: signing key %q not found", kid)
+}
+
+// lookup 在缓存中查找公钥，调用方需持有锁.
+func (s *keySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" {
+		if len(s.keys) == 1 {
+			for _, key := range s.keys {
+				return key, true
+			}
+		}
+		return nil, false
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// refresh 重新获取 JWKS，调用方需持有锁.
+func (s *keySet) refresh(ctx context.Context) error {
+	s.lastRefresh = time.Now()
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(ctx, s.client, s.uri, &jwks); err != nil {
+		return fmt.Errorf("oidc: failed to fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// 忽略无法解析的密钥，Provider 可能同时发布了本包不支持的密钥类型
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	s.keys = keys
+
+	return nil
+}
+
+// publicKey 将 JWK 转换为公钥.
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,162 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+	"github.com/clin211/gin-enterprise-template/pkg/oidc/oidctest"
+)
+
+const redirectURL = "http://localhost/v1/auth/oidc/callback"
+
+func newProvider(t *testing.T) (*oidctest.Provider, *oidc.Provider) {
+	t.Helper()
+
+	fake := oidctest.NewProvider("client-id", "client-secret")
+	t.Cleanup(fake.Close)
+
+	provider, err := oidc.NewProvider(context.Background(), oidc.Config{
+		Issuer:       fake.Issuer(),
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"profile", "email"},
+	})
+	require.NoError(t, err)
+
+	return fake, provider
+}
+
+// TestAuthorizationCodeFlow 测试完整的授权码 + PKCE 登录流程
+func TestAuthorizationCodeFlow(t *testing.T) {
+	fake, provider := newProvider(t)
+	fake.SetClaims(map[string]any{
+		"sub":                "user-1",
+		"email":              "alice@example.com",
+		"email_verified":     true,
+		"preferred_username": "alice",
+		"groups":             []string{"engineering", "admins"},
+	})
+
+	state, nonce, verifier := oidc.RandomString(), oidc.RandomString(), oidc.GenerateVerifier()
+	authURL := provider.AuthCodeURL(state, nonce, verifier)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "S256", parsed.Query().Get("code_challenge_method"))
+	assert.NotContains(t, authURL, verifier)
+
+	code, returnedState, err := fake.Authorize(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, state, returnedState)
+
+	idToken, err := provider.Exchange(context.Background(), code, verifier, nonce)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", idToken.Subject)
+	assert.Equal(t, "alice@example.com", idToken.Email)
+	assert.True(t, idToken.EmailVerified)
+	assert.Equal(t, "alice", idToken.PreferredUsername)
+	assert.Equal(t, []string{"engineering", "admins"}, idToken.Groups)
+
+	// 授权码只能使用一次
+	_, err = provider.Exchange(context.Background(), code, verifier, nonce)
+	assert.Error(t, err)
+}
+
+// TestExchangeRejectsWrongVerifier 测试 PKCE code_verifier 不匹配时换取令牌失败
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	fake, provider := newProvider(t)
+
+	nonce := oidc.RandomString()
+	code, _, err := fake.Authorize(provider.AuthCodeURL(oidc.RandomString(), nonce, oidc.GenerateVerifier()))
+	require.NoError(t, err)
+
+	_, err = provider.Exchange(context.Background(), code, oidc.GenerateVerifier(), nonce)
+	assert.Error(t, err)
+}
+
+// TestExchangeRejectsWrongNonce 测试 nonce 不匹配时拒绝 ID Token
+func TestExchangeRejectsWrongNonce(t *testing.T) {
+	fake, provider := newProvider(t)
+
+	verifier := oidc.GenerateVerifier()
+	code, _, err := fake.Authorize(provider.AuthCodeURL(oidc.RandomString(), "nonce-1", verifier))
+	require.NoError(t, err)
+
+	_, err = provider.Exchange(context.Background(), code, verifier, "nonce-2")
+	assert.True(t, errors.Is(err, oidc.ErrInvalidIDToken))
+}
+
+// TestVerify 测试 ID Token 的各项校验
+func TestVerify(t *testing.T) {
+	fake, provider := newProvider(t)
+	now := time.Now()
+
+	valid := func() map[string]any {
+		return map[string]any{
+			"iss":   fake.Issuer(),
+			"aud":   "client-id",
+			"sub":   "user-1",
+			"nonce": "nonce",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(claims map[string]any)
+		wantErr bool
+	}{
+		{name: "有效令牌", mutate: func(map[string]any) {}, wantErr: false},
+		{name: "issuer 不匹配", mutate: func(c map[string]any) { c["iss"] = "https://evil.example.com" }, wantErr: true},
+		{name: "audience 不匹配", mutate: func(c map[string]any) { c["aud"] = "other-client" }, wantErr: true},
+		{name: "多个 audience 且 azp 不匹配", mutate: func(c map[string]any) { c["aud"] = []string{"client-id", "other-client"} }, wantErr: true},
+		{name: "已过期", mutate: func(c map[string]any) { c["exp"] = now.Add(-time.Minute).Unix() }, wantErr: true},
+		{name: "缺少 exp", mutate: func(c map[string]any) { delete(c, "exp") }, wantErr: true},
+		{name: "缺少 sub", mutate: func(c map[string]any) { delete(c, "sub") }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := valid()
+			tt.mutate(claims)
+
+			_, err := provider.Verify(context.Background(), fake.SignIDToken(claims), "nonce")
+			assert.Equal(t, tt.wantErr, err != nil, "err = %v", err)
+		})
+	}
+}
+
+// TestVerifyRejectsForeignSignature 测试拒绝其他 Provider 签发的令牌
+func TestVerifyRejectsForeignSignature(t *testing.T) {
+	fake, provider := newProvider(t)
+	other := oidctest.NewProvider("client-id", "client-secret")
+	defer other.Close()
+
+	rawIDToken := other.SignIDToken(map[string]any{
+		"iss": fake.Issuer(),
+		"aud": "client-id",
+		"sub": "user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	_, err := provider.Verify(context.Background(), rawIDToken, "")
+	assert.True(t, errors.Is(err, oidc.ErrInvalidIDToken))
+}
+
+// TestNewProviderIssuerMismatch 测试 Discovery 文档中的 issuer 与配置不一致时拒绝
+func TestNewProviderIssuerMismatch(t *testing.T) {
+	fake := oidctest.NewProvider("client-id", "client-secret")
+	defer fake.Close()
+
+	_, err := oidc.NewProvider(context.Background(), oidc.Config{Issuer: fake.Issuer() + "/other", ClientID: "client-id"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Registry 管理多个 OIDC Provider. Provider 在首次使用时才执行 Discovery，
+// 因此某个 Provider 暂时不可用时不会影响服务启动和其他 Provider 的使用.
+type Registry struct {
+	configs map[string]Config
+
+	mu        sync.Mutex
+	providers map[string]*Provider
+}
+
+// NewRegistry 创建 Registry 实例，configs 的键为 Provider 名称.
+func NewRegistry(configs map[string]Config) *Registry {
+	return &Registry{configs: configs, providers: make(map[string]*Provider)}
+}
+
+// Has 判断是否配置了指定名称的 Provider.
+func (r *Registry) Has(name string) bool {
+	_, ok := r.configs[name]
+	return ok
+}
+
+// Get 获取指定名称的 Provider，首次获取时执行 Discovery. Discovery 失败不会被缓存.
+func (r *Registry) Get(ctx context.Context, name string) (*Provider, error) {
+	cfg, ok := r.configs[name]
+	if !ok {
+		return nil, fmt.Errorf("oidc: provider %q is not configured", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if provider, ok := r.providers[name]; ok {
+		return provider, nil
+	}
+
+	provider, err := NewProvider(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	r.providers[name] = provider
+
+	return provider, nil
+}
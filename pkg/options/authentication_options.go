@@ -1,7 +1,12 @@
 package options
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/mtls"
 )
 
 var _ IOptions = (*ClientCertAuthenticationOptions)(nil)
@@ -10,25 +15,53 @@ var _ IOptions = (*ClientCertAuthenticationOptions)(nil)
 type ClientCertAuthenticationOptions struct {
 	// ClientCA 是您将识别的传入客户端证书的所有签名者的证书捆绑包
 	ClientCA string `json:"client-ca-file" mapstructure:"client-ca-file"`
+	// Required 表示是否要求所有客户端都提供证书，为 false 时未提供证书的客户端仍可以使用 JWT 认证。
+	Required bool `json:"required" mapstructure:"required"`
+	// CRLFile 是客户端证书的吊销列表文件，支持 PEM 和 DER 格式。
+	CRLFile string `json:"crl-file" mapstructure:"crl-file"`
+	// SubjectSource 是客户端证书主体的来源，cn 表示 CommonName，uri 表示第一个 URI SAN（例如 SPIFFE ID）。
+	SubjectSource string `json:"subject-source" mapstructure:"subject-source"`
+	// AllowedSubjects 是允许建立连接的证书主体白名单，为空表示允许所有由 ClientCA 签发的证书。
+	AllowedSubjects []string `json:"allowed-subjects" mapstructure:"allowed-subjects"`
+	// ServiceAccounts 是证书主体到服务账号用户名的映射，
+	// 携带映射证书的请求无需 JWT 即以对应用户的身份认证，并按该用户的角色授权。
+	ServiceAccounts map[string]string `json:"service-accounts" mapstructure:"service-accounts"`
+
+	fullPrefix string
 }
 
 // NewClientCertAuthenticationOptions 创建带有默认参数的 ClientCertAuthenticationOptions 对象。
 func NewClientCertAuthenticationOptions() *ClientCertAuthenticationOptions {
 	return &ClientCertAuthenticationOptions{
-		ClientCA: "",
+		ClientCA:      "",
+		SubjectSource: mtls.SubjectSourceCN,
 	}
 }
 
 // Validate 用于解析和验证用户在程序启动时在命令行输入的参数。
 func (o *ClientCertAuthenticationOptions) Validate() []error {
-	return []error{}
+	errs := []error{}
+
+	if !slices.Contains([]string{mtls.SubjectSourceCN, mtls.SubjectSourceURI}, o.SubjectSource) {
+		errs = append(errs, fmt.Errorf("--%s.subject-source must be one of: %s, %s", o.fullPrefix, mtls.SubjectSourceCN, mtls.SubjectSourceURI))
+	}
+	if o.ClientCA == "" && (o.Required || o.CRLFile != "" || len(o.AllowedSubjects) > 0 || len(o.ServiceAccounts) > 0) {
+		errs = append(errs, fmt.Errorf("--%s.client-ca-file must be specified to authenticate client certificates", o.fullPrefix))
+	}
+
+	return errs
 }
 
 // AddFlags 将与特定服务器的 ClientCertAuthenticationOptions 相关的标志添加到
 // 指定的 FlagSet。
 func (o *ClientCertAuthenticationOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
-	fs.StringVar(&o.ClientCA, fullPrefix+".ca-file", o.ClientCA, ""+
+	o.fullPrefix = fullPrefix
+	fs.StringVar(&o.ClientCA, fullPrefix+".client-ca-file", o.ClientCA, ""+
 		"If set, any request presenting a client certificate signed by one of "+
 		"the authorities in the client-ca-file is authenticated with an identity "+
-		"corresponding to the CommonName of the client certificate.")
+		"corresponding to the subject of the client certificate.")
+	fs.BoolVar(&o.Required, fullPrefix+".required", o.Required, "Require all clients to present a certificate signed by the client CA.")
+	fs.StringVar(&o.CRLFile, fullPrefix+".crl-file", o.CRLFile, "Path to the certificate revocation list of client certificates.")
+	fs.StringVar(&o.SubjectSource, fullPrefix+".subject-source", o.SubjectSource, "Source of the client certificate subject, one of: cn, uri.")
+	fs.StringSliceVar(&o.AllowedSubjects, fullPrefix+".allowed-subjects", o.AllowedSubjects, "Client certificate subjects allowed to connect, empty allows all.")
 }
@@ -0,0 +1,61 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authz"
+)
+
+var _ IOptions = (*AuthzOptions)(nil)
+
+// AuthzOptions 包含 casbin 授权策略同步相关的配置项。
+type AuthzOptions struct {
+	// Watcher 表示是否通过 Redis 发布订阅在实例之间增量同步策略变更。
+	Watcher bool `json:"watcher" mapstructure:"watcher"`
+	// Channel 是广播策略变更使用的 Redis 频道。
+	Channel string `json:"channel" mapstructure:"channel"`
+	// AutoLoadInterval 是从数据库全量加载策略的时间间隔。
+	// 启用 Watcher 后全量加载仅作为兜底，可以设置较长的间隔。
+	AutoLoadInterval time.Duration `json:"auto-load-interval" mapstructure:"auto-load-interval"`
+
+	fullPrefix string
+}
+
+// NewAuthzOptions 创建一个带有默认参数的 AuthzOptions 对象。
+func NewAuthzOptions() *AuthzOptions {
+	return &AuthzOptions{
+		Watcher:          false,
+		Channel:          authz.DefaultWatcherChannel,
+		AutoLoadInterval: 10 * time.Second,
+	}
+}
+
+// Validate 验证 AuthzOptions 中的参数是否有效。
+func (o *AuthzOptions) Validate() []error {
+	var errs []error
+
+	if o.AutoLoadInterval <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.auto-load-interval must be greater than 0", o.fullPrefix))
+	}
+	if o.Watcher && o.Channel == "" {
+		errs = append(errs, fmt.Errorf("--%s.channel is required when watcher is enabled", o.fullPrefix))
+	}
+
+	return errs
+}
+
+// AddFlags 将与授权策略同步相关的标志添加到指定的 FlagSet。
+func (o *AuthzOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.BoolVar(&o.Watcher, fullPrefix+".watcher", o.Watcher, "Propagate policy changes between replicas via Redis pub/sub.")
+	fs.StringVar(&o.Channel, fullPrefix+".channel", o.Channel, "Redis channel used to broadcast policy changes.")
+	fs.DurationVar(&o.AutoLoadInterval, fullPrefix+".auto-load-interval", o.AutoLoadInterval, ""+
+		"Interval of full policy reload from database. Acts as a safety net when watcher is enabled.")
+}
@@ -0,0 +1,116 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/pflag"
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/cache"
+)
+
+var _ IOptions = (*CacheOptions)(nil)
+
+// CacheOptions 包含 store 层读缓存相关的配置项。
+type CacheOptions struct {
+	// Enabled 表示是否缓存用户、角色和权限等读多写少的数据。
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// Redis 表示是否使用 Redis 作为二级缓存，并通过 Redis 发布订阅在实例之间失效缓存。
+	// 多副本部署时应开启，否则其他实例的本地缓存最多在 LocalTTL 内返回旧数据。
+	Redis bool `json:"redis" mapstructure:"redis"`
+	// LocalSize 是本地缓存最多保存的缓存项数量。
+	LocalSize int `json:"local-size" mapstructure:"local-size"`
+	// LocalTTL 是本地缓存的过期时间。
+	LocalTTL time.Duration `json:"local-ttl" mapstructure:"local-ttl"`
+	// TTL 是 Redis 缓存的过期时间。
+	TTL time.Duration `json:"ttl" mapstructure:"ttl"`
+	// NegativeTTL 是记录不存在的查询结果的缓存时间。
+	NegativeTTL time.Duration `json:"negative-ttl" mapstructure:"negative-ttl"`
+	// InvalidateDelay 是写入后再次失效缓存的延迟，使用只读副本时应不小于副本允许的最大复制延迟。
+	InvalidateDelay time.Duration `json:"invalidate-delay" mapstructure:"invalidate-delay"`
+	// Prefix 是 Redis 键和失效通知频道的前缀。
+	Prefix string `json:"prefix" mapstructure:"prefix"`
+
+	fullPrefix string
+}
+
+// NewCacheOptions 创建一个带有默认参数的 CacheOptions 对象。
+func NewCacheOptions() *CacheOptions {
+	return &CacheOptions{
+		Enabled:         false,
+		Redis:           false,
+		LocalSize:       cache.DefaultLocalSize,
+		LocalTTL:        cache.DefaultLocalTTL,
+		TTL:             cache.DefaultTTL,
+		NegativeTTL:     cache.DefaultNegativeTTL,
+		InvalidateDelay: cache.DefaultInvalidateDelay,
+		Prefix:          cache.DefaultPrefix,
+	}
+}
+
+// Validate 验证 CacheOptions 中的参数是否有效。
+func (o *CacheOptions) Validate() []error {
+	if !o.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if o.LocalSize <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.local-size must be greater than 0", o.fullPrefix))
+	}
+	if o.LocalTTL <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.local-ttl must be greater than 0", o.fullPrefix))
+	}
+	if o.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.ttl must be greater than 0", o.fullPrefix))
+	}
+	if o.NegativeTTL <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.negative-ttl must be greater than 0", o.fullPrefix))
+	}
+	if o.InvalidateDelay < 0 {
+		errs = append(errs, fmt.Errorf("--%s.invalidate-delay must not be negative", o.fullPrefix))
+	}
+	if o.Redis && o.Prefix == "" {
+		errs = append(errs, fmt.Errorf("--%s.prefix is required when redis is enabled", o.fullPrefix))
+	}
+
+	return errs
+}
+
+// AddFlags 将与读缓存相关的标志添加到指定的 FlagSet。
+func (o *CacheOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.BoolVar(&o.Enabled, fullPrefix+".enabled", o.Enabled, "Cache reads of users, roles and permissions.")
+	fs.BoolVar(&o.Redis, fullPrefix+".redis", o.Redis, ""+
+		"Use Redis as the second cache tier and propagate invalidations between replicas via Redis pub/sub.")
+	fs.IntVar(&o.LocalSize, fullPrefix+".local-size", o.LocalSize, "Maximum number of entries in the in-process cache.")
+	fs.DurationVar(&o.LocalTTL, fullPrefix+".local-ttl", o.LocalTTL, "TTL of in-process cache entries.")
+	fs.DurationVar(&o.TTL, fullPrefix+".ttl", o.TTL, "TTL of Redis cache entries.")
+	fs.DurationVar(&o.NegativeTTL, fullPrefix+".negative-ttl", o.NegativeTTL, "TTL of cached not-found results.")
+	fs.DurationVar(&o.InvalidateDelay, fullPrefix+".invalidate-delay", o.InvalidateDelay, ""+
+		"Delay of the second invalidation after a write. Should be at least the replica max lag when replicas are used. 0 disables it.")
+	fs.StringVar(&o.Prefix, fullPrefix+".prefix", o.Prefix, "Prefix of Redis cache keys and the invalidation channel.")
+}
+
+// NewCache 使用给定的数据库和 Redis 客户端创建缓存，client 为 nil 时只使用本地缓存。
+func (o *CacheOptions) NewCache(ctx context.Context, db *gorm.DB, client redis.UniversalClient) (*cache.Cache, error) {
+	opts := []cache.Option{
+		cache.WithLocalSize(o.LocalSize),
+		cache.WithLocalTTL(o.LocalTTL),
+		cache.WithTTL(o.TTL),
+		cache.WithNegativeTTL(o.NegativeTTL),
+		cache.WithInvalidateDelay(o.InvalidateDelay),
+		cache.WithPrefix(o.Prefix),
+	}
+	if client != nil {
+		opts = append(opts, cache.WithRedis(client))
+	}
+	return cache.New(ctx, db, opts...)
+}
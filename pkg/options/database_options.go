@@ -0,0 +1,50 @@
+package options
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/db"
+)
+
+var _ IOptions = (*DatabaseOptions)(nil)
+
+// DatabaseOptions 包含数据库类型的选择，具体的连接参数分别由 postgresql、mysql 和 sqlite 配置。
+type DatabaseOptions struct {
+	// Driver 是使用的数据库，可选 postgres、mysql 和 sqlite。
+	Driver string `json:"driver" mapstructure:"driver"`
+
+	fullPrefix string
+}
+
+// NewDatabaseOptions 创建一个带有默认参数的 DatabaseOptions 对象。
+func NewDatabaseOptions() *DatabaseOptions {
+	return &DatabaseOptions{
+		Driver: db.DriverPostgres,
+	}
+}
+
+// Validate 验证 DatabaseOptions 中的参数是否有效。
+func (o *DatabaseOptions) Validate() []error {
+	var errs []error
+
+	drivers := []string{db.DriverPostgres, db.DriverMySQL, db.DriverSQLite}
+	if !slices.Contains(drivers, o.Driver) {
+		errs = append(errs, fmt.Errorf("--%s.driver must be one of %v", o.fullPrefix, drivers))
+	}
+
+	return errs
+}
+
+// AddFlags 将与数据库选择相关的标志添加到指定的 FlagSet。
+func (o *DatabaseOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.StringVar(&o.Driver, fullPrefix+".driver", o.Driver, ""+
+		"Database to use: postgres, mysql or sqlite. Connection settings are read from the postgresql, mysql or sqlite options.")
+}
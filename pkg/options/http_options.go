@@ -1,6 +1,8 @@
 package options
 
 import (
+	"fmt"
+	"net"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -18,6 +20,10 @@ type HTTPOptions struct {
 
 	// 服务器超时时间。由 HTTP 客户端使用。
 	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+
+	// 受信任的反向代理 IP 或 CIDR。只有来自这些地址的请求才会使用 X-Forwarded-For 等请求头中的客户端 IP，
+	// 为空时不信任任何代理，客户端 IP 始终取 TCP 连接的对端地址。
+	TrustedProxies []string `json:"trusted-proxies" mapstructure:"trusted-proxies"`
 }
 
 // NewHTTPOptions 创建带有默认参数的 HTTPOptions 对象。
@@ -41,6 +47,12 @@ func (o *HTTPOptions) Validate() []error {
 		errors = append(errors, err)
 	}
 
+	for _, proxy := range o.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			errors = append(errors, fmt.Errorf("invalid trusted proxy %q, must be an IP or CIDR", proxy))
+		}
+	}
+
 	return errors
 }
 
@@ -58,6 +70,8 @@ func (o *HTTPOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
 		"Listen address for the HTTP server (e.g., :8080, 0.0.0.0:8443).")
 	fs.DurationVar(&o.Timeout, fullPrefix+".timeout", o.Timeout,
 		"Timeout for incoming HTTP connections.")
+	fs.StringSliceVar(&o.TrustedProxies, fullPrefix+".trusted-proxies", o.TrustedProxies,
+		"IPs or CIDRs of reverse proxies trusted to set X-Forwarded-For and X-Real-IP. Empty trusts no proxy.")
 }
 
 // Complete 填充未设置且需要具有有效数据的字段。
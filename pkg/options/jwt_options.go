@@ -17,6 +17,8 @@ type JWTOptions struct {
 	AccessExpiration time.Duration `json:"access-expiration" mapstructure:"access-expiration"`
 	// RefreshExpiration 是刷新令牌的过期时间。
 	RefreshExpiration time.Duration `json:"refresh-expiration" mapstructure:"refresh-expiration"`
+	// ImpersonationExpiration 是管理员模拟登录令牌的过期时间，模拟令牌不可刷新。
+	ImpersonationExpiration time.Duration `json:"impersonation-expiration" mapstructure:"impersonation-expiration"`
 
 	fullPrefix string
 }
@@ -29,6 +31,8 @@ func NewJWTOptions() *JWTOptions {
 		Secret:            "",
 		AccessExpiration:  2 * time.Hour,
 		RefreshExpiration: 168 * time.Hour, // 7 days
+
+		ImpersonationExpiration: 15 * time.Minute,
 	}
 }
 
@@ -60,6 +64,9 @@ func (o *JWTOptions) Validate() []error {
 	if o.RefreshExpiration <= 0 {
 		errs = append(errs, fmt.Errorf("--%s.refresh-expiration must be positive", o.fullPrefix))
 	}
+	if o.ImpersonationExpiration <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.impersonation-expiration must be positive", o.fullPrefix))
+	}
 	if o.RefreshExpiration < o.AccessExpiration {
 		errs = append(errs, fmt.Errorf("--%s.refresh-expiration must be greater than or equal to access-expiration", o.fullPrefix))
 	}
@@ -77,4 +84,5 @@ func (o *JWTOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
 	fs.StringVar(&o.Secret, fullPrefix+".secret", o.Secret, "Private key used to sign JWT tokens.")
 	fs.DurationVar(&o.AccessExpiration, fullPrefix+".access-expiration", o.AccessExpiration, "JWT access token expiration time.")
 	fs.DurationVar(&o.RefreshExpiration, fullPrefix+".refresh-expiration", o.RefreshExpiration, "JWT refresh token expiration time.")
+	fs.DurationVar(&o.ImpersonationExpiration, fullPrefix+".impersonation-expiration", o.ImpersonationExpiration, "Expiration time of the non-refreshable token issued when an administrator impersonates a user.")
 }
@@ -352,3 +352,44 @@ func (o *KafkaOptions) Writer() (*kafka.Writer, error) {
 	kafkaWriter := kafka.NewWriter(config)
 	return kafkaWriter, nil
 }
+
+// SyncWriter 创建一个同步写入、未指定 Topic 的 kafka.Writer，写入的消息需要自己指定 Topic.
+// 消息按 Key 的哈希写入分区，Key 相同的消息写入同一分区. 与 Writer 不同，SyncWriter 忽略 writer.async，
+// WriteMessages 返回时消息已经写入 Kafka 或者返回了 kafka.WriteErrors，适用于需要确认每条消息是否写入成功的场景.
+func (o *KafkaOptions) SyncWriter() (*kafka.Writer, error) {
+	tlsConfig, err := o.TLSOptions.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	mechanism, err := o.GetMechanism()
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(o.Brokers...),
+		Balancer:     &kafka.Hash{},
+		MaxAttempts:  o.WriterOptions.MaxAttempts,
+		BatchSize:    o.WriterOptions.BatchSize,
+		BatchBytes:   int64(o.WriterOptions.BatchBytes),
+		BatchTimeout: o.WriterOptions.BatchTimeout,
+		ReadTimeout:  o.Timeout,
+		WriteTimeout: o.Timeout,
+		RequiredAcks: kafka.RequiredAcks(o.WriterOptions.RequiredAcks),
+		Logger:       &logger{4},
+		ErrorLogger:  &logger{1},
+		Transport: &kafka.Transport{
+			DialTimeout: o.Timeout,
+			ClientID:    o.ClientID,
+			TLS:         tlsConfig,
+			SASL:        mechanism,
+		},
+	}
+
+	if o.Compressed {
+		writer.Compression = kafka.Snappy
+	}
+
+	return writer, nil
+}
@@ -0,0 +1,174 @@
+package options
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/ldap"
+)
+
+var _ IOptions = (*LDAPOptions)(nil)
+
+// LDAPOptions 包含 LDAP / Active Directory 认证相关的配置项.
+type LDAPOptions struct {
+	// Enabled 表示是否在本地数据库认证失败后尝试 LDAP 认证.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// URL 是目录服务地址，支持 ldap:// 和 ldaps://.
+	URL string `json:"url" mapstructure:"url"`
+	// StartTLS 表示在 ldap:// 连接上使用 StartTLS.
+	StartTLS bool `json:"start-tls" mapstructure:"start-tls"`
+	// InsecureSkipVerify 表示是否跳过服务端证书校验，仅用于测试环境.
+	InsecureSkipVerify bool `json:"insecure-skip-verify" mapstructure:"insecure-skip-verify"`
+	// CAFile 是用于校验服务端证书的 CA 证书文件，为空时使用系统证书.
+	CAFile string `json:"ca-file" mapstructure:"ca-file"`
+	// BindDN 和 BindPassword 是用于搜索用户的服务账号.
+	BindDN       string `json:"bind-dn" mapstructure:"bind-dn"`
+	BindPassword string `json:"bind-password" mapstructure:"bind-password"`
+	// BaseDN 是搜索用户的起始 DN.
+	BaseDN string `json:"base-dn" mapstructure:"base-dn"`
+	// UserFilter 是搜索用户的过滤条件，%s 会被替换为转义后的用户名.
+	UserFilter string `json:"user-filter" mapstructure:"user-filter"`
+	// UsernameAttribute、EmailAttribute、DisplayNameAttribute 是用户属性名称.
+	UsernameAttribute    string `json:"username-attribute" mapstructure:"username-attribute"`
+	EmailAttribute       string `json:"email-attribute" mapstructure:"email-attribute"`
+	DisplayNameAttribute string `json:"display-name-attribute" mapstructure:"display-name-attribute"`
+	// GroupAttribute 是用户条目上表示所属组的属性，例如 memberOf.
+	GroupAttribute string `json:"group-attribute" mapstructure:"group-attribute"`
+	// GroupBaseDN 和 GroupFilter 用于搜索用户所属的组，GroupFilter 中的 %s 会被替换为用户 DN.
+	GroupBaseDN string `json:"group-base-dn" mapstructure:"group-base-dn"`
+	GroupFilter string `json:"group-filter" mapstructure:"group-filter"`
+	// GroupRoles 是组 DN 到角色编码（RoleM.RoleCode）的映射，组 DN 大小写不敏感.
+	// 每次登录都会按用户当前所在的组同步这些角色，不在映射中的角色不受影响.
+	GroupRoles map[string]string `json:"group-roles" mapstructure:"group-roles"`
+	// AllowJIT 表示目录用户首次登录时是否自动创建本地用户.
+	AllowJIT bool `json:"allow-jit" mapstructure:"allow-jit"`
+	// LinkByUsername 表示目录用户首次登录时是否按用户名关联到已有的本地用户.
+	// 只有在本地用户名与目录用户名属于同一批人员时才应开启，否则目录账号可以接管同名的本地账号.
+	LinkByUsername bool `json:"link-by-username" mapstructure:"link-by-username"`
+	// Timeout 是连接和单次请求的超时时间.
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+
+	fullPrefix string
+}
+
+// NewLDAPOptions 创建一个带有默认参数的 LDAPOptions 对象.
+func NewLDAPOptions() *LDAPOptions {
+	return &LDAPOptions{
+		UserFilter:           "(uid=%s)",
+		UsernameAttribute:    "uid",
+		EmailAttribute:       "mail",
+		DisplayNameAttribute: "cn",
+		GroupAttribute:       "memberOf",
+		AllowJIT:             true,
+		Timeout:              10 * time.Second,
+	}
+}
+
+// Validate 验证 LDAPOptions 中的参数是否有效.
+func (o *LDAPOptions) Validate() []error {
+	if !o.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	u, err := url.Parse(o.URL)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("--%s.url must be an ldap:// or ldaps:// URL", o.fullPrefix))
+	} else if u.Scheme == "ldaps" && o.StartTLS {
+		errs = append(errs, fmt.Errorf("--%s.start-tls cannot be used with ldaps://", o.fullPrefix))
+	}
+	if o.BaseDN == "" {
+		errs = append(errs, fmt.Errorf("--%s.base-dn must be specified", o.fullPrefix))
+	}
+	if strings.Count(o.UserFilter, "%s") != 1 {
+		errs = append(errs, fmt.Errorf("--%s.user-filter must contain exactly one %%s", o.fullPrefix))
+	}
+	if o.GroupFilter != "" && strings.Count(o.GroupFilter, "%s") != 1 {
+		errs = append(errs, fmt.Errorf("--%s.group-filter must contain exactly one %%s", o.fullPrefix))
+	}
+	if o.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.timeout must be positive", o.fullPrefix))
+	}
+
+	return errs
+}
+
+// AddFlags 将与 LDAP 配置相关的标志添加到指定的 FlagSet. 组到角色的映射只能通过配置文件设置.
+func (o *LDAPOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.BoolVar(&o.Enabled, fullPrefix+".enabled", o.Enabled, "Enable LDAP authentication after local authentication fails.")
+	fs.StringVar(&o.URL, fullPrefix+".url", o.URL, "LDAP server URL, e.g. ldap://ldap.example.com:389 or ldaps://ldap.example.com:636.")
+	fs.BoolVar(&o.StartTLS, fullPrefix+".start-tls", o.StartTLS, "Upgrade ldap:// connections with StartTLS.")
+	fs.BoolVar(&o.InsecureSkipVerify, fullPrefix+".insecure-skip-verify", o.InsecureSkipVerify, "Skip LDAP server certificate verification.")
+	fs.StringVar(&o.CAFile, fullPrefix+".ca-file", o.CAFile, "CA certificate file used to verify the LDAP server.")
+	fs.StringVar(&o.BindDN, fullPrefix+".bind-dn", o.BindDN, "DN of the service account used to search users.")
+	fs.StringVar(&o.BindPassword, fullPrefix+".bind-password", o.BindPassword, "Password of the service account used to search users.")
+	fs.StringVar(&o.BaseDN, fullPrefix+".base-dn", o.BaseDN, "Base DN to search users from.")
+	fs.StringVar(&o.UserFilter, fullPrefix+".user-filter", o.UserFilter, "Filter used to search users, %s is replaced with the escaped username.")
+	fs.StringVar(&o.UsernameAttribute, fullPrefix+".username-attribute", o.UsernameAttribute, "Attribute holding the username.")
+	fs.StringVar(&o.EmailAttribute, fullPrefix+".email-attribute", o.EmailAttribute, "Attribute holding the email address.")
+	fs.StringVar(&o.DisplayNameAttribute, fullPrefix+".display-name-attribute", o.DisplayNameAttribute, "Attribute holding the display name.")
+	fs.StringVar(&o.GroupAttribute, fullPrefix+".group-attribute", o.GroupAttribute, "Attribute on the user entry listing group DNs.")
+	fs.StringVar(&o.GroupBaseDN, fullPrefix+".group-base-dn", o.GroupBaseDN, "Base DN to search groups from.")
+	fs.StringVar(&o.GroupFilter, fullPrefix+".group-filter", o.GroupFilter, "Filter used to search groups of a user, %s is replaced with the escaped user DN.")
+	fs.BoolVar(&o.AllowJIT, fullPrefix+".allow-jit", o.AllowJIT, "Create local users for directory users on first login.")
+	fs.BoolVar(&o.LinkByUsername, fullPrefix+".link-by-username", o.LinkByUsername, "Link directory users to existing local users with the same username.")
+	fs.DurationVar(&o.Timeout, fullPrefix+".timeout", o.Timeout, "Timeout of LDAP connections and requests.")
+}
+
+// GroupRoleMapping 返回组 DN 统一为小写后的组到角色映射.
+func (o *LDAPOptions) GroupRoleMapping() map[string]string {
+	mapping := make(map[string]string, len(o.GroupRoles))
+	for group, role := range o.GroupRoles {
+		mapping[strings.ToLower(group)] = role
+	}
+	return mapping
+}
+
+// NewClient 根据配置创建 LDAP 认证客户端.
+func (o *LDAPOptions) NewClient() (*ldap.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: o.InsecureSkipVerify, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+	if o.CAFile != "" {
+		pem, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read LDAP CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in LDAP CA file %s", o.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return ldap.New(ldap.Config{
+		URL:                  o.URL,
+		StartTLS:             o.StartTLS,
+		TLSConfig:            tlsConfig,
+		BindDN:               o.BindDN,
+		BindPassword:         o.BindPassword,
+		BaseDN:               o.BaseDN,
+		UserFilter:           o.UserFilter,
+		UsernameAttribute:    o.UsernameAttribute,
+		EmailAttribute:       o.EmailAttribute,
+		DisplayNameAttribute: o.DisplayNameAttribute,
+		GroupAttribute:       o.GroupAttribute,
+		GroupBaseDN:          o.GroupBaseDN,
+		GroupFilter:          o.GroupFilter,
+		Timeout:              o.Timeout,
+	}), nil
+}
@@ -0,0 +1,58 @@
+package options
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+)
+
+var _ IOptions = (*MigrationOptions)(nil)
+
+const (
+	// MigrationModeAuto 表示启动时自动执行待执行的迁移
+	MigrationModeAuto = "auto"
+	// MigrationModeVerify 表示启动时只检查迁移是否已全部执行，存在待执行的迁移时拒绝启动
+	MigrationModeVerify = "verify"
+	// MigrationModeOff 表示启动时不检查数据库结构
+	MigrationModeOff = "off"
+)
+
+// MigrationOptions 包含数据库迁移相关的配置项。
+type MigrationOptions struct {
+	// Mode 是服务启动时对数据库迁移的处理方式，可选 auto、verify 和 off。
+	Mode string `json:"mode" mapstructure:"mode"`
+
+	fullPrefix string
+}
+
+// NewMigrationOptions 创建一个带有默认参数的 MigrationOptions 对象。
+func NewMigrationOptions() *MigrationOptions {
+	return &MigrationOptions{
+		Mode: MigrationModeAuto,
+	}
+}
+
+// Validate 验证 MigrationOptions 中的参数是否有效。
+func (o *MigrationOptions) Validate() []error {
+	var errs []error
+
+	modes := []string{MigrationModeAuto, MigrationModeVerify, MigrationModeOff}
+	if !slices.Contains(modes, o.Mode) {
+		errs = append(errs, fmt.Errorf("--%s.mode must be one of %v", o.fullPrefix, modes))
+	}
+
+	return errs
+}
+
+// AddFlags 将与数据库迁移相关的标志添加到指定的 FlagSet。
+func (o *MigrationOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.StringVar(&o.Mode, fullPrefix+".mode", o.Mode, ""+
+		"How database migrations are handled on startup: auto applies pending migrations, "+
+		"verify refuses to start if any migration is pending, off skips the check.")
+}
@@ -0,0 +1,133 @@
+package options
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/oidc"
+)
+
+var _ IOptions = (*OIDCOptions)(nil)
+
+// providerNameRegex 限制 Provider 名称只能包含小写字母、数字、中划线和下划线，便于出现在 URL 中.
+var providerNameRegex = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// OIDCProviderOptions 是单个 OIDC Provider 的配置项.
+type OIDCProviderOptions struct {
+	// Name 是 Provider 的唯一名称，出现在登录地址中，例如 /v1/auth/oidc/{name}/login.
+	Name string `json:"name" mapstructure:"name"`
+	// DisplayName 是展示给用户的名称.
+	DisplayName string `json:"display-name" mapstructure:"display-name"`
+	// Issuer 是 Provider 的 issuer 地址.
+	Issuer string `json:"issuer" mapstructure:"issuer"`
+	// ClientID 是在 Provider 注册的客户端 ID.
+	ClientID string `json:"client-id" mapstructure:"client-id"`
+	// ClientSecret 是在 Provider 注册的客户端密钥.
+	ClientSecret string `json:"client-secret" mapstructure:"client-secret"`
+	// RedirectURL 是授权完成后的回调地址，需要指向 /v1/auth/oidc/callback 或转发到该接口的前端页面.
+	RedirectURL string `json:"redirect-url" mapstructure:"redirect-url"`
+	// Scopes 是额外申请的权限范围，openid 会被自动添加.
+	Scopes []string `json:"scopes" mapstructure:"scopes"`
+	// GroupsClaim 是 ID Token 中表示用户组的声明名称.
+	GroupsClaim string `json:"groups-claim" mapstructure:"groups-claim"`
+	// GroupRoles 是 IdP 用户组到角色编码（RoleM.RoleCode）的映射.
+	// 每次登录都会按用户当前所在的组同步这些角色，不在映射中的角色不受影响.
+	GroupRoles map[string]string `json:"group-roles" mapstructure:"group-roles"`
+	// AllowJIT 表示首次登录且无法关联到已有用户时是否自动创建用户.
+	AllowJIT bool `json:"allow-jit" mapstructure:"allow-jit"`
+	// LinkByEmail 表示是否按已验证的邮箱自动关联到已有用户.
+	// 只有在信任该 Provider 的邮箱验证时才应开启，否则可能导致账号被接管.
+	LinkByEmail bool `json:"link-by-email" mapstructure:"link-by-email"`
+}
+
+// OIDCOptions 包含 OIDC 单点登录相关的配置项.
+type OIDCOptions struct {
+	// Providers 是已配置的 OIDC Provider 列表，为空表示不启用单点登录.
+	Providers []OIDCProviderOptions `json:"providers" mapstructure:"providers"`
+	// StateTTL 是一次登录流程（从获取授权地址到回调）的最长时间.
+	StateTTL time.Duration `json:"state-ttl" mapstructure:"state-ttl"`
+
+	fullPrefix string
+}
+
+// NewOIDCOptions 创建一个带有默认参数的 OIDCOptions 对象.
+func NewOIDCOptions() *OIDCOptions {
+	return &OIDCOptions{
+		StateTTL: 10 * time.Minute,
+	}
+}
+
+// Validate 验证 OIDCOptions 中的参数是否有效.
+func (o *OIDCOptions) Validate() []error {
+	var errs []error
+
+	if o.StateTTL <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.state-ttl must be positive", o.fullPrefix))
+	}
+
+	names := make(map[string]bool, len(o.Providers))
+	for i, provider := range o.Providers {
+		if !providerNameRegex.MatchString(provider.Name) {
+			errs = append(errs, fmt.Errorf("%s.providers[%d].name must match %s", o.fullPrefix, i, providerNameRegex))
+		}
+		if provider.Name == "ldap" {
+			errs = append(errs, fmt.Errorf("%s.providers[%d].name %q is reserved for LDAP authentication", o.fullPrefix, i, provider.Name))
+		}
+		if names[provider.Name] {
+			errs = append(errs, fmt.Errorf("%s.providers[%d].name %q is duplicated", o.fullPrefix, i, provider.Name))
+		}
+		names[provider.Name] = true
+
+		if u, err := url.Parse(provider.Issuer); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("%s.providers[%d].issuer must be an absolute URL", o.fullPrefix, i))
+		}
+		if provider.ClientID == "" {
+			errs = append(errs, fmt.Errorf("%s.providers[%d].client-id must be specified", o.fullPrefix, i))
+		}
+		if u, err := url.Parse(provider.RedirectURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("%s.providers[%d].redirect-url must be an absolute URL", o.fullPrefix, i))
+		}
+	}
+
+	return errs
+}
+
+// AddFlags 将与 OIDC 配置相关的标志添加到指定的 FlagSet. Provider 列表只能通过配置文件设置.
+func (o *OIDCOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.DurationVar(&o.StateTTL, fullPrefix+".state-ttl", o.StateTTL, "Maximum duration of an OIDC login flow.")
+}
+
+// Provider 根据名称获取 Provider 配置.
+func (o *OIDCOptions) Provider(name string) (*OIDCProviderOptions, bool) {
+	for i := range o.Providers {
+		if o.Providers[i].Name == name {
+			return &o.Providers[i], true
+		}
+	}
+	return nil, false
+}
+
+// NewRegistry 根据配置创建 OIDC Provider 注册表.
+func (o *OIDCOptions) NewRegistry() *oidc.Registry {
+	configs := make(map[string]oidc.Config, len(o.Providers))
+	for _, provider := range o.Providers {
+		configs[provider.Name] = oidc.Config{
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+			GroupsClaim:  provider.GroupsClaim,
+		}
+	}
+	return oidc.NewRegistry(configs)
+}
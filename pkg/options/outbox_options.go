@@ -0,0 +1,125 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/outbox"
+)
+
+var _ IOptions = (*OutboxOptions)(nil)
+
+// OutboxOptions 包含领域事件发件箱（outbox）及其 Kafka 发布相关的配置项。
+type OutboxOptions struct {
+	// Enabled 表示是否记录领域事件并发布到 Kafka。开启后需要配置 Kafka 选项。
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// Topic 是发布领域事件的 Kafka Topic。
+	Topic string `json:"topic" mapstructure:"topic"`
+	// DeadLetterTopic 是超过最大发布次数的事件写入的 Kafka Topic，为空时这些事件会一直按最大退避时间重试。
+	DeadLetterTopic string `json:"dead-letter-topic" mapstructure:"dead-letter-topic"`
+	// BatchSize 是每次从 outbox 读取的最大事件数。
+	BatchSize int `json:"batch-size" mapstructure:"batch-size"`
+	// PollInterval 是没有待发布事件时检查 outbox 的时间间隔。
+	PollInterval time.Duration `json:"poll-interval" mapstructure:"poll-interval"`
+	// MaxAttempts 是事件写入死信 Topic 之前的最大发布次数。
+	MaxAttempts int `json:"max-attempts" mapstructure:"max-attempts"`
+	// MinBackoff 和 MaxBackoff 是发布失败后重试的最短和最长退避时间。
+	MinBackoff time.Duration `json:"min-backoff" mapstructure:"min-backoff"`
+	MaxBackoff time.Duration `json:"max-backoff" mapstructure:"max-backoff"`
+	// Retention 是已发布和死信事件在 outbox 中的保留时长，设置为 0 表示不清理。
+	Retention time.Duration `json:"retention" mapstructure:"retention"`
+	// LeaseTTL 是发布租约的有效期，多个实例中同一时间只有持有租约的实例发布事件。
+	LeaseTTL time.Duration `json:"lease-ttl" mapstructure:"lease-ttl"`
+
+	fullPrefix string
+}
+
+// NewOutboxOptions 创建一个带有默认参数的 OutboxOptions 对象。
+func NewOutboxOptions() *OutboxOptions {
+	return &OutboxOptions{
+		Enabled:         false,
+		Topic:           "gin-enterprise-template.events",
+		DeadLetterTopic: "gin-enterprise-template.events.dlq",
+		BatchSize:       outbox.DefaultBatchSize,
+		PollInterval:    outbox.DefaultInterval,
+		MaxAttempts:     outbox.DefaultMaxAttempts,
+		MinBackoff:      outbox.DefaultMinBackoff,
+		MaxBackoff:      outbox.DefaultMaxBackoff,
+		Retention:       outbox.DefaultRetention,
+		LeaseTTL:        outbox.DefaultLeaseTTL,
+	}
+}
+
+// Validate 验证 OutboxOptions 中的参数是否有效。
+func (o *OutboxOptions) Validate() []error {
+	if !o.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if o.Topic == "" {
+		errs = append(errs, fmt.Errorf("--%s.topic is required when outbox is enabled", o.fullPrefix))
+	}
+	if o.DeadLetterTopic != "" && o.DeadLetterTopic == o.Topic {
+		errs = append(errs, fmt.Errorf("--%s.dead-letter-topic must differ from --%s.topic", o.fullPrefix, o.fullPrefix))
+	}
+	if o.BatchSize <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.batch-size must be greater than 0", o.fullPrefix))
+	}
+	if o.PollInterval <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.poll-interval must be greater than 0", o.fullPrefix))
+	}
+	if o.MaxAttempts <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.max-attempts must be greater than 0", o.fullPrefix))
+	}
+	if o.MinBackoff <= 0 || o.MaxBackoff < o.MinBackoff {
+		errs = append(errs, fmt.Errorf("--%s.min-backoff must be greater than 0 and not greater than --%s.max-backoff",
+			o.fullPrefix, o.fullPrefix))
+	}
+	if o.Retention < 0 {
+		errs = append(errs, fmt.Errorf("--%s.retention must not be negative", o.fullPrefix))
+	}
+	// 持有租约的实例需要在租约过期前续期
+	if o.LeaseTTL <= o.PollInterval {
+		errs = append(errs, fmt.Errorf("--%s.lease-ttl must be greater than --%s.poll-interval", o.fullPrefix, o.fullPrefix))
+	}
+
+	return errs
+}
+
+// AddFlags 将与 outbox 相关的标志添加到指定的 FlagSet。
+func (o *OutboxOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.BoolVar(&o.Enabled, fullPrefix+".enabled", o.Enabled, "Record domain events in the outbox and publish them to Kafka.")
+	fs.StringVar(&o.Topic, fullPrefix+".topic", o.Topic, "Kafka topic domain events are published to.")
+	fs.StringVar(&o.DeadLetterTopic, fullPrefix+".dead-letter-topic", o.DeadLetterTopic, ""+
+		"Kafka topic events are moved to after max-attempts failures. Empty keeps retrying them.")
+	fs.IntVar(&o.BatchSize, fullPrefix+".batch-size", o.BatchSize, "Maximum number of events read from the outbox at a time.")
+	fs.DurationVar(&o.PollInterval, fullPrefix+".poll-interval", o.PollInterval, "Interval of polling the outbox when it is drained.")
+	fs.IntVar(&o.MaxAttempts, fullPrefix+".max-attempts", o.MaxAttempts, "Maximum publish attempts before an event is dead-lettered.")
+	fs.DurationVar(&o.MinBackoff, fullPrefix+".min-backoff", o.MinBackoff, "Backoff after the first failed publish, doubled on each failure.")
+	fs.DurationVar(&o.MaxBackoff, fullPrefix+".max-backoff", o.MaxBackoff, "Maximum backoff between publish attempts.")
+	fs.DurationVar(&o.Retention, fullPrefix+".retention", o.Retention, ""+
+		"How long published and dead-lettered events are kept in the outbox. 0 disables purging.")
+	fs.DurationVar(&o.LeaseTTL, fullPrefix+".lease-ttl", o.LeaseTTL, ""+
+		"TTL of the publishing lease. Only the instance holding the lease publishes events.")
+}
+
+// NewRelay 使用给定的 outbox 存储和 Kafka writer 创建 Relay。
+func (o *OutboxOptions) NewRelay(store outbox.Store, writer outbox.Writer) *outbox.Relay {
+	return outbox.NewRelay(store, writer, o.Topic,
+		outbox.WithDeadLetterTopic(o.DeadLetterTopic),
+		outbox.WithBatchSize(o.BatchSize),
+		outbox.WithInterval(o.PollInterval),
+		outbox.WithMaxAttempts(o.MaxAttempts),
+		outbox.WithBackoff(o.MinBackoff, o.MaxBackoff),
+		outbox.WithRetention(o.Retention),
+		outbox.WithLeaseTTL(o.LeaseTTL),
+	)
+}
@@ -0,0 +1,129 @@
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/clin211/gin-enterprise-template/pkg/authn"
+)
+
+var _ IOptions = (*PasswordOptions)(nil)
+
+// PasswordOptions 包含密码哈希相关的配置项。
+type PasswordOptions struct {
+	// Algorithm 是新密码使用的哈希算法，可选值为 bcrypt、argon2id。
+	// 使用其他算法或过时参数生成的已有哈希会在用户下次登录时自动重新哈希。
+	Algorithm string `json:"algorithm" mapstructure:"algorithm"`
+	// BcryptCost 是 bcrypt 的 cost 参数。
+	BcryptCost int `json:"bcrypt-cost" mapstructure:"bcrypt-cost"`
+	// Argon2Memory 是 argon2id 使用的内存大小，单位为 KiB。
+	Argon2Memory uint32 `json:"argon2-memory" mapstructure:"argon2-memory"`
+	// Argon2Iterations 是 argon2id 的迭代次数。
+	Argon2Iterations uint32 `json:"argon2-iterations" mapstructure:"argon2-iterations"`
+	// Argon2Parallelism 是 argon2id 的并行度。
+	Argon2Parallelism uint8 `json:"argon2-parallelism" mapstructure:"argon2-parallelism"`
+	// MaxBcryptCost 是校验已有 bcrypt 哈希时允许的最大 cost，超过上限的哈希值无法通过校验。
+	MaxBcryptCost int `json:"max-bcrypt-cost" mapstructure:"max-bcrypt-cost"`
+	// MaxArgon2Memory 是校验已有 argon2id 哈希时允许的最大内存，单位为 KiB。
+	MaxArgon2Memory uint32 `json:"max-argon2-memory" mapstructure:"max-argon2-memory"`
+	// MaxArgon2Iterations 是校验已有 argon2id 哈希时允许的最大迭代次数。
+	MaxArgon2Iterations uint32 `json:"max-argon2-iterations" mapstructure:"max-argon2-iterations"`
+	// MaxArgon2Parallelism 是校验已有 argon2id 哈希时允许的最大并行度。
+	MaxArgon2Parallelism uint8 `json:"max-argon2-parallelism" mapstructure:"max-argon2-parallelism"`
+
+	fullPrefix string
+}
+
+// NewPasswordOptions 创建一个带有默认参数的 PasswordOptions 对象。
+func NewPasswordOptions() *PasswordOptions {
+	argon2 := authn.DefaultArgon2Params()
+	limits := authn.DefaultHashLimits()
+	return &PasswordOptions{
+		Algorithm:            authn.AlgorithmArgon2id,
+		BcryptCost:           bcrypt.DefaultCost,
+		Argon2Memory:         argon2.Memory,
+		Argon2Iterations:     argon2.Iterations,
+		Argon2Parallelism:    argon2.Parallelism,
+		MaxBcryptCost:        limits.BcryptCost,
+		MaxArgon2Memory:      limits.Argon2Memory,
+		MaxArgon2Iterations:  limits.Argon2Iterations,
+		MaxArgon2Parallelism: limits.Argon2Parallelism,
+	}
+}
+
+// Validate 验证 PasswordOptions 中的参数是否有效。
+func (o *PasswordOptions) Validate() []error {
+	var errs []error
+
+	switch o.Algorithm {
+	case authn.AlgorithmBcrypt:
+		if o.BcryptCost < bcrypt.MinCost || o.BcryptCost > o.MaxBcryptCost {
+			errs = append(errs, fmt.Errorf("--%s.bcrypt-cost must be between %d and --%s.max-bcrypt-cost (%d)", o.fullPrefix, bcrypt.MinCost, o.fullPrefix, o.MaxBcryptCost))
+		}
+	case authn.AlgorithmArgon2id:
+		if o.Argon2Iterations < 1 {
+			errs = append(errs, fmt.Errorf("--%s.argon2-iterations must be at least 1", o.fullPrefix))
+		}
+		if o.Argon2Parallelism < 1 {
+			errs = append(errs, fmt.Errorf("--%s.argon2-parallelism must be at least 1", o.fullPrefix))
+		}
+		if o.Argon2Memory < 8*uint32(o.Argon2Parallelism) {
+			errs = append(errs, fmt.Errorf("--%s.argon2-memory must be at least 8*parallelism KiB", o.fullPrefix))
+		}
+		// 新生成的哈希必须能够通过校验
+		if o.Argon2Memory > o.MaxArgon2Memory || o.Argon2Iterations > o.MaxArgon2Iterations || o.Argon2Parallelism > o.MaxArgon2Parallelism {
+			errs = append(errs, fmt.Errorf("--%s.argon2-memory, argon2-iterations and argon2-parallelism must not exceed the corresponding max-argon2-* limits", o.fullPrefix))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("--%s.algorithm must be one of [bcrypt argon2id], got %q", o.fullPrefix, o.Algorithm))
+	}
+
+	if o.MaxBcryptCost < bcrypt.MinCost || o.MaxBcryptCost > bcrypt.MaxCost {
+		errs = append(errs, fmt.Errorf("--%s.max-bcrypt-cost must be between %d and %d", o.fullPrefix, bcrypt.MinCost, bcrypt.MaxCost))
+	}
+
+	return errs
+}
+
+// AddFlags 将与密码哈希配置相关的标志添加到指定的 FlagSet。
+func (o *PasswordOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.StringVar(&o.Algorithm, fullPrefix+".algorithm", o.Algorithm, "Password hashing algorithm for new hashes. Options: bcrypt, argon2id.")
+	fs.IntVar(&o.BcryptCost, fullPrefix+".bcrypt-cost", o.BcryptCost, "Cost parameter of bcrypt.")
+	fs.Uint32Var(&o.Argon2Memory, fullPrefix+".argon2-memory", o.Argon2Memory, "Memory used by argon2id, in KiB.")
+	fs.Uint32Var(&o.Argon2Iterations, fullPrefix+".argon2-iterations", o.Argon2Iterations, "Number of iterations of argon2id.")
+	fs.Uint8Var(&o.Argon2Parallelism, fullPrefix+".argon2-parallelism", o.Argon2Parallelism, "Degree of parallelism of argon2id.")
+	fs.IntVar(&o.MaxBcryptCost, fullPrefix+".max-bcrypt-cost", o.MaxBcryptCost, "Maximum cost of existing bcrypt hashes accepted when verifying passwords.")
+	fs.Uint32Var(&o.MaxArgon2Memory, fullPrefix+".max-argon2-memory", o.MaxArgon2Memory, "Maximum memory of existing argon2id hashes accepted when verifying passwords, in KiB.")
+	fs.Uint32Var(&o.MaxArgon2Iterations, fullPrefix+".max-argon2-iterations", o.MaxArgon2Iterations, "Maximum iterations of existing argon2id hashes accepted when verifying passwords.")
+	fs.Uint8Var(&o.MaxArgon2Parallelism, fullPrefix+".max-argon2-parallelism", o.MaxArgon2Parallelism, "Maximum parallelism of existing argon2id hashes accepted when verifying passwords.")
+}
+
+// NewHasher 根据配置创建密码哈希器。
+func (o *PasswordOptions) NewHasher() authn.PasswordHasher {
+	if o.Algorithm == authn.AlgorithmBcrypt {
+		return authn.NewBcryptHasher(o.BcryptCost)
+	}
+
+	params := authn.DefaultArgon2Params()
+	params.Memory = o.Argon2Memory
+	params.Iterations = o.Argon2Iterations
+	params.Parallelism = o.Argon2Parallelism
+	return authn.NewArgon2idHasher(params)
+}
+
+// HashLimits 返回校验已有哈希值时允许的最大参数。
+func (o *PasswordOptions) HashLimits() authn.HashLimits {
+	return authn.HashLimits{
+		BcryptCost:        o.MaxBcryptCost,
+		Argon2Memory:      o.MaxArgon2Memory,
+		Argon2Iterations:  o.MaxArgon2Iterations,
+		Argon2Parallelism: o.MaxArgon2Parallelism,
+	}
+}
@@ -24,19 +24,30 @@ type PostgreSQLOptions struct {
 	MaxOpenConnections    int           `json:"max-open-connections,omitempty" mapstructure:"max-open-connections"`
 	MaxConnectionLifeTime time.Duration `json:"max-connection-life-time,omitempty" mapstructure:"max-connection-life-time"`
 	LogLevel              int           `json:"log-level" mapstructure:"log-level"`
+	// Replicas 是只读副本地址，读请求路由到副本，写请求和事务使用主库
+	Replicas []string `json:"replicas,omitempty" mapstructure:"replicas"`
+	// ReplicaMaxLag 是副本允许的最大复制延迟，超过后暂时剔除
+	ReplicaMaxLag time.Duration `json:"replica-max-lag,omitempty" mapstructure:"replica-max-lag"`
+	// ReplicaHealthCheckInterval 是副本健康检查的间隔
+	ReplicaHealthCheckInterval time.Duration `json:"replica-health-check-interval,omitempty" mapstructure:"replica-health-check-interval"`
+	// ReadYourWritesWindow 是写入后同一调用方的读请求继续使用主库的时长
+	ReadYourWritesWindow time.Duration `json:"read-your-writes-window,omitempty" mapstructure:"read-your-writes-window"`
 }
 
 // NewPostgreSQLOptions 创建一个`零值`实例。
 func NewPostgreSQLOptions() *PostgreSQLOptions {
 	return &PostgreSQLOptions{
-		Addr:                  "127.0.0.1:5432",
-		Username:              "onex",
-		Password:              "onex(#)666",
-		Database:              "onex",
-		MaxIdleConnections:    100,
-		MaxOpenConnections:    100,
-		MaxConnectionLifeTime: time.Duration(10) * time.Second,
-		LogLevel:              1, // Silent
+		Addr:                       "127.0.0.1:5432",
+		Username:                   "onex",
+		Password:                   "onex(#)666",
+		Database:                   "onex",
+		MaxIdleConnections:         100,
+		MaxOpenConnections:         100,
+		MaxConnectionLifeTime:      time.Duration(10) * time.Second,
+		LogLevel:                   1, // Silent
+		ReplicaMaxLag:              5 * time.Second,
+		ReplicaHealthCheckInterval: 5 * time.Second,
+		ReadYourWritesWindow:       5 * time.Second,
 	}
 }
 
@@ -52,6 +63,16 @@ func (o *PostgreSQLOptions) Validate() []error {
 		))
 	}
 
+	if len(o.Replicas) > 0 {
+		if o.ReplicaHealthCheckInterval <= 0 {
+			errs = append(errs, fmt.Errorf("postgresql.replica-health-check-interval must be greater than 0"))
+		}
+		// 窗口小于允许的最大延迟时，写入后可能从副本读到旧数据
+		if o.ReadYourWritesWindow < o.ReplicaMaxLag {
+			errs = append(errs, fmt.Errorf("postgresql.read-your-writes-window must not be less than postgresql.replica-max-lag"))
+		}
+	}
+
 	return errs
 }
 
@@ -72,19 +93,30 @@ func (o *PostgreSQLOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
 		"Maximum connection life time allowed to connect to postgresql.")
 	fs.IntVar(&o.LogLevel, fullPrefix+".log-mode", o.LogLevel, ""+
 		"Specify gorm log level.")
+	fs.StringSliceVar(&o.Replicas, fullPrefix+".replicas", o.Replicas, ""+
+		"Addresses of postgresql read replicas. Reads are routed to healthy replicas, writes and transactions to the primary.")
+	fs.DurationVar(&o.ReplicaMaxLag, fullPrefix+".replica-max-lag", o.ReplicaMaxLag, ""+
+		"Maximum replication lag of a replica before it is ejected from read routing.")
+	fs.DurationVar(&o.ReplicaHealthCheckInterval, fullPrefix+".replica-health-check-interval", o.ReplicaHealthCheckInterval, ""+
+		"Interval between replica health checks.")
+	fs.DurationVar(&o.ReadYourWritesWindow, fullPrefix+".read-your-writes-window", o.ReadYourWritesWindow, ""+
+		"How long reads of a caller are routed to the primary after it writes. Must not be less than the replica max lag.")
 }
 
 // NewDB 使用给定配置创建 postgresql 存储。
 func (o *PostgreSQLOptions) NewDB() (*gorm.DB, error) {
 	opts := &db.PostgreSQLOptions{
-		Addr:                  o.Addr,
-		Username:              o.Username,
-		Password:              o.Password,
-		Database:              o.Database,
-		MaxIdleConnections:    o.MaxIdleConnections,
-		MaxOpenConnections:    o.MaxOpenConnections,
-		MaxConnectionLifeTime: o.MaxConnectionLifeTime,
-		Logger:                gormlogger.New(slog.Default()),
+		Addr:                       o.Addr,
+		Username:                   o.Username,
+		Password:                   o.Password,
+		Database:                   o.Database,
+		MaxIdleConnections:         o.MaxIdleConnections,
+		MaxOpenConnections:         o.MaxOpenConnections,
+		MaxConnectionLifeTime:      o.MaxConnectionLifeTime,
+		Replicas:                   o.Replicas,
+		ReplicaMaxLag:              o.ReplicaMaxLag,
+		ReplicaHealthCheckInterval: o.ReplicaHealthCheckInterval,
+		Logger:                     gormlogger.New(slog.Default()),
 	}
 
 	return db.NewPostgreSQL(opts)
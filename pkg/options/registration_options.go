@@ -0,0 +1,59 @@
+package options
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+)
+
+var _ IOptions = (*RegistrationOptions)(nil)
+
+// 定义支持的注册模式。
+const (
+	// RegistrationModeOpen 表示开放注册，任何人都可以直接注册并立即生效。
+	RegistrationModeOpen = "open"
+	// RegistrationModeClosed 表示关闭注册，只能由管理员创建用户。
+	RegistrationModeClosed = "closed"
+	// RegistrationModeInvite 表示仅允许持有效邀请码注册。
+	RegistrationModeInvite = "invite"
+	// RegistrationModeApproval 表示注册后需要管理员审核通过才能登录，持有效邀请码注册的用户免审核。
+	RegistrationModeApproval = "approval"
+)
+
+// RegistrationOptions 包含用户自助注册相关的配置项。
+type RegistrationOptions struct {
+	// Mode 是注册模式，可选值为 open、closed、invite、approval。
+	Mode string `json:"mode" mapstructure:"mode"`
+
+	fullPrefix string
+}
+
+// NewRegistrationOptions 创建一个带有默认参数的 RegistrationOptions 对象。
+func NewRegistrationOptions() *RegistrationOptions {
+	return &RegistrationOptions{
+		Mode: RegistrationModeOpen,
+	}
+}
+
+// Validate 验证 RegistrationOptions 中的参数是否有效。
+func (o *RegistrationOptions) Validate() []error {
+	var errs []error
+
+	modes := []string{RegistrationModeOpen, RegistrationModeClosed, RegistrationModeInvite, RegistrationModeApproval}
+	if !slices.Contains(modes, o.Mode) {
+		errs = append(errs, fmt.Errorf("--%s.mode must be one of %v, got %q", o.fullPrefix, modes, o.Mode))
+	}
+
+	return errs
+}
+
+// AddFlags 将与注册配置相关的标志添加到指定的 FlagSet。
+func (o *RegistrationOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.StringVar(&o.Mode, fullPrefix+".mode", o.Mode, "User self-registration mode. Options: open, closed, invite, approval.")
+}
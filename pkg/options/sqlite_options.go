@@ -0,0 +1,45 @@
+package options
+
+import (
+	"log/slog"
+
+	"github.com/spf13/pflag"
+	"gorm.io/gorm"
+
+	"github.com/clin211/gin-enterprise-template/pkg/db"
+	gormlogger "github.com/clin211/gin-enterprise-template/pkg/logger/slog/gorm"
+)
+
+var _ IOptions = (*SQLiteOptions)(nil)
+
+// SQLiteOptions 定义 sqlite 数据库的选项。
+type SQLiteOptions struct {
+	// Path 是数据库文件路径，":memory:" 表示使用内存数据库，进程退出后数据丢失。
+	Path string `json:"path" mapstructure:"path"`
+}
+
+// NewSQLiteOptions 创建一个带有默认参数的 SQLiteOptions 对象。
+func NewSQLiteOptions() *SQLiteOptions {
+	return &SQLiteOptions{
+		Path: "_output/apiserver.db",
+	}
+}
+
+// Validate 验证传递给 SQLiteOptions 的标志。
+func (o *SQLiteOptions) Validate() []error {
+	return []error{}
+}
+
+// AddFlags 将与 sqlite 存储相关的标志添加到指定的 FlagSet。
+func (o *SQLiteOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	fs.StringVar(&o.Path, fullPrefix+".path", o.Path, ""+
+		"Path of the SQLite database file. Use :memory: for an in-memory database.")
+}
+
+// NewDB 使用给定配置创建 sqlite 存储。
+func (o *SQLiteOptions) NewDB() (*gorm.DB, error) {
+	return db.NewSQLite(&db.SQLiteOptions{
+		Path:   o.Path,
+		Logger: gormlogger.New(slog.Default()),
+	})
+}
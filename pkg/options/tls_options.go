@@ -6,8 +6,11 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/pflag"
+
+	"github.com/clin211/gin-enterprise-template/pkg/mtls"
 )
 
 var _ IOptions = (*TLSOptions)(nil)
@@ -20,11 +23,15 @@ type TLSOptions struct {
 	CaCert             string `json:"ca-cert" mapstructure:"ca-cert"`
 	Cert               string `json:"cert" mapstructure:"cert"`
 	Key                string `json:"key" mapstructure:"key"`
+	// ReloadInterval 是服务端检查证书、客户端 CA 和 CRL 文件是否变化的间隔，0 表示不热加载。
+	ReloadInterval time.Duration `json:"reload-interval" mapstructure:"reload-interval"`
+	// ClientAuth 是服务端校验客户端证书（mTLS）的配置，仅在作为服务端时生效。
+	ClientAuth *ClientCertAuthenticationOptions `json:"client-auth" mapstructure:"client-auth"`
 }
 
 // NewTLSOptions 创建一个`零值`实例。
 func NewTLSOptions() *TLSOptions {
-	return &TLSOptions{}
+	return &TLSOptions{ReloadInterval: time.Minute, ClientAuth: NewClientCertAuthenticationOptions()}
 }
 
 // Validate 验证传递给 TLSOptions 的标志。
@@ -39,6 +46,14 @@ func (o *TLSOptions) Validate() []error {
 		errs = append(errs, fmt.Errorf("only one of cert and key configuration option is setted, you should set both to enable tls"))
 	}
 
+	if o.ReloadInterval < 0 {
+		errs = append(errs, fmt.Errorf("reload-interval cannot be negative"))
+	}
+
+	if o.ClientAuth != nil {
+		errs = append(errs, o.ClientAuth.Validate()...)
+	}
+
 	return errs
 }
 
@@ -50,6 +65,11 @@ func (o *TLSOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
 	fs.StringVar(&o.CaCert, fullPrefix+".ca-cert", o.CaCert, "Path to ca cert for connecting to the server.")
 	fs.StringVar(&o.Cert, fullPrefix+".cert", o.Cert, "Path to cert file for connecting to the server.")
 	fs.StringVar(&o.Key, fullPrefix+".key", o.Key, "Path to key file for connecting to the server.")
+	fs.DurationVar(&o.ReloadInterval, fullPrefix+".reload-interval", o.ReloadInterval, ""+
+		"Interval to reload the server certificate, client CA and CRL from disk, 0 disables reloading.")
+	if o.ClientAuth != nil {
+		o.ClientAuth.AddFlags(fs, fullPrefix+".client-auth")
+	}
 }
 
 func (o *TLSOptions) MustTLSConfig() *tls.Config {
@@ -106,6 +126,20 @@ func (o *TLSOptions) TLSConfig() (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
+// ServerTLS 创建服务端 TLS 配置的加载器，服务端证书、客户端 CA 和 CRL 可以在运行时热加载。
+// 配置了 ClientAuth.ClientCA 时会请求并校验客户端证书。
+func (o *TLSOptions) ServerTLS() (*mtls.Reloader, error) {
+	cfg := mtls.Config{CertFile: o.Cert, KeyFile: o.Key}
+	if o.ClientAuth != nil {
+		cfg.ClientCAFile = o.ClientAuth.ClientCA
+		cfg.RequireClientCert = o.ClientAuth.Required
+		cfg.CRLFile = o.ClientAuth.CRLFile
+		cfg.SubjectSource = o.ClientAuth.SubjectSource
+		cfg.AllowedSubjects = o.ClientAuth.AllowedSubjects
+	}
+	return mtls.New(cfg)
+}
+
 // Scheme returns the URL scheme based on the TLS configuration.
 func (o *TLSOptions) Scheme() string {
 	if o.UseTLS {
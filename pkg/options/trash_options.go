@@ -0,0 +1,55 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+var _ IOptions = (*TrashOptions)(nil)
+
+// TrashOptions 包含回收站（已软删除的记录）清理相关的配置项。
+type TrashOptions struct {
+	// Retention 是已删除记录在回收站中的保留时长，超过保留期的记录会被彻底删除，无法再恢复。
+	// 设置为 0 表示不自动清理。
+	Retention time.Duration `json:"retention" mapstructure:"retention"`
+	// PurgeInterval 是检查并清理超过保留期的记录的时间间隔。
+	PurgeInterval time.Duration `json:"purge-interval" mapstructure:"purge-interval"`
+
+	fullPrefix string
+}
+
+// NewTrashOptions 创建一个带有默认参数的 TrashOptions 对象。
+func NewTrashOptions() *TrashOptions {
+	return &TrashOptions{
+		Retention:     30 * 24 * time.Hour,
+		PurgeInterval: time.Hour,
+	}
+}
+
+// Validate 验证 TrashOptions 中的参数是否有效。
+func (o *TrashOptions) Validate() []error {
+	var errs []error
+
+	if o.Retention < 0 {
+		errs = append(errs, fmt.Errorf("--%s.retention must not be negative", o.fullPrefix))
+	}
+	if o.PurgeInterval <= 0 {
+		errs = append(errs, fmt.Errorf("--%s.purge-interval must be greater than 0", o.fullPrefix))
+	}
+
+	return errs
+}
+
+// AddFlags 将与回收站清理相关的标志添加到指定的 FlagSet。
+func (o *TrashOptions) AddFlags(fs *pflag.FlagSet, fullPrefix string) {
+	if fs == nil {
+		return
+	}
+
+	o.fullPrefix = fullPrefix
+	fs.DurationVar(&o.Retention, fullPrefix+".retention", o.Retention, ""+
+		"How long deleted records are kept in the trash before being purged permanently. 0 disables purging.")
+	fs.DurationVar(&o.PurgeInterval, fullPrefix+".purge-interval", o.PurgeInterval, "Interval of purging expired records from the trash.")
+}
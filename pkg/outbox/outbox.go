@@ -0,0 +1,120 @@
+// Package outbox 实现事务性发件箱（transactional outbox）：领域事件和业务变更在同一个数据库事务中写入，
+// 由 Relay 在事务提交后异步地将事件发布到 Kafka，业务变更回滚时事件也不会被发布.
+//
+// 同一聚合的事件使用相同的消息 Key，被写入 Kafka 的同一分区，并按写入 outbox 的顺序逐条发布；
+// 发布失败的事件按指数退避重试，在此之前同一聚合的后续事件不会被发布. 超过最大重试次数的事件写入死信 Topic.
+// 事件至少被发布一次，消费者应根据 event-id 消息头去重.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+)
+
+// 发布到 Kafka 的消息头.
+const (
+	HeaderEventID       = "event-id"
+	HeaderEventType     = "event-type"
+	HeaderAggregateType = "aggregate-type"
+	HeaderAggregateID   = "aggregate-id"
+	// HeaderError 和 HeaderAttempts 只出现在死信消息中，记录最后一次发布失败的原因和尝试次数
+	HeaderError    = "error"
+	HeaderAttempts = "attempts"
+)
+
+// Event 是 outbox 中的一条领域事件.
+type Event struct {
+	// ID 是事件在 outbox 中的自增序号，决定事件的发布顺序
+	ID int64
+	// EventID 是事件的全局唯一标识，消费者可以据此去重
+	EventID       string
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	// Payload 是 JSON 格式的 Envelope，原样作为 Kafka 消息体
+	Payload []byte
+	// Attempts 是已经尝试发布的次数
+	Attempts int
+	// NextAttemptAt 是下一次可以尝试发布的时间
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// Key 返回事件的 Kafka 消息 Key，同一聚合的事件 Key 相同.
+func (e *Event) Key() string {
+	return e.AggregateType + ":" + e.AggregateID
+}
+
+// Envelope 是发布到 Kafka 的消息体.
+type Envelope struct {
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	AggregateType string    `json:"aggregateType"`
+	AggregateID   string    `json:"aggregateId"`
+	OccurredAt    time.Time `json:"occurredAt"`
+	// Metadata 记录与业务数据无关的上下文，例如触发事件的操作者
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Data     json.RawMessage   `json:"data,omitempty"`
+}
+
+// NewEvent 创建一条领域事件，data 会被序列化为 JSON 作为 Envelope 的 data 字段，metadata 可以为 nil.
+func NewEvent(aggregateType, aggregateID, eventType string, data any, metadata map[string]string) (*Event, error) {
+	now := time.Now()
+	envelope := Envelope{
+		ID:            uuid.NewString(),
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		OccurredAt:    now,
+		Metadata:      metadata,
+	}
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		envelope.Data = raw
+	}
+
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		EventID:       envelope.ID,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       payload,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// Store 定义了 Relay 读取和更新 outbox 所需的方法. 写入事件由业务在自己的事务中完成，不在此接口中.
+type Store interface {
+	// Acquire 获取或续期发布事件的租约，租约在 ttl 后过期. 多个实例中同一时间只有持有租约的实例发布事件，
+	// 以保证同一聚合的事件按顺序发布.
+	Acquire(ctx context.Context, holder string, ttl time.Duration) (bool, error)
+	// Pending 按 ID 升序返回最多 limit 条在 now 之前到达发布时间的事件，每个聚合只返回最早一条尚未发布的事件.
+	// 聚合中更早的事件尚未发布（包括还在退避中）时，不返回该聚合的后续事件.
+	Pending(ctx context.Context, now time.Time, limit int) ([]*Event, error)
+	// MarkPublished 将事件标记为已发布.
+	MarkPublished(ctx context.Context, ids ...int64) error
+	// MarkFailed 记录一次发布失败，事件在 next 之后重试.
+	MarkFailed(ctx context.Context, id int64, attempts int, next time.Time, lastError string) error
+	// MarkDead 将超过最大重试次数、已写入死信 Topic 的事件标记为死信，不再重试.
+	MarkDead(ctx context.Context, id int64, attempts int, lastError string) error
+	// Purge 删除在 before 之前发布或成为死信的事件，返回删除的数量.
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Writer 将消息写入 Kafka，*kafka.Writer 实现了该接口.
+// Relay 需要知道每条消息是否写入成功，因此 Writer 必须同步写入，并且允许消息指定 Topic.
+type Writer interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
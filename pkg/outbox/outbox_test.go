@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	event, err := NewEvent("role", "r1", "role.created", map[string]string{"roleCode": "admin"}, map[string]string{"operatorId": "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, "role:r1", event.Key())
+	assert.Equal(t, event.CreatedAt, event.NextAttemptAt)
+
+	var envelope Envelope
+	require.NoError(t, json.Unmarshal(event.Payload, &envelope))
+	assert.Equal(t, event.EventID, envelope.ID)
+	assert.Equal(t, "role.created", envelope.Type)
+	assert.Equal(t, "r1", envelope.AggregateID)
+	assert.Equal(t, map[string]string{"operatorId": "u1"}, envelope.Metadata)
+	assert.JSONEq(t, `{"roleCode":"admin"}`, string(envelope.Data))
+
+	_, err = NewEvent("role", "r1", "role.created", make(chan int), nil)
+	require.Error(t, err)
+}
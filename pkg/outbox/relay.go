@@ -0,0 +1,274 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+)
+
+// 默认配置.
+const (
+	DefaultBatchSize   = 100
+	DefaultInterval    = time.Second
+	DefaultMaxAttempts = 10
+	DefaultMinBackoff  = time.Second
+	DefaultMaxBackoff  = 5 * time.Minute
+	DefaultRetention   = 7 * 24 * time.Hour
+	DefaultLeaseTTL    = 30 * time.Second
+)
+
+// purgeInterval 是两次清理已发布事件之间的最小间隔.
+const purgeInterval = time.Hour
+
+// Relay 周期性地将 outbox 中的事件发布到 Kafka.
+type Relay struct {
+	store  Store
+	writer Writer
+	topic  string
+	// holder 是本实例的租约持有者标识
+	holder string
+
+	deadLetterTopic string
+	batchSize       int
+	interval        time.Duration
+	maxAttempts     int
+	minBackoff      time.Duration
+	maxBackoff      time.Duration
+	retention       time.Duration
+	leaseTTL        time.Duration
+
+	lastPurge time.Time
+	now       func() time.Time
+}
+
+// Option 定义用于配置 Relay 的函数类型.
+type Option func(*Relay)
+
+// WithDeadLetterTopic 设置死信 Topic，为空时超过最大重试次数的事件按最大退避时间一直重试.
+func WithDeadLetterTopic(topic string) Option {
+	return func(r *Relay) {
+		r.deadLetterTopic = topic
+	}
+}
+
+// WithBatchSize 设置每次从 outbox 读取的最大事件数.
+func WithBatchSize(size int) Option {
+	return func(r *Relay) {
+		r.batchSize = size
+	}
+}
+
+// WithInterval 设置没有可发布的事件时两次检查之间的间隔.
+func WithInterval(interval time.Duration) Option {
+	return func(r *Relay) {
+		r.interval = interval
+	}
+}
+
+// WithMaxAttempts 设置写入死信 Topic 之前的最大发布次数.
+func WithMaxAttempts(attempts int) Option {
+	return func(r *Relay) {
+		r.maxAttempts = attempts
+	}
+}
+
+// WithBackoff 设置重试的退避时间，第 n 次失败后等待 min*2^(n-1)，最长不超过 max.
+func WithBackoff(min, max time.Duration) Option {
+	return func(r *Relay) {
+		r.minBackoff = min
+		r.maxBackoff = max
+	}
+}
+
+// WithRetention 设置已发布和死信事件的保留时长，为 0 时不清理.
+func WithRetention(retention time.Duration) Option {
+	return func(r *Relay) {
+		r.retention = retention
+	}
+}
+
+// WithLeaseTTL 设置发布租约的有效期，应大于检查间隔，实例退出后其他实例最多等待 ttl 接管发布.
+func WithLeaseTTL(ttl time.Duration) Option {
+	return func(r *Relay) {
+		r.leaseTTL = ttl
+	}
+}
+
+// NewRelay 创建将事件发布到 topic 的 Relay.
+func NewRelay(store Store, writer Writer, topic string, opts ...Option) *Relay {
+	r := &Relay{
+		store:       store,
+		writer:      writer,
+		topic:       topic,
+		holder:      uuid.NewString(),
+		batchSize:   DefaultBatchSize,
+		interval:    DefaultInterval,
+		maxAttempts: DefaultMaxAttempts,
+		minBackoff:  DefaultMinBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+		retention:   DefaultRetention,
+		leaseTTL:    DefaultLeaseTTL,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run 持续发布事件，直到 ctx 被取消. 有事件发布成功时立即继续发布，否则等待一个检查间隔.
+// writer 实现了 io.Closer 时，Run 返回前会关闭 writer.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	if closer, ok := r.writer.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	for {
+		for ctx.Err() == nil {
+			published, err := r.relay(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to relay outbox events", "error", err)
+			}
+			if err != nil || published == 0 {
+				break
+			}
+		}
+		r.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay 发布一批事件，返回发布成功的事件数. 每个聚合每次最多发布一条事件，
+// 前一条事件发布成功之前不会发布同一聚合的后续事件.
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	leader, err := r.store.Acquire(ctx, r.holder, r.leaseTTL)
+	if err != nil || !leader {
+		return 0, err
+	}
+
+	// 退避中的事件在数据库中过滤，不会占满一批事件而阻塞其他聚合
+	batch, err := r.store.Pending(ctx, r.now(), r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	msgs := make([]kafka.Message, 0, len(batch))
+	for _, event := range batch {
+		msgs = append(msgs, r.message(event, r.topic))
+	}
+	err = r.writer.WriteMessages(ctx, msgs...)
+
+	var writeErrs kafka.WriteErrors
+	if !errors.As(err, &writeErrs) || len(writeErrs) != len(batch) {
+		writeErrs = nil
+	}
+	published := make([]int64, 0, len(batch))
+	for i, event := range batch {
+		msgErr := err
+		if writeErrs != nil {
+			msgErr = writeErrs[i]
+		}
+		if msgErr != nil {
+			r.fail(ctx, event, msgErr)
+			continue
+		}
+		published = append(published, event.ID)
+	}
+	if len(published) == 0 {
+		return 0, nil
+	}
+
+	// 标记失败时事件会被再次发布，由消费者根据 event-id 去重
+	if err := r.store.MarkPublished(ctx, published...); err != nil {
+		return 0, err
+	}
+	return len(published), nil
+}
+
+// fail 记录事件发布失败，超过最大发布次数时将事件写入死信 Topic.
+func (r *Relay) fail(ctx context.Context, event *Event, err error) {
+	attempts := event.Attempts + 1
+	if attempts >= r.maxAttempts && r.deadLetterTopic != "" {
+		msg := r.message(event, r.deadLetterTopic)
+		msg.Headers = append(msg.Headers,
+			kafka.Header{Key: HeaderError, Value: []byte(err.Error())},
+			kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		)
+		dlqErr := r.writer.WriteMessages(ctx, msg)
+		if dlqErr == nil {
+			slog.WarnContext(ctx, "Moved outbox event to dead letter topic", "eventID", event.EventID, "type", event.EventType,
+				"attempts", attempts, "error", err)
+			if err := r.store.MarkDead(ctx, event.ID, attempts, err.Error()); err != nil {
+				slog.ErrorContext(ctx, "Failed to mark outbox event as dead", "eventID", event.EventID, "error", err)
+			}
+			return
+		}
+		slog.ErrorContext(ctx, "Failed to write outbox event to dead letter topic", "eventID", event.EventID, "error", dlqErr)
+	}
+
+	next := r.now().Add(r.backoff(attempts))
+	slog.WarnContext(ctx, "Failed to publish outbox event", "eventID", event.EventID, "type", event.EventType,
+		"attempts", attempts, "next", next, "error", err)
+	if err := r.store.MarkFailed(ctx, event.ID, attempts, next, err.Error()); err != nil {
+		slog.ErrorContext(ctx, "Failed to record outbox event failure", "eventID", event.EventID, "error", err)
+	}
+}
+
+// backoff 返回第 attempts 次失败后的退避时间.
+func (r *Relay) backoff(attempts int) time.Duration {
+	backoff := r.minBackoff
+	for i := 1; i < attempts && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.maxBackoff)
+}
+
+// message 将事件转换为写入 topic 的 Kafka 消息.
+func (r *Relay) message(event *Event, topic string) kafka.Message {
+	return kafka.Message{
+		Topic: topic,
+		Key:   []byte(event.Key()),
+		Value: event.Payload,
+		Headers: []kafka.Header{
+			{Key: HeaderEventID, Value: []byte(event.EventID)},
+			{Key: HeaderEventType, Value: []byte(event.EventType)},
+			{Key: HeaderAggregateType, Value: []byte(event.AggregateType)},
+			{Key: HeaderAggregateID, Value: []byte(event.AggregateID)},
+		},
+		Time: event.CreatedAt,
+	}
+}
+
+// purge 清理超过保留期的已发布和死信事件，每个 purgeInterval 最多执行一次.
+func (r *Relay) purge(ctx context.Context) {
+	now := r.now()
+	if r.retention <= 0 || now.Sub(r.lastPurge) < purgeInterval {
+		return
+	}
+	r.lastPurge = now
+
+	purged, err := r.store.Purge(ctx, now.Add(-r.retention))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to purge outbox events", "error", err)
+		return
+	}
+	if purged > 0 {
+		slog.InfoContext(ctx, "Purged outbox events", "count", purged)
+	}
+}
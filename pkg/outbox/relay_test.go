@@ -0,0 +1,234 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memStore struct {
+	events    []*Event
+	published []int64
+	dead      []int64
+	holder    string
+}
+
+func (s *memStore) Acquire(_ context.Context, holder string, _ time.Duration) (bool, error) {
+	if s.holder != "" && s.holder != holder {
+		return false, nil
+	}
+	s.holder = holder
+	return true, nil
+}
+
+func (s *memStore) Pending(_ context.Context, now time.Time, limit int) ([]*Event, error) {
+	sort.Slice(s.events, func(i, j int) bool { return s.events[i].ID < s.events[j].ID })
+	seen := make(map[string]bool)
+	var events []*Event
+	for _, event := range s.events {
+		key := event.Key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if !event.NextAttemptAt.After(now) && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (s *memStore) remove(id int64) {
+	for i, event := range s.events {
+		if event.ID == id {
+			s.events = append(s.events[:i:i], s.events[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *memStore) MarkPublished(_ context.Context, ids ...int64) error {
+	for _, id := range ids {
+		s.remove(id)
+	}
+	s.published = append(s.published, ids...)
+	return nil
+}
+
+func (s *memStore) MarkFailed(_ context.Context, id int64, attempts int, next time.Time, _ string) error {
+	for _, event := range s.events {
+		if event.ID == id {
+			event.Attempts = attempts
+			event.NextAttemptAt = next
+		}
+	}
+	return nil
+}
+
+func (s *memStore) MarkDead(_ context.Context, id int64, _ int, _ string) error {
+	s.remove(id)
+	s.dead = append(s.dead, id)
+	return nil
+}
+
+func (s *memStore) Purge(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type memWriter struct {
+	msgs []kafka.Message
+	// fail 返回某条消息的写入错误，为 nil 时全部写入成功
+	fail func(kafka.Message) error
+}
+
+func (w *memWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	errs := make(kafka.WriteErrors, len(msgs))
+	failed := false
+	for i, msg := range msgs {
+		if w.fail != nil {
+			errs[i] = w.fail(msg)
+		}
+		if errs[i] != nil {
+			failed = true
+			continue
+		}
+		w.msgs = append(w.msgs, msg)
+	}
+	if failed {
+		return errs
+	}
+	return nil
+}
+
+func newEvent(t *testing.T, id int64, aggregateID, eventType string) *Event {
+	t.Helper()
+
+	event, err := NewEvent("user", aggregateID, eventType, map[string]string{"userID": aggregateID}, nil)
+	require.NoError(t, err)
+	event.ID = id
+	event.NextAttemptAt = time.Time{}
+	return event
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestRelay_OrderPerAggregate(t *testing.T) {
+	store := &memStore{events: []*Event{
+		newEvent(t, 1, "u1", "user.created"),
+		newEvent(t, 2, "u1", "user.updated"),
+		newEvent(t, 3, "u2", "user.created"),
+	}}
+	writer := &memWriter{}
+	r := NewRelay(store, writer, "events")
+	ctx := context.Background()
+
+	// 每个聚合每次只发布一条事件
+	n, err := r.relay(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []int64{1, 3}, store.published)
+
+	n, err = r.relay(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []int64{1, 3, 2}, store.published)
+
+	require.Len(t, writer.msgs, 3)
+	msg := writer.msgs[2]
+	assert.Equal(t, "events", msg.Topic)
+	assert.Equal(t, "user:u1", string(msg.Key))
+	assert.Equal(t, "user.updated", header(msg, HeaderEventType))
+	assert.NotEmpty(t, header(msg, HeaderEventID))
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	store := &memStore{events: []*Event{
+		newEvent(t, 1, "u1", "user.created"),
+		newEvent(t, 2, "u1", "user.updated"),
+		newEvent(t, 3, "u2", "user.created"),
+	}}
+	writer := &memWriter{fail: func(msg kafka.Message) error {
+		if string(msg.Key) == "user:u1" {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	}}
+	now := time.Now()
+	r := NewRelay(store, writer, "events", WithBackoff(time.Second, 3*time.Second))
+	r.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	n, err := r.relay(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, store.events[0].Attempts)
+	assert.Equal(t, now.Add(time.Second), store.events[0].NextAttemptAt)
+
+	// 退避期间同一聚合的后续事件也不会被发布
+	n, err = r.relay(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Equal(t, []int64{3}, store.published)
+
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, 2*time.Second, r.backoff(2))
+	assert.Equal(t, 3*time.Second, r.backoff(3))
+	assert.Equal(t, 3*time.Second, r.backoff(20))
+
+	// 恢复后按顺序发布
+	writer.fail = nil
+	now = now.Add(time.Second)
+	_, err = r.relay(ctx)
+	require.NoError(t, err)
+	_, err = r.relay(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 1, 2}, store.published)
+}
+
+func TestRelay_DeadLetter(t *testing.T) {
+	store := &memStore{events: []*Event{newEvent(t, 1, "u1", "user.created")}}
+	writer := &memWriter{fail: func(msg kafka.Message) error {
+		if msg.Topic == "events" {
+			return errors.New("message too large")
+		}
+		return nil
+	}}
+	r := NewRelay(store, writer, "events", WithMaxAttempts(2), WithBackoff(0, 0), WithDeadLetterTopic("events.dlq"))
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := r.relay(ctx)
+		require.NoError(t, err)
+	}
+	assert.Empty(t, store.events)
+	assert.Equal(t, []int64{1}, store.dead)
+
+	require.Len(t, writer.msgs, 1)
+	msg := writer.msgs[0]
+	assert.Equal(t, "events.dlq", msg.Topic)
+	assert.Equal(t, "message too large", header(msg, HeaderError))
+	assert.Equal(t, "2", header(msg, HeaderAttempts))
+}
+
+func TestRelay_Lease(t *testing.T) {
+	store := &memStore{events: []*Event{newEvent(t, 1, "u1", "user.created")}, holder: "other"}
+	r := NewRelay(store, &memWriter{}, "events")
+
+	n, err := r.relay(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Empty(t, store.published)
+}
@@ -18,26 +18,40 @@ import (
 type GRPCServer struct {
 	srv *grpc.Server
 	lis net.Listener
+	// cancel 停止服务端证书、客户端 CA 和 CRL 的热加载.
+	cancel context.CancelFunc
 }
 
 // NewGRPCServer 创建一个新的 GRPC 服务器实例.
+// 启用 TLS 且配置了客户端 CA 时，服务器会请求并校验客户端证书（mTLS），
+// 拦截器可以通过 mtls.PeerCertificateFromContext 获取客户端证书.
 func NewGRPCServer(
 	grpcOptions *genericoptions.GRPCOptions,
 	tlsOptions *genericoptions.TLSOptions,
 	serverOptions []grpc.ServerOption,
 	registerBuilder func() (func(grpc.ServiceRegistrar), string),
 ) (*GRPCServer, error) {
+	s := &GRPCServer{}
+	if tlsOptions != nil && tlsOptions.UseTLS {
+		reloader, err := tlsOptions.ServerTLS()
+		if err != nil {
+			return nil, err
+		}
+		var ctx context.Context
+		ctx, s.cancel = context.WithCancel(context.Background())
+		go reloader.Run(ctx, tlsOptions.ReloadInterval)
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(reloader.TLSConfig())))
+	}
+
 	lis, err := net.Listen("tcp", grpcOptions.Addr)
 	if err != nil {
 		slog.Error("Failed to listen", "err", err)
+		if s.cancel != nil {
+			s.cancel()
+		}
 		return nil, err
 	}
 
-	if tlsOptions != nil && tlsOptions.UseTLS {
-		tlsConfig := tlsOptions.MustTLSConfig()
-		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
-	}
-
 	grpcsrv := grpc.NewServer(serverOptions...)
 
 	registerFn, serverName := registerBuilder()
@@ -45,10 +59,9 @@ func NewGRPCServer(
 	registerHealthServer(serverName, grpcsrv)
 	reflection.Register(grpcsrv)
 
-	return &GRPCServer{
-		srv: grpcsrv,
-		lis: lis,
-	}, nil
+	s.srv = grpcsrv
+	s.lis = lis
+	return s, nil
 }
 
 // RunOrDie 启动 GRPC 服务器并在出错时记录致命错误.
@@ -64,6 +77,9 @@ func (s *GRPCServer) RunOrDie() {
 // GracefulStop 优雅地关闭 GRPC 服务器.
 func (s *GRPCServer) GracefulStop(ctx context.Context) {
 	slog.Info("Gracefully stop grpc server")
+	if s.cancel != nil {
+		s.cancel()
+	}
 	s.srv.GracefulStop()
 }
 
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -13,22 +12,32 @@ import (
 // HTTPServer 代表一个 HTTP 服务器.
 type HTTPServer struct {
 	srv *http.Server
+	// cancel 停止服务端证书、客户端 CA 和 CRL 的热加载.
+	cancel context.CancelFunc
 }
 
 // NewHTTPServer 创建一个新的 HTTP 服务器实例.
-func NewHTTPServer(httpOptions *genericoptions.HTTPOptions, tlsOptions *genericoptions.TLSOptions, handler http.Handler) *HTTPServer {
-	var tlsConfig *tls.Config
-	if tlsOptions != nil && tlsOptions.UseTLS {
-		tlsConfig = tlsOptions.MustTLSConfig()
-	}
-
-	return &HTTPServer{
+// 启用 TLS 且配置了客户端 CA 时，服务器会请求并校验客户端证书（mTLS）.
+func NewHTTPServer(httpOptions *genericoptions.HTTPOptions, tlsOptions *genericoptions.TLSOptions, handler http.Handler) (*HTTPServer, error) {
+	s := &HTTPServer{
 		srv: &http.Server{
-			Addr:      httpOptions.Addr,
-			Handler:   handler,
-			TLSConfig: tlsConfig,
+			Addr:    httpOptions.Addr,
+			Handler: handler,
 		},
 	}
+
+	if tlsOptions != nil && tlsOptions.UseTLS {
+		reloader, err := tlsOptions.ServerTLS()
+		if err != nil {
+			return nil, err
+		}
+		var ctx context.Context
+		ctx, s.cancel = context.WithCancel(context.Background())
+		go reloader.Run(ctx, tlsOptions.ReloadInterval)
+		s.srv.TLSConfig = reloader.TLSConfig()
+	}
+
+	return s, nil
 }
 
 // RunOrDie 启动 HTTP 服务器并在出错时记录致命错误.
@@ -50,6 +59,9 @@ func (s *HTTPServer) RunOrDie() {
 // GracefulStop 优雅地关闭 HTTP 服务器.
 func (s *HTTPServer) GracefulStop(ctx context.Context) {
 	slog.Info("Gracefully stop HTTP(s) server")
+	if s.cancel != nil {
+		s.cancel()
+	}
 	if err := s.srv.Shutdown(ctx); err != nil {
 		slog.Error("HTTP(s) server forced to shutdown", "err", err)
 	}
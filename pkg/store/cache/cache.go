@@ -0,0 +1,415 @@
+// Package cache 为通用 Store 提供旁路缓存（cache-aside），包括本地 LRU 和可选的 Redis 二级缓存.
+//
+// 缓存按表划分命名空间，每张表有一个单调递增的版本号，缓存键中包含版本号.
+// 通过 gorm 写入已注册的表后，版本号加一，该表之前的缓存全部失效，避免逐个推算受影响的查询条件.
+// 配置 Redis 时版本号保存在 Redis 中，并通过发布订阅通知其他实例，实现跨实例失效.
+//
+// 不经过 gorm 回调的写入（例如 db.Exec 执行的原生 SQL）不会使缓存失效，需要调用 Invalidate.
+// 包含密码哈希等凭证的模型应使用 WithLocalOnly，避免凭证写入 Redis.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+// 默认配置.
+const (
+	DefaultLocalSize       = 10000
+	DefaultLocalTTL        = 30 * time.Second
+	DefaultTTL             = 5 * time.Minute
+	DefaultNegativeTTL     = 30 * time.Second
+	DefaultInvalidateDelay = 5 * time.Second
+	DefaultPrefix          = "store:cache"
+)
+
+// PluginName 是 Cache 注册的 gorm 插件名称.
+const PluginName = "store:cache"
+
+// skipInvalidationKey 是标记写入后不失效缓存的 gorm 设置项.
+const skipInvalidationKey = PluginName + ":skip-invalidation"
+
+// 缓存值的第一个字节标记记录是否存在.
+const (
+	markNotFound byte = iota
+	markFound
+)
+
+// 缓存层级和结果，用作指标的属性.
+const (
+	tierLocal = "local"
+	tierRedis = "redis"
+
+	resultHit  = "hit"
+	resultMiss = "miss"
+)
+
+// Option 定义用于配置 Cache 的函数类型.
+type Option func(*Cache)
+
+// WithRedis 启用 Redis 二级缓存和跨实例失效. Cache 不持有 client，Close 时不会关闭 client.
+func WithRedis(client redis.UniversalClient) Option {
+	return func(c *Cache) {
+		c.redis = client
+	}
+}
+
+// WithLocalSize 设置本地缓存最多保存的缓存项数量.
+func WithLocalSize(size int) Option {
+	return func(c *Cache) {
+		c.localSize = size
+	}
+}
+
+// WithLocalTTL 设置本地缓存的过期时间. 丢失失效通知时，本地缓存最多在该时间内返回旧数据.
+func WithLocalTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.localTTL = ttl
+	}
+}
+
+// WithTTL 设置 Redis 缓存的过期时间.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.ttl = ttl
+	}
+}
+
+// WithNegativeTTL 设置记录不存在的查询结果的缓存时间.
+func WithNegativeTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.negativeTTL = ttl
+	}
+}
+
+// WithInvalidateDelay 设置写入后再次失效缓存的延迟，为 0 时不再次失效.
+// 事务提交前或只读副本追上主库前，其他请求可能把旧数据重新写入缓存，延迟失效用于清除这些旧数据，
+// 使用只读副本时应不小于副本允许的最大复制延迟.
+func WithInvalidateDelay(delay time.Duration) Option {
+	return func(c *Cache) {
+		c.invalidateDelay = delay
+	}
+}
+
+// WithPrefix 设置 Redis 键和失效通知频道的前缀.
+func WithPrefix(prefix string) Option {
+	return func(c *Cache) {
+		c.prefix = prefix
+	}
+}
+
+// Cache 保存所有缓存的表共享的缓存数据和版本号，同时是一个在写入后失效缓存的 gorm 插件.
+type Cache struct {
+	db    *gorm.DB
+	redis redis.UniversalClient
+	local *lru
+	group singleflight.Group
+
+	localSize       int
+	localTTL        time.Duration
+	ttl             time.Duration
+	negativeTTL     time.Duration
+	invalidateDelay time.Duration
+	prefix          string
+
+	// tables 是启用缓存的表
+	tables sync.Map
+	mu     sync.RWMutex
+	// generations 是本实例已知的各表的缓存版本号
+	generations map[string]uint64
+
+	pubsub   *redis.PubSub
+	done     chan struct{}
+	requests metric.Int64Counter
+}
+
+// 确保 Cache 实现了 gorm 插件接口.
+var _ gorm.Plugin = (*Cache)(nil)
+
+// invalidation 是通过 Redis 广播的缓存失效消息.
+type invalidation struct {
+	Table      string `json:"table"`
+	Generation uint64 `json:"generation"`
+}
+
+// New 创建 Cache 并注册到 db，启用 Redis 时订阅失效通知频道.
+func New(ctx context.Context, db *gorm.DB, opts ...Option) (*Cache, error) {
+	c := &Cache{
+		db:              db,
+		localSize:       DefaultLocalSize,
+		localTTL:        DefaultLocalTTL,
+		ttl:             DefaultTTL,
+		negativeTTL:     DefaultNegativeTTL,
+		invalidateDelay: DefaultInvalidateDelay,
+		prefix:          DefaultPrefix,
+		generations:     make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.local = newLRU(c.localSize)
+
+	requests, err := otel.Meter("github.com/clin211/gin-enterprise-template/pkg/store/cache").Int64Counter(
+		"store_cache_requests_total",
+		metric.WithDescription("Total number of store cache lookups by table, tier and result"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.requests = requests
+
+	if c.redis != nil {
+		pubsub := c.redis.Subscribe(ctx, c.channel())
+		// 等待订阅确认，确保返回后不会丢失失效通知
+		if _, err := pubsub.Receive(ctx); err != nil {
+			_ = pubsub.Close()
+			return nil, fmt.Errorf("failed to subscribe cache invalidation channel: %w", err)
+		}
+		c.pubsub = pubsub
+		c.done = make(chan struct{})
+		go c.receive()
+	}
+
+	if err := db.Use(c); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Name 返回插件名称.
+func (c *Cache) Name() string {
+	return PluginName
+}
+
+// Initialize 注册写入后失效缓存的回调.
+func (c *Cache) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if err := callbacks.Create().After("gorm:create").Register(PluginName+":create", c.afterWrite); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(PluginName+":update", c.afterWrite); err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:delete").Register(PluginName+":delete", c.afterWrite)
+}
+
+// Register 为模型对应的表启用缓存失效. 写入任何实例上已注册的表都会使所有实例上该表的缓存失效，
+// 因此应在启动时注册所有缓存的模型，而不是等到第一次读取时.
+func (c *Cache) Register(models ...any) error {
+	for _, model := range models {
+		if _, err := c.register(model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// register 注册模型对应的表并返回表名.
+func (c *Cache) register(model any) (string, error) {
+	stmt := &gorm.Statement{DB: c.db}
+	if err := stmt.Parse(model); err != nil {
+		return "", fmt.Errorf("failed to parse cached model %T: %w", model, err)
+	}
+	c.tables.Store(stmt.Schema.Table, struct{}{})
+	return stmt.Schema.Table, nil
+}
+
+// Invalidate 使表中所有的缓存失效，并通知其他实例.
+func (c *Cache) Invalidate(ctx context.Context, table string) {
+	if c.redis == nil {
+		c.mu.Lock()
+		c.generations[table]++
+		c.mu.Unlock()
+		return
+	}
+
+	generation, err := c.redis.Incr(ctx, c.generationKey(table)).Uint64()
+	if err != nil {
+		// Redis 中的版本号没有变化，其他实例和 Redis 中的缓存只能等待过期
+		slog.ErrorContext(ctx, "Failed to invalidate cache", "table", table, "error", err)
+		c.mu.Lock()
+		c.generations[table]++
+		c.mu.Unlock()
+		return
+	}
+	c.advance(table, generation)
+
+	data, _ := json.Marshal(&invalidation{Table: table, Generation: generation})
+	if err := c.redis.Publish(ctx, c.channel(), data).Err(); err != nil {
+		slog.ErrorContext(ctx, "Failed to publish cache invalidation", "table", table, "error", err)
+	}
+}
+
+// Close 取消订阅失效通知并等待接收协程退出.
+func (c *Cache) Close() {
+	if c.pubsub == nil {
+		return
+	}
+	_ = c.pubsub.Close()
+	<-c.done
+}
+
+// SkipInvalidation 返回写入后不使缓存失效的 db. 只用于频繁更新且允许在缓存过期前读到旧值的列，
+// 例如最后登录时间，避免每次写入都使整张表的缓存失效.
+func SkipInvalidation(db *gorm.DB) *gorm.DB {
+	return db.Set(skipInvalidationKey, true)
+}
+
+// afterWrite 在写入已注册的表后失效缓存，并在延迟后再次失效.
+func (c *Cache) afterWrite(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Table == "" {
+		return
+	}
+	if _, skip := db.Get(skipInvalidationKey); skip {
+		return
+	}
+	table := db.Statement.Table
+	if _, ok := c.tables.Load(table); !ok {
+		return
+	}
+
+	ctx := context.WithoutCancel(db.Statement.Context)
+	c.Invalidate(ctx, table)
+	if c.invalidateDelay > 0 {
+		time.AfterFunc(c.invalidateDelay, func() { c.Invalidate(ctx, table) })
+	}
+}
+
+// receive 持续接收其他实例广播的失效通知并更新本地版本号.
+func (c *Cache) receive() {
+	defer close(c.done)
+
+	for msg := range c.pubsub.Channel() {
+		var inv invalidation
+		if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil {
+			slog.Warn("Failed to decode cache invalidation", "error", err)
+			continue
+		}
+		c.advance(inv.Table, inv.Generation)
+	}
+}
+
+// advance 将表的本地版本号更新为 generation，版本号只增不减.
+func (c *Cache) advance(table string, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if current, ok := c.generations[table]; !ok || generation > current {
+		c.generations[table] = generation
+	}
+}
+
+// generation 返回表当前的缓存版本号，首次读取时从 Redis 加载.
+func (c *Cache) generation(ctx context.Context, table string) (uint64, error) {
+	c.mu.RLock()
+	generation, ok := c.generations[table]
+	c.mu.RUnlock()
+	if ok || c.redis == nil {
+		return generation, nil
+	}
+
+	generation, err := c.redis.Get(ctx, c.generationKey(table)).Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+	c.advance(table, generation)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generations[table], nil
+}
+
+// fetch 依次从本地缓存和 Redis 读取 key 对应的缓存值，都未命中时调用 load 从数据库加载并回填缓存.
+// localOnly 为 true 时不读写 Redis，只使用本地缓存.
+// 同一实例上相同 key 的并发加载只会执行一次 load. load 返回的缓存值第一个字节标记记录是否存在.
+func (c *Cache) fetch(ctx context.Context, table, key string, localOnly bool, load func(context.Context) ([]byte, error)) ([]byte, error) {
+	generation, err := c.generation(ctx, table)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load cache generation, bypassing cache", "table", table, "error", err)
+		return load(ctx)
+	}
+	key = c.prefix + ":" + table + ":" + strconv.FormatUint(generation, 10) + ":" + key
+
+	if data, ok := c.local.get(key); ok {
+		c.record(ctx, table, tierLocal, resultHit)
+		return data, nil
+	}
+	c.record(ctx, table, tierLocal, resultMiss)
+
+	remote := c.redis != nil && !localOnly
+	data, err, _ := c.group.Do(key, func() (any, error) {
+		// 调用方取消请求不应影响共享同一次加载的其他调用方
+		ctx := context.WithoutCancel(ctx)
+		if remote {
+			data, err := c.redis.Get(ctx, key).Bytes()
+			if err == nil {
+				c.record(ctx, table, tierRedis, resultHit)
+				c.local.add(key, data, c.localTTLOf(data))
+				return data, nil
+			}
+			if !errors.Is(err, redis.Nil) {
+				slog.WarnContext(ctx, "Failed to read cache from redis", "table", table, "error", err)
+			}
+			c.record(ctx, table, tierRedis, resultMiss)
+		}
+
+		data, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if remote {
+			if err := c.redis.Set(ctx, key, data, c.ttlOf(data)).Err(); err != nil {
+				slog.WarnContext(ctx, "Failed to write cache to redis", "table", table, "error", err)
+			}
+		}
+		c.local.add(key, data, c.localTTLOf(data))
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data.([]byte), nil
+}
+
+// ttlOf 返回缓存值在 Redis 中的过期时间.
+func (c *Cache) ttlOf(data []byte) time.Duration {
+	if data[0] == markNotFound {
+		return c.negativeTTL
+	}
+	return c.ttl
+}
+
+// localTTLOf 返回缓存值在本地缓存中的过期时间.
+func (c *Cache) localTTLOf(data []byte) time.Duration {
+	return min(c.localTTL, c.ttlOf(data))
+}
+
+func (c *Cache) record(ctx context.Context, table, tier, result string) {
+	c.requests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("table", table),
+		attribute.String("tier", tier),
+		attribute.String("result", result),
+	))
+}
+
+func (c *Cache) channel() string {
+	return c.prefix + ":invalidate"
+}
+
+func (c *Cache) generationKey(table string) string {
+	return c.prefix + ":" + table + ":generation"
+}
@@ -0,0 +1,304 @@
+package cache
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/glebarez/sqlite"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+type item struct {
+	ID   uint `gorm:"primaryKey"`
+	Name string
+	Note *string
+}
+
+// provider 是测试使用的 DBProvider.
+type provider struct {
+	db *gorm.DB
+}
+
+func (p *provider) DB(ctx context.Context, wheres ...where.Where) *gorm.DB {
+	db := p.db.WithContext(ctx)
+	for _, whr := range wheres {
+		db = whr.Where(db)
+	}
+	return db
+}
+
+// openDB 打开 path 指定的 SQLite 数据库，并统计执行的查询次数.
+func openDB(t *testing.T, path string) (*gorm.DB, *atomic.Int64) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&item{}))
+
+	queries := &atomic.Int64{}
+	require.NoError(t, db.Callback().Query().Before("gorm:query").Register("test:count", func(*gorm.DB) {
+		queries.Add(1)
+	}))
+	return db, queries
+}
+
+func newCache(t *testing.T, db *gorm.DB, opts ...Option) *Cache {
+	t.Helper()
+
+	c, err := New(context.Background(), db, append([]Option{WithInvalidateDelay(0)}, opts...)...)
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestStore_CacheAside(t *testing.T) {
+	db, queries := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	s := NewStore[item](&provider{db}, nil, newCache(t, db))
+	ctx := context.Background()
+
+	obj := &item{Name: "alice"}
+	require.NoError(t, s.Create(ctx, obj))
+
+	got, err := s.Get(ctx, where.F("name", "alice"))
+	require.NoError(t, err)
+	assert.Equal(t, obj.ID, got.ID)
+
+	// 调用方修改返回的对象不影响缓存
+	got.Name = "changed"
+	queries.Store(0)
+	got, err = s.Get(ctx, where.F("name", "alice"))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", got.Name)
+	assert.Zero(t, queries.Load(), "the second read should be served from cache")
+
+	// 不经过 gorm 回调的写入不会使缓存失效
+	require.NoError(t, db.Exec("UPDATE items SET name = ? WHERE id = ?", "bob", obj.ID).Error)
+	got, err = s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", got.Name)
+	_, err = s.Get(ctx, where.F("name", "alice"))
+	require.NoError(t, err)
+
+	obj.Name = "carol"
+	require.NoError(t, s.Update(ctx, obj))
+	got, err = s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "carol", got.Name, "writes should invalidate the table")
+
+	count, items, err := s.List(ctx, where.F("name", "carol"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	require.Len(t, items, 1)
+
+	require.NoError(t, s.Delete(ctx, where.F("id", obj.ID)))
+	count, _, err = s.List(ctx, where.F("name", "carol"))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestStore_NegativeCaching(t *testing.T) {
+	db, queries := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	s := NewStore[item](&provider{db}, nil, newCache(t, db))
+	ctx := context.Background()
+
+	_, err := s.Get(ctx, where.F("name", "dave"))
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	queries.Store(0)
+	_, err = s.Get(ctx, where.F("name", "dave"))
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Zero(t, queries.Load(), "missing records should be cached")
+
+	require.NoError(t, s.Create(ctx, &item{Name: "dave"}))
+	got, err := s.Get(ctx, where.F("name", "dave"))
+	require.NoError(t, err)
+	assert.Equal(t, "dave", got.Name)
+}
+
+func TestStore_Bypass(t *testing.T) {
+	db, queries := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	s := NewStore[item](&provider{db}, nil, newCache(t, db))
+	ctx := context.Background()
+	require.NoError(t, s.Create(ctx, &item{Name: "erin", Note: new(string)}))
+
+	tests := map[string]*where.Options{
+		"clauses": where.C(clause.Eq{Column: "name", Value: "erin"}),
+		"queries": where.NewWhere().Q("name = ?", "erin"),
+		"pointer": where.F("note", new(string)),
+	}
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			queries.Store(0)
+			_, err := s.Get(ctx, opts)
+			require.NoError(t, err)
+			_, err = s.Get(ctx, opts)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), queries.Load())
+		})
+	}
+
+	// 事务内读取直接访问数据库
+	require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+		txs := NewStore[item](&provider{tx}, nil, s.cache)
+		require.NoError(t, txs.Create(ctx, &item{Name: "frank"}))
+		_, err := txs.Get(ctx, where.F("name", "frank"))
+		return err
+	}))
+}
+
+func TestStore_Singleflight(t *testing.T) {
+	db, queries := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, db.Create(&item{Name: "grace"}).Error)
+	s := NewStore[item](&provider{db}, nil, newCache(t, db))
+
+	// 在查询中阻塞，使并发读取都等待同一次加载
+	release := make(chan struct{})
+	require.NoError(t, db.Callback().Query().Before("gorm:query").Register("test:block", func(*gorm.DB) {
+		<-release
+	}))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := s.Get(context.Background(), where.F("name", "grace"))
+			assert.NoError(t, err)
+			assert.Equal(t, "grace", got.Name)
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), queries.Load(), "concurrent misses should load once")
+}
+
+func TestStore_Redis(t *testing.T) {
+	mr := miniredis.RunT(t)
+	path := filepath.Join(t.TempDir(), "test.db")
+	ctx := context.Background()
+
+	newInstance := func() (*Store[item], *atomic.Int64) {
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		db, queries := openDB(t, path)
+		c := newCache(t, db, WithRedis(client))
+		require.NoError(t, c.Register(&item{}))
+		return NewStore[item](&provider{db}, nil, c), queries
+	}
+	a, _ := newInstance()
+	b, queries := newInstance()
+
+	obj := &item{Name: "heidi"}
+	require.NoError(t, a.Create(ctx, obj))
+	_, err := a.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+
+	// 另一个实例从 Redis 读取
+	got, err := b.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "heidi", got.Name)
+	assert.Zero(t, queries.Load(), "the second instance should hit redis")
+
+	// 一个实例写入后，其他实例的本地缓存失效
+	obj.Name = "ivan"
+	require.NoError(t, a.Update(ctx, obj))
+	assert.Eventually(t, func() bool {
+		got, err := b.Get(ctx, where.F("id", obj.ID))
+		return err == nil && got.Name == "ivan"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStore_LocalOnly(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	db, queries := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	s := NewStore[item](&provider{db}, nil, newCache(t, db, WithRedis(client)), WithLocalOnly())
+	ctx := context.Background()
+
+	obj := &item{Name: "kate"}
+	require.NoError(t, s.Create(ctx, obj))
+	_, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+
+	// 缓存值不写入 Redis，Redis 中只有表的版本号
+	for _, key := range mr.Keys() {
+		assert.Contains(t, key, ":generation", "only generations should be stored in redis")
+	}
+	queries.Store(0)
+	_, err = s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Zero(t, queries.Load(), "local cache should still be used")
+}
+
+func TestSkipInvalidation(t *testing.T) {
+	db, queries := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	s := NewStore[item](&provider{db}, nil, newCache(t, db))
+	ctx := context.Background()
+
+	obj := &item{Name: "leo"}
+	require.NoError(t, s.Create(ctx, obj))
+	_, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+
+	require.NoError(t, SkipInvalidation(db).Model(&item{}).Where("id = ?", obj.ID).UpdateColumn("note", "seen").Error)
+	queries.Store(0)
+	got, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Nil(t, got.Note)
+	assert.Zero(t, queries.Load(), "writes skipping invalidation should keep the cache")
+}
+
+func TestCache_InvalidateDelay(t *testing.T) {
+	db, _ := openDB(t, filepath.Join(t.TempDir(), "test.db"))
+	s := NewStore[item](&provider{db}, nil, newCache(t, db, WithInvalidateDelay(50*time.Millisecond)))
+	ctx := context.Background()
+
+	obj := &item{Name: "judy"}
+	require.NoError(t, s.Create(ctx, obj))
+
+	// 模拟写入后立即从落后的副本读到旧数据并写入缓存
+	require.NoError(t, db.Exec("UPDATE items SET name = ? WHERE id = ?", "stale", obj.ID).Error)
+	got, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	require.Equal(t, "stale", got.Name)
+	require.NoError(t, db.Exec("UPDATE items SET name = ? WHERE id = ?", "judy", obj.ID).Error)
+
+	assert.Eventually(t, func() bool {
+		got, err := s.Get(ctx, where.F("id", obj.ID))
+		return err == nil && got.Name == "judy"
+	}, time.Second, 10*time.Millisecond, "the delayed invalidation should evict stale entries")
+}
+
+func TestLRU(t *testing.T) {
+	c := newLRU(2)
+	c.add("a", []byte("1"), time.Minute)
+	c.add("b", []byte("2"), time.Minute)
+	_, ok := c.get("a")
+	require.True(t, ok)
+
+	// b 是最久未使用的缓存项
+	c.add("c", []byte("3"), time.Minute)
+	_, ok = c.get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, c.len())
+
+	c.add("d", []byte("4"), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	_, ok = c.get("d")
+	assert.False(t, ok, "expired entries should not be returned")
+}
@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+
+	genericstore "github.com/clin211/gin-enterprise-template/pkg/store"
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+// Store 是通用 Store 的缓存装饰器，Get 和 List 优先读取缓存，其他方法直接访问数据库.
+// 只缓存由 Filters、Offset 和 Limit 组成且过滤值为标量或标量切片的查询，
+// 包含 Clauses、Queries、Cursor 的查询和事务内的查询直接访问数据库.
+// 缓存值使用 JSON 编码，模型的全部字段都需要能通过 JSON 往返.
+type Store[T any] struct {
+	*genericstore.Store[T]
+
+	storage genericstore.DBProvider
+	cache   *Cache
+	// table 为空表示不使用缓存
+	table string
+	// localOnly 为 true 表示缓存值只保存在本地缓存中
+	localOnly bool
+}
+
+// StoreOption 定义用于配置 Store 的函数类型.
+type StoreOption func(*storeOptions)
+
+type storeOptions struct {
+	localOnly bool
+}
+
+// WithLocalOnly 只在本地缓存中保存缓存值，不写入 Redis，用于包含密码哈希等凭证的模型.
+// 配置 Redis 时仍然通过 Redis 接收其他实例的失效通知.
+func WithLocalOnly() StoreOption {
+	return func(o *storeOptions) {
+		o.localOnly = true
+	}
+}
+
+// listResult 是 List 的缓存值.
+type listResult[T any] struct {
+	Count int64 `json:"count"`
+	Items []*T  `json:"items"`
+}
+
+// NewStore 创建带缓存的 Store，c 为 nil 时不使用缓存.
+func NewStore[T any](storage genericstore.DBProvider, logger genericstore.Logger, c *Cache, opts ...StoreOption) *Store[T] {
+	var o storeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	s := &Store[T]{
+		Store:     genericstore.NewStore[T](storage, logger),
+		storage:   storage,
+		cache:     c,
+		localOnly: o.localOnly,
+	}
+	if c == nil {
+		return s
+	}
+
+	table, err := c.register(new(T))
+	if err != nil {
+		slog.Warn("Failed to enable store cache", "error", err)
+		return s
+	}
+	s.table = table
+	return s
+}
+
+// Get 根据提供的 where 选项检索单个对象，记录不存在的结果同样会被缓存.
+func (s *Store[T]) Get(ctx context.Context, opts *where.Options) (*T, error) {
+	key, ok := s.key(ctx, "get", opts)
+	if !ok {
+		return s.Store.Get(ctx, opts)
+	}
+
+	data, err := s.cache.fetch(ctx, s.table, key, s.localOnly, func(ctx context.Context) ([]byte, error) {
+		obj, err := s.Store.Get(ctx, opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return []byte{markNotFound}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return encode(obj)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data[0] == markNotFound {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var obj T
+	if err := json.Unmarshal(data[1:], &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// List 根据提供的 where 选项检索对象列表.
+func (s *Store[T]) List(ctx context.Context, opts *where.Options) (int64, []*T, error) {
+	key, ok := s.key(ctx, "list", opts)
+	if !ok {
+		return s.Store.List(ctx, opts)
+	}
+
+	data, err := s.cache.fetch(ctx, s.table, key, s.localOnly, func(ctx context.Context) ([]byte, error) {
+		count, items, err := s.Store.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return encode(&listResult[T]{Count: count, Items: items})
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var ret listResult[T]
+	if err := json.Unmarshal(data[1:], &ret); err != nil {
+		return 0, nil, err
+	}
+	return ret.Count, ret.Items, nil
+}
+
+// key 返回查询的缓存键，查询不能使用缓存时返回 false.
+func (s *Store[T]) key(ctx context.Context, method string, opts *where.Options) (string, bool) {
+	if s.table == "" || inTransaction(s.storage.DB(ctx)) {
+		return "", false
+	}
+	if opts == nil {
+		return method, true
+	}
+	if len(opts.Clauses) > 0 || len(opts.Queries) > 0 || opts.Cursor != nil {
+		return "", false
+	}
+
+	filters := make([]string, 0, len(opts.Filters))
+	for k, v := range opts.Filters {
+		name, ok := k.(string)
+		if !ok || !cacheable(v) {
+			return "", false
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		filters = append(filters, fmt.Sprintf("%s=%T:%s", name, v, value))
+	}
+	sort.Strings(filters)
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s|%d|%d|%s", method, opts.Offset, opts.Limit, strings.Join(filters, "&")))
+	return hex.EncodeToString(sum[:]), true
+}
+
+// cacheable 判断过滤值能否可靠地作为缓存键的一部分，指针等值在不同请求之间没有稳定的表示.
+func cacheable(v any) bool {
+	if v == nil {
+		return false
+	}
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// inTransaction 判断 db 是否是事务实例，事务内需要读到本事务尚未提交的写入.
+func inTransaction(db *gorm.DB) bool {
+	_, ok := db.Statement.ConnPool.(gorm.TxCommitter)
+	return ok
+}
+
+// encode 将记录编码为缓存值.
+func encode(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{markFound}, data...), nil
+}
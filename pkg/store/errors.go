@@ -0,0 +1,264 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/glebarez/go-sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+// ErrorTranslatorName 是 ErrorTranslator 插件的名称。
+const ErrorTranslatorName = "store:error_translator"
+
+// PostgreSQL 的 SQLSTATE 错误码。
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgNotNullViolation     = "23502"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// MySQL 的错误码。
+const (
+	mysqlDuplicateEntry        = 1062
+	mysqlRowIsReferenced       = 1451
+	mysqlNoReferencedRow       = 1452
+	mysqlBadNull               = 1048
+	mysqlNoDefaultForField     = 1364
+	mysqlLockDeadlock          = 1213
+	mysqlLockWaitTimeout       = 1205
+	mysqlNoReferencedRowLegacy = 1216
+	mysqlRowIsReferencedLegacy = 1217
+)
+
+// SQLite 的扩展错误码。
+const (
+	sqliteBusy                 = 5
+	sqliteConstraintForeignKey = 787
+	sqliteConstraintNotNull    = 1299
+	sqliteConstraintPrimaryKey = 1555
+	sqliteConstraintUnique     = 2067
+)
+
+var (
+	// pgKeyDetail 匹配 PostgreSQL 唯一约束冲突详情中的列名，例如 Key (username)=(alice) already exists.
+	pgKeyDetail = regexp.MustCompile(`Key \((.+?)\)=`)
+	// mysqlQuoted 匹配 MySQL 错误信息中单引号括起的名称，例如 for key 'user.uk_user_username'
+	mysqlQuoted = regexp.MustCompile(`'([^']*)'`)
+	// mysqlForeignKey 匹配 MySQL 外键冲突信息中的约束名，例如 CONSTRAINT `fk_user_role_role` FOREIGN KEY
+	mysqlForeignKey = regexp.MustCompile("CONSTRAINT `([^`]+)` FOREIGN KEY")
+	// sqliteColumns 匹配 SQLite 约束冲突信息中的列名，例如 UNIQUE constraint failed: user.username, user.email
+	sqliteColumns = regexp.MustCompile(`constraint failed: ([\w.]+(?:, [\w.]+)*)`)
+)
+
+// ErrorTranslator 是一个 gorm 插件，在每次执行 SQL 后将数据库驱动返回的错误转换为 errorsx 中的业务错误，
+// 直接使用 *gorm.DB 的代码也会得到转换后的错误。支持 PostgreSQL、MySQL 和 SQLite：
+//   - 违反唯一约束转换为 AlreadyExists，Metadata 中的 field 为冲突的字段；
+//   - 违反外键约束转换为 FailedPrecondition；
+//   - 违反非空约束转换为 InvalidArgument；
+//   - 序列化失败、死锁等并发冲突转换为可以重试的 Aborted。
+type ErrorTranslator struct{}
+
+var _ gorm.Plugin = ErrorTranslator{}
+
+// Name 返回插件的名称。
+func (ErrorTranslator) Name() string {
+	return ErrorTranslatorName
+}
+
+// Initialize 在所有回调之后注册错误转换回调。
+func (ErrorTranslator) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().After("*").Register(ErrorTranslatorName, translateCallback),
+		cb.Query().After("*").Register(ErrorTranslatorName, translateCallback),
+		cb.Update().After("*").Register(ErrorTranslatorName, translateCallback),
+		cb.Delete().After("*").Register(ErrorTranslatorName, translateCallback),
+		cb.Row().After("*").Register(ErrorTranslatorName, translateCallback),
+		cb.Raw().After("*").Register(ErrorTranslatorName, translateCallback),
+	)
+}
+
+func translateCallback(db *gorm.DB) {
+	if db.Error != nil {
+		db.Error = translateError(db.Error, db.Statement.Schema)
+	}
+}
+
+// TranslateError 将数据库驱动返回的错误转换为 errorsx 中的业务错误，无法识别的错误原样返回。
+// 用于转换不经过 gorm 回调的错误，例如事务提交时返回的错误。
+func TranslateError(err error) error {
+	return translateError(err, nil)
+}
+
+// translateError 转换 err，sch 用于根据 MySQL 的索引名推断冲突的字段，可以为 nil。
+func translateError(err error, sch *schema.Schema) error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		pgErr     *pgconn.PgError
+		mysqlErr  *mysql.MySQLError
+		sqliteErr *sqlite.Error
+	)
+	switch {
+	case errors.As(err, &pgErr):
+		return translatePostgres(pgErr, err)
+	case errors.As(err, &mysqlErr):
+		return translateMySQL(mysqlErr, sch, err)
+	case errors.As(err, &sqliteErr):
+		return translateSQLite(sqliteErr, err)
+	default:
+		return err
+	}
+}
+
+func translatePostgres(pgErr *pgconn.PgError, err error) error {
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		var field string
+		if m := pgKeyDetail.FindStringSubmatch(pgErr.Detail); m != nil {
+			field = strings.ReplaceAll(m[1], " ", "")
+		}
+		return alreadyExists(field, pgErr.ConstraintName)
+	case pgForeignKeyViolation:
+		return failedPrecondition(pgErr.ConstraintName)
+	case pgNotNullViolation:
+		return notNull(pgErr.ColumnName)
+	case pgSerializationFailure, pgDeadlockDetected:
+		return aborted(err)
+	default:
+		return err
+	}
+}
+
+func translateMySQL(mysqlErr *mysql.MySQLError, sch *schema.Schema, err error) error {
+	switch mysqlErr.Number {
+	case mysqlDuplicateEntry:
+		// 错误信息形如 Duplicate entry 'alice-1' for key 'user.uk_user_username'，MySQL 8 的索引名带有表名前缀
+		var constraint string
+		if m := mysqlQuoted.FindAllStringSubmatch(mysqlErr.Message, -1); len(m) > 0 {
+			constraint = m[len(m)-1][1]
+			if i := strings.LastIndexByte(constraint, '.'); i >= 0 {
+				constraint = constraint[i+1:]
+			}
+		}
+		return alreadyExists(fieldOfIndex(sch, constraint), constraint)
+	case mysqlRowIsReferenced, mysqlNoReferencedRow, mysqlRowIsReferencedLegacy, mysqlNoReferencedRowLegacy:
+		var constraint string
+		if m := mysqlForeignKey.FindStringSubmatch(mysqlErr.Message); m != nil {
+			constraint = m[1]
+		}
+		return failedPrecondition(constraint)
+	case mysqlBadNull, mysqlNoDefaultForField:
+		var field string
+		if m := mysqlQuoted.FindStringSubmatch(mysqlErr.Message); m != nil {
+			field = m[1]
+		}
+		return notNull(field)
+	case mysqlLockDeadlock, mysqlLockWaitTimeout:
+		return aborted(err)
+	default:
+		return err
+	}
+}
+
+func translateSQLite(sqliteErr *sqlite.Error, err error) error {
+	// 约束冲突信息只包含列名，形如 constraint failed: UNIQUE constraint failed: user.username (2067)
+	var field string
+	if m := sqliteColumns.FindAllStringSubmatch(sqliteErr.Error(), -1); len(m) > 0 {
+		columns := strings.Split(m[len(m)-1][1], ",")
+		for i, column := range columns {
+			column = strings.TrimSpace(column)
+			if j := strings.LastIndexByte(column, '.'); j >= 0 {
+				column = column[j+1:]
+			}
+			columns[i] = column
+		}
+		field = strings.Join(columns, ",")
+	}
+
+	switch code := sqliteErr.Code(); {
+	case code == sqliteConstraintUnique || code == sqliteConstraintPrimaryKey:
+		return alreadyExists(field, "")
+	case code == sqliteConstraintForeignKey:
+		return failedPrecondition("")
+	case code == sqliteConstraintNotNull:
+		return notNull(field)
+	case code&0xff == sqliteBusy:
+		return aborted(err)
+	default:
+		return err
+	}
+}
+
+// fieldOfIndex 根据索引名推断唯一约束的字段，索引名以字段名结尾，例如 uk_user_username 和 sod_rule_name_key。
+// 多个字段都匹配时使用最长的字段名，无法推断时返回空字符串。
+func fieldOfIndex(sch *schema.Schema, index string) string {
+	if sch == nil {
+		return ""
+	}
+
+	name := strings.TrimSuffix(index, "_key")
+	var field string
+	for _, dbName := range sch.DBNames {
+		if (name == dbName || strings.HasSuffix(name, "_"+dbName)) && len(dbName) > len(field) {
+			field = dbName
+		}
+	}
+	return field
+}
+
+// metadata 返回包含非空 key-value 的元数据，没有元数据时返回 nil。
+func metadata(kvs ...string) map[string]interface{} {
+	var md map[string]interface{}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		if kvs[i+1] == "" {
+			continue
+		}
+		if md == nil {
+			md = make(map[string]interface{})
+		}
+		md[kvs[i]] = kvs[i+1]
+	}
+	return md
+}
+
+func alreadyExists(field, constraint string) error {
+	message := errorsx.ErrAlreadyExists.Message
+	if field != "" {
+		message = fmt.Sprintf("A resource with the same %s already exists.", field)
+	}
+	return errorsx.ErrAlreadyExists.WithMessage(message).WithMetadata(metadata("field", field, "constraint", constraint))
+}
+
+func failedPrecondition(constraint string) error {
+	return errorsx.ErrFailedPrecondition.
+		WithMessage("The operation violates a reference to another resource.").
+		WithMetadata(metadata("constraint", constraint))
+}
+
+func notNull(field string) error {
+	message := "A required field is missing."
+	if field != "" {
+		message = fmt.Sprintf("Field %s is required.", field)
+	}
+	return errorsx.ErrInvalidArgument.WithMessage(message).WithMetadata(metadata("field", field))
+}
+
+// aborted 返回可以重试的业务错误. 驱动返回的错误信息可能包含 SQL 和数据，只记录到日志，不返回给客户端。
+func aborted(err error) error {
+	slog.Warn("Database aborted the operation", "error", err)
+	return errorsx.ErrAborted.WithMessage(errorsx.ErrAborted.Message)
+}
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	"github.com/clin211/gin-enterprise-template/pkg/errorsx"
+)
+
+type account struct {
+	ID       uint   `gorm:"primaryKey"`
+	Username string `gorm:"uniqueIndex:uk_account_username;not null"`
+	Email    *string
+	RoleCode string
+}
+
+// bizError 断言 err 是 code 对应的业务错误，并返回其元数据.
+func bizError(t *testing.T, err error, code errorsx.BizCode) map[string]interface{} {
+	t.Helper()
+
+	bizErr := new(errorsx.BizError)
+	require.True(t, errors.As(err, &bizErr), "expected a biz error, got %v", err)
+	assert.Equal(t, code, bizErr.Code)
+	return bizErr.Metadata
+}
+
+func TestTranslateError_Postgres(t *testing.T) {
+	err := TranslateError(&pgconn.PgError{
+		Code:           "23505",
+		Detail:         "Key (username)=(alice) already exists.",
+		ConstraintName: "uk_user_username",
+	})
+	assert.Equal(t, map[string]interface{}{"field": "username", "constraint": "uk_user_username"},
+		bizError(t, err, errorsx.CodeAlreadyExists))
+	assert.ErrorIs(t, err, errorsx.ErrAlreadyExists)
+
+	err = TranslateError(&pgconn.PgError{Code: "23505", Detail: "Key (user_id, role_id)=(u1, r1) already exists."})
+	assert.Equal(t, "user_id,role_id", bizError(t, err, errorsx.CodeAlreadyExists)["field"])
+
+	err = TranslateError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_user_role_role"})
+	assert.Equal(t, "fk_user_role_role", bizError(t, err, errorsx.CodeFailedPrecondition)["constraint"])
+
+	err = TranslateError(&pgconn.PgError{Code: "23502", ColumnName: "nickname"})
+	assert.Equal(t, "nickname", bizError(t, err, errorsx.CodeUserInvalidCredentials)["field"])
+
+	err = TranslateError(&pgconn.PgError{Code: "40001", Message: "could not serialize access due to concurrent update"})
+	bizError(t, err, errorsx.CodeAborted)
+	assert.Empty(t, errorsx.FromError(err).Details, "driver messages should not be returned to clients")
+	bizError(t, TranslateError(&pgconn.PgError{Code: "40P01"}), errorsx.CodeAborted)
+
+	// 无法识别的错误原样返回
+	pgErr := &pgconn.PgError{Code: "42P01"}
+	assert.Same(t, pgErr, TranslateError(pgErr))
+	assert.NoError(t, TranslateError(nil))
+}
+
+func TestTranslateError_MySQL(t *testing.T) {
+	sch, err := schema.Parse(&account{}, &sync.Map{}, schema.NamingStrategy{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		key   string
+		field string
+	}{
+		{"account.uk_account_username", "username"},
+		{"uk_account_role_code", "role_code"},
+		{"account_email_key", "email"},
+		{"PRIMARY", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			err := translateError(&mysql.MySQLError{
+				Number:  1062,
+				Message: "Duplicate entry 'alice-1' for key '" + tt.key + "'",
+			}, sch)
+			md := bizError(t, err, errorsx.CodeAlreadyExists)
+			if tt.field == "" {
+				assert.NotContains(t, md, "field")
+			} else {
+				assert.Equal(t, tt.field, md["field"])
+			}
+		})
+	}
+
+	err = TranslateError(&mysql.MySQLError{
+		Number: 1452,
+		Message: "Cannot add or update a child row: a foreign key constraint fails " +
+			"(`app`.`user_role`, CONSTRAINT `fk_user_role_role` FOREIGN KEY (`role_id`) REFERENCES `role` (`role_id`))",
+	})
+	assert.Equal(t, "fk_user_role_role", bizError(t, err, errorsx.CodeFailedPrecondition)["constraint"])
+
+	err = TranslateError(&mysql.MySQLError{Number: 1048, Message: "Column 'username' cannot be null"})
+	assert.Equal(t, "username", bizError(t, err, errorsx.CodeUserInvalidCredentials)["field"])
+
+	bizError(t, TranslateError(&mysql.MySQLError{Number: 1213, Message: "Deadlock found"}), errorsx.CodeAborted)
+}
+
+func TestErrorTranslator(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&account{}))
+	require.NoError(t, db.Use(ErrorTranslator{}))
+
+	s := NewStore[account](&provider{db}, nil)
+	ctx := context.Background()
+	require.NoError(t, s.Create(ctx, &account{Username: "alice"}))
+
+	err = s.Create(ctx, &account{Username: "alice"})
+	assert.Equal(t, "username", bizError(t, err, errorsx.CodeAlreadyExists)["field"])
+
+	err = db.Exec("INSERT INTO accounts (username) VALUES (NULL)").Error
+	assert.Equal(t, "username", bizError(t, err, errorsx.CodeUserInvalidCredentials)["field"])
+
+	// 不是约束冲突的错误原样返回
+	err = db.Exec("SELECT * FROM missing").Error
+	require.Error(t, err)
+	assert.False(t, errors.As(err, new(*errorsx.BizError)))
+}
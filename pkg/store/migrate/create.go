@@ -0,0 +1,49 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// nonNameRegexp 匹配迁移名称中不允许出现的字符.
+var nonNameRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// NextVersion 返回 dirs 中已有的最大版本号加 1. 多个数据库的迁移目录需要使用相同的版本号.
+func NextVersion(dirs ...string) (int64, error) {
+	var version int64
+	for _, dir := range dirs {
+		migrations, err := Load(os.DirFS(dir))
+		if err != nil {
+			return 0, err
+		}
+		if len(migrations) > 0 {
+			version = max(version, migrations[len(migrations)-1].Version)
+		}
+	}
+	return version + 1, nil
+}
+
+// Create 在 dir 目录中创建指定版本的空迁移文件，返回创建的 up 和 down 文件路径.
+// name 会被转换为小写下划线格式.
+func Create(dir string, version int64, name string) (string, string, error) {
+	name = strings.Trim(nonNameRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", "", fmt.Errorf("migration name must contain letters or digits")
+	}
+
+	base := fmt.Sprintf("%06d_%s", version, name)
+	up := filepath.Join(dir, base+".up.sql")
+	down := filepath.Join(dir, base+".down.sql")
+
+	if err := os.WriteFile(up, []byte(fmt.Sprintf("-- %s: 在此编写升级 SQL.\n", base)), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(down, []byte(fmt.Sprintf("-- %s: 在此编写回滚 SQL，撤销 up 文件中的变更.\n", base)), 0o644); err != nil {
+		return "", "", err
+	}
+
+	return up, down, nil
+}
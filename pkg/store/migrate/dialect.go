@@ -0,0 +1,179 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// 支持的数据库驱动名称，与 gorm Dialector 的 Name() 一致.
+const (
+	DriverPostgres = "postgres"
+	DriverMySQL    = "mysql"
+	DriverSQLite   = "sqlite"
+)
+
+// dialect 描述不同数据库在迁移历史表、加锁和执行脚本上的差异.
+type dialect struct {
+	// quote 为标识符加引号
+	quote func(name string) string
+	// tableExists 是查询历史表是否存在的 SQL，参数为 table 返回的值
+	tableExists string
+	// tableArg 返回 tableExists 使用的表名参数
+	tableArg func(table string) string
+	// columnTypes 依次是历史表 version、checksum、execution_time_ms 和 applied_at 列的类型
+	columnTypes [4]string
+	// lock 获取迁移锁，unlock 释放迁移锁，为空表示不支持跨进程加锁
+	lock, unlock string
+	// split 为 true 时将脚本拆分为单条语句逐条执行，用于不支持一次执行多条语句的驱动
+	split bool
+	// rebind 将 ? 占位符转换为驱动使用的占位符
+	rebind func(query string) string
+}
+
+// dialects 是支持的数据库方言.
+var dialects = map[string]*dialect{
+	DriverPostgres: {
+		quote:       doubleQuote,
+		tableExists: `SELECT to_regclass(?) IS NOT NULL`,
+		tableArg:    doubleQuote,
+		columnTypes: [4]string{"int8", "char(64)", "int8", "timestamptz(6)"},
+		// 会话级 advisory lock 在连接关闭时自动释放，进程异常退出也不会一直持有
+		lock:   `SELECT pg_advisory_lock(?)`,
+		unlock: `SELECT pg_advisory_unlock(?)`,
+		rebind: dollarPlaceholders,
+	},
+	DriverMySQL: {
+		quote:       func(name string) string { return "`" + strings.ReplaceAll(name, "`", "``") + "`" },
+		tableExists: `SELECT COUNT(*) > 0 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?`,
+		tableArg:    func(table string) string { return table },
+		columnTypes: [4]string{"bigint", "char(64)", "bigint", "datetime(6)"},
+		// 命名锁同样在连接关闭时自动释放，-1 表示一直等待
+		lock:   `SELECT GET_LOCK(CONCAT('migrate:', ?), -1)`,
+		unlock: `SELECT RELEASE_LOCK(CONCAT('migrate:', ?))`,
+		split:  true,
+		rebind: func(query string) string { return query },
+	},
+	DriverSQLite: {
+		quote:       doubleQuote,
+		tableExists: `SELECT COUNT(*) > 0 FROM sqlite_master WHERE type = 'table' AND name = ?`,
+		tableArg:    func(table string) string { return table },
+		columnTypes: [4]string{"integer", "char(64)", "integer", "datetime"},
+		// SQLite 通常只被单个进程使用，不需要跨进程的迁移锁
+		split:  true,
+		rebind: func(query string) string { return query },
+	},
+}
+
+// lockArg 返回加锁 SQL 使用的参数. PostgreSQL 使用整数键，MySQL 使用锁名称.
+func (m *Migrator) lockArg() any {
+	if m.driver == DriverMySQL {
+		return m.table
+	}
+	return m.lockKey
+}
+
+// acquireLock 在连接上获取迁移锁，返回释放锁的函数.
+func (m *Migrator) acquireLock(ctx context.Context, conn *sql.Conn) (func(), error) {
+	if m.dialect.lock == "" {
+		return func() {}, nil
+	}
+
+	if _, err := conn.ExecContext(ctx, m.dialect.rebind(m.dialect.lock), m.lockArg()); err != nil {
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	return func() {
+		// 使用独立的 context，确保 ctx 被取消后仍能释放锁
+		_, _ = conn.ExecContext(context.Background(), m.dialect.rebind(m.dialect.unlock), m.lockArg())
+	}, nil
+}
+
+// doubleQuote 使用双引号为标识符加引号.
+func doubleQuote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// dollarPlaceholders 将 ? 占位符依次转换为 $1、$2 ...
+func dollarPlaceholders(query string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// splitStatements 将脚本按分号拆分为单条语句，忽略字符串、引号标识符和注释中的分号，并丢弃只包含注释的片段.
+// 字符串只支持标准的两个引号转义，不支持反斜杠转义.
+func splitStatements(script string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+		hasCode    bool
+	)
+	flush := func() {
+		if hasCode {
+			statements = append(statements, strings.TrimSpace(current.String()))
+		}
+		current.Reset()
+		hasCode = false
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '-' && i+1 < len(script) && script[i+1] == '-':
+			// 行注释一直到行尾
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				end = len(script) - i
+			}
+			current.WriteString(script[i : i+end])
+			i += end - 1
+		case c == '/' && i+1 < len(script) && script[i+1] == '*':
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				end = len(script) - i - 2
+			} else {
+				end += 2
+			}
+			current.WriteString(script[i : i+2+end])
+			i += 1 + end
+		case c == '\'' || c == '"' || c == '`':
+			// 引号内的内容原样保留，连续两个引号表示转义
+			j := i + 1
+			for j < len(script) {
+				if script[j] == c {
+					if j+1 < len(script) && script[j+1] == c {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			if j >= len(script) {
+				j = len(script) - 1
+			}
+			current.WriteString(script[i : j+1])
+			hasCode = true
+			i = j
+		case c == ';':
+			flush()
+		default:
+			current.WriteByte(c)
+			if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+				hasCode = true
+			}
+		}
+	}
+	flush()
+
+	return statements
+}
@@ -0,0 +1,415 @@
+// Package migrate 实现基于版本号的 SQL 数据库迁移.
+//
+// 迁移文件按 <版本号>_<名称>.<up|down>.sql 命名，通常通过 embed.FS 编译进二进制.
+// 已执行的迁移记录在历史表中，并保存 up 文件的校验和，用于发现已执行的迁移文件被修改.
+// 执行迁移前会获取 PostgreSQL advisory lock 或 MySQL 命名锁，保证多个副本同时启动时只有一个在执行迁移.
+// 支持 PostgreSQL、MySQL 和 SQLite，MySQL 的 DDL 会隐式提交事务，迁移失败时可能需要手动清理.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultTable 是默认的迁移历史表名.
+const DefaultTable = "schema_migrations"
+
+// ErrSchemaOutOfDate 表示数据库结构与迁移文件不一致，例如存在未执行的迁移.
+var ErrSchemaOutOfDate = errors.New("database schema is out of date")
+
+// State 表示迁移的状态.
+type State string
+
+const (
+	// StateApplied 表示迁移已执行且文件未被修改
+	StateApplied State = "applied"
+	// StatePending 表示迁移尚未执行
+	StatePending State = "pending"
+	// StateModified 表示迁移已执行，但文件在执行后被修改
+	StateModified State = "modified"
+	// StateMissing 表示迁移已执行，但当前程序中找不到对应的文件，通常是数据库已被更新版本的程序迁移过
+	StateMissing State = "missing"
+)
+
+// Status 描述一个迁移的执行状态.
+type Status struct {
+	Version   int64
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+// record 是迁移历史表中的一条记录.
+type record struct {
+	version   int64
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Option 定义了一个函数选项类型，用于自定义 Migrator 的行为.
+type Option func(*Migrator)
+
+// WithTable 设置迁移历史表名.
+func WithTable(table string) Option {
+	return func(m *Migrator) {
+		m.table = table
+	}
+}
+
+// WithDriver 设置数据库驱动，可选 postgres、mysql 和 sqlite，默认为 postgres.
+func WithDriver(driver string) Option {
+	return func(m *Migrator) {
+		m.driver = driver
+	}
+}
+
+// WithLockKey 设置 PostgreSQL advisory lock 的键. 默认根据历史表名计算.
+func WithLockKey(key int64) Option {
+	return func(m *Migrator) {
+		m.lockKey = key
+	}
+}
+
+// Migrator 负责执行、回滚和检查迁移.
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+	table      string
+	lockKey    int64
+	driver     string
+	dialect    *dialect
+}
+
+// New 创建 Migrator 实例. migrations 需按版本号升序排列，通常由 Load 返回.
+func New(db *sql.DB, migrations []*Migration, opts ...Option) (*Migrator, error) {
+	m := &Migrator{
+		db:         db,
+		migrations: migrations,
+		table:      DefaultTable,
+		driver:     DriverPostgres,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	d, ok := dialects[m.driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported migration driver %q", m.driver)
+	}
+	m.dialect = d
+	if m.lockKey == 0 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte("migrate:" + m.table))
+		m.lockKey = int64(h.Sum64())
+	}
+	return m, nil
+}
+
+// Status 返回所有迁移的状态，按版本号升序排列. 历史表不存在时所有迁移均为待执行.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	records, err := m.records(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	return m.status(records), nil
+}
+
+// Verify 检查数据库是否已执行全部迁移且已执行的迁移文件未被修改，否则返回包装了 ErrSchemaOutOfDate 的错误.
+func (m *Migrator) Verify(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending, problems []string
+	for _, s := range statuses {
+		switch s.State {
+		case StatePending:
+			pending = append(pending, fmt.Sprintf("%06d_%s", s.Version, s.Name))
+		case StateModified, StateMissing:
+			problems = append(problems, fmt.Sprintf("%06d_%s is %s", s.Version, s.Name, s.State))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrSchemaOutOfDate, strings.Join(problems, ", "))
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending migration(s) (%s), run \"migrate up\" first", ErrSchemaOutOfDate, len(pending), strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Up 按顺序执行最多 n 个待执行的迁移，n <= 0 时执行全部待执行的迁移，返回本次执行的迁移.
+// 已执行的迁移文件被修改或在程序中找不到时拒绝执行.
+func (m *Migrator) Up(ctx context.Context, n int) ([]*Migration, error) {
+	var applied []*Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := m.records(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkApplied(m.status(records)); err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if n > 0 && len(applied) >= n {
+				break
+			}
+			if _, ok := records[migration.Version]; ok {
+				continue
+			}
+
+			slog.InfoContext(ctx, "Applying migration", "migration", migration.String())
+			start := time.Now()
+			err := m.exec(ctx, conn, migration.Up, migration.upInTransaction(), func(exec execer) error {
+				return m.insertRecord(ctx, exec, migration, time.Since(start))
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %s: %w", migration, err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down 按版本号从大到小回滚最近执行的 n 个迁移，返回本次回滚的迁移.
+func (m *Migrator) Down(ctx context.Context, n int) ([]*Migration, error) {
+	var reverted []*Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := m.records(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkApplied(m.status(records)); err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < n; i-- {
+			migration := m.migrations[i]
+			if _, ok := records[migration.Version]; !ok {
+				continue
+			}
+			if strings.TrimSpace(migration.Down) == "" {
+				return fmt.Errorf("migration %s cannot be reverted: no down file", migration)
+			}
+
+			slog.InfoContext(ctx, "Reverting migration", "migration", migration.String())
+			err := m.exec(ctx, conn, migration.Down, migration.downInTransaction(), func(exec execer) error {
+				_, err := exec.ExecContext(ctx, m.dialect.rebind(fmt.Sprintf(`DELETE FROM %s WHERE version = ?`, m.quotedTable())), migration.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revert migration %s: %w", migration, err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Baseline 将版本号不大于 version 的迁移标记为已执行而不实际执行，
+// 用于接管在引入迁移之前已经手动建好结构的数据库. 历史表中已有记录时拒绝执行.
+func (m *Migrator) Baseline(ctx context.Context, version int64) ([]*Migration, error) {
+	var marked []*Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := m.records(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(records) > 0 {
+			return fmt.Errorf("cannot baseline: %d migration(s) have already been applied", len(records))
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if err := m.insertRecord(ctx, tx, migration, 0); err != nil {
+				return err
+			}
+			marked = append(marked, migration)
+		}
+		if len(marked) == 0 {
+			return fmt.Errorf("no migration with version <= %d", version)
+		}
+		return tx.Commit()
+	})
+	return marked, err
+}
+
+// execer 是 *sql.Conn 和 *sql.Tx 的公共方法.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// exec 执行迁移脚本 script，然后调用 record 更新历史表. inTx 为 true 时两者在同一个事务中执行.
+func (m *Migrator) exec(ctx context.Context, conn *sql.Conn, script string, inTx bool, record func(execer) error) error {
+	if !inTx {
+		if err := m.execScript(ctx, conn, script); err != nil {
+			return err
+		}
+		return record(conn)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := m.execScript(ctx, tx, script); err != nil {
+		return err
+	}
+	if err := record(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// execScript 执行迁移脚本. 驱动不支持一次执行多条语句时逐条执行.
+func (m *Migrator) execScript(ctx context.Context, exec execer, script string) error {
+	if !m.dialect.split {
+		_, err := exec.ExecContext(ctx, script)
+		return err
+	}
+
+	for _, statement := range splitStatements(script) {
+		if _, err := exec.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertRecord 在历史表中记录已执行的迁移.
+func (m *Migrator) insertRecord(ctx context.Context, exec execer, migration *Migration, elapsed time.Duration) error {
+	_, err := exec.ExecContext(ctx,
+		m.dialect.rebind(fmt.Sprintf(`INSERT INTO %s (version, name, checksum, execution_time_ms, applied_at) VALUES (?, ?, ?, ?, ?)`, m.quotedTable())),
+		migration.Version, migration.Name, migration.Checksum, elapsed.Milliseconds(), time.Now())
+	return err
+}
+
+// withLock 在持有迁移锁的连接上创建历史表并执行 fn. 锁在连接上获取和释放，
+// 因此 fn 中的所有操作都必须使用同一个连接.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	unlock, err := m.acquireLock(ctx, conn)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	types := m.dialect.columnTypes
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+  version %s NOT NULL PRIMARY KEY,
+  name varchar(255) NOT NULL,
+  checksum %s NOT NULL,
+  execution_time_ms %s NOT NULL DEFAULT 0,
+  applied_at %s NOT NULL
+)`, m.quotedTable(), types[0], types[1], types[2], types[3])); err != nil {
+		return fmt.Errorf("failed to create migration history table: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// records 读取历史表中的记录，历史表不存在时返回空.
+func (m *Migrator) records(ctx context.Context, conn *sql.Conn) (map[int64]*record, error) {
+	var exists bool
+	if err := conn.QueryRowContext(ctx, m.dialect.rebind(m.dialect.tableExists), m.dialect.tableArg(m.table)).Scan(&exists); err != nil {
+		return nil, err
+	}
+	records := make(map[int64]*record)
+	if !exists {
+		return records, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf(`SELECT version, name, checksum, applied_at FROM %s`, m.quotedTable()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r record
+		if err := rows.Scan(&r.version, &r.name, &r.checksum, &r.appliedAt); err != nil {
+			return nil, err
+		}
+		records[r.version] = &r
+	}
+	return records, rows.Err()
+}
+
+// status 根据历史记录计算每个迁移的状态.
+func (m *Migrator) status(records map[int64]*record) []*Status {
+	statuses := make([]*Status, 0, len(m.migrations))
+	known := make(map[int64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		s := &Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if r, ok := records[migration.Version]; ok {
+			s.AppliedAt = &r.appliedAt
+			s.State = StateApplied
+			if r.checksum != migration.Checksum {
+				s.State = StateModified
+			}
+		}
+		statuses = append(statuses, s)
+	}
+
+	for version, r := range records {
+		if !known[version] {
+			statuses = append(statuses, &Status{Version: version, Name: r.name, State: StateMissing, AppliedAt: &r.appliedAt})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses
+}
+
+// checkApplied 检查已执行的迁移是否与程序中的文件一致.
+func checkApplied(statuses []*Status) error {
+	for _, s := range statuses {
+		switch s.State {
+		case StateModified:
+			return fmt.Errorf("migration %06d_%s has been modified after it was applied", s.Version, s.Name)
+		case StateMissing:
+			return fmt.Errorf("migration %06d_%s has been applied but is unknown to this binary", s.Version, s.Name)
+		}
+	}
+	return nil
+}
+
+// quotedTable 返回加引号的历史表名.
+func (m *Migrator) quotedTable() string {
+	return m.dialect.quote(m.table)
+}
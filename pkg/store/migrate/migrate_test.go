@@ -0,0 +1,92 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000002_add_index.up.sql":   {Data: []byte("-- migrate:no-transaction\nCREATE INDEX CONCURRENTLY idx ON t (c);")},
+		"000002_add_index.down.sql": {Data: []byte("DROP INDEX idx;")},
+		"000001_init.up.sql":        {Data: []byte("CREATE TABLE t (c int);")},
+		"000001_init.down.sql":      {Data: []byte("DROP TABLE t;")},
+		"migrations.go":             {Data: []byte("package migrations")},
+	}
+
+	migrations, err := Load(fsys)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+
+	assert.Equal(t, int64(1), migrations[0].Version)
+	assert.Equal(t, "init", migrations[0].Name)
+	assert.Equal(t, "DROP TABLE t;", migrations[0].Down)
+	assert.Equal(t, checksum([]byte("CREATE TABLE t (c int);")), migrations[0].Checksum)
+	assert.True(t, migrations[0].upInTransaction())
+
+	assert.Equal(t, "000002_add_index", migrations[1].String())
+	assert.False(t, migrations[1].upInTransaction())
+	assert.True(t, migrations[1].downInTransaction())
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"invalid name": {"init.up.sql": {Data: []byte("")}},
+		"no up file":   {"000001_init.down.sql": {Data: []byte("")}},
+		"duplicate version": {
+			"000001_init.up.sql":  {Data: []byte("")},
+			"000001_other.up.sql": {Data: []byte("")},
+		},
+	}
+
+	for name, fsys := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Load(fsys)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	postgres, sqlite := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(postgres, "000003_init.up.sql"), []byte(""), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sqlite, "000002_init.up.sql"), []byte(""), 0o644))
+
+	version, err := NextVersion(postgres, sqlite)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+
+	up, down, err := Create(sqlite, version, "Add User-Avatar")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(sqlite, "000004_add_user_avatar.up.sql"), up)
+	assert.Equal(t, filepath.Join(sqlite, "000004_add_user_avatar.down.sql"), down)
+
+	migrations, err := Load(os.DirFS(sqlite))
+	require.NoError(t, err)
+	assert.Len(t, migrations, 2)
+
+	_, _, err = Create(sqlite, version, "!!!")
+	assert.Error(t, err)
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := `-- 创建表; 注释中的分号
+CREATE TABLE t (c varchar(10) DEFAULT 'a;b' COMMENT 'it''s');
+/* 块注释; */
+INSERT INTO "t;x" VALUES (1);
+-- 末尾只有注释
+`
+	statements := splitStatements(script)
+	require.Len(t, statements, 2)
+	assert.Equal(t, "-- 创建表; 注释中的分号\nCREATE TABLE t (c varchar(10) DEFAULT 'a;b' COMMENT 'it''s')", statements[0])
+	assert.Equal(t, "/* 块注释; */\nINSERT INTO \"t;x\" VALUES (1)", statements[1])
+}
+
+func TestDollarPlaceholders(t *testing.T) {
+	assert.Equal(t, "DELETE FROM t WHERE a = $1 AND b = $2", dollarPlaceholders("DELETE FROM t WHERE a = ? AND b = ?"))
+}
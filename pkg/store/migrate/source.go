@@ -0,0 +1,113 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// noTransactionDirective 出现在迁移文件第一行时，该文件不在事务中执行，
+// 用于 CREATE INDEX CONCURRENTLY 等无法在事务中执行的语句.
+const noTransactionDirective = "-- migrate:no-transaction"
+
+// fileNameRegexp 匹配迁移文件名，格式为 <版本号>_<名称>.<up|down>.sql，例如 000001_init.up.sql.
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration 表示一个版本的迁移，由同一版本号的 up 和 down 两个 SQL 文件组成.
+type Migration struct {
+	// Version 是迁移的版本号，迁移按版本号从小到大依次执行
+	Version int64
+	// Name 是迁移的名称，取自文件名
+	Name string
+	// Up 是升级时执行的 SQL
+	Up string
+	// Down 是回滚时执行的 SQL，为空表示该迁移不可回滚
+	Down string
+	// Checksum 是 Up 的 SHA-256 摘要，用于发现已执行的迁移文件被修改
+	Checksum string
+}
+
+// upInTransaction 返回 up 脚本是否需要在事务中执行.
+func (m *Migration) upInTransaction() bool {
+	return !strings.HasPrefix(m.Up, noTransactionDirective)
+}
+
+// downInTransaction 返回 down 脚本是否需要在事务中执行.
+func (m *Migration) downInTransaction() bool {
+	return !strings.HasPrefix(m.Down, noTransactionDirective)
+}
+
+// String 返回迁移的可读名称，例如 000001_init.
+func (m *Migration) String() string {
+	return fmt.Sprintf("%06d_%s", m.Version, m.Name)
+}
+
+// Load 从 fsys 的根目录读取迁移文件，按版本号升序返回.
+// 非 .sql 文件会被忽略；文件名不符合命名规则、版本号重复或缺少 up 文件时返回错误.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		matches := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name %q, expected <version>_<name>.<up|down>.sql", entry.Name())
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %q: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %q and %q", version, m.Name, matches[2])
+		}
+
+		switch matches[3] {
+		case "up":
+			m.Up = string(data)
+			m.Checksum = checksum(data)
+		case "down":
+			m.Down = string(data)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Checksum == "" {
+			return nil, fmt.Errorf("migration %s has no up file", m)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// checksum 返回 data 的 SHA-256 摘要的十六进制字符串.
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -3,13 +3,23 @@ package store
 import (
 	"context"
 	"errors"
+	"reflect"
+	"slices"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/clin211/gin-enterprise-template/pkg/store/logger/empty"
 	"github.com/clin211/gin-enterprise-template/pkg/store/where"
 )
 
+// VersionField 是乐观锁版本号字段的列名，包含该字段的模型在更新时会校验并递增版本号。
+const VersionField = "version"
+
+// ErrConflict 表示对象在读取之后已被其他请求修改，更新的前置条件不成立。
+var ErrConflict = errors.New("object has been modified")
+
 // DBProvider 定义了一个提供数据库连接的接口。
 type DBProvider interface {
 	// DB 返回给定上下文的数据库实例。
@@ -65,14 +75,85 @@ func (s *Store[T]) Create(ctx context.Context, obj *T) error {
 }
 
 // Update 修改数据库中的现有对象。
+// 模型包含 version 字段时使用乐观锁：只有数据库中的版本号仍等于 obj 的版本号时才会写入所有字段并递增版本号，
+// 否则返回 ErrConflict。
 func (s *Store[T]) Update(ctx context.Context, obj *T) error {
-	if err := s.db(ctx).Save(obj).Error; err != nil {
-		s.logger.Error(ctx, err, "Failed to update object in database", "object", obj)
+	return s.update(ctx, obj, nil)
+}
+
+// UpdateFields 只修改对象的指定字段，fields 可以是结构体字段名或列名，为空时等同于 Update。
+// 模型包含 version 字段时的行为与 Update 相同，调用方可以先将 obj 的版本号设置为客户端读取时的版本号作为前置条件。
+func (s *Store[T]) UpdateFields(ctx context.Context, obj *T, fields ...string) error {
+	return s.update(ctx, obj, fields)
+}
+
+// update 实现 Update 和 UpdateFields，成功后 obj 的版本号为数据库中的新版本号。
+func (s *Store[T]) update(ctx context.Context, obj *T, fields []string) error {
+	db := s.db(ctx)
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(obj); err != nil {
 		return err
 	}
+
+	versionField := stmt.Schema.LookUpField(VersionField)
+	if versionField != nil && !isInteger(versionField.FieldType.Kind()) {
+		versionField = nil
+	}
+	if versionField == nil {
+		if len(fields) == 0 {
+			db = db.Save(obj)
+		} else {
+			db = db.Model(obj).Select(fields).Updates(obj)
+		}
+		if db.Error != nil {
+			s.logger.Error(ctx, db.Error, "Failed to update object in database", "object", obj)
+		}
+		return db.Error
+	}
+
+	// 没有主键时只有版本号条件，会修改表中所有同版本的记录
+	value := reflect.ValueOf(obj)
+	if pk := stmt.Schema.PrioritizedPrimaryField; pk == nil {
+		return gorm.ErrPrimaryKeyRequired
+	} else if _, zero := pk.ValueOf(ctx, value); zero {
+		return gorm.ErrPrimaryKeyRequired
+	}
+
+	current, _ := versionField.ValueOf(ctx, value)
+	if err := versionField.Set(ctx, value, reflect.ValueOf(current).Int()+1); err != nil {
+		return err
+	}
+
+	selects := []string{"*"}
+	if len(fields) > 0 {
+		selects = append(slices.Clip(fields), versionField.Name)
+	}
+	result := db.Model(obj).
+		Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: versionField.DBName}, Value: current}).
+		Select(selects).Updates(obj)
+	if result.Error == nil && result.RowsAffected == 0 {
+		result.Error = ErrConflict
+	}
+	if result.Error != nil {
+		_ = versionField.Set(ctx, value, current)
+		if !errors.Is(result.Error, ErrConflict) {
+			s.logger.Error(ctx, result.Error, "Failed to update object in database", "object", obj)
+		}
+		return result.Error
+	}
 	return nil
 }
 
+// isInteger 判断版本号字段是否是整数类型。
+func isInteger(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
 // Delete 根据提供的 where 选项从数据库中删除对象。
 func (s *Store[T]) Delete(ctx context.Context, opts *where.Options) error {
 	err := s.db(ctx, opts).Delete(new(T)).Error
@@ -101,3 +182,46 @@ func (s *Store[T]) List(ctx context.Context, opts *where.Options) (count int64,
 	}
 	return
 }
+
+// 以下方法用于带有 deleted_at 软删除字段（gorm.DeletedAt）的模型，Delete 只会标记删除时间，
+// 已删除的对象仍然保留在数据库中，可以从回收站恢复，或者在超过保留期后被彻底清除。
+
+// ListDeleted 根据提供的 where 选项从数据库中检索已软删除的对象列表。
+func (s *Store[T]) ListDeleted(ctx context.Context, opts *where.Options) (count int64, ret []*T, err error) {
+	err = s.db(ctx, opts).Unscoped().Where("deleted_at IS NOT NULL").
+		Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		s.logger.Error(ctx, err, "Failed to list deleted objects from database", "conditions", opts)
+	}
+	return
+}
+
+// GetDeleted 根据提供的 where 选项从数据库中检索单个已软删除的对象。
+func (s *Store[T]) GetDeleted(ctx context.Context, opts *where.Options) (*T, error) {
+	var obj T
+	if err := s.db(ctx, opts).Unscoped().Where("deleted_at IS NOT NULL").First(&obj).Error; err != nil {
+		s.logger.Error(ctx, err, "Failed to retrieve deleted object from database", "conditions", opts)
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// Restore 根据提供的 where 选项恢复已软删除的对象，返回是否实际恢复了对象。
+func (s *Store[T]) Restore(ctx context.Context, opts *where.Options) (bool, error) {
+	result := s.db(ctx, opts).Unscoped().Model(new(T)).Where("deleted_at IS NOT NULL").Update("deleted_at", nil)
+	if result.Error != nil {
+		s.logger.Error(ctx, result.Error, "Failed to restore object in database", "conditions", opts)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Purge 彻底删除在 before 之前被软删除的对象，返回删除的对象数量。
+func (s *Store[T]) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result := s.db(ctx).Unscoped().Where("deleted_at < ?", before).Delete(new(T))
+	if result.Error != nil {
+		s.logger.Error(ctx, result.Error, "Failed to purge deleted objects from database", "before", before)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/clin211/gin-enterprise-template/pkg/store/where"
+)
+
+type versioned struct {
+	ID        uint `gorm:"primaryKey"`
+	Name      string
+	Note      string
+	Version   int64 `gorm:"not null;default:1"`
+	UpdatedAt time.Time
+}
+
+type plain struct {
+	ID   uint `gorm:"primaryKey"`
+	Name string
+	Note string
+}
+
+// provider 是测试使用的 DBProvider.
+type provider struct {
+	db *gorm.DB
+}
+
+func (p *provider) DB(ctx context.Context, wheres ...where.Where) *gorm.DB {
+	return p.db.WithContext(ctx)
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&versioned{}, &plain{}))
+	return db
+}
+
+func TestStore_UpdateVersion(t *testing.T) {
+	db := openDB(t)
+	s := NewStore[versioned](&provider{db}, nil)
+	ctx := context.Background()
+
+	obj := &versioned{Name: "alice", Version: 1}
+	require.NoError(t, s.Create(ctx, obj))
+
+	a, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	b, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+
+	a.Name = "bob"
+	require.NoError(t, s.Update(ctx, a))
+	assert.Equal(t, int64(2), a.Version)
+
+	// b 基于旧版本修改，不能覆盖 a 的写入
+	b.Note = "stale"
+	require.ErrorIs(t, s.Update(ctx, b), ErrConflict)
+	assert.Equal(t, int64(1), b.Version, "the version should be restored on conflict")
+
+	got, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", got.Name)
+	assert.Empty(t, got.Note)
+	assert.Equal(t, int64(2), got.Version)
+
+	// 零值字段同样会被写入
+	got.Name = ""
+	require.NoError(t, s.Update(ctx, got))
+	got, err = s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Empty(t, got.Name)
+	assert.Equal(t, int64(3), got.Version)
+
+	require.ErrorIs(t, s.Update(ctx, &versioned{Version: 3}), gorm.ErrPrimaryKeyRequired)
+}
+
+func TestStore_UpdateFields(t *testing.T) {
+	db := openDB(t)
+	s := NewStore[versioned](&provider{db}, nil)
+	ctx := context.Background()
+
+	obj := &versioned{Name: "alice", Note: "note", Version: 1}
+	require.NoError(t, s.Create(ctx, obj))
+	before := obj.UpdatedAt
+
+	// 只写入 Name，其他字段即使在内存中被修改也不会写入
+	update := &versioned{ID: obj.ID, Name: "bob", Note: "ignored", Version: 1}
+	require.NoError(t, s.UpdateFields(ctx, update, "Name"))
+	assert.Equal(t, int64(2), update.Version)
+
+	got, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", got.Name)
+	assert.Equal(t, "note", got.Note)
+	assert.Equal(t, int64(2), got.Version)
+	assert.True(t, got.UpdatedAt.After(before), "updated_at should be refreshed")
+
+	update = &versioned{ID: obj.ID, Note: "stale", Version: 1}
+	require.ErrorIs(t, s.UpdateFields(ctx, update, "note"), ErrConflict)
+
+	update.Version = 2
+	require.NoError(t, s.UpdateFields(ctx, update, "note"))
+	got, err = s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "stale", got.Note)
+	assert.Equal(t, int64(3), got.Version)
+}
+
+func TestStore_UpdateWithoutVersion(t *testing.T) {
+	db := openDB(t)
+	s := NewStore[plain](&provider{db}, nil)
+	ctx := context.Background()
+
+	obj := &plain{Name: "alice", Note: "note"}
+	require.NoError(t, s.Create(ctx, obj))
+
+	obj.Name = "bob"
+	require.NoError(t, s.Update(ctx, obj))
+	require.NoError(t, s.UpdateFields(ctx, &plain{ID: obj.ID, Name: "ignored", Note: "carol"}, "Note"))
+
+	got, err := s.Get(ctx, where.F("id", obj.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", got.Name)
+	assert.Equal(t, "carol", got.Note)
+}
@@ -25,6 +25,8 @@ type Config struct {
 	accessExpiration time.Duration
 	// refreshExpiration 是 Refresh Token 的过期时间
 	refreshExpiration time.Duration
+	// impersonationExpiration 是模拟登录 Access Token 的过期时间
+	impersonationExpiration time.Duration
 	// skipPaths 需要跳过认证的路径列表
 	skipPaths []string
 }
@@ -38,6 +40,28 @@ const (
 	TokenTypeRefresh = "refresh"
 )
 
+// ClaimSessionID 是 token 中会话 ID 的键，同一次登录签发的 Access Token 和 Refresh Token 共享该值.
+const ClaimSessionID = "sid"
+
+// ClaimActor 是 token 中实际操作者的键（RFC 8693 act 声明），值为 {"sub": 操作者 ID}.
+// 仅出现在管理员模拟登录签发的 token 中.
+const ClaimActor = "act"
+
+// SignOption 用于在签发 token 时向 claims 中写入额外的字段.
+type SignOption func(claims jwt.MapClaims)
+
+// WithClaim 返回一个 SignOption，将指定的键值写入 Access Token 和 Refresh Token 的 claims.
+func WithClaim(key string, value any) SignOption {
+	return func(claims jwt.MapClaims) {
+		claims[key] = value
+	}
+}
+
+// WithSessionID 返回一个 SignOption，将会话 ID 写入 token 的 claims.
+func WithSessionID(sessionID string) SignOption {
+	return WithClaim(ClaimSessionID, sessionID)
+}
+
 var (
 	config = Config{
 		key:               "",
@@ -45,6 +69,8 @@ var (
 		accessExpiration:  2 * time.Hour,
 		refreshExpiration: 7 * 24 * time.Hour,
 		skipPaths:         []string{}, // 默认不跳过任何路径
+
+		impersonationExpiration: 15 * time.Minute,
 	}
 	once sync.Once // 确保配置只被初始化一次
 )
@@ -81,6 +107,15 @@ func WithIdentityKey(identityKey string) Option {
 	}
 }
 
+// WithImpersonationExpiration 设置模拟登录 Access Token 的过期时间
+func WithImpersonationExpiration(expiration time.Duration) Option {
+	return func(c *Config) {
+		if expiration > 0 {
+			c.impersonationExpiration = expiration
+		}
+	}
+}
+
 // WithSkipPaths 设置需要跳过认证的路径列表
 // 支持精确匹配和通配符匹配
 func WithSkipPaths(paths ...string) Option {
@@ -149,6 +184,8 @@ func Reset() {
 		accessExpiration:  2 * time.Hour,
 		refreshExpiration: 7 * 24 * time.Hour,
 		skipPaths:         []string{},
+
+		impersonationExpiration: 15 * time.Minute,
 	}
 }
 
@@ -311,6 +348,56 @@ func ParseRequest(ctx context.Context) (string, error) {
 	return ParseIdentity(tokenString, config.key)
 }
 
+// ParseRequestClaims 从请求头中获取 Access Token 并返回身份信息和全部 claims.
+// 与 ParseRequest 不同，该函数不会跳过 skipPaths 中配置的路径，调用方需要先使用 SkipRequest 判断.
+func ParseRequestClaims(ctx context.Context) (string, jwt.MapClaims, error) {
+	tokenString, err := extractTokenFromRequest(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return parseTypedClaims(tokenString, TokenTypeAccess, ErrNotAccessToken)
+}
+
+// ParseRefreshTokenClaims 解析并验证 Refresh Token，返回身份信息和全部 claims.
+func ParseRefreshTokenClaims(tokenString string) (string, jwt.MapClaims, error) {
+	return parseTypedClaims(tokenString, TokenTypeRefresh, ErrNotRefreshToken)
+}
+
+// parseTypedClaims 校验 token 类型后提取身份信息和 claims.
+func parseTypedClaims(tokenString, expectedType string, typeErr error) (string, jwt.MapClaims, error) {
+	claims, err := GetClaims(tokenString)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tokenType, ok := claims["token_type"].(string)
+	if !ok {
+		return "", nil, ErrMissingTokenType
+	}
+	if tokenType != expectedType {
+		return "", nil, typeErr
+	}
+
+	identity, err := extractIdentity(claims)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return identity, claims, nil
+}
+
+// ClaimString 从 claims 中读取字符串类型的字段，不存在或类型不匹配时返回空字符串.
+func ClaimString(claims jwt.MapClaims, key string) string {
+	value, _ := claims[key].(string)
+	return value
+}
+
+// SkipRequest 判断请求路径是否在 WithSkipPaths 等选项配置的跳过认证路径中.
+func SkipRequest(ctx context.Context) bool {
+	return shouldSkipRequestPath(ctx)
+}
+
 // shouldSkipRequestPath 检查请求路径是否应该跳过认证
 func shouldSkipRequestPath(ctx context.Context) bool {
 	switch typed := ctx.(type) {
@@ -387,8 +474,9 @@ func parseAuthorizationHeader(header string) (string, error) {
 	return token, nil
 }
 
-// Sign 使用 jwtSecret 签发 Access Token 和 Refresh Token 对
-func Sign(identityValue string) (accessToken, refreshToken string, accessExpireAt, refreshExpireAt time.Time, err error) {
+// Sign 使用 jwtSecret 签发 Access Token 和 Refresh Token 对.
+// 可以通过 opts 向两个 token 写入额外的 claims（例如会话 ID）.
+func Sign(identityValue string, opts ...SignOption) (accessToken, refreshToken string, accessExpireAt, refreshExpireAt time.Time, err error) {
 	if config.key == "" {
 		return "", "", time.Time{}, time.Time{}, jwt.ErrInvalidKey
 	}
@@ -407,6 +495,9 @@ func Sign(identityValue string) (accessToken, refreshToken string, accessExpireA
 	if config.identityKey != "" && identityValue != "" {
 		accessClaims[config.identityKey] = identityValue
 	}
+	for _, opt := range opts {
+		opt(accessClaims)
+	}
 
 	accessTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
 	accessToken, err = accessTokenObj.SignedString([]byte(config.key))
@@ -424,6 +515,9 @@ func Sign(identityValue string) (accessToken, refreshToken string, accessExpireA
 	if config.identityKey != "" && identityValue != "" {
 		refreshClaims[config.identityKey] = identityValue
 	}
+	for _, opt := range opts {
+		opt(refreshClaims)
+	}
 
 	refreshTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
 	refreshToken, err = refreshTokenObj.SignedString([]byte(config.key))
@@ -434,6 +528,54 @@ func Sign(identityValue string) (accessToken, refreshToken string, accessExpireA
 	return accessToken, refreshToken, accessExpireAt, refreshExpireAt, nil
 }
 
+// SignImpersonation 签发管理员模拟登录使用的 Access Token.
+// token 的身份为被模拟用户 identityValue，act 声明记录实际操作者 actor；
+// 有效期为 impersonationExpiration，且不签发 Refresh Token，过期后必须重新发起模拟.
+func SignImpersonation(identityValue, actor string, opts ...SignOption) (string, time.Time, error) {
+	if config.key == "" {
+		return "", time.Time{}, jwt.ErrInvalidKey
+	}
+	if identityValue == "" || actor == "" {
+		return "", time.Time{}, ErrInvalidTokenClaims
+	}
+
+	now := time.Now()
+	expireAt := now.Add(config.impersonationExpiration)
+
+	claims := jwt.MapClaims{
+		"token_type": TokenTypeAccess,
+		"sub":        identityValue,
+		"nbf":        now.Unix(),
+		"iat":        now.Unix(),
+		"exp":        expireAt.Unix(),
+	}
+	if config.identityKey != "" {
+		claims[config.identityKey] = identityValue
+	}
+	for _, opt := range opts {
+		opt(claims)
+	}
+	// act 声明最后写入，避免被 opts 覆盖
+	claims[ClaimActor] = map[string]any{"sub": actor}
+
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.key))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign impersonation token: %w", err)
+	}
+
+	return tokenString, expireAt, nil
+}
+
+// Actor 返回 claims 中 act 声明记录的实际操作者 ID，非模拟登录的 token 返回空字符串.
+func Actor(claims jwt.MapClaims) string {
+	act, ok := claims[ClaimActor].(map[string]any)
+	if !ok {
+		return ""
+	}
+	sub, _ := act["sub"].(string)
+	return sub
+}
+
 // SignWithClaims 使用自定义 claims 签发 token（使用 accessExpiration）
 func SignWithClaims(customClaims jwt.MapClaims) (string, time.Time, error) {
 	if config.key == "" {
@@ -551,6 +693,11 @@ func GetRefreshExpiration() time.Duration {
 	return config.refreshExpiration
 }
 
+// GetImpersonationExpiration 获取模拟登录 Access Token 过期时间
+func GetImpersonationExpiration() time.Duration {
+	return config.impersonationExpiration
+}
+
 // GetSkipPaths 获取跳过认证的路径列表
 func GetSkipPaths() []string {
 	return append([]string{}, config.skipPaths...) // 返回副本
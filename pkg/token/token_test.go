@@ -93,6 +93,58 @@ func TestParseRefreshToken(t *testing.T) {
 	assert.Equal(t, ErrNotRefreshToken, err)
 }
 
+// TestSignWithSessionID 测试签发携带会话 ID 的 token
+func TestSignWithSessionID(t *testing.T) {
+	identityKey := "testUser"
+	accessToken, refreshToken, _, _, err := Sign(identityKey, WithSessionID("session-1"))
+	assert.NoError(t, err)
+
+	// Access Token 与 Refresh Token 共享同一个会话 ID
+	claims, err := GetClaims(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "session-1", ClaimString(claims, ClaimSessionID))
+
+	parsedIdentityKey, refreshClaims, err := ParseRefreshTokenClaims(refreshToken)
+	assert.NoError(t, err)
+	assert.Equal(t, identityKey, parsedIdentityKey)
+	assert.Equal(t, "session-1", ClaimString(refreshClaims, ClaimSessionID))
+
+	// 使用 Access Token 调用 ParseRefreshTokenClaims 应该失败
+	_, _, err = ParseRefreshTokenClaims(accessToken)
+	assert.Equal(t, ErrNotRefreshToken, err)
+}
+
+// TestSignImpersonation 测试签发模拟登录 token
+func TestSignImpersonation(t *testing.T) {
+	accessToken, expireAt, err := SignImpersonation("targetUser", "adminUser", WithSessionID("session-2"))
+	assert.NoError(t, err)
+	assert.True(t, expireAt.After(time.Now()))
+	assert.True(t, expireAt.Before(time.Now().Add(GetAccessExpiration())))
+
+	// 身份为被模拟用户，act 声明记录实际操作者
+	parsedIdentityKey, err := ParseIdentity(accessToken, config.key)
+	assert.NoError(t, err)
+	assert.Equal(t, "targetUser", parsedIdentityKey)
+	assert.True(t, IsAccessToken(accessToken))
+
+	claims, err := GetClaims(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "targetUser", ClaimString(claims, "sub"))
+	assert.Equal(t, "adminUser", Actor(claims))
+	assert.Equal(t, "session-2", ClaimString(claims, ClaimSessionID))
+
+	// 普通 token 不携带 act 声明
+	normalToken, _, _, _, err := Sign("targetUser")
+	assert.NoError(t, err)
+	normalClaims, err := GetClaims(normalToken)
+	assert.NoError(t, err)
+	assert.Empty(t, Actor(normalClaims))
+
+	// 缺少操作者时拒绝签发
+	_, _, err = SignImpersonation("targetUser", "")
+	assert.Equal(t, ErrInvalidTokenClaims, err)
+}
+
 // TestParseInvalidToken 测试解析无效的 token
 func TestParseInvalidToken(t *testing.T) {
 	invalidToken := "invalid.token.string"